@@ -66,6 +66,13 @@ const (
 	// contains resources that should not be deleted.
 	AnnotationKeyKeepNamespace = "kargo.akuity.io/keep-namespace"
 
+	// AnnotationKeyPromotionWindowOverride is an annotation key that can be set
+	// on a Stage to temporarily lift the promotion windows and blackouts of the
+	// PromotionPolicy applicable to it, e.g. to promote an emergency fix during
+	// a change freeze. The value must be an RFC 3339 timestamp, until which the
+	// override is in effect. Values that cannot be parsed are ignored.
+	AnnotationKeyPromotionWindowOverride = "kargo.akuity.io/promotion-window-override"
+
 	// AnnotationValueTrue is the value used to indicate that an annotation
 	// is set to true.
 	AnnotationValueTrue = "true"
@@ -37,12 +37,13 @@ const (
 )
 
 const (
-	EventActorAdmin                = "admin"
-	EventActorControllerPrefix     = "controller:"
-	EventActorEmailPrefix          = "email:"
-	EventActorSubjectPrefix        = "subject:"
-	EventActorKubernetesUserPrefix = "kubernetes:"
-	EventActorUnknown              = "unknown actor"
+	EventActorAdmin                 = "admin"
+	EventActorControllerPrefix      = "controller:"
+	EventActorEmailPrefix           = "email:"
+	EventActorSubjectPrefix         = "subject:"
+	EventActorKubernetesUserPrefix  = "kubernetes:"
+	EventActorWebhookReceiverPrefix = "webhook-receiver:"
+	EventActorUnknown               = "unknown actor"
 )
 
 type EventType string
@@ -114,6 +114,11 @@ type FreightStatus struct {
 	// might wish to promote a piece of Freight to a given Stage without
 	// transiting the entire pipeline.
 	ApprovedFor map[string]ApprovedStage `json:"approvedFor,omitempty" protobuf:"bytes,2,rep,name=approvedFor" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// PendingApprovals describes approvals that have been recorded for Stages
+	// whose ApprovalPolicy requires more distinct approvers than have approved
+	// the Freight so far. Once enough approvals have been recorded for a Stage,
+	// they are moved to the corresponding entry in ApprovedFor.
+	PendingApprovals map[string]PendingApproval `json:"pendingApprovals,omitempty" protobuf:"bytes,5,rep,name=pendingApprovals" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// Metadata is a map of arbitrary metadata associated with the Freight.
 	// This is useful for storing additional information about the Freight
 	// or Promotion that can be shared across steps or stages.
@@ -236,6 +241,67 @@ func (f *FreightStatus) AddApprovedStage(stage string, approvedAt time.Time) {
 	}
 }
 
+// AddApproval records the provided approval of the Freight for the specified
+// Stage. Once the number of distinct approvals recorded for the Stage reaches
+// requiredApprovals, the Freight is marked as approved for the Stage. It
+// returns true if the Freight is approved for the Stage after the approval has
+// been recorded. Approvals by an approver who has already approved the Freight
+// for the Stage, or for a Stage the Freight is already approved for, are
+// ignored.
+func (f *FreightStatus) AddApproval(
+	stage string,
+	approval FreightApproval,
+	requiredApprovals int,
+) bool {
+	if _, approved := f.ApprovedFor[stage]; approved {
+		return true
+	}
+	pending := f.PendingApprovals[stage]
+	for _, a := range pending.Approvals {
+		if a.Approver == approval.Approver {
+			return false
+		}
+	}
+	pending.Approvals = append(pending.Approvals, approval)
+	if len(pending.Approvals) < requiredApprovals {
+		if f.PendingApprovals == nil {
+			f.PendingApprovals = map[string]PendingApproval{}
+		}
+		f.PendingApprovals[stage] = pending
+		return false
+	}
+	delete(f.PendingApprovals, stage)
+	if f.ApprovedFor == nil {
+		f.ApprovedFor = map[string]ApprovedStage{}
+	}
+	f.ApprovedFor[stage] = ApprovedStage{
+		ApprovedAt: approval.ApprovedAt,
+		Approvals:  pending.Approvals,
+	}
+	return true
+}
+
+// GetApprovals returns all approvals that have been recorded for the specified
+// Stage, regardless of whether enough have been recorded for the Freight to be
+// approved for the Stage.
+func (f *FreightStatus) GetApprovals(stage string) []FreightApproval {
+	if approved, ok := f.ApprovedFor[stage]; ok {
+		return approved.Approvals
+	}
+	return f.PendingApprovals[stage].Approvals
+}
+
+// HasApprovalFrom returns whether the specified approver has approved the
+// Freight for the specified Stage.
+func (f *FreightStatus) HasApprovalFrom(stage string, approver string) bool {
+	for _, a := range f.GetApprovals(stage) {
+		if a.Approver == approver {
+			return true
+		}
+	}
+	return false
+}
+
 // UpsertMetadata inserts or updates the given key in Freight status Metadata
 func (f *FreightStatus) UpsertMetadata(key string, data any) error {
 	if len(f.Metadata) == 0 {
@@ -295,6 +361,29 @@ type VerifiedStage struct {
 type ApprovedStage struct {
 	// ApprovedAt is the time at which the Freight was approved for the Stage.
 	ApprovedAt *metav1.Time `json:"approvedAt,omitempty" protobuf:"bytes,1,opt,name=approvedAt"`
+	// Approvals records the individual approvals that resulted in the Freight
+	// being approved for the Stage.
+	Approvals []FreightApproval `json:"approvals,omitempty" protobuf:"bytes,2,rep,name=approvals"`
+}
+
+// PendingApproval describes the approvals that have been recorded for a Stage
+// for which Freight has not yet received the number of approvals required by
+// the Stage's ApprovalPolicy.
+type PendingApproval struct {
+	// Approvals records the individual approvals recorded so far.
+	Approvals []FreightApproval `json:"approvals,omitempty" protobuf:"bytes,1,rep,name=approvals"`
+}
+
+// FreightApproval records a single approval of Freight for a Stage.
+type FreightApproval struct {
+	// Approver identifies who approved the Freight.
+	Approver string `json:"approver" protobuf:"bytes,1,opt,name=approver"`
+	// ApprovedAt is the time at which the approval was recorded.
+	ApprovedAt *metav1.Time `json:"approvedAt,omitempty" protobuf:"bytes,2,opt,name=approvedAt"`
+	// Comment is an optional comment provided by the approver.
+	//
+	// +optional
+	Comment string `json:"comment,omitempty" protobuf:"bytes,3,opt,name=comment"`
 }
 
 // +kubebuilder:object:root=true
@@ -468,6 +468,47 @@ func TestFreightStatus_AddApprovedStage(t *testing.T) {
 	})
 }
 
+func TestFreightStatus_AddApproval(t *testing.T) {
+	const testStage = "fake-stage"
+	now := &metav1.Time{Time: time.Now()}
+	t.Run("already approved", func(t *testing.T) {
+		status := FreightStatus{
+			ApprovedFor: map[string]ApprovedStage{testStage: {}},
+		}
+		require.True(t, status.AddApproval(testStage, FreightApproval{Approver: "alice"}, 2))
+		require.Empty(t, status.ApprovedFor[testStage].Approvals)
+		require.Empty(t, status.PendingApprovals)
+	})
+	t.Run("single approval required", func(t *testing.T) {
+		status := FreightStatus{}
+		approval := FreightApproval{Approver: "alice", ApprovedAt: now, Comment: "lgtm"}
+		require.True(t, status.AddApproval(testStage, approval, 1))
+		record, approved := status.ApprovedFor[testStage]
+		require.True(t, approved)
+		require.Equal(t, now, record.ApprovedAt)
+		require.Equal(t, []FreightApproval{approval}, record.Approvals)
+	})
+	t.Run("multiple approvals required", func(t *testing.T) {
+		status := FreightStatus{}
+		alice := FreightApproval{Approver: "alice", ApprovedAt: now}
+		bob := FreightApproval{Approver: "bob", ApprovedAt: now}
+		require.False(t, status.AddApproval(testStage, alice, 2))
+		require.NotContains(t, status.ApprovedFor, testStage)
+		require.Equal(t, []FreightApproval{alice}, status.PendingApprovals[testStage].Approvals)
+		require.True(t, status.HasApprovalFrom(testStage, "alice"))
+
+		// A repeated approval from the same approver is not counted
+		require.False(t, status.AddApproval(testStage, alice, 2))
+		require.Len(t, status.PendingApprovals[testStage].Approvals, 1)
+
+		require.True(t, status.AddApproval(testStage, bob, 2))
+		require.NotContains(t, status.PendingApprovals, testStage)
+		require.Equal(t, []FreightApproval{alice, bob}, status.ApprovedFor[testStage].Approvals)
+		require.True(t, status.HasApprovalFrom(testStage, "bob"))
+		require.False(t, status.HasApprovalFrom(testStage, "carol"))
+	})
+}
+
 func TestFreightStatus_UpsertMetadata(t *testing.T) {
 	testCases := []struct {
 		name         string
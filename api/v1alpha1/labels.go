@@ -24,6 +24,10 @@ const (
 	// credentials. A Secret with this label value is expected to contain
 	// credentials for a container image registry.
 	LabelValueCredentialTypeImage = "image"
+	// LabelValueCredentialTypeS3 is the value for S3-compatible object store
+	// credentials. A Secret with this label value is expected to contain an
+	// access key ID and secret access key for an S3-compatible object store.
+	LabelValueCredentialTypeS3 = "s3"
 	// LabelValueCredentialTypeGeneric is the value for generic credentials.
 	// A Secret with this label can contain any type of credential, and is
 	// allowed to be managed through the Kargo API.
@@ -35,6 +35,14 @@ type ProjectConfigSpec struct {
 	// WebhookReceivers describes Project-specific webhook receivers used for
 	// processing events from various external platforms
 	WebhookReceivers []WebhookReceiverConfig `json:"webhookReceivers,omitempty" protobuf:"bytes,2,rep,name=webhookReceivers"`
+	// NotificationSinks describes Project-specific destinations to which
+	// notifications about events pertaining to the Project (e.g. Promotions
+	// succeeding or failing, or Freight being verified or approved) are
+	// delivered.
+	//
+	// +listType=map
+	// +listMapKey=name
+	NotificationSinks []NotificationSinkConfig `json:"notificationSinks,omitempty" protobuf:"bytes,3,rep,name=notificationSinks"`
 }
 
 // ProjectConfigStatus describes the current status of a ProjectConfig.
@@ -58,6 +66,9 @@ type ProjectConfigStatus struct {
 	// WebhookReceivers describes the status of Project-specific webhook
 	// receivers.
 	WebhookReceivers []WebhookReceiverDetails `json:"webhookReceivers,omitempty" protobuf:"bytes,2,rep,name=webhookReceivers"`
+	// NotificationSinks describes the status of notification delivery to
+	// Project-specific notification sinks.
+	NotificationSinks []NotificationSinkStatus `json:"notificationSinks,omitempty" protobuf:"bytes,5,rep,name=notificationSinks"`
 }
 
 // GetConditions implements the conditions.Getter interface.
@@ -92,6 +103,74 @@ type PromotionPolicy struct {
 	// users to define Stages that are automatically updated as soon as new
 	// artifacts are detected.
 	AutoPromotionEnabled bool `json:"autoPromotionEnabled,omitempty" protobuf:"varint,2,opt,name=autoPromotionEnabled"`
+	// PromotionWindows defines recurring windows of time during which Freight
+	// may be promoted into the Stage. When any windows are defined, promotions
+	// into the Stage, whether automatic or manual, are only permitted while at
+	// least one of them is open. When no windows are defined, promotions are
+	// permitted at any time not covered by a blackout.
+	//
+	// +optional
+	PromotionWindows []PromotionWindow `json:"promotionWindows,omitempty" protobuf:"bytes,4,rep,name=promotionWindows"`
+	// Blackouts defines periods of time during which Freight may not be
+	// promoted into the Stage, regardless of any PromotionWindows. This is
+	// useful for implementing change freezes, e.g. over holidays.
+	//
+	// +optional
+	Blackouts []PromotionBlackout `json:"blackouts,omitempty" protobuf:"bytes,5,rep,name=blackouts"`
+}
+
+// PromotionWindow describes a recurring window of time during which Freight
+// may be promoted into a Stage.
+type PromotionWindow struct {
+	// Name is a name for the window. It is used to identify the window when
+	// reporting why a promotion was or was not permitted.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Schedule is a standard five-field cron expression (minute, hour,
+	// day-of-month, month, day-of-week) describing when the window opens. e.g.
+	// "0 9 * * 1-5" opens the window at 09:00 on every weekday. The descriptors
+	// @yearly, @monthly, @weekly, @daily, and @hourly are also supported.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule" protobuf:"bytes,2,opt,name=schedule"`
+	// Duration is how long the window remains open each time it opens.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
+	// +akuity:test-kubebuilder-pattern=Duration
+	Duration metav1.Duration `json:"duration" protobuf:"bytes,3,opt,name=duration"`
+	// TimeZone is the IANA name of the time zone in which the Schedule is
+	// interpreted, e.g. "America/New_York". If not specified, UTC is used.
+	//
+	// +optional
+	TimeZone string `json:"timeZone,omitempty" protobuf:"bytes,4,opt,name=timeZone"`
+}
+
+// PromotionBlackout describes a period of time during which Freight may not be
+// promoted into a Stage.
+type PromotionBlackout struct {
+	// Name is a name for the blackout. It is used to identify the blackout when
+	// reporting why a promotion was not permitted.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Start is the time at which the blackout begins.
+	//
+	// +kubebuilder:validation:Required
+	Start metav1.Time `json:"start" protobuf:"bytes,2,opt,name=start"`
+	// End is the time at which the blackout ends. It must be after Start.
+	//
+	// +kubebuilder:validation:Required
+	End metav1.Time `json:"end" protobuf:"bytes,3,opt,name=end"`
+	// Reason is an optional, human-readable explanation of the blackout.
+	//
+	// +optional
+	Reason string `json:"reason,omitempty" protobuf:"bytes,4,opt,name=reason"`
 }
 
 // WebhookReceiverConfig describes the configuration for a single webhook
@@ -348,11 +427,12 @@ type AzureWebhookReceiverConfig struct {
 // configured to respond to any arbitrary POST by applying user-defined actions
 // on user-defined sets of resources selected by name, labels and/or values in pre-built indices.
 // Both types of selectors support using values extracted from the request by
-// means of expressions. Currently, refreshing resources is the only supported
-// action and Warehouse is the only supported kind. "Refreshing" means
+// means of expressions. Warehouses may be refreshed. "Refreshing" means
 // immediately enqueuing the target resource for reconciliation by its
 // controller. The practical effect of refreshing a Warehouses is triggering its
-// artifact discovery process.
+// artifact discovery process. Stages may have Freight promoted into them, may
+// have Freight approved for them, or may have the verification of their current
+// Freight re-run.
 type GenericWebhookReceiverConfig struct {
 	// SecretRef contains a reference to a Secret. For Project-scoped webhook
 	// receivers, the referenced Secret must be in the same namespace as the
@@ -378,10 +458,10 @@ type GenericWebhookReceiverConfig struct {
 // GenericWebhookAction describes an action to be performed on a resource
 // and the conditions under which it should be performed.
 type GenericWebhookAction struct {
-	// ActionType indicates the type of action to be performed. `Refresh` is the
-	// only currently supported action.
+	// ActionType indicates the type of action to be performed. `Refresh` applies
+	// to Warehouses. `Promote`, `Approve`, and `Reverify` apply to Stages.
 	//
-	// +kubebuilder:validation:Enum=Refresh;
+	// +kubebuilder:validation:Enum=Refresh;Promote;Approve;Reverify;
 	ActionType GenericWebhookActionType `json:"action" protobuf:"bytes,1,opt,name=action"`
 
 	// WhenExpression defines criteria that a request must meet to run this
@@ -391,7 +471,9 @@ type GenericWebhookAction struct {
 	WhenExpression string `json:"whenExpression,omitempty" protobuf:"bytes,2,opt,name=whenExpression"`
 
 	// Parameters contains additional, action-specific parameters. Values may be
-	// static or extracted from the request using expressions.
+	// static or extracted from the request using expressions. The `Promote` and
+	// `Approve` actions require exactly one of the `freight`, `freightAlias`, or
+	// `freightExpression` parameters to identify the Freight to act upon.
 	//
 	// +optional
 	Parameters map[string]string `json:"parameters,omitempty" protobuf:"bytes,3,rep,name=parameters" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
@@ -409,6 +491,15 @@ type GenericWebhookActionType string
 const (
 	// GenericWebhookActionTypeRefresh indicates a request to refresh the resource.
 	GenericWebhookActionTypeRefresh GenericWebhookActionType = "Refresh"
+	// GenericWebhookActionTypePromote indicates a request to promote Freight
+	// into a Stage.
+	GenericWebhookActionTypePromote GenericWebhookActionType = "Promote"
+	// GenericWebhookActionTypeApprove indicates a request to approve Freight for
+	// promotion into a Stage.
+	GenericWebhookActionTypeApprove GenericWebhookActionType = "Approve"
+	// GenericWebhookActionTypeReverify indicates a request to re-run the
+	// verification of the Freight currently in use by a Stage.
+	GenericWebhookActionTypeReverify GenericWebhookActionType = "Reverify"
 )
 
 // GenericWebhookTargetSelectionCriteria describes selection criteria for resources to which some
@@ -418,7 +509,7 @@ const (
 type GenericWebhookTargetSelectionCriteria struct {
 	// Kind is the kind of the target resource.
 	//
-	// +kubebuilder:validation:Enum=Warehouse;
+	// +kubebuilder:validation:Enum=Warehouse;Stage;
 	Kind GenericWebhookTargetKind `json:"kind" protobuf:"bytes,1,opt,name=kind"`
 
 	// Name is the name of the target resource. If LabelSelector and/or IndexSelectors
@@ -445,6 +536,7 @@ type GenericWebhookTargetKind string
 
 const (
 	GenericWebhookTargetKindWarehouse GenericWebhookTargetKind = "Warehouse"
+	GenericWebhookTargetKindStage     GenericWebhookTargetKind = "Stage"
 )
 
 // IndexSelector defines selection criteria that match resources on the basis of
@@ -495,6 +587,124 @@ type WebhookReceiverDetails struct {
 	URL string `json:"url,omitempty" protobuf:"bytes,4,opt,name=url"`
 }
 
+// NotificationSinkConfig describes a single destination to which
+// notifications about events pertaining to a Project are delivered.
+//
+// +kubebuilder:validation:XValidation:message="NotificationSinkConfig must have exactly one of webhook, cloudEvents, slack, or msTeams set",rule="[has(self.webhook), has(self.cloudEvents), has(self.slack), has(self.msTeams)].exists_one(x, x)"
+type NotificationSinkConfig struct {
+	// Name is the name of the notification sink.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +akuity:test-kubebuilder-pattern=KubernetesName
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Filter is an expression that an event must satisfy to be delivered to
+	// this sink. The event is available to the expression as `event`, with
+	// `type`, `kind`, `name`, `project`, `id`, `message` and `data` fields. For
+	// example: `event.type in ["PromotionSucceeded", "PromotionFailed"]`. If
+	// empty, all events are delivered to this sink.
+	//
+	// +optional
+	Filter string `json:"filter,omitempty" protobuf:"bytes,2,opt,name=filter"`
+	// Message is a template for the human-readable message included in
+	// notifications delivered to this sink. It may contain expressions enclosed
+	// in `${{` and `}}`, which have access to the same `event` as Filter. If
+	// empty, the event's own message is used.
+	//
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+	// Webhook contains the configuration for a sink that POSTs a generic JSON
+	// representation of each event to an arbitrary URL.
+	Webhook *WebhookNotificationSinkConfig `json:"webhook,omitempty" protobuf:"bytes,4,opt,name=webhook"`
+	// CloudEvents contains the configuration for a sink that POSTs each event
+	// to an arbitrary URL as a CloudEvent in structured content mode.
+	CloudEvents *CloudEventsNotificationSinkConfig `json:"cloudEvents,omitempty" protobuf:"bytes,5,opt,name=cloudEvents"`
+	// Slack contains the configuration for a sink that POSTs the message for
+	// each event to a Slack incoming webhook.
+	Slack *SlackNotificationSinkConfig `json:"slack,omitempty" protobuf:"bytes,6,opt,name=slack"`
+	// MSTeams contains the configuration for a sink that POSTs the message for
+	// each event to a Microsoft Teams incoming webhook.
+	MSTeams *MSTeamsNotificationSinkConfig `json:"msTeams,omitempty" protobuf:"bytes,7,opt,name=msTeams"`
+}
+
+// WebhookNotificationSinkConfig describes a notification sink that POSTs a
+// generic JSON representation of each event to an arbitrary URL.
+type WebhookNotificationSinkConfig struct {
+	// SecretRef contains a reference to a Secret in the same namespace as the
+	// ProjectConfig.
+	//
+	// The Secret's data map is expected to contain a `url` key whose value is
+	// the URL to which events are delivered. It may optionally contain an
+	// `authorization` key whose value is used as the value of the
+	// Authorization header of each request.
+	//
+	// +kubebuilder:validation:Required
+	SecretRef corev1.LocalObjectReference `json:"secretRef" protobuf:"bytes,1,opt,name=secretRef"`
+}
+
+// CloudEventsNotificationSinkConfig describes a notification sink that POSTs
+// each event to an arbitrary URL as a CloudEvent.
+type CloudEventsNotificationSinkConfig struct {
+	// SecretRef contains a reference to a Secret in the same namespace as the
+	// ProjectConfig.
+	//
+	// The Secret's data map is expected to contain a `url` key whose value is
+	// the URL to which events are delivered. It may optionally contain an
+	// `authorization` key whose value is used as the value of the
+	// Authorization header of each request.
+	//
+	// +kubebuilder:validation:Required
+	SecretRef corev1.LocalObjectReference `json:"secretRef" protobuf:"bytes,1,opt,name=secretRef"`
+}
+
+// SlackNotificationSinkConfig describes a notification sink that POSTs the
+// message for each event to a Slack incoming webhook.
+type SlackNotificationSinkConfig struct {
+	// SecretRef contains a reference to a Secret in the same namespace as the
+	// ProjectConfig.
+	//
+	// The Secret's data map is expected to contain a `url` key whose value is
+	// the URL of the Slack incoming webhook. For more information please refer
+	// to the Slack documentation:
+	//   https://api.slack.com/messaging/webhooks
+	//
+	// +kubebuilder:validation:Required
+	SecretRef corev1.LocalObjectReference `json:"secretRef" protobuf:"bytes,1,opt,name=secretRef"`
+}
+
+// MSTeamsNotificationSinkConfig describes a notification sink that POSTs the
+// message for each event to a Microsoft Teams incoming webhook.
+type MSTeamsNotificationSinkConfig struct {
+	// SecretRef contains a reference to a Secret in the same namespace as the
+	// ProjectConfig.
+	//
+	// The Secret's data map is expected to contain a `url` key whose value is
+	// the URL of the Microsoft Teams incoming webhook.
+	//
+	// +kubebuilder:validation:Required
+	SecretRef corev1.LocalObjectReference `json:"secretRef" protobuf:"bytes,1,opt,name=secretRef"`
+}
+
+// NotificationSinkStatus describes the status of notification delivery to a
+// single notification sink.
+type NotificationSinkStatus struct {
+	// Name is the name of the notification sink.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// LastDeliveryTime is the time at which a notification was last
+	// successfully delivered to the sink.
+	LastDeliveryTime *metav1.Time `json:"lastDeliveryTime,omitempty" protobuf:"bytes,2,opt,name=lastDeliveryTime"`
+	// LastFailureTime is the time at which delivery of a notification to the
+	// sink last failed after all retries were exhausted.
+	LastFailureTime *metav1.Time `json:"lastFailureTime,omitempty" protobuf:"bytes,3,opt,name=lastFailureTime"`
+	// LastFailureMessage describes the most recent delivery failure.
+	LastFailureMessage string `json:"lastFailureMessage,omitempty" protobuf:"bytes,4,opt,name=lastFailureMessage"`
+	// ConsecutiveFailures is the number of notifications that have failed to be
+	// delivered to the sink since the last successful delivery.
+	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty" protobuf:"varint,5,opt,name=consecutiveFailures"`
+}
+
 // PromotionPolicySelector is a selector that matches the resource to which
 // this policy applies. It can be used to match a specific resource by name or
 // to match a set of resources by label.
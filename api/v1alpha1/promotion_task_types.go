@@ -30,8 +30,15 @@ type PromotionTaskSpec struct {
 	//
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinItems=1
-	// +kubebuilder:validation:items:XValidation:message="PromotionTask step must have uses set and must not reference another task",rule="has(self.uses) && !has(self.task)"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTask step must have one of uses or parallel set and must not reference another task",rule="[has(self.uses), has(self.parallel)].exists_one(x, x) && !has(self.task)"
 	Steps []PromotionStep `json:"steps" protobuf:"bytes,2,rep,name=steps"`
+	// OnFailure specifies the directives to be executed when the steps of a
+	// Promotion referencing this PromotionTask do not succeed. The directives
+	// as defined here are inflated into the onFailure steps of the Promotion.
+	//
+	// +optional
+	// +kubebuilder:validation:items:XValidation:message="PromotionTask step must have one of uses or parallel set and must not reference another task",rule="[has(self.uses), has(self.parallel)].exists_one(x, x) && !has(self.task)"
+	OnFailure []PromotionStep `json:"onFailure,omitempty" protobuf:"bytes,3,rep,name=onFailure"`
 }
 
 // +kubebuilder:object:root=true
@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -135,6 +136,7 @@ func (s PromotionStepStatus) Compare(other PromotionStepStatus) int {
 // +kubebuilder:printcolumn:name=Stage,type=string,JSONPath=`.spec.stage`
 // +kubebuilder:printcolumn:name=Freight,type=string,JSONPath=`.spec.freight`
 // +kubebuilder:printcolumn:name=Phase,type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name=DryRun,type=boolean,JSONPath=`.spec.dryRun`,priority=1
 // +kubebuilder:printcolumn:name=Age,type=date,JSONPath=`.metadata.creationTimestamp`
 
 // Promotion represents a request to transition a particular Stage into a
@@ -187,8 +189,26 @@ type PromotionSpec struct {
 	//
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinItems=1
-	// +kubebuilder:validation:items:XValidation:message="Promotion step must have uses set and must not reference a task",rule="has(self.uses) && !has(self.task)"
+	// +kubebuilder:validation:items:XValidation:message="Promotion step must have one of uses or parallel set and must not reference a task",rule="[has(self.uses), has(self.parallel)].exists_one(x, x) && !has(self.task)"
 	Steps []PromotionStep `json:"steps" protobuf:"bytes,3,rep,name=steps"`
+	// OnFailure specifies the directives to be executed when the Steps of this
+	// Promotion do not succeed, i.e. when the Promotion would otherwise end in a
+	// Failed, Errored, or Aborted phase. The Promotion remains Running until
+	// these directives have been executed, after which it ends in the phase
+	// determined by its Steps.
+	//
+	// +optional
+	// +kubebuilder:validation:items:XValidation:message="Promotion step must have one of uses or parallel set and must not reference a task",rule="[has(self.uses), has(self.parallel)].exists_one(x, x) && !has(self.task)"
+	OnFailure []PromotionStep `json:"onFailure,omitempty" protobuf:"bytes,5,rep,name=onFailure"`
+	// DryRun indicates whether this Promotion is a dry run. The steps of a dry
+	// run that would affect anything outside of the Promotion's working
+	// directory, such as pushing to a remote Git repository or updating an
+	// Argo CD Application, are planned instead of executed. The outcome of the
+	// dry run is recorded in the Promotion's status and does not affect the
+	// state of the Stage.
+	//
+	// +optional
+	DryRun bool `json:"dryRun,omitempty" protobuf:"varint,6,opt,name=dryRun"`
 }
 
 // PromotionTaskReference describes a reference to a PromotionTask.
@@ -299,6 +319,49 @@ type PromotionStep struct {
 	// expressions in defining values at any level of this block.
 	// See https://docs.kargo.io/user-guide/reference-docs/expressions for details.
 	Config *apiextensionsv1.JSON `json:"config,omitempty" protobuf:"bytes,3,opt,name=config"`
+	// Parallel is a list of steps to be executed concurrently. When specified,
+	// this step acts as a group that is complete only once all of its steps
+	// are complete and it MUST NOT specify Uses, Task, or Config. The outcome
+	// of the group is the worst outcome of its steps, disregarding any steps
+	// that have ContinueOnError set to true.
+	Parallel []ParallelPromotionStep `json:"parallel,omitempty" protobuf:"bytes,9,rep,name=parallel"`
+	// ForEach is an optional expression that, if present, must evaluate to a
+	// list. When the Promotion is created, the step (or the steps of the
+	// referenced PromotionTask) is expanded once per item in the list. Within
+	// the expanded steps, the item and its index are available to expressions
+	// as `item` and `index`, and the alias of each expansion is suffixed with
+	// "-<index>". The expression has access to the variables and the context
+	// of the Promotion, but not to the outputs of other steps. It MUST NOT be
+	// combined with Parallel.
+	ForEach string `json:"forEach,omitempty" protobuf:"bytes,10,opt,name=forEach"`
+	// Iteration is set on steps that have been expanded from a step with a
+	// ForEach expression. It holds the item the step was expanded for. This
+	// field is set by Kargo when the Promotion is created.
+	//
+	// +optional
+	Iteration *PromotionStepIteration `json:"iteration,omitempty" protobuf:"bytes,11,opt,name=iteration"`
+}
+
+// PromotionStepIteration describes a single item of the list a PromotionStep
+// with a ForEach expression has been expanded for.
+type PromotionStepIteration struct {
+	// Index is the index of the item in the list.
+	Index int64 `json:"index" protobuf:"varint,1,opt,name=index"`
+	// Item is the item.
+	Item *apiextensionsv1.JSON `json:"item,omitempty" protobuf:"bytes,2,opt,name=item"`
+}
+
+// GetItem returns the Item field as unmarshalled JSON.
+func (i *PromotionStepIteration) GetItem() any {
+	if i == nil || i.Item == nil {
+		return nil
+	}
+
+	var item any
+	if err := json.Unmarshal(i.Item.Raw, &item); err != nil {
+		return nil
+	}
+	return item
 }
 
 // GetAlias returns the As field, or a default value in the form of "step-<i>"
@@ -316,6 +379,76 @@ func (s *PromotionStep) GetAlias(i int) string {
 	}
 }
 
+// GetParallelSteps returns the steps of a parallel group as PromotionSteps.
+// Variables of the group are prepended to the variables of each step. If
+// this step is not a parallel group, nil is returned.
+func (s *PromotionStep) GetParallelSteps() []PromotionStep {
+	if len(s.Parallel) == 0 {
+		return nil
+	}
+	steps := make([]PromotionStep, len(s.Parallel))
+	for i, step := range s.Parallel {
+		var vars []ExpressionVariable
+		if len(s.Vars) > 0 || len(step.Vars) > 0 {
+			vars = make([]ExpressionVariable, 0, len(s.Vars)+len(step.Vars))
+			vars = append(append(vars, s.Vars...), step.Vars...)
+		}
+		steps[i] = PromotionStep{
+			Uses:            step.Uses,
+			As:              step.As,
+			If:              step.If,
+			ContinueOnError: step.ContinueOnError,
+			Retry:           step.Retry,
+			Vars:            vars,
+			Config:          step.Config,
+		}
+	}
+	return steps
+}
+
+// ParallelPromotionStep describes a directive to be executed concurrently
+// with the other steps of a parallel group.
+type ParallelPromotionStep struct {
+	// Uses identifies a runner that can execute this step.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Uses string `json:"uses" protobuf:"bytes,1,opt,name=uses"`
+	// As is the alias this step can be referred to as. It MUST be unique
+	// amongst all steps of the Promotion.
+	As string `json:"as,omitempty" protobuf:"bytes,2,opt,name=as"`
+	// If is an optional expression that, if present, must evaluate to a boolean
+	// value. If the expression evaluates to false, the step will be skipped.
+	// If the expression does not evaluate to a boolean value, the step will be
+	// considered to have failed.
+	If string `json:"if,omitempty" protobuf:"bytes,3,opt,name=if"`
+	// ContinueOnError is a boolean value that, if set to true, will not permit
+	// a failure of this step to impact the outcome of the parallel group it
+	// belongs to.
+	ContinueOnError bool `json:"continueOnError,omitempty" protobuf:"varint,4,opt,name=continueOnError"`
+	// Retry is the retry policy for this step.
+	Retry *PromotionStepRetry `json:"retry,omitempty" protobuf:"bytes,5,opt,name=retry"`
+	// Vars is a list of variables that can be referenced by expressions in
+	// the step's Config. The values override the values specified in the
+	// parallel group and the PromotionSpec.
+	Vars []ExpressionVariable `json:"vars,omitempty" protobuf:"bytes,6,rep,name=vars"`
+	// Config is opaque configuration for the step that is understood only by
+	// the step's implementation. It is legal to utilize expressions in
+	// defining values at any level of this block.
+	// See https://docs.kargo.io/user-guide/reference-docs/expressions for details.
+	Config *apiextensionsv1.JSON `json:"config,omitempty" protobuf:"bytes,7,opt,name=config"`
+}
+
+// GetAlias returns the As field, or a default value in the form of
+// "<groupAlias>-<i>" if the As field is empty. The alias of the parallel group
+// and the index i of the step within the group are provided as arguments to
+// this method.
+func (s *ParallelPromotionStep) GetAlias(groupAlias string, i int) string {
+	if s.As != "" {
+		return s.As
+	}
+	return fmt.Sprintf("%s-%d", groupAlias, i+1)
+}
+
 // PromotionStatus describes the current state of the transition represented by
 // a Promotion.
 type PromotionStatus struct {
@@ -354,6 +487,56 @@ type PromotionStatus struct {
 	// State stores the state of the promotion process between reconciliation
 	// attempts.
 	State *apiextensionsv1.JSON `json:"state,omitempty" protobuf:"bytes,10,opt,name=state"`
+	// OnFailure tracks the execution of the OnFailure steps of the Promotion.
+	// It is only set once the Steps of the Promotion did not succeed.
+	//
+	// +optional
+	OnFailure *PromotionOnFailureStatus `json:"onFailure,omitempty" protobuf:"bytes,13,opt,name=onFailure"`
+	// DryRun describes the outcome of the Promotion if it is a dry run.
+	//
+	// +optional
+	DryRun *PromotionDryRunResult `json:"dryRun,omitempty" protobuf:"bytes,14,opt,name=dryRun"`
+}
+
+// PromotionDryRunResult describes the outcome of a Promotion that is a dry
+// run.
+type PromotionDryRunResult struct {
+	// PlannedSteps lists the steps of the Promotion that were planned instead
+	// of executed because they would have affected something outside of the
+	// Promotion's working directory.
+	PlannedSteps []PlannedPromotionStep `json:"plannedSteps,omitempty" protobuf:"bytes,1,rep,name=plannedSteps"`
+	// Diff is a unified diff of the changes made to the Git working trees in
+	// the Promotion's working directory. It is only set once the Promotion has
+	// completed, and may be truncated if it is exceptionally large.
+	Diff string `json:"diff,omitempty" protobuf:"bytes,2,opt,name=diff"`
+}
+
+// PlannedPromotionStep describes a step of a Promotion that was planned
+// instead of executed because the Promotion is a dry run.
+type PlannedPromotionStep struct {
+	// As is the alias of the step.
+	As string `json:"as,omitempty" protobuf:"bytes,1,opt,name=as"`
+	// Uses identifies the runner that would have executed the step.
+	Uses string `json:"uses" protobuf:"bytes,2,opt,name=uses"`
+	// Config is the configuration the step would have been executed with,
+	// after the evaluation of any expressions. Values which may be sensitive,
+	// such as HTTP header values, are redacted.
+	Config *apiextensionsv1.JSON `json:"config,omitempty" protobuf:"bytes,3,opt,name=config"`
+}
+
+// PromotionOnFailureStatus describes the execution of the OnFailure steps of
+// a Promotion.
+type PromotionOnFailureStatus struct {
+	// Phase describes the outcome of the OnFailure steps. It does not affect
+	// the phase of the Promotion, which is determined by its Steps.
+	Phase PromotionPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase"`
+	// Message is a display message about the outcome of the OnFailure steps.
+	Message string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
+	// CurrentStep is the index of the current OnFailure step being executed.
+	CurrentStep int64 `json:"currentStep,omitempty" protobuf:"varint,3,opt,name=currentStep"`
+	// StepExecutionMetadata tracks metadata pertaining to the execution of
+	// individual OnFailure steps.
+	StepExecutionMetadata StepExecutionMetadataList `json:"stepExecutionMetadata,omitempty" protobuf:"bytes,4,rep,name=stepExecutionMetadata"`
 }
 
 // GetState returns the State field as unmarshalled YAML.
@@ -451,4 +634,30 @@ type StepExecutionMetadata struct {
 	// also will not permit this failure to impact the overall status of the
 	// Promotion.
 	ContinueOnError bool `json:"continueOnError,omitempty" protobuf:"varint,7,opt,name=continueOnError"`
+	// Parallel tracks metadata pertaining to the execution of the individual
+	// steps of a parallel group.
+	Parallel []ParallelStepExecutionMetadata `json:"parallel,omitempty" protobuf:"bytes,8,rep,name=parallel"`
+}
+
+// ParallelStepExecutionMetadata tracks metadata pertaining to the execution
+// of a single step of a parallel group.
+type ParallelStepExecutionMetadata struct {
+	// Alias is the alias of the step.
+	Alias string `json:"alias,omitempty" protobuf:"bytes,1,opt,name=alias"`
+	// StartedAt is the time at which the first attempt to execute the step
+	// began.
+	StartedAt *metav1.Time `json:"startedAt,omitempty" protobuf:"bytes,2,opt,name=startedAt"`
+	// FinishedAt is the time at which the final attempt to execute the step
+	// completed.
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,3,opt,name=finishedAt"`
+	// ErrorCount tracks consecutive failed attempts to execute the step.
+	ErrorCount uint32 `json:"errorCount,omitempty" protobuf:"varint,4,opt,name=errorCount"`
+	// Status is the high-level outcome of the step.
+	Status PromotionStepStatus `json:"status,omitempty" protobuf:"bytes,5,opt,name=status"`
+	// Message is a display message about the step, including any errors.
+	Message string `json:"message,omitempty" protobuf:"bytes,6,opt,name=message"`
+	// ContinueOnError is a boolean value that, if set to true, will not permit
+	// a failure of this step to impact the outcome of the parallel group it
+	// belongs to.
+	ContinueOnError bool `json:"continueOnError,omitempty" protobuf:"varint,7,opt,name=continueOnError"`
 }
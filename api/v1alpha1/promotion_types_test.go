@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -186,6 +187,97 @@ func TestPromotionRetry_GetErrorThreshold(t *testing.T) {
 	}
 }
 
+func TestPromotionStep_GetParallelSteps(t *testing.T) {
+	require.Nil(t, (&PromotionStep{Uses: "fake-step"}).GetParallelSteps())
+
+	step := &PromotionStep{
+		Vars: []ExpressionVariable{{Name: "group", Value: "group"}},
+		Parallel: []ParallelPromotionStep{
+			{
+				Uses:            "fake-step",
+				As:              "step1",
+				If:              "${{ true }}",
+				ContinueOnError: true,
+				Retry:           &PromotionStepRetry{ErrorThreshold: 3},
+				Vars:            []ExpressionVariable{{Name: "step", Value: "step"}},
+				Config:          &apiextensionsv1.JSON{Raw: []byte(`{}`)},
+			},
+			{Uses: "other-fake-step"},
+		},
+	}
+	require.Equal(
+		t,
+		[]PromotionStep{
+			{
+				Uses:            "fake-step",
+				As:              "step1",
+				If:              "${{ true }}",
+				ContinueOnError: true,
+				Retry:           &PromotionStepRetry{ErrorThreshold: 3},
+				Vars: []ExpressionVariable{
+					{Name: "group", Value: "group"},
+					{Name: "step", Value: "step"},
+				},
+				Config: &apiextensionsv1.JSON{Raw: []byte(`{}`)},
+			},
+			{
+				Uses: "other-fake-step",
+				Vars: []ExpressionVariable{{Name: "group", Value: "group"}},
+			},
+		},
+		step.GetParallelSteps(),
+	)
+}
+
+func TestParallelPromotionStep_GetAlias(t *testing.T) {
+	require.Equal(t, "step1", (&ParallelPromotionStep{As: "step1"}).GetAlias("group", 0))
+	require.Equal(t, "group-2", (&ParallelPromotionStep{}).GetAlias("group", 1))
+}
+
+func TestPromotionStepIteration_GetItem(t *testing.T) {
+	tests := []struct {
+		name      string
+		iteration *PromotionStepIteration
+		expected  any
+	}{
+		{
+			name:     "nil iteration",
+			expected: nil,
+		},
+		{
+			name:      "nil item",
+			iteration: &PromotionStepIteration{Index: 1},
+			expected:  nil,
+		},
+		{
+			name: "invalid item",
+			iteration: &PromotionStepIteration{
+				Item: &apiextensionsv1.JSON{Raw: []byte("{")},
+			},
+			expected: nil,
+		},
+		{
+			name: "object item",
+			iteration: &PromotionStepIteration{
+				Item: &apiextensionsv1.JSON{Raw: []byte(`{"name":"foo","replicas":2}`)},
+			},
+			expected: map[string]any{"name": "foo", "replicas": float64(2)},
+		},
+		{
+			name: "string item",
+			iteration: &PromotionStepIteration{
+				Item: &apiextensionsv1.JSON{Raw: []byte(`"foo"`)},
+			},
+			expected: "foo",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.iteration.GetItem())
+		})
+	}
+}
+
 func TestStepExecutionMetadataList_HasFailures(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -226,6 +226,47 @@ type StageSpec struct {
 	// Verification describes how to verify a Stage's current Freight is fit for
 	// promotion downstream.
 	Verification *Verification `json:"verification,omitempty" protobuf:"bytes,3,opt,name=verification"`
+	// ApprovalPolicy describes the approvals that are required before Freight
+	// is considered to have been manually approved for the Stage. If not
+	// specified, the approval of any single user authorized to promote to the
+	// Stage suffices.
+	//
+	// +optional
+	ApprovalPolicy *ApprovalPolicy `json:"approvalPolicy,omitempty" protobuf:"bytes,8,opt,name=approvalPolicy"`
+}
+
+// ApprovalPolicy describes the approvals that are required before Freight is
+// considered to have been manually approved for a Stage.
+type ApprovalPolicy struct {
+	// RequiredApprovals is the number of distinct approvers who must approve
+	// Freight for the Stage before the Freight is considered approved.
+	//
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	RequiredApprovals int32 `json:"requiredApprovals,omitempty" protobuf:"varint,1,opt,name=requiredApprovals"`
+	// ApproverGroups restricts who may approve Freight for the Stage to users
+	// whose identity provider "groups" claim contains at least one of the
+	// listed groups. If not specified, any user authorized to promote to the
+	// Stage may approve Freight for it.
+	//
+	// +optional
+	ApproverGroups []string `json:"approverGroups,omitempty" protobuf:"bytes,2,rep,name=approverGroups"`
+	// ProhibitSelfApproval, when true, prevents a user who approved Freight for
+	// the Stage from also promoting that Freight to the Stage whenever the
+	// Freight is available to the Stage only by virtue of that approval.
+	//
+	// +optional
+	ProhibitSelfApproval bool `json:"prohibitSelfApproval,omitempty" protobuf:"varint,3,opt,name=prohibitSelfApproval"`
+}
+
+// GetRequiredApprovals returns the number of distinct approvers who must
+// approve Freight for a Stage governed by the ApprovalPolicy. A nil
+// ApprovalPolicy requires a single approval.
+func (a *ApprovalPolicy) GetRequiredApprovals() int {
+	if a == nil || a.RequiredApprovals < 1 {
+		return 1
+	}
+	return int(a.RequiredApprovals)
 }
 
 // FreightRequest expresses a Stage's need for Freight having originated from a
@@ -373,10 +414,22 @@ type PromotionTemplateSpec struct {
 	// are listed in this field.
 	//
 	// +kubebuilder:validation:MinItems=1
-	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step must have exactly one of uses or task set",rule="(has(self.uses) ? !has(self.task) : has(self.task))"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step must have exactly one of uses, task, or parallel set",rule="[has(self.uses), has(self.task), has(self.parallel)].exists_one(x, x)"
 	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step referencing a task cannot set continueOnError",rule="!has(self.task) || !has(self.continueOnError)"
 	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step referencing a task cannot set retry",rule="!has(self.task) || !has(self.retry)"
 	Steps []PromotionStep `json:"steps,omitempty" protobuf:"bytes,1,rep,name=steps"`
+	// OnFailure specifies the directives to be executed when the Steps of a
+	// Promotion do not succeed, i.e. when the Promotion would otherwise end in a
+	// Failed, Errored, or Aborted phase. These directives can be used to
+	// compensate for any partially applied changes. The outcome of the Steps is
+	// available to them through the failure() and status() expression
+	// functions.
+	//
+	// +optional
+	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step must have exactly one of uses, task, or parallel set",rule="[has(self.uses), has(self.task), has(self.parallel)].exists_one(x, x)"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step referencing a task cannot set continueOnError",rule="!has(self.task) || !has(self.continueOnError)"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step referencing a task cannot set retry",rule="!has(self.task) || !has(self.retry)"
+	OnFailure []PromotionStep `json:"onFailure,omitempty" protobuf:"bytes,3,rep,name=onFailure"`
 }
 
 // StageStatus describes a Stages's current and recent Freight, health, and
@@ -422,12 +475,43 @@ type StageStatus struct {
 	// AutoPromotionEnabled indicates whether automatic promotion is enabled
 	// for the Stage based on the ProjectConfig.
 	AutoPromotionEnabled bool `json:"autoPromotionEnabled,omitempty" protobuf:"varint,14,opt,name=autoPromotionEnabled"`
+	// PromotionBlock, if set, indicates that the PromotionPolicy applicable to
+	// the Stage does not currently permit Freight to be promoted into it, and
+	// describes why and until when.
+	PromotionBlock *PromotionBlock `json:"promotionBlock,omitempty" protobuf:"bytes,16,opt,name=promotionBlock"`
 	// Metadata is a map of arbitrary metadata associated with the Stage.
 	// This is useful for storing additional information about the Stage
 	// that can be shared across promotions, verifications, or other processes.
 	Metadata map[string]apiextensionsv1.JSON `json:"metadata,omitempty" protobuf:"bytes,15,rep,name=metadata" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
+const (
+	// PromotionBlockReasonBlackout is the reason used when promotion into a
+	// Stage is blocked by a blackout.
+	PromotionBlockReasonBlackout = "Blackout"
+	// PromotionBlockReasonOutsideWindows is the reason used when promotion into
+	// a Stage is blocked because none of its promotion windows are open.
+	PromotionBlockReasonOutsideWindows = "OutsidePromotionWindows"
+)
+
+// PromotionBlock describes why the PromotionPolicy applicable to a Stage does
+// not currently permit Freight to be promoted into it.
+type PromotionBlock struct {
+	// Reason is a machine-readable reason for the block. It is either
+	// "Blackout" or "OutsidePromotionWindows".
+	Reason string `json:"reason" protobuf:"bytes,1,opt,name=reason"`
+	// Name is the name of the blackout blocking promotion or, if promotion is
+	// blocked because no promotion window is open, the name of the promotion
+	// window that will next permit promotion.
+	Name string `json:"name,omitempty" protobuf:"bytes,2,opt,name=name"`
+	// Message is a human-readable description of the block.
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+	// NextPermittedTime is the earliest time at which promotion into the Stage
+	// will be permitted again. It is not set if no such time could be
+	// determined.
+	NextPermittedTime *metav1.Time `json:"nextPermittedTime,omitempty" protobuf:"bytes,4,opt,name=nextPermittedTime"`
+}
+
 // GetConditions implements the conditions.Getter interface.
 func (w *StageStatus) GetConditions() []metav1.Condition {
 	return w.Conditions
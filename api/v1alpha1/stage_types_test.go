@@ -284,6 +284,12 @@ func TestStage_IsFreightAvailable(t *testing.T) {
 	}
 }
 
+func TestApprovalPolicy_GetRequiredApprovals(t *testing.T) {
+	require.Equal(t, 1, (*ApprovalPolicy)(nil).GetRequiredApprovals())
+	require.Equal(t, 1, (&ApprovalPolicy{}).GetRequiredApprovals())
+	require.Equal(t, 3, (&ApprovalPolicy{RequiredApprovals: 3}).GetRequiredApprovals())
+}
+
 func TestVerificationInfo_HasAnalysisRun(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -0,0 +1,100 @@
+package v1alpha1
+
+import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name=Address,type=string,JSONPath=`.spec.address`
+// +kubebuilder:printcolumn:name=Age,type=date,JSONPath=`.metadata.creationTimestamp`
+
+// StepPlugin registers an out-of-process plugin that executes one or more
+// kinds of promotion steps on behalf of the Kargo controller. The plugin is a
+// gRPC server, typically running as a sidecar of the controller, that
+// implements the StepExecutor service.
+type StepPlugin struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec describes how to reach the plugin and the kinds of steps it
+	// executes.
+	//
+	// +kubebuilder:validation:Required
+	Spec StepPluginSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// StepPluginSpec describes how to reach a StepPlugin and the kinds of steps it
+// executes.
+type StepPluginSpec struct {
+	// Address is the address of the plugin's gRPC server in the form
+	// host:port. e.g. localhost:50051 for a plugin running as a sidecar of the
+	// controller.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Address string `json:"address" protobuf:"bytes,1,opt,name=address"`
+	// Plaintext indicates whether the controller should connect to the plugin
+	// without TLS. This is only appropriate when the plugin is reachable over a
+	// trusted network, such as the loopback interface of a sidecar.
+	//
+	// +optional
+	Plaintext bool `json:"plaintext,omitempty" protobuf:"varint,2,opt,name=plaintext"`
+	// Steps describes the kinds of promotion steps executed by the plugin.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	Steps []StepPluginStep `json:"steps" protobuf:"bytes,3,rep,name=steps"`
+}
+
+// StepPluginStep describes a kind of promotion step executed by a StepPlugin.
+type StepPluginStep struct {
+	// Kind is the kind of step executed by the plugin. Promotion steps make use
+	// of it by referencing it in their uses field. It must not be the kind of
+	// a step that is built into Kargo, or be executed by any other StepPlugin.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	Kind string `json:"kind" protobuf:"bytes,1,opt,name=kind"`
+	// ConfigSchema is an optional JSON schema describing the configuration of
+	// the step. When specified, the configuration of steps of this kind is
+	// validated against it when Stages and PromotionTasks are created or
+	// updated, and again, once any expressions it contains have been
+	// evaluated, before each step is executed.
+	//
+	// +optional
+	ConfigSchema *apiextensionsv1.JSON `json:"configSchema,omitempty" protobuf:"bytes,2,opt,name=configSchema"`
+	// SideEffecting indicates whether the step affects anything outside of the
+	// working directory of a Promotion. Steps with side effects are planned
+	// instead of executed when the Promotion is a dry run. Because Kargo cannot
+	// know what a plugin does, steps are assumed to have side effects unless
+	// this is explicitly set to false.
+	//
+	// +optional
+	SideEffecting *bool `json:"sideEffecting,omitempty" protobuf:"varint,3,opt,name=sideEffecting"`
+	// DefaultTimeout is the default soft maximum interval in which a step of
+	// this kind that returns a Running status may be retried. It may be
+	// overridden by the retry configuration of an individual step.
+	//
+	// +optional
+	DefaultTimeout *metav1.Duration `json:"defaultTimeout,omitempty" protobuf:"bytes,4,opt,name=defaultTimeout"`
+	// DefaultErrorThreshold is the default number of consecutive times a step
+	// of this kind must fail before retries are abandoned. It may be
+	// overridden by the retry configuration of an individual step. A value of
+	// 0 is interpreted as 1.
+	//
+	// +optional
+	DefaultErrorThreshold uint32 `json:"defaultErrorThreshold,omitempty" protobuf:"varint,5,opt,name=defaultErrorThreshold"`
+}
+
+// +kubebuilder:object:root=true
+
+// StepPluginList contains a list of StepPlugins.
+type StepPluginList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []StepPlugin `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
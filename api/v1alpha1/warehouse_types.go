@@ -112,6 +112,13 @@ type WarehouseSpec struct {
 	//
 	// +kubebuilder:validation:Optional
 	FreightCreationCriteria *FreightCreationCriteria `json:"freightCreationCriteria,omitempty" protobuf:"bytes,5,opt,name=freightCreationCriteria"`
+	// FreightCreationGrouping defines how artifacts that are discovered in quick
+	// succession are grouped into a single Freight instead of each producing
+	// Freight of its own. This field has no effect when the
+	// FreightCreationPolicy is `Manual`.
+	//
+	// +kubebuilder:validation:Optional
+	FreightCreationGrouping *FreightCreationGrouping `json:"freightCreationGrouping,omitempty" protobuf:"bytes,6,opt,name=freightCreationGrouping"`
 }
 
 var legacySubscriptionTypes = []string{"chart", "git", "image"}
@@ -288,6 +295,70 @@ type FreightCreationCriteria struct {
 	Expression string `json:"expression,omitempty" protobuf:"bytes,1,opt,name=expression"`
 }
 
+// FreightCreationGrouping defines how artifacts that are discovered in quick
+// succession are grouped into a single Freight.
+type FreightCreationGrouping struct {
+	// SettleWindow is the minimum duration for which the newest discovered
+	// artifacts must remain unchanged before Freight is created automatically
+	// from them. Any change to the newest artifacts restarts the window. Changes
+	// are only observed when artifacts are discovered, so the Warehouse's
+	// interval should be shorter than this window unless discovery is
+	// triggered by webhooks. If nil or zero, Freight is created as soon as new
+	// artifacts are discovered.
+	//
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
+	// +akuity:test-kubebuilder-pattern=Duration
+	SettleWindow *metav1.Duration `json:"settleWindow,omitempty" protobuf:"bytes,1,opt,name=settleWindow"`
+	// CorrelationKey is the name of an annotation (for instance,
+	// "org.opencontainers.image.revision") used to correlate artifacts
+	// discovered for different subscriptions. When specified, Freight is only
+	// created automatically from artifacts that agree on the value for the key,
+	// as described by the CorrelationStrategy. The value for an image is that of
+	// the annotation having this name. The value for a Git commit is its ID. The
+	// value for a chart version is its appVersion. Artifacts that carry no value
+	// for the key, including all generic artifacts, are disregarded.
+	//
+	// +optional
+	CorrelationKey string `json:"correlationKey,omitempty" protobuf:"bytes,2,opt,name=correlationKey"`
+	// CorrelationStrategy specifies how artifacts are correlated using the
+	// CorrelationKey. This field is optional. When left unspecified, the field
+	// is implicitly treated as if its value were "Newest".
+	//
+	// Accepted values:
+	//
+	// - "Newest": Freight is only created once the newest artifacts discovered
+	//   for each subscription agree on the value for the key.
+	// - "Matching": Freight is created from the newest set of artifacts, one
+	//   per subscription, that agree on the value for the key, even if some of
+	//   those artifacts are not the newest ones discovered for their
+	//   subscription. Subscriptions none of whose artifacts carry a value for
+	//   the key contribute their newest artifact.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Newest;Matching
+	CorrelationStrategy CorrelationStrategy `json:"correlationStrategy,omitempty" protobuf:"bytes,3,opt,name=correlationStrategy"`
+	// CorrelationExcludedRepoURLs lists the repository URLs of subscriptions
+	// whose artifacts are disregarded for the purposes of correlation. Such
+	// subscriptions always contribute their newest artifact to Freight.
+	//
+	// +optional
+	CorrelationExcludedRepoURLs []string `json:"correlationExcludedRepoURLs,omitempty" protobuf:"bytes,4,rep,name=correlationExcludedRepoURLs"`
+}
+
+type CorrelationStrategy string
+
+const (
+	// CorrelationStrategyNewest specifies that Freight is only created once the
+	// newest artifacts discovered for each subscription agree on the value of
+	// the correlation key.
+	CorrelationStrategyNewest CorrelationStrategy = "Newest"
+	// CorrelationStrategyMatching specifies that Freight is created from the
+	// newest set of discovered artifacts that agree on the value of the
+	// correlation key.
+	CorrelationStrategyMatching CorrelationStrategy = "Matching"
+)
+
 // RepoSubscription describes a subscription to ONE OF a Git repository, a
 // container image repository, a Helm chart repository, or something else.
 type RepoSubscription struct {
@@ -351,6 +422,23 @@ type WarehouseStatus struct {
 	LastFreightID string `json:"lastFreightID,omitempty" protobuf:"bytes,8,opt,name=lastFreightID"`
 	// DiscoveredArtifacts holds the artifacts discovered by the Warehouse.
 	DiscoveredArtifacts *DiscoveredArtifacts `json:"discoveredArtifacts,omitempty" protobuf:"bytes,7,opt,name=discoveredArtifacts"`
+	// PendingFreight describes Freight that the Warehouse has built from the
+	// newest discovered artifacts, but has not yet created because the
+	// artifacts have not yet settled.
+	//
+	// +optional
+	PendingFreight *PendingFreight `json:"pendingFreight,omitempty" protobuf:"bytes,10,opt,name=pendingFreight"`
+}
+
+// PendingFreight describes Freight that a Warehouse has yet to create because
+// the artifacts it is composed of have not yet settled.
+type PendingFreight struct {
+	// ID is the system-assigned identifier (name) the Freight will have once
+	// created.
+	ID string `json:"id" protobuf:"bytes,1,opt,name=id"`
+	// Since is the time at which the Warehouse first observed the artifacts the
+	// Freight is composed of as the newest ones.
+	Since metav1.Time `json:"since" protobuf:"bytes,2,opt,name=since"`
 }
 
 // GetConditions implements the conditions.Getter interface.
@@ -432,6 +520,23 @@ type DiscoveredCommit struct {
 	// CreatorDate is the commit creation date as specified by the commit, or
 	// the tagger date if the commit belongs to an annotated tag.
 	CreatorDate *metav1.Time `json:"creatorDate,omitempty" protobuf:"bytes,7,opt,name=creatorDate"`
+	// Signer describes the verified signature of the commit or, if the
+	// GitSubscription's verification policy applies to tags, of the tag. This
+	// field is only populated if the GitSubscription specifies a verification
+	// policy.
+	Signer *CommitSigner `json:"signer,omitempty" protobuf:"bytes,8,opt,name=signer"`
+}
+
+// CommitSigner describes the verified signature of a commit or tag.
+type CommitSigner struct {
+	// Type is the type of the signature. Either "gpg" or "ssh".
+	Type string `json:"type,omitempty" protobuf:"bytes,1,opt,name=type"`
+	// Identity is the identity of the signer. For GPG signatures, this is the
+	// user ID of the signing key. For SSH signatures, this is the key of the
+	// Secret entry holding the signing key.
+	Identity string `json:"identity,omitempty" protobuf:"bytes,2,opt,name=identity"`
+	// Key is the fingerprint of the signing key.
+	Key string `json:"key,omitempty" protobuf:"bytes,3,opt,name=key"`
 }
 
 // ImageDiscoveryResult represents the result of an image discovery operation
@@ -453,6 +558,24 @@ type ImageDiscoveryResult struct {
 	//
 	// +optional
 	References []DiscoveredImageReference `json:"references" protobuf:"bytes,3,rep,name=references"`
+	// Rejected is a list of images that otherwise satisfied the
+	// ImageSubscription's criteria, but were rejected by its verification
+	// policy. This field is only populated if the ImageSubscription specifies a
+	// verification policy.
+	//
+	// +optional
+	Rejected []RejectedImageReference `json:"rejected,omitempty" protobuf:"bytes,4,rep,name=rejected"`
+}
+
+// RejectedImageReference represents an image that was rejected by the
+// verification policy of an ImageSubscription.
+type RejectedImageReference struct {
+	// Tag is the tag of the image.
+	Tag string `json:"tag" protobuf:"bytes,1,opt,name=tag"`
+	// Digest is the digest of the image.
+	Digest string `json:"digest" protobuf:"bytes,2,opt,name=digest"`
+	// Reason is a human-readable explanation of why the image was rejected.
+	Reason string `json:"reason" protobuf:"bytes,3,opt,name=reason"`
 }
 
 // DiscoveredImageReference represents an image reference discovered by a
@@ -500,6 +623,11 @@ type ChartDiscoveryResult struct {
 	//
 	// +optional
 	Versions []string `json:"versions" protobuf:"bytes,4,rep,name=versions"`
+	// AppVersions maps discovered versions to the appVersion of the chart at
+	// that version. Versions for which no appVersion is known are omitted.
+	//
+	// +optional
+	AppVersions map[string]string `json:"appVersions,omitempty" protobuf:"bytes,5,rep,name=appVersions" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 // DiscoveryResult represents the result of an artifact discovery operation for
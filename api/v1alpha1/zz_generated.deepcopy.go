@@ -84,6 +84,26 @@ func (in *AnalysisTemplateReference) DeepCopy() *AnalysisTemplateReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalPolicy) DeepCopyInto(out *ApprovalPolicy) {
+	*out = *in
+	if in.ApproverGroups != nil {
+		in, out := &in.ApproverGroups, &out.ApproverGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalPolicy.
+func (in *ApprovalPolicy) DeepCopy() *ApprovalPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApprovedStage) DeepCopyInto(out *ApprovedStage) {
 	*out = *in
@@ -91,6 +111,13 @@ func (in *ApprovedStage) DeepCopyInto(out *ApprovedStage) {
 		in, out := &in.ApprovedAt, &out.ApprovedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.Approvals != nil {
+		in, out := &in.Approvals, &out.Approvals
+		*out = make([]FreightApproval, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovedStage.
@@ -261,6 +288,13 @@ func (in *ChartDiscoveryResult) DeepCopyInto(out *ChartDiscoveryResult) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AppVersions != nil {
+		in, out := &in.AppVersions, &out.AppVersions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChartDiscoveryResult.
@@ -288,6 +322,22 @@ func (in *ChartSubscription) DeepCopy() *ChartSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudEventsNotificationSinkConfig) DeepCopyInto(out *CloudEventsNotificationSinkConfig) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudEventsNotificationSinkConfig.
+func (in *CloudEventsNotificationSinkConfig) DeepCopy() *CloudEventsNotificationSinkConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudEventsNotificationSinkConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 	*out = *in
@@ -454,6 +504,21 @@ func (in *ClusterPromotionTaskList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommitSigner) DeepCopyInto(out *CommitSigner) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommitSigner.
+func (in *CommitSigner) DeepCopy() *CommitSigner {
+	if in == nil {
+		return nil
+	}
+	out := new(CommitSigner)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CurrentStage) DeepCopyInto(out *CurrentStage) {
 	*out = *in
@@ -524,6 +589,11 @@ func (in *DiscoveredCommit) DeepCopyInto(out *DiscoveredCommit) {
 		in, out := &in.CreatorDate, &out.CreatorDate
 		*out = (*in).DeepCopy()
 	}
+	if in.Signer != nil {
+		in, out := &in.Signer, &out.Signer
+		*out = new(CommitSigner)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscoveredCommit.
@@ -666,6 +736,25 @@ func (in *Freight) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreightApproval) DeepCopyInto(out *FreightApproval) {
+	*out = *in
+	if in.ApprovedAt != nil {
+		in, out := &in.ApprovedAt, &out.ApprovedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreightApproval.
+func (in *FreightApproval) DeepCopy() *FreightApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(FreightApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FreightCollection) DeepCopyInto(out *FreightCollection) {
 	*out = *in
@@ -710,6 +799,31 @@ func (in *FreightCreationCriteria) DeepCopy() *FreightCreationCriteria {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreightCreationGrouping) DeepCopyInto(out *FreightCreationGrouping) {
+	*out = *in
+	if in.SettleWindow != nil {
+		in, out := &in.SettleWindow, &out.SettleWindow
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.CorrelationExcludedRepoURLs != nil {
+		in, out := &in.CorrelationExcludedRepoURLs, &out.CorrelationExcludedRepoURLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreightCreationGrouping.
+func (in *FreightCreationGrouping) DeepCopy() *FreightCreationGrouping {
+	if in == nil {
+		return nil
+	}
+	out := new(FreightCreationGrouping)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in FreightHistory) DeepCopyInto(out *FreightHistory) {
 	{
@@ -893,6 +1007,13 @@ func (in *FreightStatus) DeepCopyInto(out *FreightStatus) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.PendingApprovals != nil {
+		in, out := &in.PendingApprovals, &out.PendingApprovals
+		*out = make(map[string]PendingApproval, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	if in.Metadata != nil {
 		in, out := &in.Metadata, &out.Metadata
 		*out = make(map[string]apiextensionsv1.JSON, len(*in))
@@ -1083,6 +1204,11 @@ func (in *GitSubscription) DeepCopyInto(out *GitSubscription) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(GitVerification)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitSubscription.
@@ -1095,6 +1221,21 @@ func (in *GitSubscription) DeepCopy() *GitSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitVerification) DeepCopyInto(out *GitVerification) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitVerification.
+func (in *GitVerification) DeepCopy() *GitVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(GitVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GiteaWebhookReceiverConfig) DeepCopyInto(out *GiteaWebhookReceiverConfig) {
 	*out = *in
@@ -1214,6 +1355,26 @@ func (in *Image) DeepCopy() *Image {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageAttestationPolicy) DeepCopyInto(out *ImageAttestationPolicy) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageAttestationPolicy.
+func (in *ImageAttestationPolicy) DeepCopy() *ImageAttestationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageAttestationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageDiscoveryResult) DeepCopyInto(out *ImageDiscoveryResult) {
 	*out = *in
@@ -1224,6 +1385,11 @@ func (in *ImageDiscoveryResult) DeepCopyInto(out *ImageDiscoveryResult) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Rejected != nil {
+		in, out := &in.Rejected, &out.Rejected
+		*out = make([]RejectedImageReference, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageDiscoveryResult.
@@ -1259,6 +1425,11 @@ func (in *ImageSubscription) DeepCopyInto(out *ImageSubscription) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(ImageVerification)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSubscription.
@@ -1271,6 +1442,28 @@ func (in *ImageSubscription) DeepCopy() *ImageSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageVerification) DeepCopyInto(out *ImageVerification) {
+	*out = *in
+	if in.Attestations != nil {
+		in, out := &in.Attestations, &out.Attestations
+		*out = make([]ImageAttestationPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageVerification.
+func (in *ImageVerification) DeepCopy() *ImageVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IndexSelector) DeepCopyInto(out *IndexSelector) {
 	*out = *in
@@ -1307,162 +1500,361 @@ func (in *IndexSelectorRequirement) DeepCopy() *IndexSelectorRequirement {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Project) DeepCopyInto(out *Project) {
+func (in *MSTeamsNotificationSinkConfig) DeepCopyInto(out *MSTeamsNotificationSinkConfig) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Status.DeepCopyInto(&out.Status)
+	out.SecretRef = in.SecretRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Project.
-func (in *Project) DeepCopy() *Project {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSTeamsNotificationSinkConfig.
+func (in *MSTeamsNotificationSinkConfig) DeepCopy() *MSTeamsNotificationSinkConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(Project)
+	out := new(MSTeamsNotificationSinkConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Project) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectConfig) DeepCopyInto(out *ProjectConfig) {
+func (in *NotificationSinkConfig) DeepCopyInto(out *NotificationSinkConfig) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookNotificationSinkConfig)
+		**out = **in
+	}
+	if in.CloudEvents != nil {
+		in, out := &in.CloudEvents, &out.CloudEvents
+		*out = new(CloudEventsNotificationSinkConfig)
+		**out = **in
+	}
+	if in.Slack != nil {
+		in, out := &in.Slack, &out.Slack
+		*out = new(SlackNotificationSinkConfig)
+		**out = **in
+	}
+	if in.MSTeams != nil {
+		in, out := &in.MSTeams, &out.MSTeams
+		*out = new(MSTeamsNotificationSinkConfig)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectConfig.
-func (in *ProjectConfig) DeepCopy() *ProjectConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSinkConfig.
+func (in *NotificationSinkConfig) DeepCopy() *NotificationSinkConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectConfig)
+	out := new(NotificationSinkConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProjectConfig) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectConfigList) DeepCopyInto(out *ProjectConfigList) {
+func (in *NotificationSinkStatus) DeepCopyInto(out *NotificationSinkStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]ProjectConfig, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.LastDeliveryTime != nil {
+		in, out := &in.LastDeliveryTime, &out.LastDeliveryTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastFailureTime != nil {
+		in, out := &in.LastFailureTime, &out.LastFailureTime
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectConfigList.
-func (in *ProjectConfigList) DeepCopy() *ProjectConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSinkStatus.
+func (in *NotificationSinkStatus) DeepCopy() *NotificationSinkStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectConfigList)
+	out := new(NotificationSinkStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ProjectConfigList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectConfigSpec) DeepCopyInto(out *ProjectConfigSpec) {
+func (in *ParallelPromotionStep) DeepCopyInto(out *ParallelPromotionStep) {
 	*out = *in
-	if in.PromotionPolicies != nil {
-		in, out := &in.PromotionPolicies, &out.PromotionPolicies
-		*out = make([]PromotionPolicy, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(PromotionStepRetry)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.WebhookReceivers != nil {
-		in, out := &in.WebhookReceivers, &out.WebhookReceivers
-		*out = make([]WebhookReceiverConfig, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Vars != nil {
+		in, out := &in.Vars, &out.Vars
+		*out = make([]ExpressionVariable, len(*in))
+		copy(*out, *in)
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectConfigSpec.
-func (in *ProjectConfigSpec) DeepCopy() *ProjectConfigSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParallelPromotionStep.
+func (in *ParallelPromotionStep) DeepCopy() *ParallelPromotionStep {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectConfigSpec)
+	out := new(ParallelPromotionStep)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectConfigStatus) DeepCopyInto(out *ProjectConfigStatus) {
+func (in *ParallelStepExecutionMetadata) DeepCopyInto(out *ParallelStepExecutionMetadata) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
 	}
-	if in.WebhookReceivers != nil {
-		in, out := &in.WebhookReceivers, &out.WebhookReceivers
-		*out = make([]WebhookReceiverDetails, len(*in))
-		copy(*out, *in)
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectConfigStatus.
-func (in *ProjectConfigStatus) DeepCopy() *ProjectConfigStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParallelStepExecutionMetadata.
+func (in *ParallelStepExecutionMetadata) DeepCopy() *ParallelStepExecutionMetadata {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectConfigStatus)
+	out := new(ParallelStepExecutionMetadata)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectList) DeepCopyInto(out *ProjectList) {
+func (in *PendingApproval) DeepCopyInto(out *PendingApproval) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Project, len(*in))
+	if in.Approvals != nil {
+		in, out := &in.Approvals, &out.Approvals
+		*out = make([]FreightApproval, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectList.
-func (in *ProjectList) DeepCopy() *ProjectList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingApproval.
+func (in *PendingApproval) DeepCopy() *PendingApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingFreight) DeepCopyInto(out *PendingFreight) {
+	*out = *in
+	in.Since.DeepCopyInto(&out.Since)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingFreight.
+func (in *PendingFreight) DeepCopy() *PendingFreight {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingFreight)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlannedPromotionStep) DeepCopyInto(out *PlannedPromotionStep) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlannedPromotionStep.
+func (in *PlannedPromotionStep) DeepCopy() *PlannedPromotionStep {
+	if in == nil {
+		return nil
+	}
+	out := new(PlannedPromotionStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Project) DeepCopyInto(out *Project) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Project.
+func (in *Project) DeepCopy() *Project {
+	if in == nil {
+		return nil
+	}
+	out := new(Project)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Project) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectConfig) DeepCopyInto(out *ProjectConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectConfig.
+func (in *ProjectConfig) DeepCopy() *ProjectConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectConfigList) DeepCopyInto(out *ProjectConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProjectConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectConfigList.
+func (in *ProjectConfigList) DeepCopy() *ProjectConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectConfigSpec) DeepCopyInto(out *ProjectConfigSpec) {
+	*out = *in
+	if in.PromotionPolicies != nil {
+		in, out := &in.PromotionPolicies, &out.PromotionPolicies
+		*out = make([]PromotionPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.WebhookReceivers != nil {
+		in, out := &in.WebhookReceivers, &out.WebhookReceivers
+		*out = make([]WebhookReceiverConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NotificationSinks != nil {
+		in, out := &in.NotificationSinks, &out.NotificationSinks
+		*out = make([]NotificationSinkConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectConfigSpec.
+func (in *ProjectConfigSpec) DeepCopy() *ProjectConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectConfigStatus) DeepCopyInto(out *ProjectConfigStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.WebhookReceivers != nil {
+		in, out := &in.WebhookReceivers, &out.WebhookReceivers
+		*out = make([]WebhookReceiverDetails, len(*in))
+		copy(*out, *in)
+	}
+	if in.NotificationSinks != nil {
+		in, out := &in.NotificationSinks, &out.NotificationSinks
+		*out = make([]NotificationSinkStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectConfigStatus.
+func (in *ProjectConfigStatus) DeepCopy() *ProjectConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectList) DeepCopyInto(out *ProjectList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Project, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectList.
+func (in *ProjectList) DeepCopy() *ProjectList {
 	if in == nil {
 		return nil
 	}
@@ -1550,6 +1942,64 @@ func (in *Promotion) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionBlackout) DeepCopyInto(out *PromotionBlackout) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionBlackout.
+func (in *PromotionBlackout) DeepCopy() *PromotionBlackout {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionBlackout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionBlock) DeepCopyInto(out *PromotionBlock) {
+	*out = *in
+	if in.NextPermittedTime != nil {
+		in, out := &in.NextPermittedTime, &out.NextPermittedTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionBlock.
+func (in *PromotionBlock) DeepCopy() *PromotionBlock {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionBlock)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionDryRunResult) DeepCopyInto(out *PromotionDryRunResult) {
+	*out = *in
+	if in.PlannedSteps != nil {
+		in, out := &in.PlannedSteps, &out.PlannedSteps
+		*out = make([]PlannedPromotionStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionDryRunResult.
+func (in *PromotionDryRunResult) DeepCopy() *PromotionDryRunResult {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionDryRunResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromotionList) DeepCopyInto(out *PromotionList) {
 	*out = *in
@@ -1582,6 +2032,28 @@ func (in *PromotionList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionOnFailureStatus) DeepCopyInto(out *PromotionOnFailureStatus) {
+	*out = *in
+	if in.StepExecutionMetadata != nil {
+		in, out := &in.StepExecutionMetadata, &out.StepExecutionMetadata
+		*out = make(StepExecutionMetadataList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionOnFailureStatus.
+func (in *PromotionOnFailureStatus) DeepCopy() *PromotionOnFailureStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionOnFailureStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromotionPolicy) DeepCopyInto(out *PromotionPolicy) {
 	*out = *in
@@ -1590,6 +2062,18 @@ func (in *PromotionPolicy) DeepCopyInto(out *PromotionPolicy) {
 		*out = new(PromotionPolicySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PromotionWindows != nil {
+		in, out := &in.PromotionWindows, &out.PromotionWindows
+		*out = make([]PromotionWindow, len(*in))
+		copy(*out, *in)
+	}
+	if in.Blackouts != nil {
+		in, out := &in.Blackouts, &out.Blackouts
+		*out = make([]PromotionBlackout, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionPolicy.
@@ -1666,6 +2150,13 @@ func (in *PromotionSpec) DeepCopyInto(out *PromotionSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.OnFailure != nil {
+		in, out := &in.OnFailure, &out.OnFailure
+		*out = make([]PromotionStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionSpec.
@@ -1718,6 +2209,16 @@ func (in *PromotionStatus) DeepCopyInto(out *PromotionStatus) {
 		*out = new(apiextensionsv1.JSON)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OnFailure != nil {
+		in, out := &in.OnFailure, &out.OnFailure
+		*out = new(PromotionOnFailureStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(PromotionDryRunResult)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStatus.
@@ -1753,6 +2254,18 @@ func (in *PromotionStep) DeepCopyInto(out *PromotionStep) {
 		*out = new(apiextensionsv1.JSON)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Parallel != nil {
+		in, out := &in.Parallel, &out.Parallel
+		*out = make([]ParallelPromotionStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Iteration != nil {
+		in, out := &in.Iteration, &out.Iteration
+		*out = new(PromotionStepIteration)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStep.
@@ -1765,6 +2278,26 @@ func (in *PromotionStep) DeepCopy() *PromotionStep {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionStepIteration) DeepCopyInto(out *PromotionStepIteration) {
+	*out = *in
+	if in.Item != nil {
+		in, out := &in.Item, &out.Item
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStepIteration.
+func (in *PromotionStepIteration) DeepCopy() *PromotionStepIteration {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionStepIteration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromotionStepRetry) DeepCopyInto(out *PromotionStepRetry) {
 	*out = *in
@@ -1873,6 +2406,13 @@ func (in *PromotionTaskSpec) DeepCopyInto(out *PromotionTaskSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.OnFailure != nil {
+		in, out := &in.OnFailure, &out.OnFailure
+		*out = make([]PromotionStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionTaskSpec.
@@ -1916,6 +2456,13 @@ func (in *PromotionTemplateSpec) DeepCopyInto(out *PromotionTemplateSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.OnFailure != nil {
+		in, out := &in.OnFailure, &out.OnFailure
+		*out = make([]PromotionStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionTemplateSpec.
@@ -1928,6 +2475,22 @@ func (in *PromotionTemplateSpec) DeepCopy() *PromotionTemplateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionWindow) DeepCopyInto(out *PromotionWindow) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionWindow.
+func (in *PromotionWindow) DeepCopy() *PromotionWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QuayWebhookReceiverConfig) DeepCopyInto(out *QuayWebhookReceiverConfig) {
 	*out = *in
@@ -1944,6 +2507,21 @@ func (in *QuayWebhookReceiverConfig) DeepCopy() *QuayWebhookReceiverConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RejectedImageReference) DeepCopyInto(out *RejectedImageReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RejectedImageReference.
+func (in *RejectedImageReference) DeepCopy() *RejectedImageReference {
+	if in == nil {
+		return nil
+	}
+	out := new(RejectedImageReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RepoSubscription) DeepCopyInto(out *RepoSubscription) {
 	*out = *in
@@ -1979,6 +2557,22 @@ func (in *RepoSubscription) DeepCopy() *RepoSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SlackNotificationSinkConfig) DeepCopyInto(out *SlackNotificationSinkConfig) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlackNotificationSinkConfig.
+func (in *SlackNotificationSinkConfig) DeepCopy() *SlackNotificationSinkConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SlackNotificationSinkConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Stage) DeepCopyInto(out *Stage) {
 	*out = *in
@@ -2063,6 +2657,11 @@ func (in *StageSpec) DeepCopyInto(out *StageSpec) {
 		*out = new(Verification)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ApprovalPolicy != nil {
+		in, out := &in.ApprovalPolicy, &out.ApprovalPolicy
+		*out = new(ApprovalPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageSpec.
@@ -2127,6 +2726,11 @@ func (in *StageStatus) DeepCopyInto(out *StageStatus) {
 		*out = new(PromotionReference)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PromotionBlock != nil {
+		in, out := &in.PromotionBlock, &out.PromotionBlock
+		*out = new(PromotionBlock)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Metadata != nil {
 		in, out := &in.Metadata, &out.Metadata
 		*out = make(map[string]apiextensionsv1.JSON, len(*in))
@@ -2157,6 +2761,13 @@ func (in *StepExecutionMetadata) DeepCopyInto(out *StepExecutionMetadata) {
 		in, out := &in.FinishedAt, &out.FinishedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.Parallel != nil {
+		in, out := &in.Parallel, &out.Parallel
+		*out = make([]ParallelStepExecutionMetadata, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepExecutionMetadata.
@@ -2190,6 +2801,116 @@ func (in StepExecutionMetadataList) DeepCopy() StepExecutionMetadataList {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepPlugin) DeepCopyInto(out *StepPlugin) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepPlugin.
+func (in *StepPlugin) DeepCopy() *StepPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(StepPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StepPlugin) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepPluginList) DeepCopyInto(out *StepPluginList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]StepPlugin, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepPluginList.
+func (in *StepPluginList) DeepCopy() *StepPluginList {
+	if in == nil {
+		return nil
+	}
+	out := new(StepPluginList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StepPluginList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepPluginSpec) DeepCopyInto(out *StepPluginSpec) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]StepPluginStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepPluginSpec.
+func (in *StepPluginSpec) DeepCopy() *StepPluginSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StepPluginSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepPluginStep) DeepCopyInto(out *StepPluginStep) {
+	*out = *in
+	if in.ConfigSchema != nil {
+		in, out := &in.ConfigSchema, &out.ConfigSchema
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SideEffecting != nil {
+		in, out := &in.SideEffecting, &out.SideEffecting
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DefaultTimeout != nil {
+		in, out := &in.DefaultTimeout, &out.DefaultTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepPluginStep.
+func (in *StepPluginStep) DeepCopy() *StepPluginStep {
+	if in == nil {
+		return nil
+	}
+	out := new(StepPluginStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Subscription) DeepCopyInto(out *Subscription) {
 	*out = *in
@@ -2395,6 +3116,11 @@ func (in *WarehouseSpec) DeepCopyInto(out *WarehouseSpec) {
 		*out = new(FreightCreationCriteria)
 		**out = **in
 	}
+	if in.FreightCreationGrouping != nil {
+		in, out := &in.FreightCreationGrouping, &out.FreightCreationGrouping
+		*out = new(FreightCreationGrouping)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseSpec.
@@ -2438,6 +3164,11 @@ func (in *WarehouseStatus) DeepCopyInto(out *WarehouseStatus) {
 		*out = new(DiscoveredArtifacts)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PendingFreight != nil {
+		in, out := &in.PendingFreight, &out.PendingFreight
+		*out = new(PendingFreight)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseStatus.
@@ -2450,6 +3181,22 @@ func (in *WarehouseStatus) DeepCopy() *WarehouseStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookNotificationSinkConfig) DeepCopyInto(out *WebhookNotificationSinkConfig) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookNotificationSinkConfig.
+func (in *WebhookNotificationSinkConfig) DeepCopy() *WebhookNotificationSinkConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookNotificationSinkConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WebhookReceiverConfig) DeepCopyInto(out *WebhookReceiverConfig) {
 	*out = *in
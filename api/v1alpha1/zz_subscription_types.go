@@ -77,7 +77,23 @@ type GitSubscription struct {
 	// StrictSemvers specifies whether only "strict" semver tags should be considered. A
 	// "strict" semver tag contains ALL of major, minor, and patch version components. Only has
 	// effect when CommitSelectionStrategy is SemVer.
-	StrictSemvers *bool `json:"strictSemvers,omitempty" protobuf:"varint,14,opt,name=strictSemvers"`
+	StrictSemvers *bool            `json:"strictSemvers,omitempty" protobuf:"varint,14,opt,name=strictSemvers"`
+	Verification  *GitVerification `json:"verification,omitempty" protobuf:"bytes,15,opt,name=verification"`
+}
+
+// GitVerification is a policy that commits must satisfy to be eligible for discovery. When
+// specified on a GitSubscription, only commits (or tags) bearing a valid GPG or SSH
+// signature made with one of the trusted keys are discovered.
+type GitVerification struct {
+	// KeysSecret is the name of a Secret in the Project namespace whose entries are the public
+	// keys of trusted signers. Each entry may hold one or more ASCII-armored GPG public keys or
+	// SSH public keys in authorized_keys format. For SSH keys, the entry's key is used as the
+	// signer's identity. This field is required.
+	KeysSecret string `json:"keysSecret" protobuf:"bytes,1,opt,name=keysSecret"`
+	// VerifyTags specifies whether the signatures of tags, rather than those of the commits
+	// they reference, should be verified. Lightweight tags can never satisfy this requirement.
+	// Only has effect when CommitSelectionStrategy is Lexical, NewestTag, or SemVer.
+	VerifyTags bool `json:"verifyTags,omitempty" protobuf:"varint,2,opt,name=verifyTags"`
 }
 
 // ImageSubscription defines a subscription to a container image repository.
@@ -137,7 +153,37 @@ type ImageSubscription struct {
 	// SemVer. This should be disabled cautiously, as it is not uncommon to tag container images
 	// with short Git commit hashes, which could be mistaken for a semver string containing the
 	// major version number only.
-	StrictSemvers *bool `json:"strictSemvers,omitempty" protobuf:"varint,12,opt,name=strictSemvers"`
+	StrictSemvers *bool              `json:"strictSemvers,omitempty" protobuf:"varint,12,opt,name=strictSemvers"`
+	Verification  *ImageVerification `json:"verification,omitempty" protobuf:"bytes,13,opt,name=verification"`
+}
+
+// ImageVerification is a policy that images must satisfy to be eligible for discovery. When
+// specified on an ImageSubscription, only images bearing a valid signature, and any
+// attestations the policy requires, are discovered. Images rejected by the policy are
+// recorded, along with the reason for their rejection, in the Warehouse's status.
+type ImageVerification struct {
+	// Attestations is an optional list of in-toto attestations that images must carry, in
+	// addition to a valid signature, to be eligible for discovery.
+	Attestations []ImageAttestationPolicy `json:"attestations,omitempty" protobuf:"bytes,1,rep,name=attestations"`
+	// PublicKeySecret is the name of a Secret in the Project namespace containing a
+	// PEM-encoded public key with which image signatures and attestations must verify. This
+	// field is required.
+	PublicKeySecret string `json:"publicKeySecret" protobuf:"bytes,2,opt,name=publicKeySecret"`
+	// PublicKeySecretKey is the key within the Secret referenced by the PublicKeySecret field
+	// under which the public key is stored. When left unspecified, the field is implicitly
+	// treated as if its value were "cosign.pub".
+	PublicKeySecretKey string `json:"publicKeySecretKey,omitempty" protobuf:"bytes,3,opt,name=publicKeySecretKey"`
+}
+
+// ImageAttestationPolicy describes an in-toto attestation that an image must carry.
+type ImageAttestationPolicy struct {
+	// Conditions is an optional list of expressions that must all evaluate to true for an
+	// attestation to satisfy this policy. Each is evaluated with the attestation's predicate
+	// available as predicate and its subjects available as subjects.
+	Conditions []string `json:"conditions,omitempty" protobuf:"bytes,1,rep,name=conditions"`
+	// PredicateType is the predicate type of the attestation. e.g.
+	// https://slsa.dev/provenance/v1. This field is required.
+	PredicateType string `json:"predicateType" protobuf:"bytes,2,opt,name=predicateType"`
 }
 
 // CommitSelectionStrategy specifies the rules for how to identify the newest commit of
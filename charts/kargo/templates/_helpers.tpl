@@ -71,6 +71,7 @@ Create default controlplane user regular expression with well-known service acco
 {{- $components := dict
     "api" .Values.api.enabled
     "controller" .Values.controller.enabled
+    "external-webhooks-server" .Values.externalWebhooksServer.enabled
     "garbage-collector" .Values.garbageCollector.enabled
     "management-controller" .Values.managementController.enabled -}}
 {{- $serviceAccounts := list -}}
@@ -10,6 +10,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	clientgokubernetes "k8s.io/client-go/kubernetes"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -44,6 +45,7 @@ import (
 	_ "github.com/akuity/kargo/pkg/credentials/gar"
 	_ "github.com/akuity/kargo/pkg/credentials/github"
 	_ "github.com/akuity/kargo/pkg/credentials/ssh"
+	stepplugin "github.com/akuity/kargo/pkg/promotion/plugin"
 	_ "github.com/akuity/kargo/pkg/promotion/runner/builtin"
 )
 
@@ -408,6 +410,35 @@ func (o *controllerOptions) setupArgoCDManager(ctx context.Context) (manager.Man
 	)
 }
 
+// setupHealthCheckClient returns an uncached client for the cluster the
+// controller is running in. It is used to assess the health of arbitrary
+// Kubernetes resources, and to manage Flux resources, which is why it works
+// without an informer cache: the kinds of resources it will be asked to read
+// are not known in advance.
+func (o *controllerOptions) setupHealthCheckClient(ctx context.Context) (client.Client, error) {
+	restCfg, err := kubernetes.GetRestConfig(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("error loading REST config for local cluster client: %w", err)
+	}
+	kubernetes.ConfigureQPSBurst(ctx, restCfg, o.QPS, o.Burst)
+	return client.New(restCfg, client.Options{})
+}
+
+// setupLocalClusterClientset returns a clientset for the cluster the
+// controller is running in. It is used by steps that execute workloads, such
+// as Jobs, which must run alongside the controller in order to share its
+// working directories.
+func (o *controllerOptions) setupLocalClusterClientset(
+	ctx context.Context,
+) (clientgokubernetes.Interface, error) {
+	restCfg, err := kubernetes.GetRestConfig(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("error loading REST config for local cluster clientset: %w", err)
+	}
+	kubernetes.ConfigureQPSBurst(ctx, restCfg, o.QPS, o.Burst)
+	return clientgokubernetes.NewForConfig(restCfg)
+}
+
 func (o *controllerOptions) setupReconcilers(
 	ctx context.Context,
 	kargoMgr, argocdMgr manager.Manager,
@@ -419,7 +450,17 @@ func (o *controllerOptions) setupReconcilers(
 		argoCDClient = argocdMgr.GetClient()
 	}
 
-	healthCheckers.Initialize(argoCDClient)
+	kubeClient, err := o.setupHealthCheckClient(ctx)
+	if err != nil {
+		return fmt.Errorf("error initializing Kubernetes client for health checks: %w", err)
+	}
+
+	healthCheckers.Initialize(argoCDClient, kubeClient)
+
+	localClusterClient, err := o.setupLocalClusterClientset(ctx)
+	if err != nil {
+		return fmt.Errorf("error initializing Kubernetes clientset for local cluster: %w", err)
+	}
 
 	sharedIndexer := indexer.NewSharedFieldIndexer(kargoMgr.GetFieldIndexer())
 
@@ -428,9 +469,19 @@ func (o *controllerOptions) setupReconcilers(
 			ctx,
 			kargoMgr,
 			argocdMgr,
+			localClusterClient,
 			promotion.NewLocalEngine(
+				// Steps that are not built in are executed by StepPlugins.
+				stepplugin.NewStepRunnerRegistry(
+					promotion.DefaultStepRunnerRegistry,
+					kargoMgr.GetClient(),
+				),
 				kargoMgr.GetClient(),
 				argoCDClient,
+				// Flux resources are read and written as unstructured objects using
+				// the same uncached client that is used for health checks.
+				kubeClient,
+				localClusterClient,
 				credentialsDB,
 				promotion.DefaultExprDataCacheFn,
 			),
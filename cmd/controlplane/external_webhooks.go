@@ -12,7 +12,9 @@ import (
 	libCluster "sigs.k8s.io/controller-runtime/pkg/cluster"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	k8sevent "github.com/akuity/kargo/pkg/event/kubernetes"
 	"github.com/akuity/kargo/pkg/indexer"
+	"github.com/akuity/kargo/pkg/kubernetes/event"
 	"github.com/akuity/kargo/pkg/logging"
 	"github.com/akuity/kargo/pkg/os"
 	"github.com/akuity/kargo/pkg/server/kubernetes"
@@ -135,7 +137,18 @@ func (o *externalWebhooksServerOptions) run(ctx context.Context) error {
 		return fmt.Errorf("error starting cluster: %w", err)
 	}
 
-	srv := external.NewServer(serverCfg, cluster.GetClient())
+	srv := external.NewServer(
+		serverCfg,
+		cluster.GetClient(),
+		k8sevent.NewEventSender(
+			event.NewRecorder(
+				ctx,
+				cluster.GetScheme(),
+				cluster.GetClient(),
+				"external-webhooks-server",
+			),
+		),
+	)
 	l, err := net.Listen("tcp", fmt.Sprintf("%s:%s", o.BindAddress, o.Port))
 	if err != nil {
 		return fmt.Errorf("error creating listener: %w", err)
@@ -29,6 +29,7 @@ import (
 	"github.com/akuity/kargo/pkg/webhook/kubernetes/promotion"
 	"github.com/akuity/kargo/pkg/webhook/kubernetes/promotiontask"
 	"github.com/akuity/kargo/pkg/webhook/kubernetes/stage"
+	"github.com/akuity/kargo/pkg/webhook/kubernetes/stepplugin"
 	"github.com/akuity/kargo/pkg/webhook/kubernetes/warehouse"
 	versionpkg "github.com/akuity/kargo/pkg/x/version"
 )
@@ -167,6 +168,9 @@ func (o *kubernetesWebhooksServerOptions) run(ctx context.Context) error {
 	if err = stage.SetupWebhookWithManager(webhookCfg, mgr); err != nil {
 		return fmt.Errorf("setup Stage webhook: %w", err)
 	}
+	if err = stepplugin.SetupWebhookWithManager(mgr); err != nil {
+		return fmt.Errorf("setup StepPlugin webhook: %w", err)
+	}
 	if err = warehouse.SetupWebhookWithManager(mgr); err != nil {
 		return fmt.Errorf("setup Warehouse webhook: %w", err)
 	}
@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
@@ -18,6 +19,7 @@ import (
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/controller/management/clusterconfigs"
 	"github.com/akuity/kargo/pkg/controller/management/namespaces"
+	"github.com/akuity/kargo/pkg/controller/management/notifications"
 	"github.com/akuity/kargo/pkg/controller/management/projectconfigs"
 	"github.com/akuity/kargo/pkg/controller/management/projects"
 	"github.com/akuity/kargo/pkg/controller/management/secrets"
@@ -137,6 +139,14 @@ func (o *managementControllerOptions) run(ctx context.Context) error {
 		return fmt.Errorf("error setting up ProjectConfigs reconciler: %w", err)
 	}
 
+	if err := notifications.SetupReconcilerWithManager(
+		ctx,
+		kargoMgr,
+		notifications.ReconcilerConfigFromEnv(),
+	); err != nil {
+		return fmt.Errorf("error setting up notification reconciler: %w", err)
+	}
+
 	if o.ManageControllerRoleBindings {
 		if err := serviceaccounts.SetupReconcilerWithManager(
 			ctx,
@@ -233,6 +243,14 @@ func (o *managementControllerOptions) setupManager(
 					&corev1.Secret{}: {
 						Namespaces: namespaceCacheConfigs,
 					},
+					// Only Events pertaining to Kargo resources are of interest to
+					// the notification reconciler.
+					&corev1.Event{}: {
+						Field: fields.OneTermEqualSelector(
+							"involvedObject.apiVersion",
+							kargoapi.GroupVersion.String(),
+						),
+					},
 				},
 			},
 		},
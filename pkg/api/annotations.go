@@ -3,6 +3,7 @@ package api
 import (
 	"encoding/json"
 	"strings"
+	"time"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -99,6 +100,22 @@ func AbortPromotionAnnotationValue(annotations map[string]string) (*kargoapi.Abo
 	return &req, ok
 }
 
+// PromotionWindowOverrideAnnotationValue returns the time until which the
+// AnnotationKeyPromotionWindowOverride annotation lifts the promotion windows
+// and blackouts applicable to a Stage, and a boolean indicating whether the
+// annotation was present with a valid RFC 3339 timestamp as its value.
+func PromotionWindowOverrideAnnotationValue(annotations map[string]string) (time.Time, bool) {
+	requested, ok := annotations[kargoapi.AnnotationKeyPromotionWindowOverride]
+	if !ok {
+		return time.Time{}, false
+	}
+	until, err := time.Parse(time.RFC3339, requested)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return until, true
+}
+
 // HasMigrationAnnotationValue checks if the AnnotationKeyMigrated annotation
 // is present in the provided annotations map and if it contains the specified
 // migration type as a key with a value of true.
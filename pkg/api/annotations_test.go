@@ -3,6 +3,7 @@ package api
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -130,6 +131,30 @@ func TestAbortPromotionAnnotationValue(t *testing.T) {
 	})
 }
 
+func TestPromotionWindowOverrideAnnotationValue(t *testing.T) {
+	t.Run("has override annotation with valid timestamp", func(t *testing.T) {
+		result, ok := PromotionWindowOverrideAnnotationValue(map[string]string{
+			kargoapi.AnnotationKeyPromotionWindowOverride: "2025-01-01T12:00:00Z",
+		})
+		require.True(t, ok)
+		require.True(t, time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC).Equal(result))
+	})
+
+	t.Run("has override annotation with invalid timestamp", func(t *testing.T) {
+		result, ok := PromotionWindowOverrideAnnotationValue(map[string]string{
+			kargoapi.AnnotationKeyPromotionWindowOverride: "true",
+		})
+		require.False(t, ok)
+		require.True(t, result.IsZero())
+	})
+
+	t.Run("does not have override annotation", func(t *testing.T) {
+		result, ok := PromotionWindowOverrideAnnotationValue(nil)
+		require.False(t, ok)
+		require.True(t, result.IsZero())
+	})
+}
+
 func TestHasMigrationAnnotationValue(t *testing.T) {
 	mockObj := &kargoapi.Project{}
 
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/server/user"
+)
+
+// groupsClaim is the name of the identity provider claim listing the groups a
+// user belongs to.
+const groupsClaim = "groups"
+
+// ErrDuplicateApproval is returned by ApproveFreight when the approver has
+// already approved the Freight for the Stage.
+var ErrDuplicateApproval = errors.New("approver has already approved the Freight for the Stage")
+
+// UserGroups returns the groups listed in the provided user's "groups" claim.
+func UserGroups(u user.Info) []string {
+	switch groups := u.Claims[groupsClaim].(type) {
+	case string:
+		return []string{groups}
+	case []string:
+		return groups
+	case []any:
+		strs := make([]string, 0, len(groups))
+		for _, g := range groups {
+			if str, ok := g.(string); ok {
+				strs = append(strs, str)
+			}
+		}
+		return strs
+	default:
+		return nil
+	}
+}
+
+// IsPermittedApprover returns whether a user belonging to the provided groups
+// may approve Freight for a Stage governed by the provided ApprovalPolicy.
+func IsPermittedApprover(policy *kargoapi.ApprovalPolicy, groups []string) bool {
+	if policy == nil || len(policy.ApproverGroups) == 0 {
+		return true
+	}
+	for _, g := range groups {
+		if slices.Contains(policy.ApproverGroups, g) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSelfApprovedPromotion returns whether promoting the provided Freight to
+// the provided Stage would violate the Stage's ApprovalPolicy because the
+// promoter approved the Freight for the Stage themselves and the Freight is
+// available to the Stage only by virtue of that approval.
+func IsSelfApprovedPromotion(
+	stage *kargoapi.Stage,
+	freight *kargoapi.Freight,
+	promoter string,
+) bool {
+	policy := stage.Spec.ApprovalPolicy
+	if policy == nil || !policy.ProhibitSelfApproval || !freight.IsApprovedFor(stage.Name) {
+		return false
+	}
+	if !freight.Status.HasApprovalFrom(stage.Name, promoter) {
+		return false
+	}
+	unapproved := freight.DeepCopy()
+	delete(unapproved.Status.ApprovedFor, stage.Name)
+	return !stage.IsFreightAvailable(unapproved)
+}
+
+// ApproveFreight records the provided approval of the provided Freight for the
+// provided Stage. Because approvals may be recorded concurrently, the latest
+// version of the Freight is retrieved before the approval is added, and its
+// status is patched using an optimistic lock. If the Freight was modified in
+// the meantime, the process is retried. The provided Freight is updated to
+// reflect its latest state. It returns true only if this approval resulted in
+// the Freight becoming approved for the Stage. If the approver has already
+// approved the Freight for the Stage, ErrDuplicateApproval is returned.
+func ApproveFreight(
+	ctx context.Context,
+	c client.Client,
+	freight *kargoapi.Freight,
+	stage *kargoapi.Stage,
+	approval kargoapi.FreightApproval,
+) (bool, error) {
+	var approved bool
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		approved = false
+		latest := &kargoapi.Freight{}
+		if err := c.Get(ctx, client.ObjectKeyFromObject(freight), latest); err != nil {
+			return fmt.Errorf(
+				"error getting Freight %q in namespace %q: %w",
+				freight.Name, freight.Namespace, err,
+			)
+		}
+		*freight = *latest
+		if latest.IsApprovedFor(stage.Name) {
+			return nil
+		}
+		if latest.Status.HasApprovalFrom(stage.Name, approval.Approver) {
+			return ErrDuplicateApproval
+		}
+		patch := client.MergeFromWithOptions(latest.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		approved = latest.Status.AddApproval(
+			stage.Name,
+			approval,
+			stage.Spec.ApprovalPolicy.GetRequiredApprovals(),
+		)
+		if err := c.Status().Patch(ctx, latest, patch); err != nil {
+			return fmt.Errorf(
+				"error patching Freight %q status in namespace %q: %w",
+				freight.Name, freight.Namespace, err,
+			)
+		}
+		*freight = *latest
+		return nil
+	})
+	return approved, err
+}
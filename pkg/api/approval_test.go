@@ -0,0 +1,343 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/server/user"
+)
+
+func TestUserGroups(t *testing.T) {
+	testCases := []struct {
+		name     string
+		user     user.Info
+		expected []string
+	}{
+		{
+			name: "no groups claim",
+			user: user.Info{Claims: map[string]any{"sub": "alice"}},
+		},
+		{
+			name:     "single group",
+			user:     user.Info{Claims: map[string]any{"groups": "admins"}},
+			expected: []string{"admins"},
+		},
+		{
+			name:     "string slice",
+			user:     user.Info{Claims: map[string]any{"groups": []string{"admins", "devs"}}},
+			expected: []string{"admins", "devs"},
+		},
+		{
+			name:     "any slice",
+			user:     user.Info{Claims: map[string]any{"groups": []any{"admins", 42, "devs"}}},
+			expected: []string{"admins", "devs"},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, UserGroups(testCase.user))
+		})
+	}
+}
+
+func TestIsPermittedApprover(t *testing.T) {
+	testCases := []struct {
+		name     string
+		policy   *kargoapi.ApprovalPolicy
+		groups   []string
+		expected bool
+	}{
+		{
+			name:     "no policy",
+			expected: true,
+		},
+		{
+			name:     "no approver groups",
+			policy:   &kargoapi.ApprovalPolicy{RequiredApprovals: 2},
+			expected: true,
+		},
+		{
+			name:     "member of an approver group",
+			policy:   &kargoapi.ApprovalPolicy{ApproverGroups: []string{"release-managers"}},
+			groups:   []string{"devs", "release-managers"},
+			expected: true,
+		},
+		{
+			name:   "not a member of any approver group",
+			policy: &kargoapi.ApprovalPolicy{ApproverGroups: []string{"release-managers"}},
+			groups: []string{"devs"},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				IsPermittedApprover(testCase.policy, testCase.groups),
+			)
+		})
+	}
+}
+
+func TestIsSelfApprovedPromotion(t *testing.T) {
+	const testNamespace = "fake-project"
+	origin := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "fake-warehouse",
+	}
+	newStage := func(prohibitSelfApproval bool) *kargoapi.Stage {
+		return &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNamespace,
+				Name:      "fake-stage",
+			},
+			Spec: kargoapi.StageSpec{
+				RequestedFreight: []kargoapi.FreightRequest{{
+					Origin: origin,
+					Sources: kargoapi.FreightSources{
+						Stages: []string{"upstream-stage"},
+					},
+				}},
+				ApprovalPolicy: &kargoapi.ApprovalPolicy{
+					ProhibitSelfApproval: prohibitSelfApproval,
+				},
+			},
+		}
+	}
+	approvedFreight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "fake-freight",
+		},
+		Origin: origin,
+		Status: kargoapi.FreightStatus{
+			ApprovedFor: map[string]kargoapi.ApprovedStage{
+				"fake-stage": {
+					Approvals: []kargoapi.FreightApproval{{Approver: "alice"}},
+				},
+			},
+		},
+	}
+	verifiedFreight := approvedFreight.DeepCopy()
+	verifiedFreight.Status.VerifiedIn = map[string]kargoapi.VerifiedStage{
+		"upstream-stage": {},
+	}
+
+	testCases := []struct {
+		name     string
+		stage    *kargoapi.Stage
+		freight  *kargoapi.Freight
+		promoter string
+		expected bool
+	}{
+		{
+			name:     "self-approval not prohibited",
+			stage:    newStage(false),
+			freight:  approvedFreight,
+			promoter: "alice",
+		},
+		{
+			name:     "promoter did not approve",
+			stage:    newStage(true),
+			freight:  approvedFreight,
+			promoter: "bob",
+		},
+		{
+			name:     "Freight is available without approval",
+			stage:    newStage(true),
+			freight:  verifiedFreight,
+			promoter: "alice",
+		},
+		{
+			name:     "self-approved promotion",
+			stage:    newStage(true),
+			freight:  approvedFreight,
+			promoter: "alice",
+			expected: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				IsSelfApprovedPromotion(testCase.stage, testCase.freight, testCase.promoter),
+			)
+		})
+	}
+}
+
+func TestApproveFreight(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, kargoapi.SchemeBuilder.AddToScheme(scheme))
+
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-stage",
+			Namespace: "fake-namespace",
+		},
+		Spec: kargoapi.StageSpec{
+			ApprovalPolicy: &kargoapi.ApprovalPolicy{RequiredApprovals: 2},
+		},
+	}
+	newFreight := func(approvers ...string) *kargoapi.Freight {
+		freight := &kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "fake-freight",
+				Namespace: "fake-namespace",
+			},
+		}
+		for _, approver := range approvers {
+			freight.Status.AddApproval(
+				testStage.Name,
+				kargoapi.FreightApproval{Approver: approver},
+				testStage.Spec.ApprovalPolicy.GetRequiredApprovals(),
+			)
+		}
+		return freight
+	}
+
+	testCases := []struct {
+		name        string
+		freight     *kargoapi.Freight
+		interceptor interceptor.Funcs
+		assertions  func(*testing.T, *kargoapi.Freight, bool, error)
+	}{
+		{
+			name:    "Freight not found",
+			freight: nil,
+			assertions: func(t *testing.T, _ *kargoapi.Freight, approved bool, err error) {
+				require.ErrorContains(t, err, "error getting Freight")
+				require.True(t, apierrors.IsNotFound(err))
+				require.False(t, approved)
+			},
+		},
+		{
+			name:    "already approved for Stage",
+			freight: newFreight("bob", "carol"),
+			assertions: func(t *testing.T, freight *kargoapi.Freight, approved bool, err error) {
+				require.NoError(t, err)
+				require.False(t, approved)
+				require.True(t, freight.IsApprovedFor(testStage.Name))
+				require.False(t, freight.Status.HasApprovalFrom(testStage.Name, "alice"))
+			},
+		},
+		{
+			name:    "already approved by approver",
+			freight: newFreight("alice"),
+			assertions: func(t *testing.T, _ *kargoapi.Freight, approved bool, err error) {
+				require.ErrorIs(t, err, ErrDuplicateApproval)
+				require.False(t, approved)
+			},
+		},
+		{
+			name:    "additional approvals required",
+			freight: newFreight(),
+			assertions: func(t *testing.T, freight *kargoapi.Freight, approved bool, err error) {
+				require.NoError(t, err)
+				require.False(t, approved)
+				require.False(t, freight.IsApprovedFor(testStage.Name))
+				require.True(t, freight.Status.HasApprovalFrom(testStage.Name, "alice"))
+			},
+		},
+		{
+			name:    "approved",
+			freight: newFreight("bob"),
+			assertions: func(t *testing.T, freight *kargoapi.Freight, approved bool, err error) {
+				require.NoError(t, err)
+				require.True(t, approved)
+				require.True(t, freight.IsApprovedFor(testStage.Name))
+				require.True(t, freight.Status.HasApprovalFrom(testStage.Name, "alice"))
+				require.True(t, freight.Status.HasApprovalFrom(testStage.Name, "bob"))
+			},
+		},
+		{
+			name:    "concurrent approval is retained on conflict",
+			freight: newFreight(),
+			interceptor: func() interceptor.Funcs {
+				var conflicted bool
+				return interceptor.Funcs{
+					SubResourcePatch: func(
+						ctx context.Context,
+						c client.Client,
+						subResourceName string,
+						obj client.Object,
+						patch client.Patch,
+						opts ...client.SubResourcePatchOption,
+					) error {
+						if conflicted {
+							return c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+						}
+						conflicted = true
+						// Simulate another approver winning the race.
+						concurrent := &kargoapi.Freight{}
+						if err := c.Get(ctx, client.ObjectKeyFromObject(obj), concurrent); err != nil {
+							return err
+						}
+						concurrent.Status.AddApproval(
+							testStage.Name,
+							kargoapi.FreightApproval{Approver: "bob"},
+							testStage.Spec.ApprovalPolicy.GetRequiredApprovals(),
+						)
+						if err := c.Status().Update(ctx, concurrent); err != nil {
+							return err
+						}
+						return apierrors.NewConflict(
+							kargoapi.GroupVersion.WithResource("freights").GroupResource(),
+							obj.GetName(),
+							nil,
+						)
+					},
+				}
+			}(),
+			assertions: func(t *testing.T, freight *kargoapi.Freight, approved bool, err error) {
+				require.NoError(t, err)
+				require.True(t, approved)
+				require.True(t, freight.IsApprovedFor(testStage.Name))
+				require.True(t, freight.Status.HasApprovalFrom(testStage.Name, "alice"))
+				require.True(t, freight.Status.HasApprovalFrom(testStage.Name, "bob"))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			clientBuilder := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithStatusSubresource(&kargoapi.Freight{}).
+				WithInterceptorFuncs(testCase.interceptor)
+			freight := newFreight()
+			if testCase.freight != nil {
+				clientBuilder = clientBuilder.WithObjects(testCase.freight)
+				freight = testCase.freight.DeepCopy()
+				// Approvals are recorded against the latest version of the
+				// Freight, not the provided one.
+				freight.Status = kargoapi.FreightStatus{}
+			}
+			c := clientBuilder.Build()
+
+			approved, err := ApproveFreight(
+				context.Background(),
+				c,
+				freight,
+				testStage,
+				kargoapi.FreightApproval{Approver: "alice"},
+			)
+			testCase.assertions(t, freight, approved, err)
+
+			if testCase.freight != nil && err == nil {
+				latest := &kargoapi.Freight{}
+				require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(freight), latest))
+				require.Equal(t, latest.Status, freight.Status)
+			}
+		})
+	}
+}
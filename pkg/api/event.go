@@ -44,6 +44,13 @@ func FormatEventKubernetesUserActor(u authnv1.UserInfo) string {
 	return kargoapi.EventActorKubernetesUserPrefix + u.Username
 }
 
+// FormatEventWebhookReceiverActor returns a string representation of a webhook
+// receiver acting in an event that can be used as a value of
+// AnnotationKeyEventActor.
+func FormatEventWebhookReceiverActor(name string) string {
+	return kargoapi.EventActorWebhookReceiverPrefix + name
+}
+
 func formatOIDCUsername(u user.Info) string {
 	return fmt.Sprintf("%s:%s", u.UsernameClaim, u.Username)
 }
@@ -0,0 +1,273 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+	// Embed the IANA time zone database so that the time zones of promotion
+	// windows can be resolved regardless of what is available in the image.
+	_ "time/tzdata"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/cron"
+	"github.com/akuity/kargo/pkg/pattern"
+)
+
+// maxPromotionBlockIterations limits the number of blackouts and closed
+// promotion windows that are skipped over when searching for the next time at
+// which promotion is permitted.
+const maxPromotionBlockIterations = 100
+
+// MatchPromotionPolicy returns the first PromotionPolicy in the provided
+// ProjectConfig that applies to the Stage described by the provided
+// ObjectMeta. If no PromotionPolicy applies to the Stage, nil is returned.
+func MatchPromotionPolicy(
+	projectCfg *kargoapi.ProjectConfig,
+	stage metav1.ObjectMeta,
+) (*kargoapi.PromotionPolicy, error) {
+	for _, policy := range projectCfg.Spec.PromotionPolicies {
+		selector := policy.StageSelector
+		if selector == nil {
+			// Maintain backward compatibility with older versions of the
+			// PromotionPolicy where the selector was not available.
+			selector = &kargoapi.PromotionPolicySelector{
+				Name: policy.Stage, // nolint:staticcheck
+			}
+		}
+
+		// Match the Stage name with the PromotionPolicy name pattern.
+		if nameSelector := selector.Name; nameSelector != "" {
+			m, err := pattern.ParseNamePattern(nameSelector)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing PromotionPolicy name pattern %q: %w", nameSelector, err)
+			}
+			if !m.Matches(stage.Name) {
+				continue
+			}
+		}
+
+		// Match the Stage labels with the PromotionPolicy label selector.
+		if labelSelector := selector.LabelSelector; labelSelector != nil {
+			s, err := metav1.LabelSelectorAsSelector(labelSelector)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing PromotionPolicy label selector %q: %w", labelSelector, err)
+			}
+			if !s.Matches(labels.Set(stage.Labels)) {
+				continue
+			}
+		}
+
+		return &policy, nil
+	}
+	return nil, nil
+}
+
+// GetPromotionBlock returns a PromotionBlock describing why the PromotionPolicy
+// applicable to the Stage described by the provided ObjectMeta does not permit
+// Freight to be promoted into it at the provided time. If promotion is
+// permitted, nil is returned.
+func GetPromotionBlock(
+	ctx context.Context,
+	c client.Client,
+	stage metav1.ObjectMeta,
+	now time.Time,
+) (*kargoapi.PromotionBlock, error) {
+	projectCfg, err := GetProjectConfig(ctx, c, stage.Namespace)
+	if err != nil || projectCfg == nil {
+		return nil, err
+	}
+	policy, err := MatchPromotionPolicy(projectCfg, stage)
+	if err != nil || policy == nil {
+		return nil, err
+	}
+	return EvaluatePromotionPolicy(policy, stage, now)
+}
+
+// EvaluatePromotionPolicy returns a PromotionBlock describing why the provided
+// PromotionPolicy does not permit Freight to be promoted into the Stage
+// described by the provided ObjectMeta at the provided time. If promotion is
+// permitted, nil is returned. Promotion is always permitted while the Stage
+// carries an unexpired AnnotationKeyPromotionWindowOverride annotation.
+func EvaluatePromotionPolicy(
+	policy *kargoapi.PromotionPolicy,
+	stage metav1.ObjectMeta,
+	now time.Time,
+) (*kargoapi.PromotionBlock, error) {
+	if len(policy.PromotionWindows) == 0 && len(policy.Blackouts) == 0 {
+		return nil, nil
+	}
+	if until, ok := PromotionWindowOverrideAnnotationValue(stage.Annotations); ok && now.Before(until) {
+		return nil, nil
+	}
+
+	windows := make([]promotionWindow, len(policy.PromotionWindows))
+	for i, w := range policy.PromotionWindows {
+		var err error
+		if windows[i], err = parsePromotionWindow(w); err != nil {
+			return nil, err
+		}
+	}
+
+	block := getPromotionBlockAt(windows, policy.Blackouts, now)
+	if block == nil {
+		return nil, nil
+	}
+
+	// Search for the next time at which promotion is permitted by repeatedly
+	// skipping to the end of whatever blocks promotion at the candidate time.
+	var nextPermitted time.Time
+	candidate := now
+	for range maxPromotionBlockIterations {
+		b := getPromotionBlockAt(windows, policy.Blackouts, candidate)
+		if b == nil {
+			nextPermitted = candidate
+			break
+		}
+		if b.until.IsZero() {
+			break
+		}
+		candidate = b.until
+	}
+
+	if !nextPermitted.IsZero() {
+		block.NextPermittedTime = &metav1.Time{Time: nextPermitted}
+		if block.Reason == kargoapi.PromotionBlockReasonOutsideWindows {
+			block.Name = openPromotionWindowAt(windows, nextPermitted)
+		}
+	}
+	block.Message = formatPromotionBlockMessage(block)
+	return &block.PromotionBlock, nil
+}
+
+// promotionWindow is a PromotionWindow with its schedule and time zone parsed.
+type promotionWindow struct {
+	name     string
+	schedule *cron.Schedule
+	duration time.Duration
+	location *time.Location
+}
+
+// parsePromotionWindow parses the schedule and time zone of the provided
+// PromotionWindow.
+func parsePromotionWindow(w kargoapi.PromotionWindow) (promotionWindow, error) {
+	schedule, err := cron.Parse(w.Schedule)
+	if err != nil {
+		return promotionWindow{}, fmt.Errorf(
+			"error parsing schedule of promotion window %q: %w", w.Name, err,
+		)
+	}
+	location := time.UTC
+	if w.TimeZone != "" {
+		if location, err = time.LoadLocation(w.TimeZone); err != nil {
+			return promotionWindow{}, fmt.Errorf(
+				"error loading time zone of promotion window %q: %w", w.Name, err,
+			)
+		}
+	}
+	return promotionWindow{
+		name:     w.Name,
+		schedule: schedule,
+		duration: w.Duration.Duration,
+		location: location,
+	}, nil
+}
+
+// isOpenAt returns whether the window is open at the provided time. The window
+// is open if it most recently opened no more than its duration ago.
+func (w promotionWindow) isOpenAt(t time.Time) bool {
+	opened := w.schedule.Next(t.In(w.location).Add(-w.duration))
+	return !opened.IsZero() && !opened.After(t)
+}
+
+// promotionBlockAt is a PromotionBlock along with the time at which the
+// specific condition causing it ends. A zero until indicates that the
+// condition does not end.
+type promotionBlockAt struct {
+	kargoapi.PromotionBlock
+	until time.Time
+	// blackoutReason is the reason given for the blackout causing the block, if
+	// any.
+	blackoutReason string
+}
+
+// getPromotionBlockAt returns what blocks promotion at the provided time given
+// the provided windows and blackouts. If promotion is permitted, nil is
+// returned.
+func getPromotionBlockAt(
+	windows []promotionWindow,
+	blackouts []kargoapi.PromotionBlackout,
+	t time.Time,
+) *promotionBlockAt {
+	for _, blackout := range blackouts {
+		if !t.Before(blackout.Start.Time) && t.Before(blackout.End.Time) {
+			return &promotionBlockAt{
+				PromotionBlock: kargoapi.PromotionBlock{
+					Reason: kargoapi.PromotionBlockReasonBlackout,
+					Name:   blackout.Name,
+				},
+				until:          blackout.End.Time,
+				blackoutReason: blackout.Reason,
+			}
+		}
+	}
+	if len(windows) == 0 {
+		return nil
+	}
+	block := &promotionBlockAt{
+		PromotionBlock: kargoapi.PromotionBlock{
+			Reason: kargoapi.PromotionBlockReasonOutsideWindows,
+		},
+	}
+	for _, w := range windows {
+		if w.isOpenAt(t) {
+			return nil
+		}
+		if next := w.schedule.Next(t.In(w.location)); !next.IsZero() &&
+			(block.until.IsZero() || next.Before(block.until)) {
+			block.Name = w.name
+			block.until = next
+		}
+	}
+	return block
+}
+
+// openPromotionWindowAt returns the name of the first of the provided windows
+// that is open at the provided time.
+func openPromotionWindowAt(windows []promotionWindow, t time.Time) string {
+	for _, w := range windows {
+		if w.isOpenAt(t) {
+			return w.name
+		}
+	}
+	return ""
+}
+
+// formatPromotionBlockMessage returns a human-readable description of the
+// provided PromotionBlock.
+func formatPromotionBlockMessage(block *promotionBlockAt) string {
+	var msg string
+	switch block.Reason {
+	case kargoapi.PromotionBlockReasonBlackout:
+		msg = fmt.Sprintf("promotion is blocked by blackout %q", block.Name)
+		if block.blackoutReason != "" {
+			msg += fmt.Sprintf(" (%s)", block.blackoutReason)
+		}
+	default:
+		msg = "promotion is blocked because no promotion window is open"
+	}
+	if block.NextPermittedTime == nil {
+		return msg + "; the next time at which promotion will be permitted could not be determined"
+	}
+	msg += fmt.Sprintf(
+		"; promotion will next be permitted at %s",
+		block.NextPermittedTime.UTC().Format(time.RFC3339),
+	)
+	if block.Reason == kargoapi.PromotionBlockReasonOutsideWindows && block.Name != "" {
+		msg += fmt.Sprintf(" within promotion window %q", block.Name)
+	}
+	return msg
+}
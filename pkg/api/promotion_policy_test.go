@@ -0,0 +1,392 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestMatchPromotionPolicy(t *testing.T) {
+	testCases := []struct {
+		name       string
+		policies   []kargoapi.PromotionPolicy
+		stage      metav1.ObjectMeta
+		assertions func(*testing.T, *kargoapi.PromotionPolicy, error)
+	}{
+		{
+			name: "no policies",
+			stage: metav1.ObjectMeta{
+				Name: "test",
+			},
+			assertions: func(t *testing.T, policy *kargoapi.PromotionPolicy, err error) {
+				require.NoError(t, err)
+				require.Nil(t, policy)
+			},
+		},
+		{
+			name: "matches deprecated stage field",
+			policies: []kargoapi.PromotionPolicy{
+				{Stage: "other"},
+				{Stage: "test", AutoPromotionEnabled: true},
+			},
+			stage: metav1.ObjectMeta{Name: "test"},
+			assertions: func(t *testing.T, policy *kargoapi.PromotionPolicy, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, policy)
+				require.True(t, policy.AutoPromotionEnabled)
+			},
+		},
+		{
+			name: "matches name pattern and labels",
+			policies: []kargoapi.PromotionPolicy{{
+				StageSelector: &kargoapi.PromotionPolicySelector{
+					Name: "glob:prod-*",
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"tier": "critical"},
+					},
+				},
+				AutoPromotionEnabled: true,
+			}},
+			stage: metav1.ObjectMeta{
+				Name:   "prod-eu",
+				Labels: map[string]string{"tier": "critical"},
+			},
+			assertions: func(t *testing.T, policy *kargoapi.PromotionPolicy, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, policy)
+			},
+		},
+		{
+			name: "labels do not match",
+			policies: []kargoapi.PromotionPolicy{{
+				StageSelector: &kargoapi.PromotionPolicySelector{
+					Name: "glob:prod-*",
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"tier": "critical"},
+					},
+				},
+			}},
+			stage: metav1.ObjectMeta{Name: "prod-eu"},
+			assertions: func(t *testing.T, policy *kargoapi.PromotionPolicy, err error) {
+				require.NoError(t, err)
+				require.Nil(t, policy)
+			},
+		},
+		{
+			name: "invalid name pattern",
+			policies: []kargoapi.PromotionPolicy{{
+				StageSelector: &kargoapi.PromotionPolicySelector{
+					Name: "regex:[unclosed",
+				},
+			}},
+			stage: metav1.ObjectMeta{Name: "test"},
+			assertions: func(t *testing.T, _ *kargoapi.PromotionPolicy, err error) {
+				require.ErrorContains(t, err, "error parsing PromotionPolicy name pattern")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			policy, err := MatchPromotionPolicy(
+				&kargoapi.ProjectConfig{
+					Spec: kargoapi.ProjectConfigSpec{
+						PromotionPolicies: testCase.policies,
+					},
+				},
+				testCase.stage,
+			)
+			testCase.assertions(t, policy, err)
+		})
+	}
+}
+
+func TestGetPromotionBlock(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	// A Saturday
+	testNow := time.Date(2025, 1, 4, 12, 0, 0, 0, time.UTC)
+	testStage := metav1.ObjectMeta{
+		Namespace: "fake-project",
+		Name:      "fake-stage",
+	}
+
+	t.Run("no ProjectConfig", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		block, err := GetPromotionBlock(context.Background(), c, testStage, testNow)
+		require.NoError(t, err)
+		require.Nil(t, block)
+	})
+
+	t.Run("blocked by matching policy", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&kargoapi.ProjectConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "fake-project",
+				},
+				Spec: kargoapi.ProjectConfigSpec{
+					PromotionPolicies: []kargoapi.PromotionPolicy{{
+						StageSelector: &kargoapi.PromotionPolicySelector{
+							Name: "fake-stage",
+						},
+						PromotionWindows: []kargoapi.PromotionWindow{{
+							Name:     "weekdays",
+							Schedule: "0 9 * * 1-5",
+							Duration: metav1.Duration{Duration: 8 * time.Hour},
+						}},
+					}},
+				},
+			},
+		).Build()
+		block, err := GetPromotionBlock(context.Background(), c, testStage, testNow)
+		require.NoError(t, err)
+		require.NotNil(t, block)
+		require.Equal(t, kargoapi.PromotionBlockReasonOutsideWindows, block.Reason)
+	})
+}
+
+func TestEvaluatePromotionPolicy(t *testing.T) {
+	// A Friday
+	testNow := time.Date(2025, 1, 3, 12, 0, 0, 0, time.UTC)
+	weekdayWindow := kargoapi.PromotionWindow{
+		Name:     "business-hours",
+		Schedule: "0 9 * * mon-fri",
+		Duration: metav1.Duration{Duration: 8 * time.Hour},
+	}
+	testCases := []struct {
+		name       string
+		policy     kargoapi.PromotionPolicy
+		stage      metav1.ObjectMeta
+		now        time.Time
+		assertions func(*testing.T, *kargoapi.PromotionBlock, error)
+	}{
+		{
+			name:   "no windows or blackouts",
+			policy: kargoapi.PromotionPolicy{},
+			now:    testNow,
+			assertions: func(t *testing.T, block *kargoapi.PromotionBlock, err error) {
+				require.NoError(t, err)
+				require.Nil(t, block)
+			},
+		},
+		{
+			name: "invalid schedule",
+			policy: kargoapi.PromotionPolicy{
+				PromotionWindows: []kargoapi.PromotionWindow{{
+					Name:     "bad",
+					Schedule: "not a schedule",
+				}},
+			},
+			now: testNow,
+			assertions: func(t *testing.T, _ *kargoapi.PromotionBlock, err error) {
+				require.ErrorContains(t, err, `error parsing schedule of promotion window "bad"`)
+			},
+		},
+		{
+			name: "invalid time zone",
+			policy: kargoapi.PromotionPolicy{
+				PromotionWindows: []kargoapi.PromotionWindow{{
+					Name:     "bad",
+					Schedule: "@daily",
+					TimeZone: "Mars/Olympus_Mons",
+				}},
+			},
+			now: testNow,
+			assertions: func(t *testing.T, _ *kargoapi.PromotionBlock, err error) {
+				require.ErrorContains(t, err, `error loading time zone of promotion window "bad"`)
+			},
+		},
+		{
+			name: "inside window",
+			policy: kargoapi.PromotionPolicy{
+				PromotionWindows: []kargoapi.PromotionWindow{weekdayWindow},
+			},
+			now: testNow,
+			assertions: func(t *testing.T, block *kargoapi.PromotionBlock, err error) {
+				require.NoError(t, err)
+				require.Nil(t, block)
+			},
+		},
+		{
+			name: "window closes at end of duration",
+			policy: kargoapi.PromotionPolicy{
+				PromotionWindows: []kargoapi.PromotionWindow{weekdayWindow},
+			},
+			now: time.Date(2025, 1, 3, 17, 0, 0, 0, time.UTC),
+			assertions: func(t *testing.T, block *kargoapi.PromotionBlock, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, block)
+				require.Equal(t, kargoapi.PromotionBlockReasonOutsideWindows, block.Reason)
+				require.Equal(t, "business-hours", block.Name)
+				require.NotNil(t, block.NextPermittedTime)
+				// The following Monday
+				require.True(
+					t,
+					time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC).Equal(block.NextPermittedTime.Time),
+				)
+				require.Equal(
+					t,
+					"promotion is blocked because no promotion window is open; promotion will "+
+						"next be permitted at 2025-01-06T09:00:00Z within promotion window "+
+						`"business-hours"`,
+					block.Message,
+				)
+			},
+		},
+		{
+			name: "window in time zone",
+			policy: kargoapi.PromotionPolicy{
+				PromotionWindows: []kargoapi.PromotionWindow{{
+					Name:     "business-hours",
+					Schedule: "0 9 * * mon-fri",
+					Duration: metav1.Duration{Duration: 8 * time.Hour},
+					TimeZone: "America/New_York",
+				}},
+			},
+			// 07:00 in New York
+			now: testNow,
+			assertions: func(t *testing.T, block *kargoapi.PromotionBlock, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, block)
+				require.True(
+					t,
+					time.Date(2025, 1, 3, 14, 0, 0, 0, time.UTC).Equal(block.NextPermittedTime.Time),
+				)
+			},
+		},
+		{
+			name: "blackout overrides open window",
+			policy: kargoapi.PromotionPolicy{
+				PromotionWindows: []kargoapi.PromotionWindow{weekdayWindow},
+				Blackouts: []kargoapi.PromotionBlackout{{
+					Name:   "new-year",
+					Start:  metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+					End:    metav1.NewTime(time.Date(2025, 1, 3, 15, 0, 0, 0, time.UTC)),
+					Reason: "holiday freeze",
+				}},
+			},
+			now: testNow,
+			assertions: func(t *testing.T, block *kargoapi.PromotionBlock, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, block)
+				require.Equal(t, kargoapi.PromotionBlockReasonBlackout, block.Reason)
+				require.Equal(t, "new-year", block.Name)
+				// The window is still open when the blackout ends.
+				require.True(
+					t,
+					time.Date(2025, 1, 3, 15, 0, 0, 0, time.UTC).Equal(block.NextPermittedTime.Time),
+				)
+				require.Equal(
+					t,
+					`promotion is blocked by blackout "new-year" (holiday freeze); promotion `+
+						"will next be permitted at 2025-01-03T15:00:00Z",
+					block.Message,
+				)
+			},
+		},
+		{
+			name: "blackout ends outside of windows",
+			policy: kargoapi.PromotionPolicy{
+				PromotionWindows: []kargoapi.PromotionWindow{weekdayWindow},
+				Blackouts: []kargoapi.PromotionBlackout{{
+					Name:  "new-year",
+					Start: metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+					End:   metav1.NewTime(time.Date(2025, 1, 4, 0, 0, 0, 0, time.UTC)),
+				}},
+			},
+			now: testNow,
+			assertions: func(t *testing.T, block *kargoapi.PromotionBlock, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, block)
+				require.Equal(t, kargoapi.PromotionBlockReasonBlackout, block.Reason)
+				require.Equal(t, "new-year", block.Name)
+				require.True(
+					t,
+					time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC).Equal(block.NextPermittedTime.Time),
+				)
+			},
+		},
+		{
+			name: "blackout without windows",
+			policy: kargoapi.PromotionPolicy{
+				Blackouts: []kargoapi.PromotionBlackout{{
+					Name:  "new-year",
+					Start: metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+					End:   metav1.NewTime(time.Date(2025, 1, 4, 0, 0, 0, 0, time.UTC)),
+				}},
+			},
+			now: testNow,
+			assertions: func(t *testing.T, block *kargoapi.PromotionBlock, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, block)
+				require.True(
+					t,
+					time.Date(2025, 1, 4, 0, 0, 0, 0, time.UTC).Equal(block.NextPermittedTime.Time),
+				)
+			},
+		},
+		{
+			name: "window never opens",
+			policy: kargoapi.PromotionPolicy{
+				PromotionWindows: []kargoapi.PromotionWindow{{
+					Name:     "never",
+					Schedule: "0 0 30 2 *",
+					Duration: metav1.Duration{Duration: time.Hour},
+				}},
+			},
+			now: testNow,
+			assertions: func(t *testing.T, block *kargoapi.PromotionBlock, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, block)
+				require.Nil(t, block.NextPermittedTime)
+				require.Contains(t, block.Message, "could not be determined")
+			},
+		},
+		{
+			name: "unexpired override",
+			policy: kargoapi.PromotionPolicy{
+				PromotionWindows: []kargoapi.PromotionWindow{weekdayWindow},
+			},
+			stage: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					kargoapi.AnnotationKeyPromotionWindowOverride: "2025-01-04T00:00:00Z",
+				},
+			},
+			now: time.Date(2025, 1, 3, 20, 0, 0, 0, time.UTC),
+			assertions: func(t *testing.T, block *kargoapi.PromotionBlock, err error) {
+				require.NoError(t, err)
+				require.Nil(t, block)
+			},
+		},
+		{
+			name: "expired override",
+			policy: kargoapi.PromotionPolicy{
+				PromotionWindows: []kargoapi.PromotionWindow{weekdayWindow},
+			},
+			stage: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					kargoapi.AnnotationKeyPromotionWindowOverride: "2025-01-03T18:00:00Z",
+				},
+			},
+			now: time.Date(2025, 1, 3, 20, 0, 0, 0, time.UTC),
+			assertions: func(t *testing.T, block *kargoapi.PromotionBlock, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, block)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			block, err := EvaluatePromotionPolicy(&testCase.policy, testCase.stage, testCase.now)
+			testCase.assertions(t, block, err)
+		})
+	}
+}
@@ -184,11 +184,27 @@ func AnnotateStageWithArgoCDContext(
 // Freight associated with a Stage by setting an AnnotationKeyReverify
 // annotation on the Stage, causing the controller to rerun the verification.
 // The annotation value is the identifier of the existing VerificationInfo for
-// the Stage.
+// the Stage. The actor is derived from the user information in the context,
+// if any.
 func ReverifyStageFreight(
 	ctx context.Context,
 	c client.Client,
 	namespacedName types.NamespacedName,
+) error {
+	var actor string
+	if u, ok := user.InfoFromContext(ctx); ok {
+		actor = FormatEventUserActor(u)
+	}
+	return ReverifyStageFreightAs(ctx, c, namespacedName, actor)
+}
+
+// ReverifyStageFreightAs is the same as ReverifyStageFreight, but records the
+// provided actor as the one requesting the reverification.
+func ReverifyStageFreightAs(
+	ctx context.Context,
+	c client.Client,
+	namespacedName types.NamespacedName,
+	actor string,
 ) error {
 	stage, err := GetStage(ctx, c, namespacedName)
 	if err != nil || stage == nil {
@@ -215,10 +231,8 @@ func ReverifyStageFreight(
 
 	rr := kargoapi.VerificationRequest{
 		ID: currentVI.ID,
-	}
-	// Put actor information to track on the controller side
-	if u, ok := user.InfoFromContext(ctx); ok {
-		rr.Actor = FormatEventUserActor(u)
+		// Put actor information to track on the controller side
+		Actor: actor,
 	}
 	return patchAnnotation(ctx, c, stage, kargoapi.AnnotationKeyReverify, rr.String())
 }
@@ -389,6 +389,45 @@ func TestReverifyStageFreight(t *testing.T) {
 			ID: "fake-id",
 		}).String(), stage.Annotations[kargoapi.AnnotationKeyReverify])
 	})
+
+	t.Run("success with actor", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "fake-stage",
+					Namespace: "fake-namespace",
+				},
+				Status: kargoapi.StageStatus{
+					FreightHistory: kargoapi.FreightHistory{
+						{
+							Freight: map[string]kargoapi.FreightReference{
+								"fake-warehouse": {},
+							},
+							VerificationHistory: []kargoapi.VerificationInfo{{
+								ID: "fake-id",
+							}},
+						},
+					},
+				},
+			},
+		).Build()
+
+		err := ReverifyStageFreightAs(context.TODO(), c, types.NamespacedName{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		}, "fake-actor")
+		require.NoError(t, err)
+
+		stage, err := GetStage(context.TODO(), c, types.NamespacedName{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		})
+		require.NoError(t, err)
+		require.Equal(t, (&kargoapi.VerificationRequest{
+			ID:    "fake-id",
+			Actor: "fake-actor",
+		}).String(), stage.Annotations[kargoapi.AnnotationKeyReverify])
+	})
 }
 
 func TestAbortStageFreightVerification(t *testing.T) {
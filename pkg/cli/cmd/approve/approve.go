@@ -22,6 +22,7 @@ type approvalOptions struct {
 	FreightName  string
 	FreightAlias string
 	Stage        string
+	Comment      string
 }
 
 func NewCommand(cfg config.CLIConfig) *cobra.Command {
@@ -30,7 +31,7 @@ func NewCommand(cfg config.CLIConfig) *cobra.Command {
 	}
 
 	cmd := &cobra.Command{
-		Use:   "approve [--project=project] (--freight=freight | --freight-alias=alias) --stage=stage",
+		Use:   "approve [--project=project] (--freight=freight | --freight-alias=alias) --stage=stage [--comment=comment]",
 		Short: "Manually approve a piece of freight for promotion to a stage",
 		Args:  option.NoArgs,
 		Example: templates.Example(`
@@ -40,6 +41,9 @@ kargo approve --project=my-project --freight=abc1234 --stage=qa
 # Approve a piece of freight specified by alias for the QA stage
 kargo approve --project=my-project --freight-alias=wonky-wombat --stage=qa
 
+# Approve a piece of freight for the prod stage with a comment recorded alongside the approval
+kargo approve --project=my-project --freight=abc1234 --stage=prod --comment="Change ticket CHG-1234"
+
 # Approve a piece of freight specified by name for the QA stage in the default project
 kargo config set-project my-project
 kargo approve --freight=abc1234 --stage=qa
@@ -74,6 +78,7 @@ func (o *approvalOptions) addFlags(cmd *cobra.Command) {
 	option.Freight(cmd.Flags(), &o.FreightName, "The name of the freight to approve.")
 	option.FreightAlias(cmd.Flags(), &o.FreightAlias, "The alias of the freight to approve.")
 	option.Stage(cmd.Flags(), &o.Stage, "The stage for which to approve the freight.")
+	option.Comment(cmd.Flags(), &o.Comment, "An optional comment to record with the approval.")
 
 	if err := cmd.MarkFlagRequired(option.StageFlag); err != nil {
 		panic(fmt.Errorf("could not mark %s flag as required: %w", option.StageFlag, err))
@@ -117,13 +122,15 @@ func (o *approvalOptions) run(ctx context.Context) error {
 		freightNameOrAlias = o.FreightAlias
 	}
 
-	if _, err = apiClient.Core.ApproveFreight(
-		core.NewApproveFreightParams().
-			WithProject(o.Project).
-			WithFreightNameOrAlias(freightNameOrAlias).
-			WithStage(o.Stage),
-		nil,
-	); err != nil {
+	params := core.NewApproveFreightParams().
+		WithProject(o.Project).
+		WithFreightNameOrAlias(freightNameOrAlias).
+		WithStage(o.Stage)
+	if o.Comment != "" {
+		params = params.WithComment(&o.Comment)
+	}
+
+	if _, err = apiClient.Core.ApproveFreight(params, nil); err != nil {
 		return fmt.Errorf("approve freight: %w", err)
 	}
 	return nil
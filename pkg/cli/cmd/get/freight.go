@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -215,6 +217,7 @@ func newFreightTable(list *metav1.List) *metav1.Table {
 				frt.Name,
 				alias,
 				frt.Origin.String(),
+				formatFreightApprovals(frt),
 				duration.HumanDuration(time.Since(frt.CreationTimestamp.Time)),
 			},
 			Object: list.Items[i],
@@ -225,8 +228,42 @@ func newFreightTable(list *metav1.List) *metav1.Table {
 			{Name: "Name", Type: "string"},
 			{Name: "Alias", Type: "string"},
 			{Name: "Origin", Type: "string"},
+			{Name: "Approvals", Type: "string"},
 			{Name: "Age", Type: "string"},
 		},
 		Rows: rows,
 	}
 }
+
+// formatFreightApprovals returns a summary of the Stages for which the
+// provided Freight has been approved, or has approvals pending, along with who
+// approved it for each.
+func formatFreightApprovals(frt *kargoapi.Freight) string {
+	stages := make([]string, 0, len(frt.Status.ApprovedFor)+len(frt.Status.PendingApprovals))
+	for stage := range frt.Status.ApprovedFor {
+		stages = append(stages, stage)
+	}
+	for stage := range frt.Status.PendingApprovals {
+		if !frt.IsApprovedFor(stage) {
+			stages = append(stages, stage)
+		}
+	}
+	slices.Sort(stages)
+	summaries := make([]string, len(stages))
+	for i, stage := range stages {
+		summary := stage
+		if !frt.IsApprovedFor(stage) {
+			summary += " (pending)"
+		}
+		approvals := frt.Status.GetApprovals(stage)
+		if len(approvals) > 0 {
+			approvers := make([]string, len(approvals))
+			for j, approval := range approvals {
+				approvers[j] = approval.Approver
+			}
+			summary += ": " + strings.Join(approvers, ", ")
+		}
+		summaries[i] = summary
+	}
+	return strings.Join(summaries, "; ")
+}
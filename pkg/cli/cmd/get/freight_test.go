@@ -0,0 +1,60 @@
+package get
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func Test_formatFreightApprovals(t *testing.T) {
+	testCases := []struct {
+		name     string
+		freight  *kargoapi.Freight
+		expected string
+	}{
+		{
+			name:    "no approvals",
+			freight: &kargoapi.Freight{},
+		},
+		{
+			name: "approved without approval records",
+			freight: &kargoapi.Freight{
+				Status: kargoapi.FreightStatus{
+					ApprovedFor: map[string]kargoapi.ApprovedStage{"qa": {}},
+				},
+			},
+			expected: "qa",
+		},
+		{
+			name: "approved and pending approvals",
+			freight: &kargoapi.Freight{
+				Status: kargoapi.FreightStatus{
+					ApprovedFor: map[string]kargoapi.ApprovedStage{
+						"uat": {
+							Approvals: []kargoapi.FreightApproval{
+								{Approver: "email:alice@example.com"},
+								{Approver: "email:bob@example.com"},
+							},
+						},
+					},
+					PendingApprovals: map[string]kargoapi.PendingApproval{
+						"prod": {
+							Approvals: []kargoapi.FreightApproval{
+								{Approver: "email:carol@example.com"},
+							},
+						},
+					},
+				},
+			},
+			expected: "prod (pending): email:carol@example.com; " +
+				"uat: email:alice@example.com, email:bob@example.com",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, formatFreightApprovals(testCase.freight))
+		})
+	}
+}
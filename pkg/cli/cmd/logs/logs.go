@@ -26,6 +26,7 @@ type logsOptions struct {
 	Name      string
 	Metric    string
 	Container string
+	Step      string
 }
 
 func NewCommand(
@@ -38,8 +39,8 @@ func NewCommand(
 	}
 
 	cmd := &cobra.Command{
-		Use:   "logs [--project=project] NAME [--metric=metric] [--container=container]",
-		Short: "View logs of completed AnalysisRuns (verifications) that utilize JobMetrics",
+		Use:   "logs [--project=project] NAME [--metric=metric] [--container=container] [--step=step]",
+		Short: "View logs of AnalysisRuns (verifications) that utilize JobMetrics or of container-run Promotion steps",
 		Args:  cobra.ExactArgs(1),
 		Example: templates.Example(`
 # Show logs from an AnalysisRun with one JobMetric
@@ -53,6 +54,9 @@ kargo logs --project=my-project some-analysis-run --container=some-container
 
 # Show logs from a specific JobMetric and container
 kargo logs --project=my-project some-analysis-run --metric=some-metric --container=some-container
+
+# Show logs from a container-run step of a Promotion
+kargo logs --project=my-project some-promotion --step=some-step
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmdOpts.complete(args)
@@ -79,6 +83,13 @@ func (o *logsOptions) addFlags(cmd *cobra.Command) {
 	)
 	option.Metric(cmd.Flags(), &o.Metric, "A specific JobMetric of the AnalysisRun")
 	option.Container(cmd.Flags(), &o.Container, "A specific container specified by the JobMetric")
+	option.Step(
+		cmd.Flags(), &o.Step,
+		"The alias of a container-run step. If set, NAME is the name of a Promotion rather than an AnalysisRun.",
+	)
+
+	cmd.MarkFlagsMutuallyExclusive(option.StepFlag, option.MetricFlag)
+	cmd.MarkFlagsMutuallyExclusive(option.StepFlag, option.ContainerFlag)
 }
 
 // complete sets the options from the command arguments.
@@ -86,7 +97,7 @@ func (o *logsOptions) complete(args []string) {
 	o.Name = strings.TrimSpace(args[0])
 }
 
-// run retrieves logs for the specified AnalysisRun.
+// run retrieves logs for the specified AnalysisRun or Promotion step.
 func (o *logsOptions) run(ctx context.Context) error {
 	if o.Project == "" {
 		return errors.New("project is required")
@@ -97,13 +108,19 @@ func (o *logsOptions) run(ctx context.Context) error {
 		return fmt.Errorf("get client from config: %w", err)
 	}
 
-	logCh, errCh := watchClient.StreamAnalysisRunLogs(
-		ctx,
-		o.Project,
-		o.Name,
-		o.Metric,
-		o.Container,
-	)
+	var logCh <-chan string
+	var errCh <-chan error
+	if o.Step != "" {
+		logCh, errCh = watchClient.StreamPromotionStepLogs(ctx, o.Project, o.Name, o.Step)
+	} else {
+		logCh, errCh = watchClient.StreamAnalysisRunLogs(
+			ctx,
+			o.Project,
+			o.Name,
+			o.Metric,
+			o.Container,
+		)
+	}
 
 	return o.displayLogs(ctx, logCh, errCh)
 }
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	goio "io"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"golang.org/x/sync/errgroup"
@@ -38,6 +40,7 @@ type promotionOptions struct {
 	DownstreamFrom string
 	Abort          bool
 	Wait           bool
+	DryRun         bool
 }
 
 func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
@@ -49,7 +52,7 @@ func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra
 
 	cmd := &cobra.Command{
 		Use: "promote [--project=project] (--freight=freight | --freight-alias=alias | --name=name) " +
-			"[(--stage=stage | --downstream-from=stage) | --abort]",
+			"[(--stage=stage [--dry-run] | --downstream-from=stage) | --abort]",
 		Short: "Promote a piece of freight",
 		Args:  option.NoArgs,
 		// nolint: lll
@@ -66,6 +69,10 @@ kargo promote --project=my-project --freight=abc123 --downstream-from=qa
 # Promote a piece of freight specified by alias to stages immediately downstream from the QA stage
 kargo promote --project=my-project --freight-alias=wonky-wombat --downstream-from=qa
 
+# Preview what promoting a piece of freight to the QA stage would change without
+# pushing commits, opening pull requests or updating Argo CD applications
+kargo promote --project=my-project --freight=abc123 --stage=qa --dry-run
+
 # Abort a Promotion by name
 kargo promote --project=my-project --name=my-promotion --abort
 
@@ -133,6 +140,11 @@ func (o *promotionOptions) addFlags(cmd *cobra.Command) {
 		"Abort a non-terminal promotion. If set, --%s must be set.", option.NameFlag,
 	))
 	option.Wait(cmd.Flags(), &o.Wait, false, "Wait for the promotion(s) to complete.")
+	option.DryRun(cmd.Flags(), &o.DryRun, false, fmt.Sprintf(
+		"Plan, rather than execute, any promotion steps with side effects and print the resulting "+
+			"changes once the promotion completes. Implies --%s. If set, --%s must be set.",
+		option.WaitFlag, option.StageFlag,
+	))
 
 	cmd.MarkFlagsOneRequired(option.FreightFlag, option.FreightAliasFlag, option.NameFlag)
 	cmd.MarkFlagsMutuallyExclusive(option.FreightFlag, option.FreightAliasFlag, option.NameFlag)
@@ -141,6 +153,9 @@ func (o *promotionOptions) addFlags(cmd *cobra.Command) {
 	cmd.MarkFlagsMutuallyExclusive(option.StageFlag, option.DownstreamFromFlag, option.AbortFlag)
 
 	cmd.MarkFlagsRequiredTogether(option.NameFlag, option.AbortFlag)
+
+	cmd.MarkFlagsMutuallyExclusive(option.DryRunFlag, option.DownstreamFromFlag)
+	cmd.MarkFlagsMutuallyExclusive(option.DryRunFlag, option.AbortFlag)
 }
 
 // validate performs validation of the options. If the options are invalid, an
@@ -203,6 +218,7 @@ func (o *promotionOptions) run(ctx context.Context) error {
 				WithBody(&models.PromoteToStageRequest{
 					Freight:      o.FreightName,
 					FreightAlias: o.FreightAlias,
+					DryRun:       o.DryRun,
 				}),
 			nil,
 		); err != nil {
@@ -220,11 +236,14 @@ func (o *promotionOptions) run(ctx context.Context) error {
 			return fmt.Errorf("unmarshal promotion: %w", err)
 		}
 		promo := result.Promotion
-		if o.Wait {
+		if o.Wait || o.DryRun {
 			if err = o.waitForPromotion(ctx, nil, promo); err != nil {
 				return fmt.Errorf("wait for promotion: %w", err)
 			}
 		}
+		if o.DryRun && (o.OutputFormat == nil || *o.OutputFormat == "") {
+			return printDryRunResult(o.Out, promo)
+		}
 		_ = printer.PrintObj(promo, o.Out)
 		return nil
 	case o.DownstreamFrom != "":
@@ -287,6 +306,8 @@ func (o *promotionOptions) waitForPromotions(
 	return g.Wait()
 }
 
+// waitForPromotion waits for the provided Promotion to reach a terminal phase
+// and updates it with its terminal state.
 func (o *promotionOptions) waitForPromotion(
 	ctx context.Context,
 	watchClient *watch.Client,
@@ -323,6 +344,7 @@ func (o *promotionOptions) waitForPromotion(
 				return errors.New("unexpected end of watch stream")
 			}
 			if event.Object != nil && event.Object.Status.Phase.IsTerminal() {
+				*p = *event.Object
 				return nil
 			}
 		case err := <-errCh:
@@ -334,3 +356,42 @@ func (o *promotionOptions) waitForPromotion(
 		}
 	}
 }
+
+// printDryRunResult prints a human-readable summary of the result of the
+// provided Promotion, which must be a completed dry run, to the provided
+// writer.
+func printDryRunResult(out goio.Writer, p *kargoapi.Promotion) error {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Dry run of Promotion %q %s", p.Name, p.Status.Phase)
+	if p.Status.Message != "" {
+		fmt.Fprintf(&sb, ": %s", p.Status.Message)
+	}
+	sb.WriteString("\n")
+
+	res := p.Status.DryRun
+	if res == nil || len(res.PlannedSteps) == 0 {
+		sb.WriteString("\nNo steps with side effects were planned.\n")
+	} else {
+		sb.WriteString("\nPlanned steps:\n")
+		for _, step := range res.PlannedSteps {
+			fmt.Fprintf(&sb, "- %s", step.Uses)
+			if step.As != "" {
+				fmt.Fprintf(&sb, " (%s)", step.As)
+			}
+			sb.WriteString("\n")
+			if step.Config != nil && len(step.Config.Raw) > 0 {
+				fmt.Fprintf(&sb, "  config: %s\n", step.Config.Raw)
+			}
+		}
+	}
+
+	if res == nil || res.Diff == "" {
+		sb.WriteString("\nNo changes were made to the working tree.\n")
+	} else {
+		sb.WriteString("\nChanges:\n")
+		sb.WriteString(res.Diff)
+	}
+
+	_, err := goio.WriteString(out, sb.String())
+	return err
+}
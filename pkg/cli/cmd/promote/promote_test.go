@@ -0,0 +1,76 @@
+package promote
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func Test_printDryRunResult(t *testing.T) {
+	testCases := []struct {
+		name      string
+		promotion *kargoapi.Promotion
+		expected  string
+	}{
+		{
+			name: "nothing planned or changed",
+			promotion: &kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{Name: "fake-promotion"},
+				Status: kargoapi.PromotionStatus{
+					Phase: kargoapi.PromotionPhaseSucceeded,
+				},
+			},
+			expected: `Dry run of Promotion "fake-promotion" Succeeded
+
+No steps with side effects were planned.
+
+No changes were made to the working tree.
+`,
+		},
+		{
+			name: "steps planned and changes made",
+			promotion: &kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{Name: "fake-promotion"},
+				Status: kargoapi.PromotionStatus{
+					Phase:   kargoapi.PromotionPhaseFailed,
+					Message: "something went wrong",
+					DryRun: &kargoapi.PromotionDryRunResult{
+						PlannedSteps: []kargoapi.PlannedPromotionStep{
+							{
+								As:     "push",
+								Uses:   "git-push",
+								Config: &apiextensionsv1.JSON{Raw: []byte(`{"path":"./out"}`)},
+							},
+							{
+								Uses: "argocd-update",
+							},
+						},
+						Diff: "fake-diff\n",
+					},
+				},
+			},
+			expected: `Dry run of Promotion "fake-promotion" Failed: something went wrong
+
+Planned steps:
+- git-push (push)
+  config: {"path":"./out"}
+- argocd-update
+
+Changes:
+fake-diff
+`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			require.NoError(t, printDryRunResult(out, testCase.promotion))
+			require.Equal(t, testCase.expected, out.String())
+		})
+	}
+}
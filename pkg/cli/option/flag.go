@@ -25,6 +25,9 @@ const (
 	// ClaimFlag is a flag name for the claim flag.
 	ClaimFlag = "claim"
 
+	// CommentFlag is the flag name for the comment flag.
+	CommentFlag = "comment"
+
 	// ContainerFlag is the flag name for the container flag.
 	ContainerFlag = "container"
 
@@ -34,6 +37,9 @@ const (
 	// DownstreamFromFlag is the flag name for the downstream-from flag.
 	DownstreamFromFlag = "downstream-from"
 
+	// DryRunFlag is the flag name for the dry-run flag.
+	DryRunFlag = "dry-run"
+
 	// FilenameFlag is the flag name for the filename flag.
 	FilenameFlag = "filename"
 	// FilenameShortFlag is the short flag name for the filename flag.
@@ -112,6 +118,9 @@ const (
 	// StageFlag is the flag name for the stage flag.
 	StageFlag = "stage"
 
+	// StepFlag is the flag name for the step flag.
+	StepFlag = "step"
+
 	// SystemFlag is the flag name for the system flag.
 	SystemFlag = "system"
 
@@ -160,6 +169,11 @@ func Claims(fs *pflag.FlagSet, claims *[]string, usage string) {
 	fs.StringSliceVar(claims, ClaimFlag, nil, usage)
 }
 
+// Comment adds the CommentFlag to the provided flag set.
+func Comment(fs *pflag.FlagSet, comment *string, usage string) {
+	fs.StringVar(comment, CommentFlag, "", usage)
+}
+
 func Container(fs *pflag.FlagSet, container *string, usage string) {
 	fs.StringVar(container, ContainerFlag, "", usage)
 }
@@ -174,6 +188,11 @@ func DownstreamFrom(fs *pflag.FlagSet, downstreamFrom *string, usage string) {
 	fs.StringVar(downstreamFrom, DownstreamFromFlag, "", usage)
 }
 
+// DryRun adds the DryRunFlag to the provided flag set.
+func DryRun(fs *pflag.FlagSet, dryRun *bool, defaultDryRun bool, usage string) {
+	fs.BoolVar(dryRun, DryRunFlag, defaultDryRun, usage)
+}
+
 // Filenames adds the FilenameFlag and FilenameShortFlag to the provided flag set.
 func Filenames(fs *pflag.FlagSet, filenames *[]string, usage string) {
 	fs.StringSliceVarP(filenames, FilenameFlag, FilenameShortFlag, nil, usage)
@@ -308,6 +327,11 @@ func Stage(fs *pflag.FlagSet, stage *string, usage string) {
 	fs.StringVar(stage, StageFlag, "", usage)
 }
 
+// Step adds the StepFlag to the provided flag set.
+func Step(fs *pflag.FlagSet, step *string, usage string) {
+	fs.StringVar(step, StepFlag, "", usage)
+}
+
 // System adds the SystemFlag to the provided flag set.
 
 // Wait adds the WaitFlag to the provided flag set.
@@ -61,6 +61,12 @@ ApproveFreightParams contains all the parameters to send to the API endpoint
 */
 type ApproveFreightParams struct {
 
+	/* Comment.
+
+	   Optional comment recorded with the approval
+	*/
+	Comment *string
+
 	/* FreightNameOrAlias.
 
 	   Freight name or alias
@@ -132,6 +138,17 @@ func (o *ApproveFreightParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithComment adds the comment to the approve freight params
+func (o *ApproveFreightParams) WithComment(comment *string) *ApproveFreightParams {
+	o.SetComment(comment)
+	return o
+}
+
+// SetComment adds the comment to the approve freight params
+func (o *ApproveFreightParams) SetComment(comment *string) {
+	o.Comment = comment
+}
+
 // WithFreightNameOrAlias adds the freightNameOrAlias to the approve freight params
 func (o *ApproveFreightParams) WithFreightNameOrAlias(freightNameOrAlias string) *ApproveFreightParams {
 	o.SetFreightNameOrAlias(freightNameOrAlias)
@@ -173,6 +190,23 @@ func (o *ApproveFreightParams) WriteToRequest(r runtime.ClientRequest, reg strfm
 	}
 	var res []error
 
+	if o.Comment != nil {
+
+		// query param comment
+		var qrComment string
+
+		if o.Comment != nil {
+			qrComment = *o.Comment
+		}
+		qComment := qrComment
+		if qComment != "" {
+
+			if err := r.SetQueryParam("comment", qComment); err != nil {
+				return err
+			}
+		}
+	}
+
 	// path param freight-name-or-alias
 	if err := r.SetPathParam("freight-name-or-alias", o.FreightNameOrAlias); err != nil {
 		return err
@@ -94,6 +94,8 @@ type ClientService interface {
 
 	GetPromotion(params *GetPromotionParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetPromotionOK, error)
 
+	GetPromotionStepLogs(params *GetPromotionStepLogsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetPromotionStepLogsOK, error)
+
 	GetPromotionTask(params *GetPromotionTaskParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetPromotionTaskOK, error)
 
 	GetSharedConfigMap(params *GetSharedConfigMapParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetSharedConfigMapOK, error)
@@ -1043,6 +1045,52 @@ func (a *Client) GetPromotion(params *GetPromotionParams, authInfo runtime.Clien
 	panic(msg)
 }
 
+/*
+GetPromotionStepLogs streams promotion step logs
+
+Stream logs from a container-run Promotion step as Server-Sent Events (SSE).
+*/
+func (a *Client) GetPromotionStepLogs(params *GetPromotionStepLogsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetPromotionStepLogsOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewGetPromotionStepLogsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetPromotionStepLogs",
+		Method:             "GET",
+		PathPattern:        "/v1beta1/projects/{project}/promotions/{promotion}/steps/{step}/logs",
+		ProducesMediaTypes: []string{"text/event-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetPromotionStepLogsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*GetPromotionStepLogsOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+
+	// no default response is defined.
+	//
+	// safeguard: normally, in the absence of a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetPromotionStepLogs: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 GetPromotionTask retrieves a promotion task
 
@@ -1997,7 +2045,9 @@ func (a *Client) PromoteDownstream(params *PromoteDownstreamParams, authInfo run
 
 	Create a Promotion resource to transition a specified Stage into
 
-the state represented by the specified Freight.
+the state represented by the specified Freight. If dryRun is
+set, steps with side effects are planned rather than executed
+and the Stage is left unchanged.
 */
 func (a *Client) PromoteToStage(params *PromoteToStageParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PromoteToStageCreated, error) {
 	// NOTE: parameters are not validated before sending
@@ -17,6 +17,10 @@ import (
 // swagger:model PromoteToStageRequest
 type PromoteToStageRequest struct {
 
+	// DryRun indicates that the Promotion should only plan, and not execute,
+	// any steps with side effects beyond its working directory.
+	DryRun bool `json:"dryRun,omitempty"`
+
 	// freight
 	Freight string `json:"freight,omitempty"`
 
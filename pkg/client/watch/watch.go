@@ -317,6 +317,21 @@ func (c *Client) StreamAnalysisRunLogs(
 	return streamLogs(ctx, c, url)
 }
 
+// StreamPromotionStepLogs streams logs from a container-run step of a
+// promotion.
+func (c *Client) StreamPromotionStepLogs(
+	ctx context.Context,
+	project string,
+	promotion string,
+	step string,
+) (<-chan string, <-chan error) {
+	url := fmt.Sprintf(
+		"%s/v1beta1/projects/%s/promotions/%s/steps/%s/logs",
+		c.baseURL, project, promotion, step,
+	)
+	return streamLogs(ctx, c, url)
+}
+
 // streamLogs streams log data from an SSE endpoint.
 func streamLogs(
 	ctx context.Context,
@@ -671,6 +671,43 @@ func TestStreamAnalysisRunLogs_Error(t *testing.T) {
 	}
 }
 
+func TestStreamPromotionStepLogs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(
+			t,
+			"/v1beta1/projects/test-project/promotions/test-promotion/steps/step-1/logs",
+			r.URL.Path,
+		)
+		assert.Equal(t, "text/event-stream", r.Header.Get("Accept"))
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, "data: log line 1\n\n")
+		fmt.Fprint(w, "data: log line 2\n\n")
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, server.Client(), "test-token")
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	logCh, errCh := client.StreamPromotionStepLogs(ctx, "test-project", "test-promotion", "step-1")
+
+	logs := make([]string, 0)
+	for log := range logCh {
+		logs = append(logs, log)
+	}
+
+	select {
+	case err := <-errCh:
+		require.NoError(t, err)
+	default:
+	}
+
+	assert.Equal(t, []string{"log line 1", "log line 2"}, logs)
+}
+
 func TestReadLogStream(t *testing.T) {
 	tests := []struct {
 		name         string
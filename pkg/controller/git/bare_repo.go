@@ -178,6 +178,11 @@ type AddWorkTreeOptions struct {
 	// Ref specifies the branch or commit to check out in the working tree. Will
 	// be ignored if Orphan is true.
 	Ref string
+	// RecordBase specifies whether the commit checked out in the working tree
+	// should be recorded as the base that WorkTree.Diff compares against. This
+	// is only needed when the changes made to the working tree are to be
+	// reported without being pushed, as they are in a dry run.
+	RecordBase bool
 	// Sparse specifies directory patterns for sparse checkout using cone mode.
 	// When specified, only the listed directories (and their contents) will be
 	// checked out. Patterns should be directory paths relative to the repository
@@ -222,6 +227,13 @@ func (b *bareRepo) AddWorkTree(path string, opts *AddWorkTreeOptions) (WorkTree,
 		},
 		bareRepo: b,
 	}
+	// A new, orphaned branch has no commit to record as a base.
+	if opts.RecordBase && !opts.Orphan {
+		if err = wt.recordBase(); err != nil {
+			_ = b.RemoveWorkTree(path)
+			return nil, err
+		}
+	}
 	// Configure sparse checkout if patterns are specified
 	if len(opts.Sparse) > 0 {
 		if err = wt.configureSparseCheckout(opts.Sparse); err != nil {
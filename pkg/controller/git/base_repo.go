@@ -48,6 +48,11 @@ type ClientOptions struct {
 	// InsecureSkipTLSVerify indicates whether to ignore certificate verification
 	// errors when interacting with the remote repository.
 	InsecureSkipTLSVerify bool
+	// TrustedSigningKeys maps the identities of trusted signers to their public
+	// keys. Each value may hold one or more ASCII-armored GPG public keys or SSH
+	// public keys in authorized_keys format. Only signatures made with these
+	// keys are reported as verified by GetCommitSignature and GetTagSignature.
+	TrustedSigningKeys map[string]string
 }
 
 // setupClient sets up "global" git configuration with author and authentication
@@ -65,6 +70,15 @@ func (b *baseRepo) setupClient(homeDir string, opts *ClientOptions) error {
 		return fmt.Errorf("error configuring the credentials: %w", err)
 	}
 
+	if len(opts.TrustedSigningKeys) > 0 {
+		if err := b.setupSignatureVerification(
+			homeDir,
+			opts.TrustedSigningKeys,
+		); err != nil {
+			return fmt.Errorf("error configuring signature verification: %w", err)
+		}
+	}
+
 	if opts.InsecureSkipTLSVerify {
 		cmd := b.buildGitCommand("config", "--global", "http.sslVerify", "false")
 		// Override the home directory set by b.buildGitCommand().
@@ -22,6 +22,9 @@ type baseSelector struct {
 	includePaths          pattern.Matcher
 	excludePaths          pattern.Matcher
 	discoveryLimit        int
+	verifySignatures      bool
+	verifyTags            bool
+	trustedSigningKeys    map[string]string
 
 	gitCloneFn func(
 		repoURL string,
@@ -33,6 +36,7 @@ type baseSelector struct {
 func newBaseSelector(
 	sub kargoapi.GitSubscription,
 	creds *git.RepoCredentials,
+	trustedSigningKeys map[string]string,
 ) (*baseSelector, error) {
 	s := &baseSelector{
 		repoURL:               sub.RepoURL,
@@ -41,6 +45,11 @@ func newBaseSelector(
 		discoveryLimit:        int(sub.DiscoveryLimit),
 		gitCloneFn:            git.Clone,
 	}
+	if sub.Verification != nil {
+		s.verifySignatures = true
+		s.verifyTags = sub.Verification.VerifyTags
+		s.trustedSigningKeys = trustedSigningKeys
+	}
 	var err error
 	if sub.ExpressionFilter != "" {
 		s.filterExpression, err = expr.Compile(sub.ExpressionFilter)
@@ -63,5 +72,29 @@ func (b *baseSelector) getLoggerContext() []any {
 	return []any{
 		"repo", b.repoURL,
 		"pathConstrained", b.includePaths != nil || b.excludePaths != nil,
+		"signatureVerified", b.verifySignatures,
+	}
+}
+
+// getClientOptions returns options for a repository-specific Git client that
+// can be used by any selector to clone the selector's repository.
+func (b *baseSelector) getClientOptions() *git.ClientOptions {
+	return &git.ClientOptions{
+		Credentials:           b.creds,
+		InsecureSkipTLSVerify: b.insecureSkipTLSVerify,
+		TrustedSigningKeys:    b.trustedSigningKeys,
+	}
+}
+
+// toAPISigner converts a git.Signature to a *kargoapi.CommitSigner. If the
+// provided signature is nil, nil is returned.
+func toAPISigner(sig *git.Signature) *kargoapi.CommitSigner {
+	if sig == nil {
+		return nil
+	}
+	return &kargoapi.CommitSigner{
+		Type:     string(sig.Type),
+		Identity: sig.Signer,
+		Key:      sig.Key,
 	}
 }
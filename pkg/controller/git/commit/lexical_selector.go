@@ -31,8 +31,9 @@ type lexicalSelector struct {
 func newLexicalSelector(
 	sub kargoapi.GitSubscription,
 	creds *git.RepoCredentials,
+	trustedSigningKeys map[string]string,
 ) (Selector, error) {
-	tagBased, err := newTagBasedSelector(sub, creds)
+	tagBased, err := newTagBasedSelector(sub, creds, trustedSigningKeys)
 	if err != nil {
 		return nil, fmt.Errorf("error building tag based selector: %w", err)
 	}
@@ -79,5 +80,9 @@ func (l *lexicalSelector) Select(ctx context.Context) (
 		return nil, fmt.Errorf("error filtering tags by paths: %w", err)
 	}
 
+	if tags, err = l.filterTagsBySignatures(repo, tags); err != nil {
+		return nil, fmt.Errorf("error filtering tags by signatures: %w", err)
+	}
+
 	return l.tagsToAPICommits(ctx, tags), nil
 }
@@ -45,13 +45,18 @@ type newestFromBranchSelector struct {
 		repo git.Repo,
 		commitID string,
 	) ([]string, error)
+	getCommitSignatureFn func(
+		repo git.Repo,
+		commitID string,
+	) (*git.Signature, error)
 }
 
 func newNewestFromBranchSelector(
 	sub kargoapi.GitSubscription,
 	creds *git.RepoCredentials,
+	trustedSigningKeys map[string]string,
 ) (Selector, error) {
-	base, err := newBaseSelector(sub, creds)
+	base, err := newBaseSelector(sub, creds, trustedSigningKeys)
 	if err != nil {
 		return nil, fmt.Errorf("error building base selector: %w", err)
 	}
@@ -62,6 +67,7 @@ func newNewestFromBranchSelector(
 	s.selectCommitsFn = s.selectCommits
 	s.listCommitsFn = s.listCommits
 	s.getDiffPathsForCommitIDFn = s.getDiffPathsForCommitID
+	s.getCommitSignatureFn = s.getCommitSignature
 	return s, nil
 }
 
@@ -99,10 +105,7 @@ func (n *newestFromBranchSelector) Select(ctx context.Context) (
 	logger.Debug("cloning repository")
 	repo, err := n.gitCloneFn(
 		n.repoURL,
-		&git.ClientOptions{
-			Credentials:           n.creds,
-			InsecureSkipTLSVerify: n.insecureSkipTLSVerify,
-		},
+		n.getClientOptions(),
 		&git.CloneOptions{
 			Branch:       n.branch,
 			SingleBranch: true,
@@ -141,7 +144,8 @@ func (n *newestFromBranchSelector) selectCommits(
 		}
 
 		// If no filters are specified, return the first commits up to the limit.
-		if n.includePaths == nil && n.excludePaths == nil && n.filterExpression == nil {
+		if n.includePaths == nil && n.excludePaths == nil &&
+			n.filterExpression == nil && !n.verifySignatures {
 			return trimSlice(commits, n.discoveryLimit), nil
 		}
 
@@ -171,6 +175,25 @@ func (n *newestFromBranchSelector) selectCommits(
 				}
 			}
 
+			// If signatures must be verified, filter out commits that are not
+			// signed by a trusted key.
+			if n.verifySignatures {
+				if commit.Signature, err = n.getCommitSignatureFn(
+					repo,
+					commit.ID,
+				); err != nil {
+					return nil, fmt.Errorf(
+						"error verifying signature of commit %q in git repo %q: %w",
+						commit.ID,
+						n.repoURL,
+						err,
+					)
+				}
+				if commit.Signature == nil {
+					continue
+				}
+			}
+
 			// If we reach this point, the commit got past all the filters.
 			selectedCommits = append(selectedCommits, commit)
 
@@ -198,6 +221,13 @@ func (n *newestFromBranchSelector) getDiffPathsForCommitID(
 	return repo.GetDiffPathsForCommitID(commitID)
 }
 
+func (n *newestFromBranchSelector) getCommitSignature(
+	repo git.Repo,
+	commitID string,
+) (*git.Signature, error) {
+	return repo.GetCommitSignature(commitID)
+}
+
 // evaluateCommitExpression evaluates the given commit expression against
 // the given commit metadata. The commit metadata is passed as the environment
 // for the expression evaluation. It returns true if the expression evaluates to
@@ -254,6 +284,7 @@ func (n *newestFromBranchSelector) commitsToAPICommits(
 			Author:      meta.Author,
 			Committer:   meta.Committer,
 			CreatorDate: &metav1.Time{Time: meta.CommitDate},
+			Signer:      toAPISigner(meta.Signature),
 		})
 		logger.Trace(
 			"discovered commit from branch",
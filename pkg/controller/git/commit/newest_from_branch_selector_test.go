@@ -47,7 +47,7 @@ func TestNewNewestFromBranchSelector(t *testing.T) {
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			s, err := newNewestFromBranchSelector(testCase.sub, nil)
+			s, err := newNewestFromBranchSelector(testCase.sub, nil, nil)
 			testCase.assertions(t, s, err)
 		})
 	}
@@ -440,6 +440,79 @@ func Test_newestFromBranchSelector_selectCommits(t *testing.T) {
 				)
 			},
 		},
+		{
+			name: "error verifying signature",
+			selector: &newestFromBranchSelector{
+				baseSelector: &baseSelector{
+					verifySignatures: true,
+				},
+				listCommitsFn: func(git.Repo, uint, uint) ([]git.CommitMetadata, error) {
+					return []git.CommitMetadata{{ID: "A"}}, nil
+				},
+				getCommitSignatureFn: func(git.Repo, string) (*git.Signature, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ []git.CommitMetadata, err error) {
+				require.ErrorContains(t, err, "error verifying signature of commit")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "signature verification filters out commits",
+			selector: &newestFromBranchSelector{
+				baseSelector: &baseSelector{
+					verifySignatures: true,
+					discoveryLimit:   2,
+				},
+				listCommitsFn: func(git.Repo, uint, uint) ([]git.CommitMetadata, error) {
+					return []git.CommitMetadata{
+						{ID: "A"},
+						{ID: "B"},
+						{ID: "C"},
+						{ID: "D"},
+					}, nil
+				},
+				getCommitSignatureFn: func(
+					_ git.Repo,
+					commitID string,
+				) (*git.Signature, error) {
+					if commitID == "B" {
+						return nil, nil
+					}
+					return &git.Signature{
+						Type:   git.SigningKeyTypeSSH,
+						Signer: "alice",
+						Key:    "SHA256:" + commitID,
+					}, nil
+				},
+			},
+			assertions: func(t *testing.T, commits []git.CommitMetadata, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]git.CommitMetadata{
+						{
+							ID: "A",
+							Signature: &git.Signature{
+								Type:   git.SigningKeyTypeSSH,
+								Signer: "alice",
+								Key:    "SHA256:A",
+							},
+						},
+						{
+							ID: "C",
+							Signature: &git.Signature{
+								Type:   git.SigningKeyTypeSSH,
+								Signer: "alice",
+								Key:    "SHA256:C",
+							},
+						},
+					},
+					commits,
+				)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -29,8 +29,9 @@ type newestTagSelector struct {
 func newNewestTagSelector(
 	sub kargoapi.GitSubscription,
 	creds *git.RepoCredentials,
+	trustedSigningKeys map[string]string,
 ) (Selector, error) {
-	tagBased, err := newTagBasedSelector(sub, creds)
+	tagBased, err := newTagBasedSelector(sub, creds, trustedSigningKeys)
 	if err != nil {
 		return nil, fmt.Errorf("error building tag based selector: %w", err)
 	}
@@ -75,5 +76,9 @@ func (n *newestTagSelector) Select(ctx context.Context) (
 		return nil, fmt.Errorf("error filtering tags by paths: %w", err)
 	}
 
+	if tags, err = n.filterTagsBySignatures(repo, tags); err != nil {
+		return nil, fmt.Errorf("error filtering tags by signatures: %w", err)
+	}
+
 	return n.tagsToAPICommits(ctx, tags), nil
 }
@@ -20,10 +20,14 @@ type Selector interface {
 
 // NewSelector returns some implementation of the Selector interface that
 // selects commits from a Git repository based on the provided subscription.
+// If the subscription specifies a verification policy, signatures are verified
+// using the provided trusted signing keys, which map the identities of signers
+// to their public keys.
 func NewSelector(
 	ctx context.Context,
 	sub kargoapi.GitSubscription,
 	creds *git.RepoCredentials,
+	trustedSigningKeys map[string]string,
 ) (Selector, error) {
 	// Pick an appropriate Selector implementation based on the subscription
 	// provided.
@@ -32,5 +36,5 @@ func NewSelector(
 		return nil, fmt.Errorf("error getting selector factory")
 	}
 	factory := reg.Value
-	return factory(sub, creds)
+	return factory(sub, creds, trustedSigningKeys)
 }
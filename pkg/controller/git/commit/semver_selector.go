@@ -36,8 +36,9 @@ type semverSelector struct {
 func newSemverSelector(
 	sub kargoapi.GitSubscription,
 	creds *git.RepoCredentials,
+	trustedSigningKeys map[string]string,
 ) (Selector, error) {
-	tagBased, err := newTagBasedSelector(sub, creds)
+	tagBased, err := newTagBasedSelector(sub, creds, trustedSigningKeys)
 	if err != nil {
 		return nil, fmt.Errorf("error building tag based selector: %w", err)
 	}
@@ -126,6 +127,10 @@ func (s *semverSelector) Select(ctx context.Context) (
 		return nil, fmt.Errorf("error filtering tags by paths: %w", err)
 	}
 
+	if tags, err = s.filterTagsBySignatures(repo, tags); err != nil {
+		return nil, fmt.Errorf("error filtering tags by signatures: %w", err)
+	}
+
 	return s.tagsToAPICommits(ctx, tags), nil
 }
 
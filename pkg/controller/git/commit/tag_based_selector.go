@@ -51,8 +51,9 @@ func compileRegexes(regexStrs []string) ([]*regexp.Regexp, error) {
 func newTagBasedSelector(
 	sub kargoapi.GitSubscription,
 	creds *git.RepoCredentials,
+	trustedSigningKeys map[string]string,
 ) (*tagBasedSelector, error) {
-	base, err := newBaseSelector(sub, creds)
+	base, err := newBaseSelector(sub, creds, trustedSigningKeys)
 	if err != nil {
 		return nil, fmt.Errorf("error building base selector: %w", err)
 	}
@@ -157,10 +158,7 @@ func (t *tagBasedSelector) clone(ctx context.Context) (git.Repo, error) {
 	}
 	repo, err := t.gitCloneFn(
 		t.repoURL,
-		&git.ClientOptions{
-			Credentials:           t.creds,
-			InsecureSkipTLSVerify: t.insecureSkipTLSVerify,
-		},
+		t.getClientOptions(),
 		cloneOpts,
 	)
 	if err != nil {
@@ -232,7 +230,8 @@ func (t *tagBasedSelector) filterTagsByExpression(
 // filterTagsByDiffPaths iterates over all provided tags, for each, retrieving
 // information about paths affected by the commit it references and evaluating
 // those paths against user-defined path-selection criteria. Only tags pointing
-// to commits that satisfy those criteria are returned.
+// to commits that satisfy those criteria are returned. Unless signatures must
+// subsequently be verified, no more tags than the discovery limit are returned.
 func (t *tagBasedSelector) filterTagsByDiffPaths(
 	repo git.Repo,
 	tags []git.TagMetadata,
@@ -254,6 +253,45 @@ func (t *tagBasedSelector) filterTagsByDiffPaths(
 		if matchesPathsFilters(t.includePaths, t.excludePaths, diffPaths) {
 			filteredTags = append(filteredTags, tag)
 		}
+		if !t.verifySignatures && len(filteredTags) >= t.discoveryLimit {
+			break
+		}
+	}
+	return filteredTags, nil
+}
+
+// filterTagsBySignatures iterates over all provided tags, for each, verifying
+// the signature of the tag itself or of the commit it references, depending on
+// the selector's verification policy. Only tags whose signatures verify with a
+// trusted key are returned, with their signatures recorded. No more tags than
+// the discovery limit are returned. If the selector has no verification
+// policy, the provided tags are returned unchanged.
+func (t *tagBasedSelector) filterTagsBySignatures(
+	repo git.Repo,
+	tags []git.TagMetadata,
+) ([]git.TagMetadata, error) {
+	if !t.verifySignatures || len(tags) == 0 {
+		return tags, nil
+	}
+	filteredTags := make([]git.TagMetadata, 0, t.discoveryLimit)
+	for _, tag := range tags {
+		var err error
+		if t.verifyTags {
+			tag.Signature, err = repo.GetTagSignature(tag.Tag)
+		} else {
+			tag.Signature, err = repo.GetCommitSignature(tag.CommitID)
+		}
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error verifying signature of tag %q in git repo %q: %w",
+				tag.Tag,
+				t.repoURL,
+				err,
+			)
+		}
+		if tag.Signature != nil {
+			filteredTags = append(filteredTags, tag)
+		}
 		if len(filteredTags) >= t.discoveryLimit {
 			break
 		}
@@ -285,6 +323,7 @@ func (t *tagBasedSelector) tagsToAPICommits(
 			Author:      tag.Author,
 			Committer:   tag.Committer,
 			CreatorDate: &metav1.Time{Time: tag.CreatorDate},
+			Signer:      toAPISigner(tag.Signature),
 		}
 		logger.Trace(
 			"discovered commit from tag",
@@ -1,6 +1,7 @@
 package commit
 
 import (
+	"errors"
 	"regexp"
 	"testing"
 	"time"
@@ -83,7 +84,7 @@ func TestNewTagBasedSelector(t *testing.T) {
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			s, err := newTagBasedSelector(testCase.sub, nil)
+			s, err := newTagBasedSelector(testCase.sub, nil, nil)
 			testCase.assertions(t, s, err)
 		})
 	}
@@ -277,3 +278,116 @@ func Test_tagBasedSelector_filterTagsByExpression(t *testing.T) {
 		})
 	}
 }
+
+func Test_tagBasedSelector_filterTagsBySignatures(t *testing.T) {
+	signature := &git.Signature{
+		Type:   git.SigningKeyTypeGPG,
+		Signer: "Alice <alice@example.com>",
+		Key:    "750D9B01E7B9040F35DEC77CE66BF16FD86AA00B",
+	}
+	tags := []git.TagMetadata{
+		{Tag: "v1.0.0", CommitID: "A"},
+		{Tag: "v1.1.0", CommitID: "B"},
+		{Tag: "v1.2.0", CommitID: "C"},
+	}
+	testCases := []struct {
+		name       string
+		selector   *tagBasedSelector
+		repo       git.Repo
+		assertions func(*testing.T, []git.TagMetadata, error)
+	}{
+		{
+			name: "verification not enabled",
+			selector: &tagBasedSelector{
+				baseSelector: &baseSelector{discoveryLimit: 1},
+			},
+			assertions: func(t *testing.T, filtered []git.TagMetadata, err error) {
+				require.NoError(t, err)
+				require.Equal(t, tags, filtered)
+			},
+		},
+		{
+			name: "error verifying commit signature",
+			selector: &tagBasedSelector{
+				baseSelector: &baseSelector{
+					verifySignatures: true,
+					discoveryLimit:   10,
+				},
+			},
+			repo: &git.MockRepo{
+				GetCommitSignatureFn: func(string) (*git.Signature, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ []git.TagMetadata, err error) {
+				require.ErrorContains(t, err, "error verifying signature of tag")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "commit signatures verified",
+			selector: &tagBasedSelector{
+				baseSelector: &baseSelector{
+					verifySignatures: true,
+					discoveryLimit:   10,
+				},
+			},
+			repo: &git.MockRepo{
+				GetCommitSignatureFn: func(id string) (*git.Signature, error) {
+					if id == "B" {
+						return nil, nil
+					}
+					return signature, nil
+				},
+			},
+			assertions: func(t *testing.T, filtered []git.TagMetadata, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]git.TagMetadata{
+						{Tag: "v1.0.0", CommitID: "A", Signature: signature},
+						{Tag: "v1.2.0", CommitID: "C", Signature: signature},
+					},
+					filtered,
+				)
+			},
+		},
+		{
+			name: "tag signatures verified up to the discovery limit",
+			selector: &tagBasedSelector{
+				baseSelector: &baseSelector{
+					verifySignatures: true,
+					verifyTags:       true,
+					discoveryLimit:   1,
+				},
+			},
+			repo: &git.MockRepo{
+				GetTagSignatureFn: func(tag string) (*git.Signature, error) {
+					if tag == "v1.0.0" {
+						return nil, nil
+					}
+					return signature, nil
+				},
+			},
+			assertions: func(t *testing.T, filtered []git.TagMetadata, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]git.TagMetadata{
+						{Tag: "v1.1.0", CommitID: "B", Signature: signature},
+					},
+					filtered,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			filtered, err := testCase.selector.filterTagsBySignatures(
+				testCase.repo,
+				tags,
+			)
+			testCase.assertions(t, filtered, err)
+		})
+	}
+}
@@ -2,4 +2,7 @@ package git
 
 type SigningKeyType string
 
-const SigningKeyTypeGPG SigningKeyType = "gpg"
+const (
+	SigningKeyTypeGPG SigningKeyType = "gpg"
+	SigningKeyTypeSSH SigningKeyType = "ssh"
+)
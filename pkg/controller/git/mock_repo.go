@@ -15,10 +15,13 @@ type MockRepo struct {
 	CreateOrphanedBranchFn    func(branch string) error
 	CurrentBranchFn           func() (string, error)
 	DeleteBranchFn            func(branch string) error
+	DiffFn                    func(pathPrefix string) (string, error)
 	DirFn                     func() string
 	HasDiffsFn                func() (bool, error)
 	HomeDirFn                 func() string
 	GetDiffPathsForCommitIDFn func(commitID string) ([]string, error)
+	GetCommitSignatureFn      func(id string) (*Signature, error)
+	GetTagSignatureFn         func(tag string) (*Signature, error)
 	IsAncestorFn              func(parent string, child string) (bool, error)
 	IsRebasingFn              func() (bool, error)
 	LastCommitIDFn            func() (string, error)
@@ -83,6 +86,10 @@ func (m *MockRepo) DeleteBranch(branch string) error {
 	return m.DeleteBranchFn(branch)
 }
 
+func (m *MockRepo) Diff(pathPrefix string) (string, error) {
+	return m.DiffFn(pathPrefix)
+}
+
 func (m *MockRepo) Dir() string {
 	return m.DirFn()
 }
@@ -101,6 +108,14 @@ func (m *MockRepo) GetDiffPathsForCommitID(
 	return m.GetDiffPathsForCommitIDFn(commitID)
 }
 
+func (m *MockRepo) GetCommitSignature(id string) (*Signature, error) {
+	return m.GetCommitSignatureFn(id)
+}
+
+func (m *MockRepo) GetTagSignature(tag string) (*Signature, error) {
+	return m.GetTagSignatureFn(tag)
+}
+
 func (m *MockRepo) IsAncestor(parent string, child string) (bool, error) {
 	return m.IsAncestorFn(parent, child)
 }
@@ -0,0 +1,280 @@
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+
+	libExec "github.com/akuity/kargo/pkg/exec"
+)
+
+const pgpPublicKeyBlockHeader = "-----BEGIN PGP PUBLIC KEY BLOCK-----"
+
+// sshGoodSignatureRegex matches the line output by `git verify-tag` upon
+// successful verification of an SSH signature made with a key that is
+// associated with a principal in the allowed signers file.
+var sshGoodSignatureRegex = regexp.MustCompile(
+	`^Good "git" signature for (\S+) with \S+ key (\S+)$`,
+)
+
+// Signature represents a verified signature of a commit or tag.
+type Signature struct {
+	// Type is the type of the key that produced the signature.
+	Type SigningKeyType
+	// Signer is the identity of the signer. For GPG signatures, this is the
+	// user ID of the signing key. For SSH signatures, this is the identity the
+	// signing key was associated with in ClientOptions.TrustedSigningKeys.
+	Signer string
+	// Key is the fingerprint of the signing key.
+	Key string
+}
+
+// setupSignatureVerification configures the git CLI to verify signatures using
+// the provided trusted keys. GPG keys are imported into a keyring in the
+// virtual home directory specified by homeDir and are ultimately trusted. SSH
+// keys are written to an allowed signers file in the same directory.
+func (b *baseRepo) setupSignatureVerification(
+	homeDir string,
+	keys map[string]string,
+) error {
+	identities := make([]string, 0, len(keys))
+	for identity := range keys {
+		identities = append(identities, identity)
+	}
+	slices.Sort(identities)
+
+	var gpgKeys, allowedSigners bytes.Buffer
+	for _, identity := range identities {
+		key := keys[identity]
+		if strings.Contains(key, pgpPublicKeyBlockHeader) {
+			gpgKeys.WriteString(key)
+			gpgKeys.WriteString("\n")
+			continue
+		}
+		for line := range strings.SplitSeq(key, "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			if _, _, _, _, err := ssh.ParseAuthorizedKey([]byte(line)); err != nil {
+				return fmt.Errorf("error parsing public key of %q: %w", identity, err)
+			}
+			fmt.Fprintf(&allowedSigners, "%s %s\n", identity, line)
+		}
+	}
+
+	if gpgKeys.Len() > 0 {
+		if err := b.importTrustedGPGKeys(homeDir, gpgKeys.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	if allowedSigners.Len() > 0 {
+		sshPath := filepath.Join(homeDir, ".ssh")
+		if err := os.MkdirAll(sshPath, 0700); err != nil {
+			return fmt.Errorf("error creating SSH directory %q: %w", sshPath, err)
+		}
+		allowedSignersPath := filepath.Join(sshPath, "allowed_signers")
+		if err := os.WriteFile(
+			allowedSignersPath,
+			allowedSigners.Bytes(),
+			0600,
+		); err != nil {
+			return fmt.Errorf(
+				"error writing allowed signers to %q: %w",
+				allowedSignersPath, err,
+			)
+		}
+		cmd := b.buildGitCommand(
+			"config",
+			"--global",
+			"gpg.ssh.allowedSignersFile",
+			allowedSignersPath,
+		)
+		// Override the home directory set by b.buildGitCommand().
+		b.setCmdHome(cmd, homeDir)
+		// Override the cmd.Dir that's set by b.buildGitCommand(). It's normally the
+		// repository's path, but if this method was called as part of the cloning
+		// process, that path may not exist yet.
+		cmd.Dir = homeDir
+		if _, err := libExec.Exec(cmd); err != nil {
+			return fmt.Errorf("error configuring allowed signers file: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// importTrustedGPGKeys imports the provided ASCII-armored GPG public keys into
+// the keyring in the virtual home directory specified by homeDir and marks
+// them as ultimately trusted. Without the latter, git would report signatures
+// made with these keys as being of unknown validity.
+func (b *baseRepo) importTrustedGPGKeys(homeDir string, keys []byte) error {
+	cmd := b.buildCommand("gpg", "--batch", "--import")
+	cmd.Stdin = bytes.NewReader(keys)
+	// Override the home directory set by b.buildCommand().
+	b.setCmdHome(cmd, homeDir)
+	// Override the cmd.Dir that's set by b.buildCommand(). It's normally the
+	// repository's path, but if this method was called as part of the cloning
+	// process, that path may not exist yet.
+	cmd.Dir = homeDir
+	if _, err := libExec.Exec(cmd); err != nil {
+		return fmt.Errorf("error importing trusted gpg keys: %w", err)
+	}
+
+	cmd = b.buildCommand("gpg", "--batch", "--with-colons", "--list-keys")
+	b.setCmdHome(cmd, homeDir)
+	cmd.Dir = homeDir
+	res, err := libExec.Exec(cmd)
+	if err != nil {
+		return fmt.Errorf("error listing trusted gpg keys: %w", err)
+	}
+	var ownerTrust bytes.Buffer
+	for _, fingerprint := range parsePrimaryKeyFingerprints(res) {
+		fmt.Fprintf(&ownerTrust, "%s:6:\n", fingerprint)
+	}
+
+	cmd = b.buildCommand("gpg", "--batch", "--import-ownertrust")
+	cmd.Stdin = &ownerTrust
+	b.setCmdHome(cmd, homeDir)
+	cmd.Dir = homeDir
+	if _, err = libExec.Exec(cmd); err != nil {
+		return fmt.Errorf("error trusting gpg keys: %w", err)
+	}
+	return nil
+}
+
+// parsePrimaryKeyFingerprints parses the fingerprints of all primary keys from
+// the output of `gpg --with-colons --list-keys`.
+func parsePrimaryKeyFingerprints(output []byte) []string {
+	var fingerprints []string
+	var inPrimaryKey bool
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), ":")
+		switch fields[0] {
+		case "pub":
+			inPrimaryKey = true
+		case "fpr":
+			// The first fingerprint following a "pub" record is that of the
+			// primary key. Any others belong to subkeys.
+			if inPrimaryKey && len(fields) > 9 {
+				fingerprints = append(fingerprints, fields[9])
+			}
+			inPrimaryKey = false
+		}
+	}
+	return fingerprints
+}
+
+func (w *workTree) GetCommitSignature(id string) (*Signature, error) {
+	res, err := libExec.Exec(w.buildGitCommand(
+		"log",
+		"--max-count=1",
+		// This format is designed to output the following fields, separated by
+		// tabs (%x09):
+		//
+		// - signature status
+		// - signer
+		// - primary key fingerprint
+		// - signing key
+		"--format=%G?%x09%GS%x09%GP%x09%GK",
+		id,
+	))
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error getting signature of commit %q: %w", id, err,
+		)
+	}
+	// Only the last line of output is relevant. Anything before it would have
+	// been written to stderr.
+	lines := strings.Split(strings.TrimRight(string(res), "\n"), "\n")
+	line := lines[len(lines)-1]
+	parts := strings.Split(line, "\t")
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("unexpected number of fields: %q", line)
+	}
+	// "G" is the only status that indicates a good signature made with a
+	// trusted key. Notably, git reports SSH signatures made with keys that are
+	// not in the allowed signers file as "U" (good signature, unknown validity).
+	if parts[0] != "G" {
+		return nil, nil
+	}
+	sig := &Signature{
+		Type:   SigningKeyTypeGPG,
+		Signer: parts[1],
+		Key:    parts[2],
+	}
+	if sig.Key == "" {
+		sig.Key = parts[3]
+	}
+	// git offers no format placeholder for the type of a signature, but SSH key
+	// fingerprints are readily distinguishable from GPG key fingerprints.
+	if strings.HasPrefix(sig.Key, "SHA256:") {
+		sig.Type = SigningKeyTypeSSH
+	}
+	return sig, nil
+}
+
+func (w *workTree) GetTagSignature(tag string) (*Signature, error) {
+	res, err := libExec.Exec(w.buildGitCommand("verify-tag", "--raw", tag))
+	if err != nil {
+		var exitErr *libExec.ExitError
+		if errors.As(err, &exitErr) {
+			// The tag is a lightweight tag, is unsigned, or its signature did not
+			// verify with any trusted key.
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error verifying signature of tag %q: %w", tag, err)
+	}
+	return parseTagSignature(res), nil
+}
+
+// parseTagSignature parses a Signature from the output of a successful
+// invocation of `git verify-tag --raw`. If the output does not describe a good
+// signature, nil is returned.
+func parseTagSignature(output []byte) *Signature {
+	var sig *Signature
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if matches := sshGoodSignatureRegex.FindStringSubmatch(line); matches != nil {
+			return &Signature{
+				Type:   SigningKeyTypeSSH,
+				Signer: matches[1],
+				Key:    matches[2],
+			}
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 3 || fields[0] != "[GNUPG:]" {
+			continue
+		}
+		switch fields[1] {
+		case "GOODSIG":
+			if sig == nil {
+				sig = &Signature{Type: SigningKeyTypeGPG}
+			}
+			if len(fields) > 3 {
+				sig.Signer = strings.Join(fields[3:], " ")
+			}
+		case "VALIDSIG":
+			if sig == nil {
+				sig = &Signature{Type: SigningKeyTypeGPG}
+			}
+			// The last field is the fingerprint of the primary key.
+			sig.Key = fields[len(fields)-1]
+		}
+	}
+	if sig == nil || sig.Key == "" {
+		return nil
+	}
+	return sig
+}
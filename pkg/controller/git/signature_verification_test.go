@@ -0,0 +1,222 @@
+package git
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	libExec "github.com/akuity/kargo/pkg/exec"
+)
+
+func Test_parsePrimaryKeyFingerprints(t *testing.T) {
+	// nolint: lll
+	const output = `tru::1:1792316837:0:3:1:5
+pub:-:255:22:E66BF16FD86AA00B:1792316826:::-:::scSC:::::ed25519:::0:
+fpr:::::::::750D9B01E7B9040F35DEC77CE66BF16FD86AA00B:
+uid:-::::1792316826::5A1F0C2B6C3E0D7B8B2E1B9E5F3F0A2C4E6D8B1A::Alice <alice@example.com>::::::::::0:
+sub:-:255:18:0A1B2C3D4E5F6071:1792316826::::::e:::::cv25519::
+fpr:::::::::1111111111111111111111110A1B2C3D4E5F6071:
+pub:-:3072:1:1234567890ABCDEF:1792316826:::-:::scSC:::::::::0:
+fpr:::::::::ABCDEF0123456789ABCDEF0123456789ABCDEF01:
+`
+	require.Equal(
+		t,
+		[]string{
+			"750D9B01E7B9040F35DEC77CE66BF16FD86AA00B",
+			"ABCDEF0123456789ABCDEF0123456789ABCDEF01",
+		},
+		parsePrimaryKeyFingerprints([]byte(output)),
+	)
+}
+
+func Test_parseTagSignature(t *testing.T) {
+	testCases := []struct {
+		name     string
+		output   string
+		expected *Signature
+	}{
+		{
+			name: "good gpg signature",
+			// nolint: lll
+			output: `[GNUPG:] NEWSIG alice@example.com
+[GNUPG:] KEY_CONSIDERED 750D9B01E7B9040F35DEC77CE66BF16FD86AA00B 0
+[GNUPG:] GOODSIG E66BF16FD86AA00B Alice <alice@example.com>
+[GNUPG:] VALIDSIG 750D9B01E7B9040F35DEC77CE66BF16FD86AA00B 2026-10-18 1792316837 0 4 0 22 8 00 750D9B01E7B9040F35DEC77CE66BF16FD86AA00B
+[GNUPG:] TRUST_ULTIMATE 0 pgp
+`,
+			expected: &Signature{
+				Type:   SigningKeyTypeGPG,
+				Signer: "Alice <alice@example.com>",
+				Key:    "750D9B01E7B9040F35DEC77CE66BF16FD86AA00B",
+			},
+		},
+		{
+			name: "good ssh signature",
+			output: `Good "git" signature for bob with ED25519 key ` +
+				`SHA256:4p0zUV/pdO1aDzT40xBmBB2yvBu/Dzx1HVLIm1aXMzo` + "\n",
+			expected: &Signature{
+				Type:   SigningKeyTypeSSH,
+				Signer: "bob",
+				Key:    "SHA256:4p0zUV/pdO1aDzT40xBmBB2yvBu/Dzx1HVLIm1aXMzo",
+			},
+		},
+		{
+			name: "gpg signature without validity",
+			output: `[GNUPG:] NEWSIG alice@example.com
+[GNUPG:] GOODSIG E66BF16FD86AA00B Alice <alice@example.com>
+`,
+		},
+		{
+			name: "ssh signature without principal",
+			output: `Good "git" signature with ED25519 key ` +
+				`SHA256:KTVYsZj7DuhuPR96U7lkqQCNPwgqHNe7TF6HN4IFtzQ` + "\n",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				parseTagSignature([]byte(testCase.output)),
+			)
+		})
+	}
+}
+
+func TestSignatureVerification(t *testing.T) {
+	for _, bin := range []string{"gpg", "ssh-keygen"} {
+		if _, err := exec.LookPath(bin); err != nil {
+			t.Skipf("%s is not installed", bin)
+		}
+	}
+
+	// Use a short base path. gpg-agent sockets are subject to length limits.
+	baseDir, err := os.MkdirTemp("", "sig-")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = exec.Command("gpgconf", "--homedir", filepath.Join(baseDir, "gnupg"),
+			"--kill", "gpg-agent").Run()
+		_ = os.RemoveAll(baseDir)
+	})
+
+	run := func(dir string, name string, args ...string) string {
+		t.Helper()
+		cmd := exec.Command(name, args...)
+		cmd.Dir = dir
+		cmd.Env = []string{
+			"HOME=" + baseDir,
+			"GNUPGHOME=" + filepath.Join(baseDir, "gnupg"),
+			"GIT_CONFIG_NOSYSTEM=1",
+		}
+		res, err := libExec.Exec(cmd)
+		require.NoError(t, err)
+		return string(res)
+	}
+
+	// Create the signers' keys.
+	require.NoError(t, os.Mkdir(filepath.Join(baseDir, "gnupg"), 0700))
+	run(baseDir, "gpg", "--batch", "--passphrase", "", "--quick-gen-key",
+		"Alice <alice@example.com>", "ed25519", "sign", "never")
+	aliceKey := run(baseDir, "gpg", "--armor", "--export", "alice@example.com")
+	for _, name := range []string{"bob", "eve"} {
+		run(baseDir, "ssh-keygen", "-q", "-t", "ed25519", "-N", "", "-C", name,
+			"-f", filepath.Join(baseDir, name))
+	}
+	bobKey, err := os.ReadFile(filepath.Join(baseDir, "bob.pub"))
+	require.NoError(t, err)
+
+	// Create a repository with signed and unsigned commits and tags.
+	srcDir := filepath.Join(baseDir, "src")
+	require.NoError(t, os.Mkdir(srcDir, 0700))
+	run(srcDir, "git", "init", "--quiet", "--initial-branch=main")
+	run(srcDir, "git", "config", "user.name", "Alice")
+	run(srcDir, "git", "config", "user.email", "alice@example.com")
+	gpgSign := []string{"-c", "user.signingkey=alice@example.com"}
+	sshSign := func(name string) []string {
+		return []string{
+			"-c", "gpg.format=ssh",
+			"-c", "user.signingkey=" + filepath.Join(baseDir, name),
+		}
+	}
+	commit := func(signArgs []string, msg string) string {
+		args := append(signArgs, "commit", "--quiet", "--allow-empty", "-m", msg)
+		if len(signArgs) > 0 {
+			args = append(args, "-S")
+		}
+		run(srcDir, "git", args...)
+		return strings.TrimSpace(run(srcDir, "git", "rev-parse", "HEAD"))
+	}
+	unsignedCommit := commit(nil, "unsigned")
+	gpgCommit := commit(gpgSign, "gpg")
+	bobCommit := commit(sshSign("bob"), "bob")
+	eveCommit := commit(sshSign("eve"), "eve")
+	run(srcDir, "git", append(gpgSign, "tag", "-s", "-m", "gpg", "gpg-tag")...)
+	run(srcDir, "git", append(sshSign("bob"), "tag", "-s", "-m", "bob", "bob-tag")...)
+	run(srcDir, "git", append(sshSign("eve"), "tag", "-s", "-m", "eve", "eve-tag")...)
+	run(srcDir, "git", "tag", "-a", "-m", "unsigned", "annotated-tag")
+	run(srcDir, "git", "tag", "lightweight-tag")
+
+	repo, err := Clone(
+		"file://"+srcDir,
+		&ClientOptions{
+			TrustedSigningKeys: map[string]string{
+				"alice": aliceKey,
+				"bob":   string(bobKey),
+			},
+		},
+		&CloneOptions{BaseDir: baseDir},
+	)
+	require.NoError(t, err)
+	defer repo.Close()
+	_, err = repo.ListTags()
+	require.NoError(t, err)
+
+	t.Run("commit signatures", func(t *testing.T) {
+		sig, err := repo.GetCommitSignature(unsignedCommit)
+		require.NoError(t, err)
+		require.Nil(t, sig)
+
+		sig, err = repo.GetCommitSignature(gpgCommit)
+		require.NoError(t, err)
+		require.NotNil(t, sig)
+		require.Equal(t, SigningKeyTypeGPG, sig.Type)
+		require.Equal(t, "Alice <alice@example.com>", sig.Signer)
+		require.NotEmpty(t, sig.Key)
+
+		sig, err = repo.GetCommitSignature(bobCommit)
+		require.NoError(t, err)
+		require.NotNil(t, sig)
+		require.Equal(t, SigningKeyTypeSSH, sig.Type)
+		require.Equal(t, "bob", sig.Signer)
+		require.True(t, strings.HasPrefix(sig.Key, "SHA256:"))
+
+		sig, err = repo.GetCommitSignature(eveCommit)
+		require.NoError(t, err)
+		require.Nil(t, sig)
+	})
+
+	t.Run("tag signatures", func(t *testing.T) {
+		sig, err := repo.GetTagSignature("gpg-tag")
+		require.NoError(t, err)
+		require.NotNil(t, sig)
+		require.Equal(t, SigningKeyTypeGPG, sig.Type)
+		require.Equal(t, "Alice <alice@example.com>", sig.Signer)
+
+		sig, err = repo.GetTagSignature("bob-tag")
+		require.NoError(t, err)
+		require.NotNil(t, sig)
+		require.Equal(t, SigningKeyTypeSSH, sig.Type)
+		require.Equal(t, "bob", sig.Signer)
+
+		for _, tag := range []string{"eve-tag", "annotated-tag", "lightweight-tag"} {
+			sig, err = repo.GetTagSignature(tag)
+			require.NoError(t, err, fmt.Sprintf("tag %q", tag))
+			require.Nil(t, sig, fmt.Sprintf("tag %q", tag))
+		}
+	})
+}
@@ -16,6 +16,11 @@ import (
 	"github.com/akuity/kargo/pkg/logging"
 )
 
+// baseRef is a ref private to each working tree that records the commit that
+// was checked out when the working tree was added with
+// AddWorkTreeOptions.RecordBase set.
+const baseRef = "refs/worktree/kargo/base"
+
 // WorkTree is an interface for interacting with any working tree of a Git
 // repository.
 type WorkTree interface {
@@ -46,6 +51,13 @@ type WorkTree interface {
 	CurrentBranch() (string, error)
 	// DeleteBranch deletes the specified branch
 	DeleteBranch(branch string) error
+	// Diff stages all pending changes and returns a unified diff of all
+	// changes, committed or not, made to the working tree since it was added
+	// with AddWorkTreeOptions.RecordBase set. If no base was recorded, as is
+	// the case for a working tree added from a new, orphaned branch, the diff
+	// includes the entire content of the working tree. The paths in the diff
+	// are prefixed with the provided prefix.
+	Diff(pathPrefix string) (string, error)
 	// Dir returns an absolute path to the working tree.
 	Dir() string
 	// HasDiffs returns a bool indicating whether the working tree currently
@@ -59,6 +71,15 @@ type WorkTree interface {
 	// relative to the root of the repository, of any files that are new or
 	// modified in the commit with the given ID.
 	GetDiffPathsForCommitID(commitID string) ([]string, error)
+	// GetCommitSignature returns the signature of the commit with the given ID
+	// if it verifies with one of the trusted signing keys specified when the
+	// repository was cloned. Otherwise, nil is returned.
+	GetCommitSignature(id string) (*Signature, error)
+	// GetTagSignature returns the signature of the specified annotated tag if
+	// it verifies with one of the trusted signing keys specified when the
+	// repository was cloned. Otherwise, including when the tag is a lightweight
+	// tag, nil is returned.
+	GetTagSignature(tag string) (*Signature, error)
 	// IsAncestor returns true if parent branch is an ancestor of child
 	IsAncestor(parent string, child string) (bool, error)
 	// IsRebasing returns a bool indicating whether the working tree is currently
@@ -319,6 +340,44 @@ func (w *workTree) DeleteBranch(branch string) error {
 	return nil
 }
 
+func (w *workTree) Diff(pathPrefix string) (string, error) {
+	if _, err := libExec.Exec(w.buildGitCommand("add", "--all")); err != nil {
+		return "", fmt.Errorf("error staging changes for diff: %w", err)
+	}
+	base := baseRef
+	if _, err := libExec.Exec(w.buildGitCommand(
+		"rev-parse", "--verify", "--quiet", baseRef,
+	)); err != nil {
+		// Without a base, everything in the working tree is a change.
+		res, err := libExec.Exec(w.buildGitCommand("hash-object", "-t", "tree", os.DevNull))
+		if err != nil {
+			return "", fmt.Errorf("error determining ID of empty tree: %w", err)
+		}
+		base = strings.TrimSpace(string(res))
+	}
+	res, err := libExec.Exec(w.buildGitCommand(
+		"diff",
+		"--cached",
+		"--no-color",
+		"--src-prefix=a/"+pathPrefix,
+		"--dst-prefix=b/"+pathPrefix,
+		base,
+	))
+	if err != nil {
+		return "", fmt.Errorf("error computing diff for working tree at %q: %w", w.dir, err)
+	}
+	return string(res), nil
+}
+
+// recordBase records the commit currently checked out in the working tree as
+// the base that Diff compares against.
+func (w *workTree) recordBase() error {
+	if _, err := libExec.Exec(w.buildGitCommand("update-ref", baseRef, "HEAD")); err != nil {
+		return fmt.Errorf("error recording base of working tree at %q: %w", w.dir, err)
+	}
+	return nil
+}
+
 func (w *workTree) GetDiffPathsForCommitID(commitID string) ([]string, error) {
 	resBytes, err := libExec.Exec(w.buildGitCommand("show", "--pretty=", "--name-only", commitID))
 	if err != nil {
@@ -403,6 +462,9 @@ type CommitMetadata struct {
 	Committer string
 	// Subject is the subject (first line) of the commit message.
 	Subject string
+	// Signature is the verified signature of the commit. It is never populated
+	// by ListCommits, but may be populated by callers that verify signatures.
+	Signature *Signature
 }
 
 func (w *workTree) ListCommits(limit, skip uint) ([]CommitMetadata, error) {
@@ -482,6 +544,10 @@ type TagMetadata struct {
 	Tagger string
 	// Annotation is the annotation of the tag, if it is an annotated tag.
 	Annotation string
+	// Signature is the verified signature of the tag or of the commit it
+	// references. It is never populated by ListTags, but may be populated by
+	// callers that verify signatures.
+	Signature *Signature
 }
 
 func parseTagMetadataLine(line []byte) (TagMetadata, error) {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http/httptest"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"testing"
 	"time"
@@ -111,6 +112,41 @@ func TestWorkTree(t *testing.T) {
 		require.Equal(t, workTree, existingWorkTree)
 	})
 
+	t.Run("does not record a base unless asked to", func(t *testing.T) {
+		err := exec.Command(
+			"git", "-C", workTree.Dir(), "rev-parse", "--verify", "--quiet", baseRef,
+		).Run()
+		require.Error(t, err)
+	})
+
+	t.Run("can diff changes since checkout", func(t *testing.T) {
+		// The branch is already checked out in the other working tree, so we
+		// check out the commit it is at instead.
+		commitID, err := workTree.LastCommitID()
+		require.NoError(t, err)
+		diffWorkTree, err := rep.AddWorkTree(
+			filepath.Join(rep.HomeDir(), "diff-working-tree"),
+			&AddWorkTreeOptions{Ref: commitID, RecordBase: true},
+		)
+		require.NoError(t, err)
+		defer diffWorkTree.Close()
+		err = os.WriteFile(filepath.Join(diffWorkTree.Dir(), "test.txt"), []byte("bar"), 0600)
+		require.NoError(t, err)
+		err = diffWorkTree.AddAllAndCommit("update test.txt", nil)
+		require.NoError(t, err)
+		err = os.WriteFile(filepath.Join(diffWorkTree.Dir(), "new.txt"), []byte("baz"), 0600)
+		require.NoError(t, err)
+		diff, err := diffWorkTree.Diff("working-tree/")
+		require.NoError(t, err)
+		require.Contains(t, diff, "--- a/working-tree/test.txt")
+		require.Contains(t, diff, "-foo")
+		require.Contains(t, diff, "+bar")
+		require.Contains(t, diff, "+++ b/working-tree/new.txt")
+		require.Contains(t, diff, "+baz")
+		// Diff stages all changes, which can now be committed.
+		require.NoError(t, diffWorkTree.Commit("add new.txt", nil))
+	})
+
 	t.Run("can close working tree", func(t *testing.T) {
 		require.NoError(t, workTree.Close())
 		_, err := os.Stat(workTree.Dir())
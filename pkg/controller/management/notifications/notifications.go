@@ -0,0 +1,459 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/controller"
+	kargoEvent "github.com/akuity/kargo/pkg/event"
+	k8sevent "github.com/akuity/kargo/pkg/event/kubernetes"
+	"github.com/akuity/kargo/pkg/event/notification"
+	"github.com/akuity/kargo/pkg/kubeclient"
+	"github.com/akuity/kargo/pkg/logging"
+)
+
+const (
+	// maxDeliveryAttempts is the number of reconciliations of an Event in
+	// which its delivery to a notification sink is attempted before the
+	// delivery is considered to have failed.
+	maxDeliveryAttempts = 5
+	// deliveryRetryInterval is the interval after which an Event whose
+	// delivery to any notification sink failed is reconciled again.
+	deliveryRetryInterval = 30 * time.Second
+)
+
+type ReconcilerConfig struct {
+	MaxConcurrentReconciles int `envconfig:"MAX_CONCURRENT_NOTIFICATION_RECONCILES" default:"4"`
+}
+
+func ReconcilerConfigFromEnv() ReconcilerConfig {
+	cfg := ReconcilerConfig{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// sender is an event.Sender that can also determine whether it is interested
+// in a given event.
+type sender interface {
+	kargoEvent.Sender
+	Matches(kargoEvent.Meta) (bool, error)
+}
+
+// reconciler delivers Kubernetes Events pertaining to Kargo resources to the
+// notification sinks configured in the ProjectConfig of the Project in which
+// they occurred.
+type reconciler struct {
+	cfg    ReconcilerConfig
+	client client.Client
+	// apiReader is used for reading Secrets, which are not cached, and for
+	// reading up-to-date ProjectConfigs before recording delivery results.
+	apiReader client.Reader
+
+	// statusMu serializes updates to the status of ProjectConfigs, which may
+	// otherwise be updated concurrently for different Events from the same
+	// Project.
+	statusMu sync.Mutex
+
+	// pendingMu guards pending.
+	pendingMu sync.Mutex
+	// pending tracks, by Event, the notification sinks to which delivery of
+	// the Event failed and is to be retried when the Event is reconciled
+	// again.
+	pending map[types.NamespacedName]*pendingDelivery
+
+	newSenderFn func(kargoapi.NotificationSinkConfig, map[string][]byte) (sender, error)
+	nowFn       func() time.Time
+}
+
+func SetupReconcilerWithManager(
+	ctx context.Context,
+	kargoMgr manager.Manager,
+	cfg ReconcilerConfig,
+) error {
+	_, err := ctrl.NewControllerManagedBy(kargoMgr).
+		Named("notification").
+		For(
+			&corev1.Event{},
+			builder.WithPredicates(kargoEventOccurred{after: time.Now()}),
+		).
+		WithOptions(controller.CommonOptions(cfg.MaxConcurrentReconciles)).
+		Build(newReconciler(kargoMgr.GetClient(), kargoMgr.GetAPIReader(), cfg))
+	if err != nil {
+		return fmt.Errorf("error creating notification reconciler: %w", err)
+	}
+
+	logging.LoggerFromContext(ctx).Info(
+		"Initialized notification reconciler",
+		"maxConcurrentReconciles", cfg.MaxConcurrentReconciles,
+	)
+	return nil
+}
+
+func newReconciler(
+	kubeClient client.Client,
+	apiReader client.Reader,
+	cfg ReconcilerConfig,
+) *reconciler {
+	return &reconciler{
+		cfg:       cfg,
+		client:    kubeClient,
+		apiReader: apiReader,
+		pending:   map[types.NamespacedName]*pendingDelivery{},
+		newSenderFn: func(
+			sinkCfg kargoapi.NotificationSinkConfig,
+			secretData map[string][]byte,
+		) (sender, error) {
+			return notification.NewSender(sinkCfg, secretData)
+		},
+		nowFn: time.Now,
+	}
+}
+
+// Reconcile is part of the main Kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *reconciler) Reconcile(
+	ctx context.Context,
+	req ctrl.Request,
+) (ctrl.Result, error) {
+	logger := logging.LoggerFromContext(ctx).WithValues(
+		"namespace", req.Namespace,
+		"event", req.Name,
+	)
+	ctx = logging.ContextWithLogger(ctx, logger)
+
+	evt := &corev1.Event{}
+	if err := r.client.Get(ctx, req.NamespacedName, evt); err != nil {
+		// Ignore if not found. This can happen if the Event was deleted after the
+		// current reconciliation request was issued.
+		if err = client.IgnoreNotFound(err); err == nil {
+			r.forgetPending(req.NamespacedName)
+		}
+		return ctrl.Result{}, err
+	}
+
+	projectCfg := &kargoapi.ProjectConfig{}
+	if err := r.client.Get(
+		ctx,
+		types.NamespacedName{Namespace: evt.Namespace, Name: evt.Namespace},
+		projectCfg,
+	); err != nil {
+		if err = client.IgnoreNotFound(err); err == nil {
+			logger.Debug("Project has no ProjectConfig; nothing to do")
+			r.forgetPending(req.NamespacedName)
+		}
+		return ctrl.Result{}, err
+	}
+	if len(projectCfg.Spec.NotificationSinks) == 0 {
+		logger.Debug("ProjectConfig does not define any notification sinks")
+		r.forgetPending(req.NamespacedName)
+		return ctrl.Result{}, nil
+	}
+
+	kargoEvt, err := k8sevent.FromKubernetesEvent(*evt)
+	if err != nil {
+		// Retrying will not help here.
+		logger.Error(err, "error parsing Event; it will not be delivered")
+		r.forgetPending(req.NamespacedName)
+		return ctrl.Result{}, nil
+	}
+
+	// If delivery of this occurrence of the Event already succeeded for some
+	// sinks, only the sinks for which it failed are retried, so as not to
+	// deliver duplicates.
+	sinks := projectCfg.Spec.NotificationSinks
+	attempt := 1
+	if p := r.getPending(req.NamespacedName, evt.Count); p != nil {
+		sinks = slices.DeleteFunc(slices.Clone(sinks), func(s kargoapi.NotificationSinkConfig) bool {
+			return !slices.Contains(p.sinks, s.Name)
+		})
+		attempt = p.attempts + 1
+	}
+
+	// Sinks are notified concurrently, so that a sink that is slow to respond
+	// does not hold up delivery to the others. Each sink bounds the time spent
+	// on a single delivery, including its own retries.
+	deliveries := make([]deliveryResult, len(sinks))
+	matched := make([]bool, len(sinks))
+	var wg sync.WaitGroup
+	for i, sinkCfg := range sinks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			deliveries[i].sinkName = sinkCfg.Name
+			matched[i], deliveries[i].err = r.notify(ctx, projectCfg.Namespace, sinkCfg, kargoEvt)
+		}()
+	}
+	wg.Wait()
+
+	// Note: Deliveries that failed for reasons that may be transient are
+	// retried by reconciling the Event again after deliveryRetryInterval, so
+	// that a sink that is down does not tie up a worker. Failures are only
+	// recorded in the ProjectConfig's status once they are final.
+	results := make([]deliveryResult, 0, len(deliveries))
+	var retrySinks []string
+	for i, result := range deliveries {
+		sinkLogger := logger.WithValues("notificationSink", result.sinkName)
+		switch {
+		case result.err != nil && attempt < maxDeliveryAttempts &&
+			!notification.IsPermanentError(result.err):
+			sinkLogger.Debug(
+				"error delivering notification; will retry",
+				"attempt", attempt,
+				"error", result.err.Error(),
+			)
+			retrySinks = append(retrySinks, result.sinkName)
+		case result.err != nil:
+			sinkLogger.Error(result.err, "error delivering notification")
+			results = append(results, result)
+		case matched[i]:
+			sinkLogger.Debug("delivered notification")
+			results = append(results, result)
+		default:
+			sinkLogger.Debug("Event did not match notification sink filter")
+		}
+	}
+	r.setPending(req.NamespacedName, evt.Count, retrySinks, attempt)
+	if len(results) > 0 {
+		if err = r.recordDeliveryResults(ctx, client.ObjectKeyFromObject(projectCfg), results); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error recording notification delivery results: %w", err)
+		}
+	}
+	if len(retrySinks) > 0 {
+		return ctrl.Result{RequeueAfter: deliveryRetryInterval}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+// pendingDelivery describes the notification sinks to which delivery of an
+// Event is to be retried.
+type pendingDelivery struct {
+	// count is the count of the Event when its delivery was attempted. A
+	// higher count indicates that the Event has since occurred again, and is
+	// to be delivered to all sinks anew.
+	count int32
+	// sinks are the names of the notification sinks to which delivery of the
+	// Event is to be retried.
+	sinks []string
+	// attempts is the number of times delivery has been attempted.
+	attempts int
+}
+
+// getPending returns the pending delivery of the Event with the provided key
+// if it pertains to the occurrence of the Event with the provided count.
+// Otherwise, it returns nil.
+func (r *reconciler) getPending(key types.NamespacedName, count int32) *pendingDelivery {
+	r.pendingMu.Lock()
+	defer r.pendingMu.Unlock()
+	if p, ok := r.pending[key]; ok && p.count == count {
+		return p
+	}
+	return nil
+}
+
+// setPending records the notification sinks to which delivery of the Event
+// with the provided key and count is to be retried. If there are no such
+// sinks, any pending delivery of the Event is forgotten.
+func (r *reconciler) setPending(
+	key types.NamespacedName,
+	count int32,
+	sinks []string,
+	attempts int,
+) {
+	r.pendingMu.Lock()
+	defer r.pendingMu.Unlock()
+	if len(sinks) == 0 {
+		delete(r.pending, key)
+		return
+	}
+	r.pending[key] = &pendingDelivery{
+		count:    count,
+		sinks:    sinks,
+		attempts: attempts,
+	}
+}
+
+// forgetPending forgets any pending delivery of the Event with the provided
+// key.
+func (r *reconciler) forgetPending(key types.NamespacedName) {
+	r.setPending(key, 0, nil, 0)
+}
+
+// notify delivers the provided event to the notification sink described by the
+// provided configuration if the event matches the sink's filter. It returns a
+// boolean indicating whether the event was delivered.
+func (r *reconciler) notify(
+	ctx context.Context,
+	project string,
+	sinkCfg kargoapi.NotificationSinkConfig,
+	evt kargoEvent.Meta,
+) (bool, error) {
+	secretName := getSecretName(sinkCfg)
+	secret := &corev1.Secret{}
+	if err := r.apiReader.Get(
+		ctx,
+		types.NamespacedName{Namespace: project, Name: secretName},
+		secret,
+	); err != nil {
+		return false, fmt.Errorf(
+			"error getting Secret %q in namespace %q: %w",
+			secretName, project, err,
+		)
+	}
+	s, err := r.newSenderFn(sinkCfg, secret.Data)
+	if err != nil {
+		return false, err
+	}
+	matches, err := s.Matches(evt)
+	if err != nil || !matches {
+		return false, err
+	}
+	if err = s.Send(ctx, evt); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// deliveryResult is the result of delivering a notification to a single
+// notification sink.
+type deliveryResult struct {
+	sinkName string
+	err      error
+}
+
+// recordDeliveryResults records the provided results of delivering a
+// notification in the status of the ProjectConfig with the provided key.
+func (r *reconciler) recordDeliveryResults(
+	ctx context.Context,
+	key client.ObjectKey,
+	results []deliveryResult,
+) error {
+	r.statusMu.Lock()
+	defer r.statusMu.Unlock()
+
+	projectCfg := &kargoapi.ProjectConfig{}
+	if err := r.apiReader.Get(ctx, key, projectCfg); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	now := metav1.NewTime(r.nowFn())
+	return kubeclient.PatchStatus(
+		ctx,
+		r.client,
+		projectCfg,
+		func(status *kargoapi.ProjectConfigStatus) {
+			for _, result := range results {
+				sinkStatus := getOrAddSinkStatus(status, result.sinkName)
+				if result.err == nil {
+					sinkStatus.LastDeliveryTime = &now
+					sinkStatus.ConsecutiveFailures = 0
+					continue
+				}
+				sinkStatus.LastFailureTime = &now
+				sinkStatus.LastFailureMessage = result.err.Error()
+				sinkStatus.ConsecutiveFailures++
+			}
+		},
+	)
+}
+
+// getOrAddSinkStatus returns the status of the notification sink with the
+// provided name, adding it to the provided ProjectConfigStatus if it does not
+// already exist.
+func getOrAddSinkStatus(
+	status *kargoapi.ProjectConfigStatus,
+	name string,
+) *kargoapi.NotificationSinkStatus {
+	for i := range status.NotificationSinks {
+		if status.NotificationSinks[i].Name == name {
+			return &status.NotificationSinks[i]
+		}
+	}
+	status.NotificationSinks = append(
+		status.NotificationSinks,
+		kargoapi.NotificationSinkStatus{Name: name},
+	)
+	return &status.NotificationSinks[len(status.NotificationSinks)-1]
+}
+
+// getSecretName returns the name of the Secret referenced by the provided
+// notification sink configuration.
+func getSecretName(sinkCfg kargoapi.NotificationSinkConfig) string {
+	switch {
+	case sinkCfg.Webhook != nil:
+		return sinkCfg.Webhook.SecretRef.Name
+	case sinkCfg.CloudEvents != nil:
+		return sinkCfg.CloudEvents.SecretRef.Name
+	case sinkCfg.Slack != nil:
+		return sinkCfg.Slack.SecretRef.Name
+	case sinkCfg.MSTeams != nil:
+		return sinkCfg.MSTeams.SecretRef.Name
+	}
+	return ""
+}
+
+// kargoEventOccurred is a predicate that only admits the creation of Events
+// that pertain to Kargo resources and were created after the given time, and
+// updates of such Events that record another occurrence of them. The former
+// prevents Events that were already handled before a restart of the
+// controller from being delivered again. The latter accounts for Events that
+// recur shortly after their creation being aggregated into a single Event,
+// whose count and last timestamp are updated.
+type kargoEventOccurred struct {
+	after time.Time
+}
+
+// Create implements predicate.Predicate.
+func (k kargoEventOccurred) Create(e event.CreateEvent) bool {
+	evt, ok := e.Object.(*corev1.Event)
+	if !ok || !isKargoEvent(evt) {
+		return false
+	}
+	return !evt.CreationTimestamp.Time.Before(k.after.Truncate(time.Second))
+}
+
+// Update implements predicate.Predicate.
+func (kargoEventOccurred) Update(e event.UpdateEvent) bool {
+	oldEvt, ok := e.ObjectOld.(*corev1.Event)
+	if !ok {
+		return false
+	}
+	newEvt, ok := e.ObjectNew.(*corev1.Event)
+	if !ok || !isKargoEvent(newEvt) {
+		return false
+	}
+	return newEvt.Count > oldEvt.Count ||
+		newEvt.LastTimestamp.After(oldEvt.LastTimestamp.Time)
+}
+
+// Delete implements predicate.Predicate.
+func (kargoEventOccurred) Delete(event.DeleteEvent) bool {
+	return false
+}
+
+// Generic implements predicate.Predicate.
+func (kargoEventOccurred) Generic(event.GenericEvent) bool {
+	return false
+}
+
+// isKargoEvent returns true if the provided Event pertains to a Kargo resource
+// in a Project.
+func isKargoEvent(evt *corev1.Event) bool {
+	if evt.InvolvedObject.APIVersion != kargoapi.GroupVersion.String() {
+		return false
+	}
+	_, ok := evt.Annotations[kargoapi.AnnotationKeyEventProject]
+	return ok
+}
@@ -0,0 +1,463 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	kargoEvent "github.com/akuity/kargo/pkg/event"
+)
+
+func TestNewReconciler(t *testing.T) {
+	testCfg := ReconcilerConfig{}
+	c := fake.NewClientBuilder().Build()
+	r := newReconciler(c, c, testCfg)
+	require.Equal(t, testCfg, r.cfg)
+	require.NotNil(t, r.client)
+	require.NotNil(t, r.apiReader)
+	require.NotNil(t, r.newSenderFn)
+	require.NotNil(t, r.nowFn)
+}
+
+func TestReconciler_Reconcile(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	const testProject = "fake-project"
+	testNow := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testEvent := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testProject,
+			Name:      "fake-event",
+			Annotations: kargoEvent.NewPromotionSucceeded(
+				"fake message",
+				"",
+				&kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: testProject,
+						Name:      "fake-promotion",
+					},
+					Spec: kargoapi.PromotionSpec{Stage: "fake-stage"},
+				},
+				nil,
+			).MarshalAnnotations(),
+		},
+		Reason:  string(kargoapi.EventTypePromotionSucceeded),
+		Message: "fake message",
+	}
+	testSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testProject,
+			Name:      "fake-secret",
+		},
+	}
+	newProjectConfig := func(status kargoapi.ProjectConfigStatus) *kargoapi.ProjectConfig {
+		return &kargoapi.ProjectConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testProject,
+				Name:      testProject,
+			},
+			Spec: kargoapi.ProjectConfigSpec{
+				NotificationSinks: []kargoapi.NotificationSinkConfig{{
+					Name: "fake-sink",
+					Slack: &kargoapi.SlackNotificationSinkConfig{
+						SecretRef: corev1.LocalObjectReference{Name: "fake-secret"},
+					},
+				}},
+			},
+			Status: status,
+		}
+	}
+
+	otherSink := kargoapi.NotificationSinkConfig{
+		Name: "other-sink",
+		Webhook: &kargoapi.WebhookNotificationSinkConfig{
+			SecretRef: corev1.LocalObjectReference{Name: "fake-secret"},
+		},
+	}
+	twoSinkProjectConfig := newProjectConfig(kargoapi.ProjectConfigStatus{})
+	twoSinkProjectConfig.Spec.NotificationSinks = append(
+		twoSinkProjectConfig.Spec.NotificationSinks,
+		otherSink,
+	)
+	repeatedEvent := testEvent.DeepCopy()
+	repeatedEvent.Count = 2
+
+	getSinkStatuses := func(t *testing.T, c client.Client) []kargoapi.NotificationSinkStatus {
+		projectCfg := &kargoapi.ProjectConfig{}
+		require.NoError(t, c.Get(
+			context.Background(),
+			types.NamespacedName{Namespace: testProject, Name: testProject},
+			projectCfg,
+		))
+		return projectCfg.Status.NotificationSinks
+	}
+
+	testCases := []struct {
+		name       string
+		objects    []client.Object
+		pending    *pendingDelivery
+		sender     *fakeSender
+		assertions func(*testing.T, *reconciler, *fakeSender, ctrl.Result, error)
+	}{
+		{
+			name:    "Event not found",
+			objects: []client.Object{newProjectConfig(kargoapi.ProjectConfigStatus{})},
+			pending: &pendingDelivery{sinks: []string{"fake-sink"}, attempts: 1},
+			sender:  &fakeSender{matches: true},
+			assertions: func(t *testing.T, r *reconciler, s *fakeSender, _ ctrl.Result, err error) {
+				require.NoError(t, err)
+				require.Empty(t, s.sent)
+				require.Empty(t, r.pending)
+			},
+		},
+		{
+			name:    "ProjectConfig not found",
+			objects: []client.Object{testEvent},
+			sender:  &fakeSender{matches: true},
+			assertions: func(t *testing.T, _ *reconciler, s *fakeSender, _ ctrl.Result, err error) {
+				require.NoError(t, err)
+				require.Empty(t, s.sent)
+			},
+		},
+		{
+			name: "Event does not match filter",
+			objects: []client.Object{
+				testEvent,
+				testSecret,
+				newProjectConfig(kargoapi.ProjectConfigStatus{}),
+			},
+			sender: &fakeSender{},
+			assertions: func(t *testing.T, r *reconciler, s *fakeSender, _ ctrl.Result, err error) {
+				require.NoError(t, err)
+				require.Empty(t, s.sent)
+				require.Empty(t, getSinkStatuses(t, r.client))
+			},
+		},
+		{
+			name: "Event delivered",
+			objects: []client.Object{
+				testEvent,
+				testSecret,
+				newProjectConfig(kargoapi.ProjectConfigStatus{
+					NotificationSinks: []kargoapi.NotificationSinkStatus{{
+						Name:                "fake-sink",
+						LastFailureMessage:  "something went wrong",
+						ConsecutiveFailures: 2,
+					}},
+				}),
+			},
+			sender: &fakeSender{matches: true},
+			assertions: func(t *testing.T, r *reconciler, s *fakeSender, res ctrl.Result, err error) {
+				require.NoError(t, err)
+				require.Zero(t, res.RequeueAfter)
+				require.Len(t, s.sent, 1)
+				require.Equal(t, kargoapi.EventTypePromotionSucceeded, s.sent[0].Type())
+				require.Equal(t, "fake-promotion", s.sent[0].GetName())
+				sinkStatuses := getSinkStatuses(t, r.client)
+				require.Len(t, sinkStatuses, 1)
+				sinkStatus := sinkStatuses[0]
+				require.NotNil(t, sinkStatus.LastDeliveryTime)
+				require.True(t, testNow.Equal(sinkStatus.LastDeliveryTime.Time))
+				require.Zero(t, sinkStatus.ConsecutiveFailures)
+				require.Equal(t, "something went wrong", sinkStatus.LastFailureMessage)
+			},
+		},
+		{
+			name: "delivery failure retried",
+			objects: []client.Object{
+				testEvent,
+				testSecret,
+				newProjectConfig(kargoapi.ProjectConfigStatus{
+					NotificationSinks: []kargoapi.NotificationSinkStatus{{
+						Name:                "fake-sink",
+						ConsecutiveFailures: 1,
+					}},
+				}),
+			},
+			sender: &fakeSender{matches: true, sendErr: errors.New("something went wrong")},
+			assertions: func(t *testing.T, r *reconciler, _ *fakeSender, res ctrl.Result, err error) {
+				require.NoError(t, err)
+				require.Equal(t, deliveryRetryInterval, res.RequeueAfter)
+				require.Equal(
+					t,
+					&pendingDelivery{sinks: []string{"fake-sink"}, attempts: 1},
+					r.pending[types.NamespacedName{Namespace: testProject, Name: "fake-event"}],
+				)
+				// The failure is not recorded until retries are exhausted.
+				sinkStatuses := getSinkStatuses(t, r.client)
+				require.Len(t, sinkStatuses, 1)
+				require.Nil(t, sinkStatuses[0].LastFailureTime)
+				require.Equal(t, int32(1), sinkStatuses[0].ConsecutiveFailures)
+			},
+		},
+		{
+			name: "delivery failure recorded once retries are exhausted",
+			objects: []client.Object{
+				testEvent,
+				testSecret,
+				newProjectConfig(kargoapi.ProjectConfigStatus{
+					NotificationSinks: []kargoapi.NotificationSinkStatus{{
+						Name:                "fake-sink",
+						ConsecutiveFailures: 1,
+					}},
+				}),
+			},
+			pending: &pendingDelivery{
+				sinks:    []string{"fake-sink"},
+				attempts: maxDeliveryAttempts - 1,
+			},
+			sender: &fakeSender{matches: true, sendErr: errors.New("something went wrong")},
+			assertions: func(t *testing.T, r *reconciler, _ *fakeSender, res ctrl.Result, err error) {
+				require.NoError(t, err)
+				require.Zero(t, res.RequeueAfter)
+				require.Empty(t, r.pending)
+				sinkStatuses := getSinkStatuses(t, r.client)
+				require.Len(t, sinkStatuses, 1)
+				sinkStatus := sinkStatuses[0]
+				require.Nil(t, sinkStatus.LastDeliveryTime)
+				require.NotNil(t, sinkStatus.LastFailureTime)
+				require.Equal(t, "something went wrong", sinkStatus.LastFailureMessage)
+				require.Equal(t, int32(2), sinkStatus.ConsecutiveFailures)
+			},
+		},
+		{
+			name: "only failed deliveries retried",
+			objects: []client.Object{
+				testEvent,
+				testSecret,
+				twoSinkProjectConfig.DeepCopy(),
+			},
+			pending: &pendingDelivery{sinks: []string{"other-sink"}, attempts: 1},
+			sender:  &fakeSender{matches: true},
+			assertions: func(t *testing.T, r *reconciler, s *fakeSender, res ctrl.Result, err error) {
+				require.NoError(t, err)
+				require.Zero(t, res.RequeueAfter)
+				require.Len(t, s.sent, 1)
+				require.Empty(t, r.pending)
+				sinkStatuses := getSinkStatuses(t, r.client)
+				require.Len(t, sinkStatuses, 1)
+				require.Equal(t, "other-sink", sinkStatuses[0].Name)
+				require.NotNil(t, sinkStatuses[0].LastDeliveryTime)
+			},
+		},
+		{
+			name: "repeated Event delivered to all sinks",
+			objects: []client.Object{
+				repeatedEvent,
+				testSecret,
+				twoSinkProjectConfig.DeepCopy(),
+			},
+			pending: &pendingDelivery{sinks: []string{"other-sink"}, attempts: 1},
+			sender:  &fakeSender{matches: true},
+			assertions: func(t *testing.T, r *reconciler, s *fakeSender, _ ctrl.Result, err error) {
+				require.NoError(t, err)
+				require.Len(t, s.sent, 2)
+				require.Empty(t, r.pending)
+				require.Len(t, getSinkStatuses(t, r.client), 2)
+			},
+		},
+		{
+			name: "Secret not found",
+			objects: []client.Object{
+				testEvent,
+				newProjectConfig(kargoapi.ProjectConfigStatus{}),
+			},
+			pending: &pendingDelivery{
+				sinks:    []string{"fake-sink"},
+				attempts: maxDeliveryAttempts - 1,
+			},
+			sender: &fakeSender{matches: true},
+			assertions: func(t *testing.T, r *reconciler, s *fakeSender, _ ctrl.Result, err error) {
+				require.NoError(t, err)
+				require.Empty(t, s.sent)
+				sinkStatuses := getSinkStatuses(t, r.client)
+				require.Len(t, sinkStatuses, 1)
+				sinkStatus := sinkStatuses[0]
+				require.Contains(t, sinkStatus.LastFailureMessage, `error getting Secret "fake-secret"`)
+				require.Equal(t, int32(1), sinkStatus.ConsecutiveFailures)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(testCase.objects...).
+				WithStatusSubresource(&kargoapi.ProjectConfig{}).
+				Build()
+			r := newReconciler(c, c, ReconcilerConfig{})
+			r.newSenderFn = func(kargoapi.NotificationSinkConfig, map[string][]byte) (sender, error) {
+				return testCase.sender, nil
+			}
+			r.nowFn = func() time.Time { return testNow }
+			key := types.NamespacedName{Namespace: testProject, Name: "fake-event"}
+			if testCase.pending != nil {
+				r.pending[key] = testCase.pending
+			}
+			res, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+			testCase.assertions(t, r, testCase.sender, res, err)
+		})
+	}
+}
+
+func Test_kargoEventOccurred(t *testing.T) {
+	testStart := time.Now()
+	testCases := []struct {
+		name     string
+		evt      *corev1.Event
+		expected bool
+	}{
+		{
+			name: "Event does not pertain to a Kargo resource",
+			evt: &corev1.Event{
+				ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: metav1.NewTime(testStart.Add(time.Minute)),
+					Annotations: map[string]string{
+						kargoapi.AnnotationKeyEventProject: "fake-project",
+					},
+				},
+				InvolvedObject: corev1.ObjectReference{APIVersion: "v1"},
+			},
+		},
+		{
+			name: "Event is not a Kargo event",
+			evt: &corev1.Event{
+				ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: metav1.NewTime(testStart.Add(time.Minute)),
+				},
+				InvolvedObject: corev1.ObjectReference{
+					APIVersion: kargoapi.GroupVersion.String(),
+				},
+			},
+		},
+		{
+			name: "Event was created before the controller started",
+			evt: &corev1.Event{
+				ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: metav1.NewTime(testStart.Add(-time.Minute)),
+					Annotations: map[string]string{
+						kargoapi.AnnotationKeyEventProject: "fake-project",
+					},
+				},
+				InvolvedObject: corev1.ObjectReference{
+					APIVersion: kargoapi.GroupVersion.String(),
+				},
+			},
+		},
+		{
+			name: "new Kargo event",
+			evt: &corev1.Event{
+				ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: metav1.NewTime(testStart.Add(time.Minute)),
+					Annotations: map[string]string{
+						kargoapi.AnnotationKeyEventProject: "fake-project",
+					},
+				},
+				InvolvedObject: corev1.ObjectReference{
+					APIVersion: kargoapi.GroupVersion.String(),
+				},
+			},
+			expected: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			p := kargoEventOccurred{after: testStart}
+			require.Equal(t, testCase.expected, p.Create(event.CreateEvent{Object: testCase.evt}))
+			require.False(t, p.Update(event.UpdateEvent{ObjectOld: testCase.evt, ObjectNew: testCase.evt}))
+		})
+	}
+}
+
+func Test_kargoEventOccurred_Update(t *testing.T) {
+	testTime := time.Now()
+	newEvent := func(apiVersion string, count int32, lastTimestamp time.Time) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					kargoapi.AnnotationKeyEventProject: "fake-project",
+				},
+			},
+			InvolvedObject: corev1.ObjectReference{APIVersion: apiVersion},
+			Count:          count,
+			LastTimestamp:  metav1.NewTime(lastTimestamp),
+		}
+	}
+	testCases := []struct {
+		name     string
+		oldEvt   *corev1.Event
+		newEvt   *corev1.Event
+		expected bool
+	}{
+		{
+			name:   "Event does not pertain to a Kargo resource",
+			oldEvt: newEvent("v1", 1, testTime),
+			newEvt: newEvent("v1", 2, testTime.Add(time.Minute)),
+		},
+		{
+			name:   "Event did not occur again",
+			oldEvt: newEvent(kargoapi.GroupVersion.String(), 1, testTime),
+			newEvt: newEvent(kargoapi.GroupVersion.String(), 1, testTime),
+		},
+		{
+			name:     "count increased",
+			oldEvt:   newEvent(kargoapi.GroupVersion.String(), 1, testTime),
+			newEvt:   newEvent(kargoapi.GroupVersion.String(), 2, testTime),
+			expected: true,
+		},
+		{
+			name:     "last timestamp advanced",
+			oldEvt:   newEvent(kargoapi.GroupVersion.String(), 1, testTime),
+			newEvt:   newEvent(kargoapi.GroupVersion.String(), 1, testTime.Add(time.Minute)),
+			expected: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				kargoEventOccurred{after: testTime}.Update(
+					event.UpdateEvent{ObjectOld: testCase.oldEvt, ObjectNew: testCase.newEvt},
+				),
+			)
+		})
+	}
+}
+
+type fakeSender struct {
+	matches bool
+	sendErr error
+	mu      sync.Mutex
+	sent    []kargoEvent.Meta
+}
+
+func (f *fakeSender) Matches(kargoEvent.Meta) (bool, error) {
+	return f.matches, nil
+}
+
+func (f *fakeSender) Send(_ context.Context, evt kargoEvent.Meta) error {
+	if f.sendErr != nil {
+		return f.sendErr
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.sent = append(f.sent, evt)
+	return nil
+}
@@ -3,6 +3,7 @@ package projectconfigs
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/kelseyhightower/envconfig"
@@ -186,6 +187,14 @@ func (r *reconciler) reconcile(
 		}
 	}
 
+	// Delivery to notification sinks is handled by a separate reconciler, which
+	// records its results in the ProjectConfig's status. All we need to do here
+	// is forget about sinks that have since been removed.
+	status.NotificationSinks = pruneNotificationSinkStatuses(
+		status.NotificationSinks,
+		projectCfg.Spec.NotificationSinks,
+	)
+
 	// At this point, we have successfully reconciled the ProjectConfig and
 	// can set the observed generation.
 	status.ObservedGeneration = projectCfg.GetGeneration()
@@ -277,3 +286,16 @@ func (r *reconciler) syncWebhookReceivers(
 	})
 	return *status, nil
 }
+
+// pruneNotificationSinkStatuses returns the provided notification sink
+// statuses, minus those of sinks that are no longer configured.
+func pruneNotificationSinkStatuses(
+	statuses []kargoapi.NotificationSinkStatus,
+	sinks []kargoapi.NotificationSinkConfig,
+) []kargoapi.NotificationSinkStatus {
+	return slices.DeleteFunc(statuses, func(status kargoapi.NotificationSinkStatus) bool {
+		return !slices.ContainsFunc(sinks, func(sink kargoapi.NotificationSinkConfig) bool {
+			return sink.Name == status.Name
+		})
+	})
+}
@@ -170,3 +170,21 @@ func TestReconciler_syncWebhookReceivers(t *testing.T) {
 		})
 	}
 }
+
+func Test_pruneNotificationSinkStatuses(t *testing.T) {
+	statuses := pruneNotificationSinkStatuses(
+		[]kargoapi.NotificationSinkStatus{
+			{Name: "removed"},
+			{Name: "kept", ConsecutiveFailures: 1},
+		},
+		[]kargoapi.NotificationSinkConfig{
+			{Name: "kept"},
+			{Name: "new"},
+		},
+	)
+	require.Equal(
+		t,
+		[]kargoapi.NotificationSinkStatus{{Name: "kept", ConsecutiveFailures: 1}},
+		statuses,
+	)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/kelseyhightower/envconfig"
@@ -12,6 +13,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	toolscache "k8s.io/client-go/tools/cache"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -33,6 +35,7 @@ import (
 	"github.com/akuity/kargo/pkg/logging"
 	intpredicate "github.com/akuity/kargo/pkg/predicate"
 	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/promotion/container"
 )
 
 // ReconcilerConfig represents configuration for the promotion reconciler.
@@ -66,6 +69,11 @@ type reconciler struct {
 
 	cfg ReconcilerConfig
 
+	// localClusterClient is used to clean up the Jobs that execute container
+	// steps.
+	localClusterClient kubernetes.Interface
+	containerCfg       container.Config
+
 	sender event.Sender
 
 	// The following behaviors are overridable for testing purposes:
@@ -91,6 +99,8 @@ type reconciler struct {
 	) error
 
 	cleanupWorkDirFn func(ctx context.Context, promoUID types.UID)
+
+	cleanupContainerJobsFn func(ctx context.Context, promo *kargoapi.Promotion)
 }
 
 // SetupReconcilerWithManager initializes a reconciler for Promotion resources
@@ -99,6 +109,7 @@ func SetupReconcilerWithManager(
 	ctx context.Context,
 	kargoMgr manager.Manager,
 	argocdMgr manager.Manager,
+	localClusterClient kubernetes.Interface,
 	promoEngine promotion.Engine,
 	cfg ReconcilerConfig,
 ) error {
@@ -122,6 +133,7 @@ func SetupReconcilerWithManager(
 		k8sevent.NewEventSender(
 			libEvent.NewRecorder(ctx, kargoMgr.GetScheme(), kargoMgr.GetClient(), cfg.Name()),
 		),
+		localClusterClient,
 		promoEngine,
 		cfg,
 	)
@@ -199,14 +211,17 @@ func SetupReconcilerWithManager(
 func newReconciler(
 	kargoClient client.Client,
 	sender event.Sender,
+	localClusterClient kubernetes.Interface,
 	promoEngine promotion.Engine,
 	cfg ReconcilerConfig,
 ) *reconciler {
 	r := &reconciler{
-		kargoClient: kargoClient,
-		promoEngine: promoEngine,
-		sender:      sender,
-		cfg:         cfg,
+		kargoClient:        kargoClient,
+		localClusterClient: localClusterClient,
+		containerCfg:       container.ConfigFromEnv(),
+		promoEngine:        promoEngine,
+		sender:             sender,
+		cfg:                cfg,
 		shardPredicate: controller.ResponsibleFor[kargoapi.Promotion]{
 			IsDefaultController: cfg.IsDefaultController,
 			ShardName:           cfg.ShardName,
@@ -216,6 +231,7 @@ func newReconciler(
 	r.promoteFn = r.promote
 	r.terminatePromotionFn = r.terminatePromotion
 	r.cleanupWorkDirFn = r.cleanupWorkDir
+	r.cleanupContainerJobsFn = r.cleanupContainerJobs
 	return r
 }
 
@@ -418,8 +434,10 @@ func (r *reconciler) Reconcile(
 		r.cleanupWorkDirFn(ctx, promo.UID)
 	}
 
-	// Record event after patching status if new phase is terminal
-	if newStatus.Phase.IsTerminal() {
+	// Record event after patching status if new phase is terminal. No event is
+	// recorded for a dry run, as nothing has actually been promoted and
+	// notifying anyone that it has would be misleading.
+	if newStatus.Phase.IsTerminal() && !promo.Spec.DryRun {
 		stage, getStageErr := r.getStageFn(
 			ctx,
 			r.kargoClient,
@@ -564,31 +582,56 @@ func (r *reconciler) promote(
 		promoCtx.StartFromStep = 0
 		promoCtx.StepExecutionMetadata = nil
 		workingPromo.Status.HealthChecks = nil
+		workingPromo.Status.OnFailure = nil
+		workingPromo.Status.DryRun = nil
 	} else if !os.IsExist(err) {
 		return nil, nil, fmt.Errorf("error creating working directory: %w", err)
 	}
-	res, err := r.promoEngine.Promote(ctx, promoCtx, steps)
-	workingPromo.Status.Phase = res.Status
-	workingPromo.Status.Message = res.Message
-	workingPromo.Status.CurrentStep = res.CurrentStep
-	workingPromo.Status.StepExecutionMetadata = res.StepExecutionMetadata
-	workingPromo.Status.State = &apiextensionsv1.JSON{Raw: res.State.ToJSON()}
-	for _, step := range res.HealthChecks {
-		workingPromo.Status.HealthChecks = append(
-			workingPromo.Status.HealthChecks,
-			kargoapi.HealthCheckStep{
-				Uses:   step.Kind,
-				Config: &apiextensionsv1.JSON{Raw: step.Input.ToJSON()},
-			},
-		)
+
+	var res promotion.Result
+	var err error
+	// Once the OnFailure steps of the Promotion are being executed, the steps
+	// have all completed and must not be executed again.
+	if workingPromo.Status.OnFailure == nil {
+		res, err = r.promoEngine.Promote(ctx, promoCtx, steps)
+		workingPromo.Status.Phase = res.Status
+		workingPromo.Status.Message = res.Message
+		workingPromo.Status.CurrentStep = res.CurrentStep
+		workingPromo.Status.StepExecutionMetadata = res.StepExecutionMetadata
+		workingPromo.Status.State = &apiextensionsv1.JSON{Raw: res.State.ToJSON()}
+		for _, step := range res.HealthChecks {
+			workingPromo.Status.HealthChecks = append(
+				workingPromo.Status.HealthChecks,
+				kargoapi.HealthCheckStep{
+					Uses:   step.Kind,
+					Config: &apiextensionsv1.JSON{Raw: step.Input.ToJSON()},
+				},
+			)
+		}
+		if workingPromo.Spec.DryRun {
+			recordDryRunResult(&workingPromo.Status, res)
+		}
+		if err != nil {
+			return &workingPromo.Status, nil, err
+		}
+		if len(workingPromo.Spec.OnFailure) > 0 && ctx.Err() == nil {
+			switch res.Status {
+			case kargoapi.PromotionPhaseFailed, kargoapi.PromotionPhaseErrored, kargoapi.PromotionPhaseAborted:
+				workingPromo.Status.OnFailure = &kargoapi.PromotionOnFailureStatus{}
+			}
+		}
 	}
-	if err != nil {
-		return &workingPromo.Status, nil, err
+	if workingPromo.Status.OnFailure != nil {
+		if res, err = r.executeOnFailureSteps(ctx, workingPromo, promoCtx, steps); err != nil {
+			return &workingPromo.Status, nil, err
+		}
 	}
 
 	logger.Debug("promotion", "phase", workingPromo.Status.Phase)
 
-	if workingPromo.Status.Phase == kargoapi.PromotionPhaseSucceeded {
+	// A dry run does not change the Stage, so it must never trigger
+	// re-verification.
+	if workingPromo.Status.Phase == kargoapi.PromotionPhaseSucceeded && !workingPromo.Spec.DryRun {
 		// Trigger re-verification of the Stage if the promotion succeeded and
 		// this is a re-promotion of the same Freight.
 		current := stage.Status.FreightHistory.Current()
@@ -620,6 +663,83 @@ func (r *reconciler) promote(
 	return &workingPromo.Status, nil, nil
 }
 
+// executeOnFailureSteps executes the OnFailure steps of the given Promotion
+// after its steps did not succeed, and records their execution on the status
+// of the Promotion. The Promotion remains Running until all OnFailure steps
+// have completed, after which its phase is the one determined by its steps.
+func (r *reconciler) executeOnFailureSteps(
+	ctx context.Context,
+	workingPromo *kargoapi.Promotion,
+	promoCtx promotion.Context,
+	steps []promotion.Step,
+) (promotion.Result, error) {
+	onFailureStatus := workingPromo.Status.OnFailure
+
+	failureCtx := promoCtx.DeepCopy()
+	failureCtx.StartFromStep = onFailureStatus.CurrentStep
+	failureCtx.StepExecutionMetadata = onFailureStatus.StepExecutionMetadata
+	failureCtx.FailedStepExecutionMetadata = workingPromo.Status.StepExecutionMetadata
+	failureCtx.State = promotion.State(workingPromo.Status.GetState())
+
+	res, err := r.promoEngine.Promote(ctx, failureCtx, promotion.NewOnFailureSteps(workingPromo))
+	onFailureStatus.Phase = res.Status
+	onFailureStatus.Message = res.Message
+	onFailureStatus.CurrentStep = res.CurrentStep
+	onFailureStatus.StepExecutionMetadata = res.StepExecutionMetadata
+	workingPromo.Status.State = &apiextensionsv1.JSON{Raw: res.State.ToJSON()}
+	if workingPromo.Spec.DryRun {
+		recordDryRunResult(&workingPromo.Status, res)
+	}
+
+	phase, msg := promotion.DetermineFinalPhase(steps, workingPromo.Status.StepExecutionMetadata)
+	workingPromo.Status.Message = msg
+	workingPromo.Status.Phase = phase
+	if res.Status == kargoapi.PromotionPhaseRunning {
+		workingPromo.Status.Phase = kargoapi.PromotionPhaseRunning
+	}
+	return res, err
+}
+
+// maxDryRunDiffSize is the maximum size, in bytes, of the working tree diff
+// recorded on the status of a Promotion that is a dry run.
+const maxDryRunDiffSize = 64 * 1024
+
+// recordDryRunResult records the steps planned, and the working tree diff
+// produced, by a dry run of a Promotion on the provided PromotionStatus.
+// Planned steps are appended to any recorded by previous executions. The diff
+// is truncated if it would otherwise make the Promotion too large to store.
+func recordDryRunResult(status *kargoapi.PromotionStatus, res promotion.Result) {
+	if status.DryRun == nil {
+		status.DryRun = &kargoapi.PromotionDryRunResult{}
+	}
+	for _, step := range res.PlannedSteps {
+		planned := kargoapi.PlannedPromotionStep{
+			As:   step.Alias,
+			Uses: step.Kind,
+		}
+		if len(step.Config) > 0 {
+			planned.Config = &apiextensionsv1.JSON{Raw: step.Config.ToJSON()}
+		}
+		status.DryRun.PlannedSteps = append(status.DryRun.PlannedSteps, planned)
+	}
+	if res.Diff != "" {
+		status.DryRun.Diff = truncateDiff(res.Diff, maxDryRunDiffSize)
+	}
+}
+
+// truncateDiff truncates the provided diff at the last line boundary within
+// the provided number of bytes and notes that it has been truncated.
+func truncateDiff(diff string, maxSize int) string {
+	if len(diff) <= maxSize {
+		return diff
+	}
+	truncated := diff[:maxSize]
+	if i := strings.LastIndexByte(truncated, '\n'); i >= 0 {
+		truncated = truncated[:i+1]
+	}
+	return truncated + "... diff truncated ...\n"
+}
+
 // buildTargetFreightCollection constructs a FreightCollection that contains all
 // FreightReferences from the previous Promotion (excepting those that are no
 // longer requested), plus a FreightReference for the provided targetFreight.
@@ -687,6 +807,13 @@ func (r *reconciler) terminatePromotion(
 		newStatus.StepExecutionMetadata[promo.Status.CurrentStep].Status = kargoapi.PromotionStepStatusAborted
 		newStatus.StepExecutionMetadata[promo.Status.CurrentStep].FinishedAt = now
 	}
+	if onFailure := newStatus.OnFailure; onFailure != nil &&
+		int(onFailure.CurrentStep) < len(onFailure.StepExecutionMetadata) &&
+		onFailure.StepExecutionMetadata[onFailure.CurrentStep].Status == kargoapi.PromotionStepStatusRunning {
+		onFailure.StepExecutionMetadata[onFailure.CurrentStep].Status = kargoapi.PromotionStepStatusAborted
+		onFailure.StepExecutionMetadata[onFailure.CurrentStep].FinishedAt = now
+		onFailure.Phase = kargoapi.PromotionPhaseAborted
+	}
 
 	newStatus.Phase = kargoapi.PromotionPhaseAborted
 	if actor != "" {
@@ -702,8 +829,10 @@ func (r *reconciler) terminatePromotion(
 		return err
 	}
 
-	// Best-effort cleanup of working directory.
+	// Best-effort cleanup of working directory and of any container step that
+	// may still be running.
 	r.cleanupWorkDirFn(ctx, promo.UID)
+	r.cleanupContainerJobsFn(ctx, promo)
 
 	evt := event.NewPromotionAborted(newStatus.Message, actor, promo, freight)
 
@@ -789,6 +918,26 @@ func (r *reconciler) cleanupWorkDir(ctx context.Context, promoUID types.UID) {
 	}
 }
 
+// cleanupContainerJobs deletes the Jobs executing container steps of the
+// provided Promotion. Errors are logged but not returned, since the Jobs are
+// eventually removed once their TTL expires regardless.
+func (r *reconciler) cleanupContainerJobs(ctx context.Context, promo *kargoapi.Promotion) {
+	if r.localClusterClient == nil || !r.containerCfg.Enabled() {
+		return
+	}
+	logger := logging.LoggerFromContext(ctx)
+	logger.Debug("removing container step Jobs of Promotion")
+	if err := container.DeletePromotionJobs(
+		ctx,
+		r.localClusterClient,
+		r.containerCfg.Namespace,
+		promo.Namespace,
+		promo.Name,
+	); err != nil {
+		logger.Error(err, "could not remove container step Jobs of Promotion")
+	}
+}
+
 var defaultRequeueInterval = 5 * time.Minute
 
 func calculateRequeueInterval(
@@ -801,12 +950,22 @@ func calculateRequeueInterval(
 		requeueInterval = *suggestedRequeueInterval
 	}
 
+	// The OnFailure steps of a Promotion are executed after all of its steps
+	// have completed, which means the current step is no longer of interest.
+	if p.Status.OnFailure != nil {
+		return requeueInterval
+	}
+
 	// Ensure we have a step for the current step index.
 	if int(p.Status.CurrentStep) >= len(p.Spec.Steps) {
 		return requeueInterval
 	}
 
 	step := p.Spec.Steps[p.Status.CurrentStep]
+	if len(step.Parallel) > 0 {
+		// The steps of a parallel group each have their own timeout.
+		return requeueInterval
+	}
 	reg, err := promotion.DefaultStepRunnerRegistry.Get(step.Uses)
 	if err != nil {
 		logging.LoggerFromContext(ctx).Error(err, err.Error())
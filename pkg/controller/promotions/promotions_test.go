@@ -7,9 +7,13 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	kubefake "k8s.io/client-go/kubernetes/fake"
 	toolscache "k8s.io/client-go/tools/cache"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -21,6 +25,7 @@ import (
 	k8sevent "github.com/akuity/kargo/pkg/event/kubernetes"
 	fakeevent "github.com/akuity/kargo/pkg/kubernetes/event/fake"
 	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/promotion/container"
 )
 
 var (
@@ -33,6 +38,7 @@ func TestNewPromotionReconciler(t *testing.T) {
 	r := newReconciler(
 		kubeClient,
 		k8sevent.NewEventSender(&fakeevent.EventRecorder{}),
+		kubefake.NewClientset(),
 		&promotion.MockEngine{},
 		ReconcilerConfig{},
 	)
@@ -41,7 +47,9 @@ func TestNewPromotionReconciler(t *testing.T) {
 	require.NotNil(t, r.promoEngine)
 	require.NotNil(t, r.getStageFn)
 	require.NotNil(t, r.promoteFn)
+	require.NotNil(t, r.localClusterClient)
 	require.NotNil(t, r.cleanupWorkDirFn)
+	require.NotNil(t, r.cleanupContainerJobsFn)
 }
 
 func newFakeReconciler(
@@ -56,6 +64,7 @@ func newFakeReconciler(
 	return newReconciler(
 		kargoClient,
 		k8sevent.NewEventSender(recorder),
+		nil,
 		&promotion.MockEngine{},
 		ReconcilerConfig{},
 	)
@@ -527,6 +536,9 @@ func Test_reconciler_terminatePromotion(t *testing.T) {
 				cleanupWorkDirFn: func(context.Context, types.UID) {
 					// no-op for tests
 				},
+				cleanupContainerJobsFn: func(context.Context, *kargoapi.Promotion) {
+					// no-op for tests
+				},
 			}
 
 			req := tt.req
@@ -641,7 +653,7 @@ func Test_reconciler_handleDeletion(t *testing.T) {
 	}
 }
 
-func Test_reconciler_terminatePromotion_cleansUpWorkDir(t *testing.T) {
+func Test_reconciler_terminatePromotion_cleansUp(t *testing.T) {
 	scheme := k8sruntime.NewScheme()
 	require.NoError(t, kargoapi.SchemeBuilder.AddToScheme(scheme))
 
@@ -654,14 +666,35 @@ func Test_reconciler_terminatePromotion_cleansUpWorkDir(t *testing.T) {
 		Build()
 	recorder := fakeevent.NewEventRecorder(1)
 
+	const testJobNamespace = "kargo"
+	testJob := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      container.JobName(promo.Namespace, promo.Name, "step-1"),
+			Namespace: testJobNamespace,
+			Labels: map[string]string{
+				kargoapi.LabelKeyProject: promo.Namespace,
+			},
+			Annotations: map[string]string{
+				kargoapi.AnnotationKeyPromotion: promo.Name,
+			},
+		},
+	}
+	localClusterClient := kubefake.NewClientset(testJob)
+
 	cleanupCalled := false
 	r := &reconciler{
-		kargoClient: c,
-		sender:      k8sevent.NewEventSender(recorder),
+		kargoClient:        c,
+		localClusterClient: localClusterClient,
+		containerCfg: container.Config{
+			Namespace:    testJobNamespace,
+			WorkDirClaim: "work-dirs",
+		},
+		sender: k8sevent.NewEventSender(recorder),
 		cleanupWorkDirFn: func(context.Context, types.UID) {
 			cleanupCalled = true
 		},
 	}
+	r.cleanupContainerJobsFn = r.cleanupContainerJobs
 
 	req := kargoapi.AbortPromotionRequest{Action: kargoapi.AbortActionTerminate}
 	err := r.terminatePromotion(context.Background(), &req, promo, nil)
@@ -669,6 +702,11 @@ func Test_reconciler_terminatePromotion_cleansUpWorkDir(t *testing.T) {
 
 	require.True(t, cleanupCalled)
 
+	_, err = localClusterClient.BatchV1().Jobs(testJobNamespace).Get(
+		context.Background(), testJob.Name, metav1.GetOptions{},
+	)
+	require.True(t, apierrors.IsNotFound(err))
+
 	var updated kargoapi.Promotion
 	require.NoError(t, c.Get(context.Background(), types.NamespacedName{
 		Namespace: "fake-ns",
@@ -721,6 +759,176 @@ func Test_extractDeletedObject(t *testing.T) {
 	})
 }
 
+func Test_reconciler_executeOnFailureSteps(t *testing.T) {
+	testCases := []struct {
+		name       string
+		onFailure  promotion.Result
+		assertions func(*testing.T, *kargoapi.Promotion, promotion.Context, error)
+	}{
+		{
+			name: "onFailure steps still running",
+			onFailure: promotion.Result{
+				Status:      kargoapi.PromotionPhaseRunning,
+				CurrentStep: 0,
+				StepExecutionMetadata: kargoapi.StepExecutionMetadataList{{
+					Alias:  "revert",
+					Status: kargoapi.PromotionStepStatusRunning,
+				}},
+			},
+			assertions: func(t *testing.T, promo *kargoapi.Promotion, promoCtx promotion.Context, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, promo.Status.Phase)
+				require.Equal(t, "something went wrong", promo.Status.Message)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, promo.Status.OnFailure.Phase)
+				require.Len(t, promo.Status.OnFailure.StepExecutionMetadata, 1)
+
+				require.Equal(t, promo.Status.StepExecutionMetadata, promoCtx.FailedStepExecutionMetadata)
+				require.Equal(t, int64(1), promoCtx.StartFromStep)
+			},
+		},
+		{
+			name: "onFailure steps completed",
+			onFailure: promotion.Result{
+				Status:      kargoapi.PromotionPhaseSucceeded,
+				CurrentStep: 1,
+				StepExecutionMetadata: kargoapi.StepExecutionMetadataList{{
+					Alias:  "revert",
+					Status: kargoapi.PromotionStepStatusSucceeded,
+				}},
+			},
+			assertions: func(t *testing.T, promo *kargoapi.Promotion, _ promotion.Context, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseFailed, promo.Status.Phase)
+				require.Equal(t, "something went wrong", promo.Status.Message)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, promo.Status.OnFailure.Phase)
+				require.Equal(t, int64(1), promo.Status.OnFailure.CurrentStep)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			promo := &kargoapi.Promotion{
+				Spec: kargoapi.PromotionSpec{
+					Steps: []kargoapi.PromotionStep{{
+						As:   "push",
+						Uses: "fake-step",
+					}},
+					OnFailure: []kargoapi.PromotionStep{{
+						As:   "revert",
+						Uses: "fake-step",
+					}},
+				},
+				Status: kargoapi.PromotionStatus{
+					Phase: kargoapi.PromotionPhaseRunning,
+					StepExecutionMetadata: kargoapi.StepExecutionMetadataList{{
+						Alias:   "push",
+						Status:  kargoapi.PromotionStepStatusFailed,
+						Message: "something went wrong",
+					}},
+					OnFailure: &kargoapi.PromotionOnFailureStatus{
+						CurrentStep: 1,
+					},
+				},
+			}
+
+			var promoCtx promotion.Context
+			r := &reconciler{
+				promoEngine: &promotion.MockEngine{
+					PromoteFn: func(
+						_ context.Context,
+						c promotion.Context,
+						steps []promotion.Step,
+					) (promotion.Result, error) {
+						promoCtx = c
+						require.Len(t, steps, 1)
+						require.Equal(t, "revert", steps[0].Alias)
+						return tc.onFailure, nil
+					},
+				},
+			}
+			_, err := r.executeOnFailureSteps(
+				context.Background(),
+				promo,
+				promotion.Context{},
+				promotion.NewSteps(promo),
+			)
+			tc.assertions(t, promo, promoCtx, err)
+		})
+	}
+}
+
+func Test_recordDryRunResult(t *testing.T) {
+	status := &kargoapi.PromotionStatus{}
+
+	recordDryRunResult(status, promotion.Result{
+		PlannedSteps: []promotion.PlannedStep{{
+			Kind:   "git-push",
+			Alias:  "push",
+			Config: promotion.Config{"path": "./out"},
+		}},
+	})
+	require.NotNil(t, status.DryRun)
+	require.Len(t, status.DryRun.PlannedSteps, 1)
+	require.Empty(t, status.DryRun.Diff)
+
+	recordDryRunResult(status, promotion.Result{
+		PlannedSteps: []promotion.PlannedStep{{
+			Kind:  "argocd-update",
+			Alias: "update",
+		}},
+		Diff: "fake-diff",
+	})
+	require.Equal(
+		t,
+		[]kargoapi.PlannedPromotionStep{
+			{
+				As:     "push",
+				Uses:   "git-push",
+				Config: &apiextensionsv1.JSON{Raw: []byte(`{"path":"./out"}`)},
+			},
+			{
+				As:   "update",
+				Uses: "argocd-update",
+			},
+		},
+		status.DryRun.PlannedSteps,
+	)
+	require.Equal(t, "fake-diff", status.DryRun.Diff)
+}
+
+func Test_truncateDiff(t *testing.T) {
+	testCases := []struct {
+		name     string
+		diff     string
+		maxSize  int
+		expected string
+	}{
+		{
+			name:     "diff within limit",
+			diff:     "line one\nline two\n",
+			maxSize:  100,
+			expected: "line one\nline two\n",
+		},
+		{
+			name:     "diff truncated at line boundary",
+			diff:     "line one\nline two\n",
+			maxSize:  12,
+			expected: "line one\n... diff truncated ...\n",
+		},
+		{
+			name:     "diff without line boundary within limit",
+			diff:     "line one\nline two\n",
+			maxSize:  4,
+			expected: "line... diff truncated ...\n",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, truncateDiff(tc.diff, tc.maxSize))
+		})
+	}
+}
+
 func Test_calculateRequeueInterval(t *testing.T) {
 	testStepKindWithoutTimeout := "fake-step-without-timeout"
 	promotion.DefaultStepRunnerRegistry.MustRegister(
@@ -760,6 +968,25 @@ func Test_calculateRequeueInterval(t *testing.T) {
 		suggestedRequeueInterval *time.Duration
 		assertions               func(*testing.T, time.Duration)
 	}{
+		{
+			name: "executing onFailure steps",
+			promo: &kargoapi.Promotion{
+				Spec: kargoapi.PromotionSpec{
+					Steps: []kargoapi.PromotionStep{{
+						Uses: testStepKindWithTimeout,
+					}},
+				},
+				Status: kargoapi.PromotionStatus{
+					StepExecutionMetadata: []kargoapi.StepExecutionMetadata{{
+						StartedAt: &metav1.Time{Time: time.Now().Add(-time.Hour)},
+					}},
+					OnFailure: &kargoapi.PromotionOnFailureStatus{},
+				},
+			},
+			assertions: func(t *testing.T, requeueInterval time.Duration) {
+				require.Equal(t, defaultRequeueInterval, requeueInterval)
+			},
+		},
 		{
 			name: "current step out of bounds",
 			promo: &kargoapi.Promotion{
@@ -43,7 +43,6 @@ import (
 	"github.com/akuity/kargo/pkg/kubernetes"
 	libEvent "github.com/akuity/kargo/pkg/kubernetes/event"
 	"github.com/akuity/kargo/pkg/logging"
-	"github.com/akuity/kargo/pkg/pattern"
 	intpredicate "github.com/akuity/kargo/pkg/predicate"
 	"github.com/akuity/kargo/pkg/rollouts"
 )
@@ -395,7 +394,16 @@ func (r *RegularStageReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	}
 	// Otherwise, requeue after a delay.
 	// TODO: Make the requeue delay configurable.
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	requeueAfter := 5 * time.Minute
+	// If promotion is currently blocked by the Stage's PromotionPolicy, requeue
+	// no later than when it is next permitted, so any pending auto-promotion
+	// happens promptly.
+	if block := newStatus.PromotionBlock; block != nil && block.NextPermittedTime != nil {
+		if untilPermitted := time.Until(block.NextPermittedTime.Time); untilPermitted < requeueAfter {
+			requeueAfter = max(untilPermitted, time.Second)
+		}
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
 func (r *RegularStageReconciler) reconcile(
@@ -654,7 +662,9 @@ func (r *RegularStageReconciler) syncPromotions(
 				}
 			}
 
-			if promo.Status.Phase.IsTerminal() {
+			// Dry runs do not affect the Stage, so they are never recorded as
+			// its last Promotion.
+			if promo.Status.Phase.IsTerminal() && !promo.Spec.DryRun {
 				info := kargoapi.PromotionReference{
 					Name:       promo.Name,
 					Status:     promo.Status.DeepCopy(),
@@ -1713,6 +1723,19 @@ func (r *RegularStageReconciler) autoPromoteFreight(
 		return newStatus, nil
 	}
 
+	// Determine whether the promotion windows and blackouts of the Stage's
+	// PromotionPolicy currently permit promotion. This is recorded in the
+	// Stage's status regardless of whether auto-promotion is enabled, since it
+	// applies to manual promotions as well.
+	block, err := api.GetPromotionBlock(ctx, r.client, stage.ObjectMeta, time.Now())
+	if err != nil {
+		return newStatus, fmt.Errorf(
+			"error evaluating PromotionPolicy for Stage %q in namespace %q: %w",
+			stage.Name, stage.Namespace, err,
+		)
+	}
+	newStatus.PromotionBlock = block
+
 	// Confirm that auto-promotion is allowed for the Stage.
 	if autoPromotionAllowed, err := r.autoPromotionAllowed(ctx, stage.ObjectMeta); err != nil || !autoPromotionAllowed {
 		newStatus.AutoPromotionEnabled = false
@@ -1720,6 +1743,16 @@ func (r *RegularStageReconciler) autoPromoteFreight(
 	}
 	newStatus.AutoPromotionEnabled = true
 
+	if block != nil {
+		logger.Debug(
+			"auto-promotion is blocked by PromotionPolicy",
+			"reason", block.Reason,
+			"name", block.Name,
+			"nextPermittedTime", block.NextPermittedTime,
+		)
+		return newStatus, nil
+	}
+
 	// Retrieve promotable Freight for the Stage.
 	promotableFreight, err := r.getPromotableFreight(ctx, stage)
 	if err != nil {
@@ -1957,38 +1990,11 @@ func (r *RegularStageReconciler) autoPromotionAllowed(
 		return false, nil
 	}
 
-	for _, policy := range projectCfg.Spec.PromotionPolicies {
-		if policy.StageSelector == nil {
-			// Maintain backward compatibility with older versions of the
-			// PromotionPolicy where the selector was not available.
-			policy.StageSelector = &kargoapi.PromotionPolicySelector{
-				Name: policy.Stage, // nolint:staticcheck
-			}
-		}
-
-		// Match the Stage name with the exact PromotionPolicy name
-		if nameSelector := policy.StageSelector.Name; nameSelector != "" {
-			m, err := pattern.ParseNamePattern(nameSelector)
-			if err != nil {
-				return false, fmt.Errorf("error parsing PromotionPolicy name pattern %q: %w", nameSelector, err)
-			}
-			if !m.Matches(stage.Name) {
-				continue
-			}
-		}
-
-		// Match the Stage labels with the PromotionPolicy label selector.
-		if labelSelector := policy.StageSelector.LabelSelector; labelSelector != nil {
-			s, err := metav1.LabelSelectorAsSelector(labelSelector)
-			if err != nil {
-				return false, fmt.Errorf("error parsing PromotionPolicy label selector %q: %w", labelSelector, err)
-			}
-			if !s.Matches(labels.Set(stage.Labels)) {
-				continue
-			}
-		}
-
-		// If we reach this point, we have found a matching PromotionPolicy.
+	policy, err := api.MatchPromotionPolicy(projectCfg, stage)
+	if err != nil {
+		return false, err
+	}
+	if policy != nil {
 		logger.Debug(
 			"found PromotionPolicy associated with Stage",
 			"autoPromotionEnabled", policy.AutoPromotionEnabled,
@@ -716,6 +716,46 @@ func TestRegularStageReconciler_syncPromotions(t *testing.T) {
 				assert.Equal(t, "WaitingForVerification", verifiedCond.Reason)
 			},
 		},
+		{
+			name: "successful dry run does not update freight history",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "test-stage",
+				},
+			},
+			objects: []client.Object{
+				&kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "dry-run-promotion",
+						Namespace: "fake-project",
+					},
+					Spec: kargoapi.PromotionSpec{
+						Stage:  "test-stage",
+						DryRun: true,
+					},
+					Status: kargoapi.PromotionStatus{
+						Phase:      kargoapi.PromotionPhaseSucceeded,
+						FinishedAt: &metav1.Time{Time: now},
+						FreightCollection: &kargoapi.FreightCollection{
+							ID: "test-collection-id",
+							Freight: map[string]kargoapi.FreightReference{
+								"warehouse-1": {
+									Name: "test-freight-1",
+								},
+							},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, status kargoapi.StageStatus, hasPendingPromotions bool, err error) {
+				require.NoError(t, err)
+				assert.False(t, hasPendingPromotions)
+				assert.Nil(t, status.CurrentPromotion)
+				assert.Nil(t, status.LastPromotion)
+				assert.Empty(t, status.FreightHistory)
+			},
+		},
 		{
 			name: "active promotion updates status",
 			stage: &kargoapi.Stage{
@@ -5090,6 +5130,80 @@ func TestRegularStageReconciler_autoPromoteFreight(t *testing.T) {
 				assert.Empty(t, promoList.Items)
 			},
 		},
+		{
+			name: "auto-promotion blocked by blackout",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "test-stage",
+				},
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{
+						{
+							Origin: kargoapi.FreightOrigin{
+								Kind: kargoapi.FreightOriginKindWarehouse,
+								Name: "test-warehouse",
+							},
+							Sources: kargoapi.FreightSources{
+								Direct: true,
+							},
+						},
+					},
+				},
+			},
+			objects: []client.Object{
+				&kargoapi.ProjectConfig{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-project",
+						Namespace: "fake-project",
+					},
+					Spec: kargoapi.ProjectConfigSpec{
+						PromotionPolicies: []kargoapi.PromotionPolicy{
+							{
+								Stage:                "test-stage",
+								AutoPromotionEnabled: true,
+								Blackouts: []kargoapi.PromotionBlackout{{
+									Name:  "freeze",
+									Start: metav1.NewTime(hourAgo),
+									End:   metav1.NewTime(now.Add(time.Hour)),
+								}},
+							},
+						},
+					},
+				},
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:         "fake-project",
+						Name:              "test-freight",
+						CreationTimestamp: metav1.Time{Time: now},
+					},
+					Origin: kargoapi.FreightOrigin{
+						Kind: kargoapi.FreightOriginKindWarehouse,
+						Name: "test-warehouse",
+					},
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				c client.Client,
+				status kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+
+				assert.True(t, status.AutoPromotionEnabled)
+				require.NotNil(t, status.PromotionBlock)
+				assert.Equal(t, kargoapi.PromotionBlockReasonBlackout, status.PromotionBlock.Reason)
+				assert.Equal(t, "freeze", status.PromotionBlock.Name)
+				require.NotNil(t, status.PromotionBlock.NextPermittedTime)
+
+				// Verify no promotions were created
+				promoList := &kargoapi.PromotionList{}
+				require.NoError(t, c.List(context.Background(), promoList, client.InNamespace("fake-project")))
+				assert.Empty(t, promoList.Items)
+			},
+		},
 		{
 			name: "handles direct freight from warehouse",
 			stage: &kargoapi.Stage{
@@ -0,0 +1,212 @@
+package warehouses
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// correlationCandidates holds the correlation values of the artifacts
+// discovered for a single subscription.
+type correlationCandidates struct {
+	// repoURL is the URL of the subscription's repository.
+	repoURL string
+	// values holds the correlation value of each artifact discovered for the
+	// subscription, in the order the artifacts were discovered (newest first).
+	// An empty string indicates an artifact carries no value for the key.
+	values []string
+	// selectFn narrows the artifacts discovered for the subscription down to
+	// the one at the specified index.
+	selectFn func(int)
+}
+
+// correlateArtifacts applies the correlation settings of the provided
+// FreightCreationGrouping to the provided DiscoveredArtifacts. It returns the
+// artifacts Freight should be built from. If the artifacts are not correlated,
+// it additionally returns a message describing why. The provided
+// DiscoveredArtifacts are never modified.
+func correlateArtifacts(
+	grouping *kargoapi.FreightCreationGrouping,
+	artifacts *kargoapi.DiscoveredArtifacts,
+) (*kargoapi.DiscoveredArtifacts, string) {
+	if grouping == nil || grouping.CorrelationKey == "" || artifacts == nil {
+		return artifacts, ""
+	}
+	switch grouping.CorrelationStrategy {
+	case kargoapi.CorrelationStrategyMatching:
+		return matchArtifacts(grouping, artifacts)
+	default:
+		return artifacts, uncorrelatedArtifacts(grouping, artifacts)
+	}
+}
+
+// uncorrelatedArtifacts checks whether the newest artifacts discovered for
+// each subscription agree on the value of the correlation key. If they do not,
+// a message describing the disagreement is returned. Otherwise, an empty
+// string is returned. Subscriptions whose newest artifact carries no value for
+// the key are disregarded.
+func uncorrelatedArtifacts(
+	grouping *kargoapi.FreightCreationGrouping,
+	artifacts *kargoapi.DiscoveredArtifacts,
+) string {
+	values := make(map[string][]string)
+	for _, candidates := range getCorrelationCandidates(grouping, artifacts) {
+		if len(candidates.values) > 0 && candidates.values[0] != "" {
+			value := candidates.values[0]
+			values[value] = append(values[value], candidates.repoURL)
+		}
+	}
+	if len(values) < 2 {
+		return ""
+	}
+	sortedValues := make([]string, 0, len(values))
+	for value := range values {
+		sortedValues = append(sortedValues, value)
+	}
+	slices.Sort(sortedValues)
+	var sb strings.Builder
+	fmt.Fprintf(
+		&sb,
+		"Newest artifacts do not agree on the value of %q:",
+		grouping.CorrelationKey,
+	)
+	for _, value := range sortedValues {
+		fmt.Fprintf(&sb, " %q from %s;", value, strings.Join(values[value], ", "))
+	}
+	return strings.TrimSuffix(sb.String(), ";")
+}
+
+// matchArtifacts finds the newest set of artifacts, one per subscription, that
+// agree on the value of the correlation key and returns a copy of the provided
+// DiscoveredArtifacts narrowed down to that set. Subscriptions none of whose
+// artifacts carry a value for the key are left untouched. If no such set
+// exists, a message saying so is additionally returned.
+//
+// The newest set is the one whose oldest member is the newest. Ties are broken
+// in favor of the set whose members are, on aggregate, the newest and then by
+// the lexical order of the values.
+func matchArtifacts(
+	grouping *kargoapi.FreightCreationGrouping,
+	artifacts *kargoapi.DiscoveredArtifacts,
+) (*kargoapi.DiscoveredArtifacts, string) {
+	artifacts = artifacts.DeepCopy()
+	var participants []correlationCandidates
+	for _, candidates := range getCorrelationCandidates(grouping, artifacts) {
+		if slices.ContainsFunc(candidates.values, func(v string) bool {
+			return v != ""
+		}) {
+			participants = append(participants, candidates)
+		}
+	}
+	if len(participants) == 0 {
+		return artifacts, ""
+	}
+
+	var (
+		bestValue   string
+		bestIndices []int
+		bestMax     = -1
+		bestSum     int
+	)
+	for _, value := range participants[0].values {
+		if value == "" {
+			continue
+		}
+		indices := make([]int, len(participants))
+		var maxIndex, sum int
+		for i, candidates := range participants {
+			if indices[i] = slices.Index(candidates.values, value); indices[i] < 0 {
+				break
+			}
+			maxIndex = max(maxIndex, indices[i])
+			sum += indices[i]
+		}
+		if slices.Contains(indices, -1) {
+			continue
+		}
+		if bestMax < 0 || maxIndex < bestMax ||
+			(maxIndex == bestMax && (sum < bestSum ||
+				(sum == bestSum && value < bestValue))) {
+			bestValue, bestIndices, bestMax, bestSum = value, indices, maxIndex, sum
+		}
+	}
+	if bestMax < 0 {
+		return artifacts, fmt.Sprintf(
+			"No set of discovered artifacts agrees on the value of %q",
+			grouping.CorrelationKey,
+		)
+	}
+	for i, candidates := range participants {
+		candidates.selectFn(bestIndices[i])
+	}
+	return artifacts, ""
+}
+
+// getCorrelationCandidates returns the correlation values of the artifacts
+// discovered for each subscription that is not excluded from correlation. The
+// value for an image is that of its annotation having the name of the key.
+// The value for a Git commit is its ID. The value for a chart version is its
+// appVersion. Generic artifacts carry no value.
+func getCorrelationCandidates(
+	grouping *kargoapi.FreightCreationGrouping,
+	artifacts *kargoapi.DiscoveredArtifacts,
+) []correlationCandidates {
+	excluded := func(repoURL string) bool {
+		return slices.Contains(grouping.CorrelationExcludedRepoURLs, repoURL)
+	}
+	var all []correlationCandidates
+	for i := range artifacts.Git {
+		result := &artifacts.Git[i]
+		if excluded(result.RepoURL) {
+			continue
+		}
+		values := make([]string, len(result.Commits))
+		for j, commit := range result.Commits {
+			values[j] = commit.ID
+		}
+		all = append(all, correlationCandidates{
+			repoURL: result.RepoURL,
+			values:  values,
+			selectFn: func(j int) {
+				result.Commits = result.Commits[j : j+1]
+			},
+		})
+	}
+	for i := range artifacts.Images {
+		result := &artifacts.Images[i]
+		if excluded(result.RepoURL) {
+			continue
+		}
+		values := make([]string, len(result.References))
+		for j, ref := range result.References {
+			values[j] = ref.Annotations[grouping.CorrelationKey]
+		}
+		all = append(all, correlationCandidates{
+			repoURL: result.RepoURL,
+			values:  values,
+			selectFn: func(j int) {
+				result.References = result.References[j : j+1]
+			},
+		})
+	}
+	for i := range artifacts.Charts {
+		result := &artifacts.Charts[i]
+		if excluded(result.RepoURL) {
+			continue
+		}
+		values := make([]string, len(result.Versions))
+		for j, version := range result.Versions {
+			values[j] = result.AppVersions[version]
+		}
+		all = append(all, correlationCandidates{
+			repoURL: result.RepoURL,
+			values:  values,
+			selectFn: func(j int) {
+				result.Versions = result.Versions[j : j+1]
+			},
+		})
+	}
+	return all
+}
@@ -0,0 +1,194 @@
+package warehouses
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+const testCorrelationKey = "org.opencontainers.image.revision"
+
+func testImageDiscoveryResult(
+	repoURL string,
+	revisions ...string,
+) kargoapi.ImageDiscoveryResult {
+	refs := make([]kargoapi.DiscoveredImageReference, len(revisions))
+	for i, revision := range revisions {
+		refs[i] = kargoapi.DiscoveredImageReference{Tag: revision + "-tag"}
+		if revision != "" {
+			refs[i].Annotations = map[string]string{testCorrelationKey: revision}
+		}
+	}
+	return kargoapi.ImageDiscoveryResult{RepoURL: repoURL, References: refs}
+}
+
+func Test_correlateArtifacts(t *testing.T) {
+	testCases := []struct {
+		name       string
+		grouping   *kargoapi.FreightCreationGrouping
+		artifacts  *kargoapi.DiscoveredArtifacts
+		assertions func(*testing.T, *kargoapi.DiscoveredArtifacts, string)
+	}{
+		{
+			name: "no correlation key",
+			grouping: &kargoapi.FreightCreationGrouping{
+				CorrelationStrategy: kargoapi.CorrelationStrategyMatching,
+			},
+			artifacts: &kargoapi.DiscoveredArtifacts{
+				Images: []kargoapi.ImageDiscoveryResult{
+					testImageDiscoveryResult("fake-image-a", "abc"),
+					testImageDiscoveryResult("fake-image-b", "def"),
+				},
+			},
+			assertions: func(t *testing.T, artifacts *kargoapi.DiscoveredArtifacts, msg string) {
+				require.Empty(t, msg)
+				require.Len(t, artifacts.Images[0].References, 1)
+			},
+		},
+		{
+			name: "newest artifacts agree",
+			grouping: &kargoapi.FreightCreationGrouping{
+				CorrelationKey: testCorrelationKey,
+			},
+			artifacts: &kargoapi.DiscoveredArtifacts{
+				Git: []kargoapi.GitDiscoveryResult{{
+					RepoURL: "fake-repo",
+					Commits: []kargoapi.DiscoveredCommit{{ID: "abc"}},
+				}},
+				Images: []kargoapi.ImageDiscoveryResult{
+					testImageDiscoveryResult("fake-image-a", "abc"),
+					testImageDiscoveryResult("fake-image-b", "abc"),
+					testImageDiscoveryResult("fake-image-c", ""),
+				},
+				Charts: []kargoapi.ChartDiscoveryResult{{
+					RepoURL:     "fake-chart",
+					Versions:    []string{"1.0.0"},
+					AppVersions: map[string]string{"1.0.0": "abc"},
+				}},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.DiscoveredArtifacts, msg string) {
+				require.Empty(t, msg)
+			},
+		},
+		{
+			name: "newest artifacts disagree",
+			grouping: &kargoapi.FreightCreationGrouping{
+				CorrelationKey: testCorrelationKey,
+			},
+			artifacts: &kargoapi.DiscoveredArtifacts{
+				Images: []kargoapi.ImageDiscoveryResult{
+					testImageDiscoveryResult("fake-image-a", "def", "abc"),
+					testImageDiscoveryResult("fake-image-b", "abc"),
+					testImageDiscoveryResult("fake-image-c", "abc"),
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.DiscoveredArtifacts, msg string) {
+				require.Equal(
+					t,
+					`Newest artifacts do not agree on the value of `+
+						`"org.opencontainers.image.revision": "abc" from fake-image-b, `+
+						`fake-image-c; "def" from fake-image-a`,
+					msg,
+				)
+			},
+		},
+		{
+			name: "disagreeing subscription excluded",
+			grouping: &kargoapi.FreightCreationGrouping{
+				CorrelationKey:              testCorrelationKey,
+				CorrelationExcludedRepoURLs: []string{"fake-repo"},
+			},
+			artifacts: &kargoapi.DiscoveredArtifacts{
+				Git: []kargoapi.GitDiscoveryResult{{
+					RepoURL: "fake-repo",
+					Commits: []kargoapi.DiscoveredCommit{{ID: "def"}},
+				}},
+				Images: []kargoapi.ImageDiscoveryResult{
+					testImageDiscoveryResult("fake-image-a", "abc"),
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.DiscoveredArtifacts, msg string) {
+				require.Empty(t, msg)
+			},
+		},
+		{
+			name: "matching artifacts found",
+			grouping: &kargoapi.FreightCreationGrouping{
+				CorrelationKey:      testCorrelationKey,
+				CorrelationStrategy: kargoapi.CorrelationStrategyMatching,
+			},
+			artifacts: &kargoapi.DiscoveredArtifacts{
+				Git: []kargoapi.GitDiscoveryResult{{
+					RepoURL: "fake-repo",
+					Commits: []kargoapi.DiscoveredCommit{
+						{ID: "ghi"},
+						{ID: "def"},
+						{ID: "abc"},
+					},
+				}},
+				Images: []kargoapi.ImageDiscoveryResult{
+					// The newest image has been built from the newest commit, but
+					// the other image has yet to be.
+					testImageDiscoveryResult("fake-image-a", "ghi", "def", "abc"),
+					testImageDiscoveryResult("fake-image-b", "def", "abc"),
+					// This subscription does not participate in correlation.
+					testImageDiscoveryResult("fake-image-c", "", ""),
+				},
+				Charts: []kargoapi.ChartDiscoveryResult{{
+					RepoURL:  "fake-chart",
+					Versions: []string{"1.1.0", "1.0.0"},
+					AppVersions: map[string]string{
+						"1.1.0": "abc",
+						"1.0.0": "def",
+					},
+				}},
+			},
+			assertions: func(t *testing.T, artifacts *kargoapi.DiscoveredArtifacts, msg string) {
+				require.Empty(t, msg)
+				require.Equal(
+					t,
+					[]kargoapi.DiscoveredCommit{{ID: "def"}},
+					artifacts.Git[0].Commits,
+				)
+				require.Len(t, artifacts.Images[0].References, 1)
+				require.Equal(t, "def-tag", artifacts.Images[0].References[0].Tag)
+				require.Len(t, artifacts.Images[1].References, 1)
+				require.Equal(t, "def-tag", artifacts.Images[1].References[0].Tag)
+				require.Len(t, artifacts.Images[2].References, 2)
+				require.Equal(t, []string{"1.0.0"}, artifacts.Charts[0].Versions)
+			},
+		},
+		{
+			name: "no matching artifacts",
+			grouping: &kargoapi.FreightCreationGrouping{
+				CorrelationKey:      testCorrelationKey,
+				CorrelationStrategy: kargoapi.CorrelationStrategyMatching,
+			},
+			artifacts: &kargoapi.DiscoveredArtifacts{
+				Images: []kargoapi.ImageDiscoveryResult{
+					testImageDiscoveryResult("fake-image-a", "def"),
+					testImageDiscoveryResult("fake-image-b", "abc"),
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.DiscoveredArtifacts, msg string) {
+				require.Equal(
+					t,
+					`No set of discovered artifacts agrees on the value of `+
+						`"org.opencontainers.image.revision"`,
+					msg,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			original := testCase.artifacts.DeepCopy()
+			artifacts, msg := correlateArtifacts(testCase.grouping, testCase.artifacts)
+			testCase.assertions(t, artifacts, msg)
+			// The provided artifacts must never be modified.
+			require.Equal(t, original, testCase.artifacts)
+		})
+	}
+}
@@ -190,10 +190,16 @@ func (r *reconciler) Reconcile(
 		return ctrl.Result{}, err
 	}
 
-	// Everything succeeded, look for new changes on the defined interval.
-	return ctrl.Result{
-		RequeueAfter: warehouse.GetInterval(r.cfg.MinReconciliationInterval),
-	}, nil
+	// Everything succeeded, look for new changes on the defined interval, or
+	// sooner if pending Freight will be ready for creation before then.
+	requeueAfter := warehouse.GetInterval(r.cfg.MinReconciliationInterval)
+	if remaining := settleWindowRemaining(
+		warehouse.Spec.FreightCreationGrouping,
+		newStatus.PendingFreight,
+	); remaining > 0 && remaining < requeueAfter {
+		requeueAfter = remaining
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
 func (r *reconciler) syncWarehouse(
@@ -248,9 +254,16 @@ func (r *reconciler) syncWarehouse(
 			logger.Error(err, "error updating Warehouse status")
 		}
 
-		// Discover the latest artifacts.
+		// Discover the latest artifacts. Subscribers are told when artifacts
+		// will be correlated so they only gather what correlation requires
+		// when it is actually needed.
+		discoveryCtx := ctx
+		if grouping := warehouse.Spec.FreightCreationGrouping; grouping != nil &&
+			grouping.CorrelationKey != "" {
+			discoveryCtx = subscription.ContextWithCorrelation(ctx)
+		}
 		discoveredArtifacts, err := r.discoverArtifactsFn(
-			ctx,
+			discoveryCtx,
 			warehouse.Namespace,
 			warehouse.Spec.InternalSubscriptions,
 		)
@@ -302,9 +315,15 @@ func (r *reconciler) syncWarehouse(
 	// Automatically create a Freight from the latest discovered artifacts
 	// if the Warehouse is configured to do so.
 	if pol := warehouse.Spec.FreightCreationPolicy; pol == kargoapi.FreightCreationPolicyAutomatic || pol == "" {
+		// Narrow the discovered artifacts down to those that are correlated, if
+		// the Warehouse is configured to correlate them.
+		artifacts, uncorrelated := correlateArtifacts(
+			warehouse.Spec.FreightCreationGrouping,
+			status.DiscoveredArtifacts,
+		)
 		criteriaSatisfied, err := freightCreationCriteriaSatisfied(ctx,
 			warehouse.Spec.FreightCreationCriteria,
-			status.DiscoveredArtifacts,
+			artifacts,
 		)
 		if err != nil {
 			logger.Error(err, "error evaluating freight creation criteria")
@@ -335,6 +354,7 @@ func (r *reconciler) syncWarehouse(
 		}
 		if !criteriaSatisfied {
 			logger.Debug("freight creation criteria not satisfied; skipping freight creation")
+			status.PendingFreight = nil
 			conditions.Set(
 				&status,
 				&metav1.Condition{
@@ -345,6 +365,19 @@ func (r *reconciler) syncWarehouse(
 					ObservedGeneration: warehouse.GetGeneration(),
 				},
 			)
+		} else if uncorrelated != "" {
+			logger.Debug("artifacts are not correlated; skipping freight creation")
+			status.PendingFreight = nil
+			conditions.Set(
+				&status,
+				&metav1.Condition{
+					Type:               kargoapi.ConditionTypeFreightCreationCriteriaSatisfied,
+					Status:             metav1.ConditionFalse,
+					Reason:             "ArtifactsNotCorrelated",
+					Message:            uncorrelated,
+					ObservedGeneration: warehouse.GetGeneration(),
+				},
+			)
 		} else {
 			logger.Debug("freight creation criteria satisfied")
 			// Mark the Warehouse as reconciling while we create the Freight.
@@ -386,7 +419,7 @@ func (r *reconciler) syncWarehouse(
 			)
 
 			// Build Freight from the latest discovered artifacts.
-			freight, err := r.buildFreightFromLatestArtifactsFn(warehouse.Namespace, status.DiscoveredArtifacts)
+			freight, err := r.buildFreightFromLatestArtifactsFn(warehouse.Namespace, artifacts)
 			if err != nil {
 				// Make the error visible in the status and mark the Warehouse as
 				// not ready.
@@ -419,70 +452,101 @@ func (r *reconciler) syncWarehouse(
 				Name: warehouse.Name,
 			}
 
-			// Attempt to create the Freight.
-			if err = r.createFreightFn(ctx, freight); err != nil {
-				if !apierrors.IsAlreadyExists(err) {
-					// Make the error visible in the status and mark the Warehouse as
-					// not ready.
-					msg := fmt.Sprintf(
-						"Error creating Freight %q in namespace %q: %s",
-						freight.Name,
-						freight.Namespace,
-						err.Error(),
-					)
+			// Hold off on creating the Freight until the artifacts it is composed
+			// of have settled, if the Warehouse is configured to do so.
+			if settling := recordPendingFreight(
+				warehouse,
+				&status,
+				freight.Name,
+			); settling > 0 {
+				logger.Debug(
+					"waiting for artifacts to settle before creating Freight",
+					"freight", freight.Name,
+					"remaining", settling,
+				)
+				conditions.Set(
+					&status,
+					&metav1.Condition{
+						Type:   kargoapi.ConditionTypeFreightCreated,
+						Status: metav1.ConditionFalse,
+						Reason: "AwaitingSettleWindow",
+						Message: fmt.Sprintf(
+							"Freight %q will be created if the newest artifacts remain unchanged until %s",
+							freight.Name,
+							status.PendingFreight.Since.Add(
+								warehouse.Spec.FreightCreationGrouping.SettleWindow.Duration,
+							).UTC().Format(time.RFC3339),
+						),
+						ObservedGeneration: warehouse.GetGeneration(),
+					},
+				)
+			} else {
+				// Attempt to create the Freight.
+				if err = r.createFreightFn(ctx, freight); err != nil {
+					if !apierrors.IsAlreadyExists(err) {
+						// Make the error visible in the status and mark the Warehouse as
+						// not ready.
+						msg := fmt.Sprintf(
+							"Error creating Freight %q in namespace %q: %s",
+							freight.Name,
+							freight.Namespace,
+							err.Error(),
+						)
+						conditions.Set(
+							&status,
+							&metav1.Condition{
+								Type:               kargoapi.ConditionTypeHealthy,
+								Status:             metav1.ConditionFalse,
+								Reason:             "FreightBuildFailure",
+								Message:            msg,
+								ObservedGeneration: warehouse.GetGeneration(),
+							},
+							&metav1.Condition{
+								Type:               kargoapi.ConditionTypeReady,
+								Status:             metav1.ConditionFalse,
+								Reason:             "FreightCreationFailure",
+								Message:            msg,
+								ObservedGeneration: warehouse.GetGeneration(),
+							},
+						)
+						return status, fmt.Errorf(
+							"error creating Freight %q in namespace %q: %w",
+							freight.Name,
+							freight.Namespace,
+							err,
+						)
+					}
 					conditions.Set(
 						&status,
 						&metav1.Condition{
-							Type:               kargoapi.ConditionTypeHealthy,
+							Type:               kargoapi.ConditionTypeFreightCreated,
 							Status:             metav1.ConditionFalse,
-							Reason:             "FreightBuildFailure",
-							Message:            msg,
+							Reason:             "AlreadyExists",
+							Message:            "Freight composed of the newest artifacts already exists",
 							ObservedGeneration: warehouse.GetGeneration(),
 						},
+					)
+				} else {
+					logger.Debug(
+						"created Freight",
+						"freight", freight.Name,
+						"namespace", freight.Namespace,
+					)
+					conditions.Set(
+						&status,
 						&metav1.Condition{
-							Type:               kargoapi.ConditionTypeReady,
-							Status:             metav1.ConditionFalse,
-							Reason:             "FreightCreationFailure",
-							Message:            msg,
+							Type:               kargoapi.ConditionTypeFreightCreated,
+							Status:             metav1.ConditionTrue,
+							Reason:             "NewFreight",
+							Message:            "No Freight composed of the newest artifacts already existed",
 							ObservedGeneration: warehouse.GetGeneration(),
 						},
 					)
-					return status, fmt.Errorf(
-						"error creating Freight %q in namespace %q: %w",
-						freight.Name,
-						freight.Namespace,
-						err,
-					)
 				}
-				conditions.Set(
-					&status,
-					&metav1.Condition{
-						Type:               kargoapi.ConditionTypeFreightCreated,
-						Status:             metav1.ConditionFalse,
-						Reason:             "AlreadyExists",
-						Message:            "Freight composed of the newest artifacts already exists",
-						ObservedGeneration: warehouse.GetGeneration(),
-					},
-				)
-			} else {
-				logger.Debug(
-					"created Freight",
-					"freight", freight.Name,
-					"namespace", freight.Namespace,
-				)
-				conditions.Set(
-					&status,
-					&metav1.Condition{
-						Type:               kargoapi.ConditionTypeFreightCreated,
-						Status:             metav1.ConditionTrue,
-						Reason:             "NewFreight",
-						Message:            "No Freight composed of the newest artifacts already existed",
-						ObservedGeneration: warehouse.GetGeneration(),
-					},
-				)
-			}
 
-			status.LastFreightID = freight.Name
+				status.LastFreightID = freight.Name
+				status.PendingFreight = nil
+			}
 		}
 	}
 
@@ -533,7 +597,7 @@ func (r *reconciler) discoverArtifacts(
 				fmt.Errorf("error finding subscriber for subscription: %w", err)
 		}
 		// The registration's value is a factory function
-		subscriber, err := subReg.Value(ctx, r.credentialsDB)
+		subscriber, err := subReg.Value(ctx, r.client, r.credentialsDB)
 		if err != nil {
 			return nil, fmt.Errorf("error instantiating subscriber: %w", err)
 		}
@@ -833,6 +897,47 @@ func freightCreationCriteriaSatisfied(
 	}
 }
 
+// recordPendingFreight records Freight with the provided ID as pending in the
+// provided WarehouseStatus if the Warehouse is configured with a settle window
+// and the Freight is not the one most recently produced by the Warehouse. It
+// returns how much longer the artifacts the Freight is composed of must remain
+// the newest ones before the Freight may be created. A return value of zero
+// or less indicates the Freight may be created immediately.
+func recordPendingFreight(
+	warehouse *kargoapi.Warehouse,
+	status *kargoapi.WarehouseStatus,
+	freightID string,
+) time.Duration {
+	grouping := warehouse.Spec.FreightCreationGrouping
+	if grouping == nil || grouping.SettleWindow == nil ||
+		grouping.SettleWindow.Duration <= 0 || freightID == status.LastFreightID {
+		status.PendingFreight = nil
+		return 0
+	}
+	if status.PendingFreight == nil || status.PendingFreight.ID != freightID {
+		// The newest artifacts have changed since we last looked. (Re)start the
+		// settle window.
+		status.PendingFreight = &kargoapi.PendingFreight{
+			ID:    freightID,
+			Since: metav1.Now(),
+		}
+	}
+	return settleWindowRemaining(grouping, status.PendingFreight)
+}
+
+// settleWindowRemaining returns how much longer the artifacts the provided
+// pending Freight is composed of must remain the newest ones before the Freight
+// may be created.
+func settleWindowRemaining(
+	grouping *kargoapi.FreightCreationGrouping,
+	pending *kargoapi.PendingFreight,
+) time.Duration {
+	if grouping == nil || grouping.SettleWindow == nil || pending == nil {
+		return 0
+	}
+	return time.Until(pending.Since.Add(grouping.SettleWindow.Duration))
+}
+
 // shouldDiscoverArtifacts returns true if the Warehouse should attempt to
 // discover new artifacts. This is determined by the following conditions:
 //
@@ -461,6 +461,302 @@ func TestSyncWarehouse(t *testing.T) {
 			},
 		},
 
+		{
+			name: "Freight creation deferred until artifacts settle",
+			reconciler: &reconciler{
+				discoverArtifactsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) (*kargoapi.DiscoveredArtifacts, error) {
+					return &kargoapi.DiscoveredArtifacts{
+						Git: []kargoapi.GitDiscoveryResult{
+							{
+								RepoURL: "fake-repo",
+								Commits: []kargoapi.DiscoveredCommit{{ID: "fake-commit"}},
+							},
+						},
+						Images: []kargoapi.ImageDiscoveryResult{
+							{
+								RepoURL: "fake-image",
+								References: []kargoapi.DiscoveredImageReference{{
+									Tag: "fake-tag",
+									Annotations: map[string]string{
+										"org.opencontainers.image.revision": "fake-commit",
+									},
+								}},
+							},
+						},
+					}, nil
+				},
+				buildFreightFromLatestArtifactsFn: func(
+					string,
+					*kargoapi.DiscoveredArtifacts,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-freight",
+							Namespace: "fake-namespace",
+						},
+					}, nil
+				},
+				createFreightFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return errors.New("Freight should not have been created")
+				},
+				patchStatusFn: func(context.Context, *kargoapi.Warehouse, func(*kargoapi.WarehouseStatus)) error {
+					return nil
+				},
+			},
+			warehouse: &kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Spec: kargoapi.WarehouseSpec{
+					FreightCreationPolicy: kargoapi.FreightCreationPolicyAutomatic,
+					FreightCreationGrouping: &kargoapi.FreightCreationGrouping{
+						SettleWindow: &metav1.Duration{Duration: time.Hour},
+					},
+				},
+			},
+			assertions: func(t *testing.T, status kargoapi.WarehouseStatus, err error) {
+				require.NoError(t, err)
+				require.Empty(t, status.LastFreightID)
+				require.NotNil(t, status.PendingFreight)
+				require.Equal(t, "fake-freight", status.PendingFreight.ID)
+
+				readyCondition := conditions.Get(&status, kargoapi.ConditionTypeReady)
+				require.NotNil(t, readyCondition)
+				require.Equal(t, metav1.ConditionTrue, readyCondition.Status)
+
+				freightCreatedCondition := conditions.Get(&status, kargoapi.ConditionTypeFreightCreated)
+				require.NotNil(t, freightCreatedCondition)
+				require.Equal(t, metav1.ConditionFalse, freightCreatedCondition.Status)
+				require.Equal(t, "AwaitingSettleWindow", freightCreatedCondition.Reason)
+			},
+		},
+		{
+			name: "Freight created once artifacts have settled",
+			reconciler: &reconciler{
+				discoverArtifactsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) (*kargoapi.DiscoveredArtifacts, error) {
+					return &kargoapi.DiscoveredArtifacts{
+						Git: []kargoapi.GitDiscoveryResult{
+							{
+								RepoURL: "fake-repo",
+								Commits: []kargoapi.DiscoveredCommit{{ID: "fake-commit"}},
+							},
+						},
+						Images: []kargoapi.ImageDiscoveryResult{
+							{
+								RepoURL: "fake-image",
+								References: []kargoapi.DiscoveredImageReference{{
+									Tag: "fake-tag",
+									Annotations: map[string]string{
+										"org.opencontainers.image.revision": "fake-commit",
+									},
+								}},
+							},
+						},
+					}, nil
+				},
+				buildFreightFromLatestArtifactsFn: func(
+					string,
+					*kargoapi.DiscoveredArtifacts,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-freight",
+							Namespace: "fake-namespace",
+						},
+					}, nil
+				},
+				createFreightFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+				patchStatusFn: func(context.Context, *kargoapi.Warehouse, func(*kargoapi.WarehouseStatus)) error {
+					return nil
+				},
+			},
+			warehouse: &kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Spec: kargoapi.WarehouseSpec{
+					FreightCreationPolicy: kargoapi.FreightCreationPolicyAutomatic,
+					FreightCreationGrouping: &kargoapi.FreightCreationGrouping{
+						SettleWindow:   &metav1.Duration{Duration: time.Hour},
+						CorrelationKey: "org.opencontainers.image.revision",
+					},
+				},
+				Status: kargoapi.WarehouseStatus{
+					PendingFreight: &kargoapi.PendingFreight{
+						ID:    "fake-freight",
+						Since: metav1.NewTime(time.Now().Add(-2 * time.Hour)),
+					},
+				},
+			},
+			assertions: func(t *testing.T, status kargoapi.WarehouseStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "fake-freight", status.LastFreightID)
+				require.Nil(t, status.PendingFreight)
+
+				freightCreatedCondition := conditions.Get(&status, kargoapi.ConditionTypeFreightCreated)
+				require.NotNil(t, freightCreatedCondition)
+				require.Equal(t, metav1.ConditionTrue, freightCreatedCondition.Status)
+				require.Equal(t, "NewFreight", freightCreatedCondition.Reason)
+			},
+		},
+		{
+			name: "newest artifacts not correlated",
+			reconciler: &reconciler{
+				discoverArtifactsFn: func(
+					ctx context.Context, _ string,
+					_ []kargoapi.RepoSubscription,
+				) (*kargoapi.DiscoveredArtifacts, error) {
+					if !subscription.CorrelationFromContext(ctx) {
+						return nil, errors.New("correlation should have been requested")
+					}
+					return &kargoapi.DiscoveredArtifacts{
+						Git: []kargoapi.GitDiscoveryResult{
+							{
+								RepoURL: "fake-repo",
+								Commits: []kargoapi.DiscoveredCommit{{ID: "fake-commit"}},
+							},
+						},
+						Images: []kargoapi.ImageDiscoveryResult{
+							{
+								RepoURL: "fake-image",
+								References: []kargoapi.DiscoveredImageReference{{
+									Tag: "fake-tag",
+									Annotations: map[string]string{
+										"org.opencontainers.image.revision": "other-commit",
+									},
+								}},
+							},
+						},
+					}, nil
+				},
+				buildFreightFromLatestArtifactsFn: func(
+					string,
+					*kargoapi.DiscoveredArtifacts,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-freight",
+							Namespace: "fake-namespace",
+						},
+					}, nil
+				},
+				createFreightFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return errors.New("Freight should not have been created")
+				},
+				patchStatusFn: func(context.Context, *kargoapi.Warehouse, func(*kargoapi.WarehouseStatus)) error {
+					return nil
+				},
+			},
+			warehouse: &kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Spec: kargoapi.WarehouseSpec{
+					FreightCreationPolicy: kargoapi.FreightCreationPolicyAutomatic,
+					FreightCreationGrouping: &kargoapi.FreightCreationGrouping{
+						CorrelationKey: "org.opencontainers.image.revision",
+					},
+				},
+			},
+			assertions: func(t *testing.T, status kargoapi.WarehouseStatus, err error) {
+				require.NoError(t, err)
+				require.Empty(t, status.LastFreightID)
+
+				readyCondition := conditions.Get(&status, kargoapi.ConditionTypeReady)
+				require.NotNil(t, readyCondition)
+				require.Equal(t, metav1.ConditionTrue, readyCondition.Status)
+
+				criteriaCondition := conditions.Get(&status, kargoapi.ConditionTypeFreightCreationCriteriaSatisfied)
+				require.NotNil(t, criteriaCondition)
+				require.Equal(t, metav1.ConditionFalse, criteriaCondition.Status)
+				require.Equal(t, "ArtifactsNotCorrelated", criteriaCondition.Reason)
+				require.Contains(t, criteriaCondition.Message, `"other-commit" from fake-image`)
+				require.Contains(t, criteriaCondition.Message, `"fake-commit" from fake-repo`)
+			},
+		},
+		{
+			name: "Freight built from matching artifacts",
+			reconciler: &reconciler{
+				discoverArtifactsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) (*kargoapi.DiscoveredArtifacts, error) {
+					return &kargoapi.DiscoveredArtifacts{
+						Git: []kargoapi.GitDiscoveryResult{{
+							RepoURL: "fake-repo",
+							Commits: []kargoapi.DiscoveredCommit{
+								{ID: "new-commit"},
+								{ID: "old-commit"},
+							},
+						}},
+						Images: []kargoapi.ImageDiscoveryResult{{
+							RepoURL: "fake-image",
+							References: []kargoapi.DiscoveredImageReference{{
+								Tag: "fake-tag",
+								Annotations: map[string]string{
+									"org.opencontainers.image.revision": "old-commit",
+								},
+							}},
+						}},
+					}, nil
+				},
+				buildFreightFromLatestArtifactsFn: func(
+					_ string,
+					artifacts *kargoapi.DiscoveredArtifacts,
+				) (*kargoapi.Freight, error) {
+					if len(artifacts.Git[0].Commits) != 1 ||
+						artifacts.Git[0].Commits[0].ID != "old-commit" {
+						return nil, errors.New("Freight built from uncorrelated artifacts")
+					}
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-freight",
+							Namespace: "fake-namespace",
+						},
+					}, nil
+				},
+				createFreightFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+				patchStatusFn: func(context.Context, *kargoapi.Warehouse, func(*kargoapi.WarehouseStatus)) error {
+					return nil
+				},
+			},
+			warehouse: &kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Spec: kargoapi.WarehouseSpec{
+					FreightCreationPolicy: kargoapi.FreightCreationPolicyAutomatic,
+					FreightCreationGrouping: &kargoapi.FreightCreationGrouping{
+						CorrelationKey:      "org.opencontainers.image.revision",
+						CorrelationStrategy: kargoapi.CorrelationStrategyMatching,
+					},
+				},
+			},
+			assertions: func(t *testing.T, status kargoapi.WarehouseStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "fake-freight", status.LastFreightID)
+				// Discovered artifacts are recorded in full.
+				require.Len(t, status.DiscoveredArtifacts.Git[0].Commits, 2)
+			},
+		},
 		{
 			name: "manual Freight creation",
 			reconciler: &reconciler{
@@ -661,6 +957,7 @@ func TestDiscoverArtifacts(t *testing.T) {
 						},
 						Value: func(
 							context.Context,
+							client.Client,
 							credentials.Database,
 						) (subscription.Subscriber, error) {
 							return &subscription.MockSubscriber{
@@ -698,6 +995,7 @@ func TestDiscoverArtifacts(t *testing.T) {
 						},
 						Value: func(
 							context.Context,
+							client.Client,
 							credentials.Database,
 						) (subscription.Subscriber, error) {
 							return &subscription.MockSubscriber{
@@ -735,6 +1033,7 @@ func TestDiscoverArtifacts(t *testing.T) {
 						},
 						Value: func(
 							context.Context,
+							client.Client,
 							credentials.Database,
 						) (subscription.Subscriber, error) {
 							return &subscription.MockSubscriber{
@@ -772,6 +1071,7 @@ func TestDiscoverArtifacts(t *testing.T) {
 						},
 						Value: func(
 							context.Context,
+							client.Client,
 							credentials.Database,
 						) (subscription.Subscriber, error) {
 							return &subscription.MockSubscriber{
@@ -809,6 +1109,7 @@ func TestDiscoverArtifacts(t *testing.T) {
 						},
 						Value: func(
 							context.Context,
+							client.Client,
 							credentials.Database,
 						) (subscription.Subscriber, error) {
 							return &subscription.MockSubscriber{
@@ -1665,3 +1966,77 @@ func Test_freightCreationCriteriaSatisfied(t *testing.T) {
 	}
 
 }
+
+func Test_recordPendingFreight(t *testing.T) {
+	settleWindow := &kargoapi.FreightCreationGrouping{
+		SettleWindow: &metav1.Duration{Duration: time.Hour},
+	}
+	testCases := []struct {
+		name       string
+		grouping   *kargoapi.FreightCreationGrouping
+		status     kargoapi.WarehouseStatus
+		assertions func(*testing.T, kargoapi.WarehouseStatus, time.Duration)
+	}{
+		{
+			name: "no settle window",
+			status: kargoapi.WarehouseStatus{
+				PendingFreight: &kargoapi.PendingFreight{ID: "fake-freight"},
+			},
+			assertions: func(t *testing.T, status kargoapi.WarehouseStatus, remaining time.Duration) {
+				require.Nil(t, status.PendingFreight)
+				require.LessOrEqual(t, remaining, time.Duration(0))
+			},
+		},
+		{
+			name:     "Freight is the most recently produced",
+			grouping: settleWindow,
+			status:   kargoapi.WarehouseStatus{LastFreightID: "fake-freight"},
+			assertions: func(t *testing.T, status kargoapi.WarehouseStatus, remaining time.Duration) {
+				require.Nil(t, status.PendingFreight)
+				require.LessOrEqual(t, remaining, time.Duration(0))
+			},
+		},
+		{
+			name:     "newest artifacts changed",
+			grouping: settleWindow,
+			status: kargoapi.WarehouseStatus{
+				PendingFreight: &kargoapi.PendingFreight{
+					ID:    "other-freight",
+					Since: metav1.NewTime(time.Now().Add(-2 * time.Hour)),
+				},
+			},
+			assertions: func(t *testing.T, status kargoapi.WarehouseStatus, remaining time.Duration) {
+				require.NotNil(t, status.PendingFreight)
+				require.Equal(t, "fake-freight", status.PendingFreight.ID)
+				require.Greater(t, remaining, 59*time.Minute)
+			},
+		},
+		{
+			name:     "newest artifacts unchanged",
+			grouping: settleWindow,
+			status: kargoapi.WarehouseStatus{
+				PendingFreight: &kargoapi.PendingFreight{
+					ID:    "fake-freight",
+					Since: metav1.NewTime(time.Now().Add(-30 * time.Minute)),
+				},
+			},
+			assertions: func(t *testing.T, status kargoapi.WarehouseStatus, remaining time.Duration) {
+				require.NotNil(t, status.PendingFreight)
+				require.Equal(t, "fake-freight", status.PendingFreight.ID)
+				require.Greater(t, remaining, 29*time.Minute)
+				require.LessOrEqual(t, remaining, 30*time.Minute)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			warehouse := &kargoapi.Warehouse{
+				Spec: kargoapi.WarehouseSpec{
+					FreightCreationGrouping: testCase.grouping,
+				},
+			}
+			remaining := recordPendingFreight(warehouse, &testCase.status, "fake-freight")
+			testCase.assertions(t, testCase.status, remaining)
+		})
+	}
+}
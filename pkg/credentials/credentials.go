@@ -26,6 +26,10 @@ const (
 	TypeHelm Type = "helm"
 	// TypeImage represents credentials for an image repository.
 	TypeImage Type = "image"
+	// TypeS3 represents credentials for an S3-compatible object store. The
+	// username and password of such credentials are an access key ID and a
+	// secret access key, respectively.
+	TypeS3 Type = "s3"
 )
 
 type Request struct {
@@ -0,0 +1,248 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed, standard five-field cron schedule (minute, hour,
+// day-of-month, month, day-of-week).
+type Schedule struct {
+	minute uint64
+	hour   uint64
+	dom    uint64
+	month  uint64
+	dow    uint64
+	// domStar and dowStar record whether the day-of-month and day-of-week
+	// fields were unrestricted. When both fields are restricted, a day matches
+	// if EITHER field matches, which is the behavior of standard cron.
+	domStar bool
+	dowStar bool
+}
+
+// bounds describes the permissible values of a single schedule field.
+type bounds struct {
+	min   uint
+	max   uint
+	names map[string]uint
+}
+
+var (
+	minuteBounds = bounds{min: 0, max: 59}
+	hourBounds   = bounds{min: 0, max: 23}
+	domBounds    = bounds{min: 1, max: 31}
+	monthBounds  = bounds{
+		min: 1,
+		max: 12,
+		names: map[string]uint{
+			"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+			"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+		},
+	}
+	// Both 0 and 7 are accepted as Sunday.
+	dowBounds = bounds{
+		min: 0,
+		max: 7,
+		names: map[string]uint{
+			"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+		},
+	}
+)
+
+// descriptors maps supported shorthand descriptors to equivalent schedules.
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// maxSearchYears limits how far into the future Next searches for a matching
+// time. This guards against schedules that can never match, such as one
+// requiring February 30th.
+const maxSearchYears = 5
+
+// Parse parses the provided standard five-field cron schedule. Each field may
+// be a wildcard (*), a value, a range (a-b), or a comma-separated list of
+// these, each optionally followed by a step (/n). Month and day-of-week fields
+// additionally accept three-letter English names. The descriptors @yearly,
+// @annually, @monthly, @weekly, @daily, @midnight, and @hourly are also
+// supported.
+func Parse(spec string) (*Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if strings.HasPrefix(spec, "@") {
+		expanded, ok := descriptors[strings.ToLower(spec)]
+		if !ok {
+			return nil, fmt.Errorf("unrecognized descriptor %q", spec)
+		}
+		spec = expanded
+	}
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf(
+			"expected exactly 5 fields (minute, hour, day-of-month, month, "+
+				"day-of-week); found %d",
+			len(fields),
+		)
+	}
+	s := &Schedule{
+		domStar: isWildcard(fields[2]),
+		dowStar: isWildcard(fields[4]),
+	}
+	var err error
+	if s.minute, err = parseField(fields[0], minuteBounds); err != nil {
+		return nil, fmt.Errorf("error parsing minute field: %w", err)
+	}
+	if s.hour, err = parseField(fields[1], hourBounds); err != nil {
+		return nil, fmt.Errorf("error parsing hour field: %w", err)
+	}
+	if s.dom, err = parseField(fields[2], domBounds); err != nil {
+		return nil, fmt.Errorf("error parsing day-of-month field: %w", err)
+	}
+	if s.month, err = parseField(fields[3], monthBounds); err != nil {
+		return nil, fmt.Errorf("error parsing month field: %w", err)
+	}
+	if s.dow, err = parseField(fields[4], dowBounds); err != nil {
+		return nil, fmt.Errorf("error parsing day-of-week field: %w", err)
+	}
+	// Fold 7 (Sunday) into 0.
+	if s.dow&(1<<7) != 0 {
+		s.dow = s.dow&^(1<<7) | 1
+	}
+	return s, nil
+}
+
+// isWildcard returns whether the provided field is unrestricted, e.g. "*" or
+// "*/2".
+func isWildcard(field string) bool {
+	return strings.HasPrefix(field, "*") || strings.HasPrefix(field, "?")
+}
+
+// parseField parses a single comma-separated schedule field into a bitset of
+// the values it matches.
+func parseField(field string, b bounds) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		partBits, err := parseRange(part, b)
+		if err != nil {
+			return 0, err
+		}
+		bits |= partBits
+	}
+	return bits, nil
+}
+
+// parseRange parses a single element of a schedule field, e.g. "*", "5",
+// "1-5", or "*/15", into a bitset of the values it matches.
+func parseRange(expr string, b bounds) (uint64, error) {
+	rangeExpr, stepExpr, hasStep := strings.Cut(expr, "/")
+	step := uint(1)
+	if hasStep {
+		s, err := strconv.ParseUint(stepExpr, 10, 8)
+		if err != nil || s == 0 {
+			return 0, fmt.Errorf("invalid step %q", stepExpr)
+		}
+		step = uint(s)
+	}
+
+	var start, end uint
+	switch {
+	case rangeExpr == "*" || rangeExpr == "?":
+		start, end = b.min, b.max
+	case strings.Contains(rangeExpr, "-"):
+		lo, hi, _ := strings.Cut(rangeExpr, "-")
+		var err error
+		if start, err = parseValue(lo, b); err != nil {
+			return 0, err
+		}
+		if end, err = parseValue(hi, b); err != nil {
+			return 0, err
+		}
+		if start > end {
+			return 0, fmt.Errorf("invalid range %q: start is after end", rangeExpr)
+		}
+	default:
+		var err error
+		if start, err = parseValue(rangeExpr, b); err != nil {
+			return 0, err
+		}
+		end = start
+		// A single value with a step, e.g. "5/15", means "starting at 5".
+		if hasStep {
+			end = b.max
+		}
+	}
+
+	var bits uint64
+	for v := start; v <= end; v += step {
+		bits |= 1 << v
+	}
+	return bits, nil
+}
+
+// parseValue parses a single numeric or named value and confirms it is within
+// the provided bounds.
+func parseValue(expr string, b bounds) (uint, error) {
+	if v, ok := b.names[strings.ToLower(expr)]; ok {
+		return v, nil
+	}
+	v, err := strconv.ParseUint(expr, 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", expr)
+	}
+	if uint(v) < b.min || uint(v) > b.max {
+		return 0, fmt.Errorf("value %d out of range [%d, %d]", v, b.min, b.max)
+	}
+	return uint(v), nil
+}
+
+// Next returns the earliest time strictly after the provided time that matches
+// the Schedule, evaluated in the provided time's location. If no such time
+// exists within the next several years, the zero time is returned.
+func (s *Schedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	yearLimit := t.Year() + maxSearchYears
+	for t.Year() <= yearLimit {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			next := time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			// Guard against the hour failing to advance across a daylight saving
+			// time transition.
+			if !next.After(t) {
+				next = t.Add(time.Hour).Truncate(time.Hour)
+			}
+			t = next
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// dayMatches returns whether the day of the provided time matches the
+// Schedule's day-of-month and day-of-week fields.
+func (s *Schedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
@@ -0,0 +1,178 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		name       string
+		spec       string
+		assertions func(*testing.T, *Schedule, error)
+	}{
+		{
+			name: "too few fields",
+			spec: "0 9 * *",
+			assertions: func(t *testing.T, _ *Schedule, err error) {
+				require.ErrorContains(t, err, "expected exactly 5 fields")
+			},
+		},
+		{
+			name: "unrecognized descriptor",
+			spec: "@fortnightly",
+			assertions: func(t *testing.T, _ *Schedule, err error) {
+				require.ErrorContains(t, err, "unrecognized descriptor")
+			},
+		},
+		{
+			name: "value out of range",
+			spec: "60 * * * *",
+			assertions: func(t *testing.T, _ *Schedule, err error) {
+				require.ErrorContains(t, err, "error parsing minute field")
+				require.ErrorContains(t, err, "out of range")
+			},
+		},
+		{
+			name: "invalid range",
+			spec: "0 17-9 * * *",
+			assertions: func(t *testing.T, _ *Schedule, err error) {
+				require.ErrorContains(t, err, "start is after end")
+			},
+		},
+		{
+			name: "invalid step",
+			spec: "*/0 * * * *",
+			assertions: func(t *testing.T, _ *Schedule, err error) {
+				require.ErrorContains(t, err, "invalid step")
+			},
+		},
+		{
+			name: "invalid name",
+			spec: "0 0 * * funday",
+			assertions: func(t *testing.T, _ *Schedule, err error) {
+				require.ErrorContains(t, err, "error parsing day-of-week field")
+			},
+		},
+		{
+			name: "lists, ranges, steps, and names",
+			spec: "0,30 9-17/2 * JAN-mar mon-fri",
+			assertions: func(t *testing.T, s *Schedule, err error) {
+				require.NoError(t, err)
+				require.Equal(t, uint64(1|1<<30), s.minute)
+				require.Equal(t, uint64(1<<9|1<<11|1<<13|1<<15|1<<17), s.hour)
+				require.Equal(t, uint64(1<<1|1<<2|1<<3), s.month)
+				require.Equal(t, uint64(1<<1|1<<2|1<<3|1<<4|1<<5), s.dow)
+				require.True(t, s.domStar)
+				require.False(t, s.dowStar)
+			},
+		},
+		{
+			name: "Sunday as 7",
+			spec: "0 0 * * 7",
+			assertions: func(t *testing.T, s *Schedule, err error) {
+				require.NoError(t, err)
+				require.Equal(t, uint64(1), s.dow)
+			},
+		},
+		{
+			name: "descriptor",
+			spec: "@daily",
+			assertions: func(t *testing.T, s *Schedule, err error) {
+				require.NoError(t, err)
+				require.Equal(t, uint64(1), s.minute)
+				require.Equal(t, uint64(1), s.hour)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s, err := Parse(testCase.spec)
+			testCase.assertions(t, s, err)
+		})
+	}
+}
+
+func TestSchedule_Next(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	testCases := []struct {
+		name     string
+		spec     string
+		from     time.Time
+		expected time.Time
+	}{
+		{
+			name:     "next minute",
+			spec:     "* * * * *",
+			from:     time.Date(2025, 1, 1, 9, 0, 30, 0, time.UTC),
+			expected: time.Date(2025, 1, 1, 9, 1, 0, 0, time.UTC),
+		},
+		{
+			name:     "strictly after a matching time",
+			spec:     "0 9 * * *",
+			from:     time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, 1, 2, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "weekdays only",
+			spec: "0 9 * * 1-5",
+			// Friday
+			from: time.Date(2025, 1, 3, 10, 0, 0, 0, time.UTC),
+			// Monday
+			expected: time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "rolls over year",
+			spec:     "0 0 1 1 *",
+			from:     time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "day-of-month OR day-of-week",
+			spec: "0 0 15 * fri",
+			// Wednesday the 1st
+			from: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			// Friday the 3rd
+			expected: time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "leap day",
+			spec:     "0 0 29 2 *",
+			from:     time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "never",
+			spec: "0 0 30 2 *",
+			from: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "evaluated in location",
+			spec:     "0 9 * * *",
+			from:     time.Date(2025, 1, 1, 15, 0, 0, 0, time.UTC).In(newYork),
+			expected: time.Date(2025, 1, 2, 9, 0, 0, 0, newYork),
+		},
+		{
+			name: "across daylight saving time transition",
+			spec: "30 2,3 * * *",
+			// Clocks in New York skip from 02:00 to 03:00 on this day.
+			from:     time.Date(2025, 3, 9, 0, 0, 0, 0, newYork),
+			expected: time.Date(2025, 3, 9, 3, 30, 0, 0, newYork),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s, err := Parse(testCase.spec)
+			require.NoError(t, err)
+			next := s.Next(testCase.from)
+			require.True(
+				t,
+				testCase.expected.Equal(next),
+				"expected %s, got %s", testCase.expected, next,
+			)
+		})
+	}
+}
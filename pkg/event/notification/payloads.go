@@ -0,0 +1,105 @@
+package notification
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+const (
+	contentTypeJSON       = "application/json"
+	contentTypeCloudEvent = "application/cloudevents+json"
+
+	// cloudEventTypePrefix is prepended to Kargo event types to form the type
+	// of a CloudEvent, following the reverse-DNS convention recommended by the
+	// CloudEvents specification.
+	cloudEventTypePrefix = "io.akuity.kargo."
+)
+
+// payloadEncoder encodes a notification into the payload expected by a
+// specific kind of notification sink. It returns the content type of the
+// payload along with the payload itself.
+type payloadEncoder func(notification) (string, []byte, error)
+
+// getPayloadEncoder returns the payloadEncoder for the kind of notification
+// sink described by the provided configuration.
+func getPayloadEncoder(cfg kargoapi.NotificationSinkConfig) (payloadEncoder, error) {
+	switch {
+	case cfg.Webhook != nil:
+		return encodeWebhookPayload, nil
+	case cfg.CloudEvents != nil:
+		return encodeCloudEventPayload, nil
+	case cfg.Slack != nil:
+		return encodeSlackPayload, nil
+	case cfg.MSTeams != nil:
+		return encodeMSTeamsPayload, nil
+	default:
+		return nil, fmt.Errorf("notification sink %q does not specify a sink type", cfg.Name)
+	}
+}
+
+// encodeWebhookPayload encodes the notification as-is.
+func encodeWebhookPayload(n notification) (string, []byte, error) {
+	body, err := json.Marshal(n)
+	return contentTypeJSON, body, err
+}
+
+// cloudEvent is a CloudEvent in structured content mode. See
+// https://github.com/cloudevents/spec/blob/v1.0.2/cloudevents/formats/json-format.md
+type cloudEvent struct {
+	SpecVersion     string       `json:"specversion"`
+	ID              string       `json:"id"`
+	Source          string       `json:"source"`
+	Type            string       `json:"type"`
+	Subject         string       `json:"subject,omitempty"`
+	Time            string       `json:"time"`
+	DataContentType string       `json:"datacontenttype"`
+	Data            notification `json:"data"`
+}
+
+// encodeCloudEventPayload encodes the notification as the data of a
+// CloudEvent.
+func encodeCloudEventPayload(n notification) (string, []byte, error) {
+	id := n.ID
+	if id == "" {
+		// The id attribute is required by the CloudEvents specification.
+		id = uuid.NewString()
+	}
+	body, err := json.Marshal(cloudEvent{
+		SpecVersion:     "1.0",
+		ID:              id,
+		Source:          fmt.Sprintf("/kargo/projects/%s", n.Project),
+		Type:            cloudEventTypePrefix + string(n.Type),
+		Subject:         fmt.Sprintf("%s/%s", n.Kind, n.Name),
+		Time:            time.Now().UTC().Format(time.RFC3339),
+		DataContentType: contentTypeJSON,
+		Data:            n,
+	})
+	return contentTypeCloudEvent, body, err
+}
+
+// encodeSlackPayload encodes the notification's message in the format
+// expected by Slack incoming webhooks.
+func encodeSlackPayload(n notification) (string, []byte, error) {
+	body, err := json.Marshal(map[string]string{
+		"text": n.Message,
+	})
+	return contentTypeJSON, body, err
+}
+
+// encodeMSTeamsPayload encodes the notification's message as a legacy
+// actionable message card, which is accepted by Microsoft Teams incoming
+// webhooks.
+func encodeMSTeamsPayload(n notification) (string, []byte, error) {
+	body, err := json.Marshal(map[string]string{
+		"@type":    "MessageCard",
+		"@context": "https://schema.org/extensions",
+		"summary":  string(n.Type),
+		"text":     n.Message,
+	})
+	return contentTypeJSON, body, err
+}
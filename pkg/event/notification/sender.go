@@ -0,0 +1,247 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/expr-lang/expr"
+	"github.com/hashicorp/go-cleanhttp"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/event"
+	"github.com/akuity/kargo/pkg/expressions"
+	kargonet "github.com/akuity/kargo/pkg/net"
+)
+
+const (
+	// SecretKeyURL is the key in a notification sink's Secret whose value is
+	// the URL to which notifications are delivered.
+	SecretKeyURL = "url"
+	// SecretKeyAuthorization is the optional key in a notification sink's
+	// Secret whose value is used as the Authorization header of each request.
+	SecretKeyAuthorization = "authorization"
+
+	requestTimeout = 10 * time.Second
+	// defaultSendTimeout bounds the total time spent delivering a
+	// notification, including retries, so that a sink that is down cannot hold
+	// up its caller for long.
+	defaultSendTimeout = 10 * time.Second
+)
+
+// errPermanent marks delivery errors that will not be resolved by retrying.
+var errPermanent = errors.New("permanent delivery failure")
+
+// IsPermanentError returns true if the provided error, returned by Send, will
+// not be resolved by retrying delivery of the notification.
+func IsPermanentError(err error) bool {
+	return errors.Is(err, errPermanent)
+}
+
+// DefaultBackoff is the backoff used when retrying the delivery of a
+// notification.
+var DefaultBackoff = wait.Backoff{
+	Steps:    5,
+	Duration: time.Second,
+	Factor:   2,
+	Jitter:   0.1,
+}
+
+// Sender is an implementation of the event.Sender interface that delivers
+// events to a single outbound notification sink, such as a generic webhook,
+// a CloudEvents consumer, or a Slack or Microsoft Teams incoming webhook.
+type Sender struct {
+	cfg           kargoapi.NotificationSinkConfig
+	url           string
+	authorization string
+	encoder       payloadEncoder
+	httpClient    *http.Client
+	backoff       wait.Backoff
+	sendTimeout   time.Duration
+}
+
+// NewSender returns a Sender for the notification sink described by the
+// provided configuration. The provided secretData is the data of the Secret
+// referenced by the configuration.
+func NewSender(
+	cfg kargoapi.NotificationSinkConfig,
+	secretData map[string][]byte,
+) (*Sender, error) {
+	encoder, err := getPayloadEncoder(cfg)
+	if err != nil {
+		return nil, err
+	}
+	url := string(secretData[SecretKeyURL])
+	if url == "" {
+		return nil, fmt.Errorf(
+			"Secret for notification sink %q does not contain a non-empty %q key",
+			cfg.Name, SecretKeyURL,
+		)
+	}
+	return &Sender{
+		cfg:           cfg,
+		url:           url,
+		authorization: string(secretData[SecretKeyAuthorization]),
+		encoder:       encoder,
+		httpClient: &http.Client{
+			Transport: kargonet.SafeTransport(cleanhttp.DefaultTransport()),
+			Timeout:   requestTimeout,
+		},
+		backoff:     DefaultBackoff,
+		sendTimeout: defaultSendTimeout,
+	}, nil
+}
+
+// Matches returns true if the provided event satisfies the notification
+// sink's filter expression. Events always match if no filter is configured.
+func (s *Sender) Matches(evt event.Meta) (bool, error) {
+	if s.cfg.Filter == "" {
+		return true, nil
+	}
+	n, err := newNotification(evt)
+	if err != nil {
+		return false, err
+	}
+	program, err := expr.Compile(s.cfg.Filter)
+	if err != nil {
+		return false, fmt.Errorf("error compiling filter %q: %w", s.cfg.Filter, err)
+	}
+	result, err := expr.Run(program, n.exprEnv())
+	if err != nil {
+		return false, fmt.Errorf("error evaluating filter %q: %w", s.cfg.Filter, err)
+	}
+	matches, ok := result.(bool)
+	if !ok {
+		return false, fmt.Errorf(
+			"filter %q did not evaluate to a boolean (got %T)",
+			s.cfg.Filter, result,
+		)
+	}
+	return matches, nil
+}
+
+// Send implements the event.Sender interface. It delivers the provided event
+// to the notification sink, retrying with exponential backoff if delivery
+// fails for reasons that are likely to be transient. Retries stop once a
+// fixed amount of time has elapsed, in which case the last error is returned. Send does
+// not evaluate the sink's filter; callers should use Matches for that.
+func (s *Sender) Send(ctx context.Context, evt event.Meta) error {
+	n, err := newNotification(evt)
+	if err != nil {
+		return err
+	}
+	if s.cfg.Message != "" {
+		msg, err := expressions.EvaluateTemplate(s.cfg.Message, n.exprEnv())
+		if err != nil {
+			return fmt.Errorf("error evaluating message template: %w", err)
+		}
+		n.Message = fmt.Sprint(msg)
+	}
+	contentType, body, err := s.encoder(n)
+	if err != nil {
+		return fmt.Errorf("error encoding notification payload: %w", err)
+	}
+	ctx, cancel := context.WithTimeout(ctx, s.sendTimeout)
+	defer cancel()
+	var lastErr error
+	if err = wait.ExponentialBackoffWithContext(
+		ctx,
+		s.backoff,
+		func(ctx context.Context) (bool, error) {
+			if lastErr = s.post(ctx, contentType, body); lastErr == nil {
+				return true, nil
+			}
+			if errors.Is(lastErr, errPermanent) {
+				return false, lastErr
+			}
+			return false, nil
+		},
+	); err != nil && lastErr != nil {
+		return lastErr
+	}
+	return err
+}
+
+func (s *Sender) post(ctx context.Context, contentType string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error creating HTTP request: %w: %w", errPermanent, err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	if s.authorization != "" {
+		req.Header.Set("Authorization", s.authorization)
+	}
+	// #nosec G704 -- The client is using a custom dialer that mitigates the worst
+	// practical risks of SSRF by refusing to dial link-local addresses.
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending HTTP request: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	err = fmt.Errorf("notification sink responded with HTTP status %d", resp.StatusCode)
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 &&
+		resp.StatusCode != http.StatusRequestTimeout &&
+		resp.StatusCode != http.StatusTooManyRequests {
+		return fmt.Errorf("%w: %w", errPermanent, err)
+	}
+	return err
+}
+
+// notification is the sink-agnostic representation of an event that is
+// encoded into a sink-specific payload.
+type notification struct {
+	Type    kargoapi.EventType `json:"type"`
+	Kind    string             `json:"kind"`
+	Name    string             `json:"name"`
+	Project string             `json:"project"`
+	ID      string             `json:"id,omitempty"`
+	Message string             `json:"message"`
+	Data    map[string]any     `json:"data,omitempty"`
+}
+
+func newNotification(evt event.Meta) (notification, error) {
+	dataJSON, err := json.Marshal(evt)
+	if err != nil {
+		return notification{}, fmt.Errorf("error marshaling event data: %w", err)
+	}
+	var data map[string]any
+	if err = json.Unmarshal(dataJSON, &data); err != nil {
+		return notification{}, fmt.Errorf("error unmarshaling event data: %w", err)
+	}
+	n := notification{
+		Type:    evt.Type(),
+		Kind:    evt.Kind(),
+		Name:    evt.GetName(),
+		Project: evt.GetProject(),
+		ID:      evt.GetID(),
+		Data:    data,
+	}
+	if msg, ok := evt.(event.Message); ok {
+		n.Message = msg.GetMessage()
+	}
+	return n, nil
+}
+
+// exprEnv returns the environment against which filters and message templates
+// are evaluated.
+func (n notification) exprEnv() map[string]any {
+	return map[string]any{
+		"event": map[string]any{
+			"type":    string(n.Type),
+			"kind":    n.Kind,
+			"name":    n.Name,
+			"project": n.Project,
+			"id":      n.ID,
+			"message": n.Message,
+			"data":    n.Data,
+		},
+	}
+}
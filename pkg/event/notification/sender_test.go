@@ -0,0 +1,302 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/event"
+)
+
+func TestNewSender(t *testing.T) {
+	testCases := []struct {
+		name       string
+		cfg        kargoapi.NotificationSinkConfig
+		secretData map[string][]byte
+		assertions func(*testing.T, *Sender, error)
+	}{
+		{
+			name: "no sink type",
+			cfg:  kargoapi.NotificationSinkConfig{Name: "fake-sink"},
+			assertions: func(t *testing.T, _ *Sender, err error) {
+				require.ErrorContains(t, err, "does not specify a sink type")
+			},
+		},
+		{
+			name: "missing URL",
+			cfg: kargoapi.NotificationSinkConfig{
+				Name:  "fake-sink",
+				Slack: &kargoapi.SlackNotificationSinkConfig{},
+			},
+			assertions: func(t *testing.T, _ *Sender, err error) {
+				require.ErrorContains(t, err, `does not contain a non-empty "url" key`)
+			},
+		},
+		{
+			name: "success",
+			cfg: kargoapi.NotificationSinkConfig{
+				Name:    "fake-sink",
+				Webhook: &kargoapi.WebhookNotificationSinkConfig{},
+			},
+			secretData: map[string][]byte{
+				SecretKeyURL:           []byte("https://example.com"),
+				SecretKeyAuthorization: []byte("Bearer fake-token"),
+			},
+			assertions: func(t *testing.T, s *Sender, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "https://example.com", s.url)
+				require.Equal(t, "Bearer fake-token", s.authorization)
+				require.NotNil(t, s.encoder)
+				require.NotNil(t, s.httpClient)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s, err := NewSender(testCase.cfg, testCase.secretData)
+			testCase.assertions(t, s, err)
+		})
+	}
+}
+
+func TestSender_Matches(t *testing.T) {
+	testEvent := newTestEvent()
+	testCases := []struct {
+		name       string
+		filter     string
+		assertions func(*testing.T, bool, error)
+	}{
+		{
+			name: "no filter",
+			assertions: func(t *testing.T, matches bool, err error) {
+				require.NoError(t, err)
+				require.True(t, matches)
+			},
+		},
+		{
+			name:   "filter on event type matches",
+			filter: `event.type in ["PromotionSucceeded", "PromotionFailed"]`,
+			assertions: func(t *testing.T, matches bool, err error) {
+				require.NoError(t, err)
+				require.True(t, matches)
+			},
+		},
+		{
+			name:   "filter on event data does not match",
+			filter: `event.data.stageName == "prod"`,
+			assertions: func(t *testing.T, matches bool, err error) {
+				require.NoError(t, err)
+				require.False(t, matches)
+			},
+		},
+		{
+			name:   "filter does not evaluate to a boolean",
+			filter: `event.name`,
+			assertions: func(t *testing.T, _ bool, err error) {
+				require.ErrorContains(t, err, "did not evaluate to a boolean")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s := &Sender{cfg: kargoapi.NotificationSinkConfig{Filter: testCase.filter}}
+			matches, err := s.Matches(testEvent)
+			testCase.assertions(t, matches, err)
+		})
+	}
+}
+
+func TestSender_Send(t *testing.T) {
+	testEvent := newTestEvent()
+	testCases := []struct {
+		name       string
+		cfg        kargoapi.NotificationSinkConfig
+		statuses   []int
+		backoff    *wait.Backoff
+		assertions func(t *testing.T, reqs []*recordedRequest, err error)
+	}{
+		{
+			name: "webhook with templated message",
+			cfg: kargoapi.NotificationSinkConfig{
+				Message: "Promotion ${{ event.name }} to ${{ event.data.stageName }} succeeded",
+				Webhook: &kargoapi.WebhookNotificationSinkConfig{},
+			},
+			statuses: []int{http.StatusOK},
+			assertions: func(t *testing.T, reqs []*recordedRequest, err error) {
+				require.NoError(t, err)
+				require.Len(t, reqs, 1)
+				require.Equal(t, contentTypeJSON, reqs[0].contentType)
+				require.Equal(t, "Bearer fake-token", reqs[0].authorization)
+				payload := map[string]any{}
+				require.NoError(t, json.Unmarshal(reqs[0].body, &payload))
+				require.Equal(t, "PromotionSucceeded", payload["type"])
+				require.Equal(t, "Promotion", payload["kind"])
+				require.Equal(t, "fake-promotion", payload["name"])
+				require.Equal(t, "fake-project", payload["project"])
+				require.Equal(t, "Promotion fake-promotion to test succeeded", payload["message"])
+				require.Equal(t, "test", payload["data"].(map[string]any)["stageName"]) // nolint: forcetypeassert
+			},
+		},
+		{
+			name: "CloudEvents",
+			cfg: kargoapi.NotificationSinkConfig{
+				CloudEvents: &kargoapi.CloudEventsNotificationSinkConfig{},
+			},
+			statuses: []int{http.StatusAccepted},
+			assertions: func(t *testing.T, reqs []*recordedRequest, err error) {
+				require.NoError(t, err)
+				require.Len(t, reqs, 1)
+				require.Equal(t, contentTypeCloudEvent, reqs[0].contentType)
+				payload := map[string]any{}
+				require.NoError(t, json.Unmarshal(reqs[0].body, &payload))
+				require.Equal(t, "1.0", payload["specversion"])
+				require.Equal(t, "fake-id", payload["id"])
+				require.Equal(t, "/kargo/projects/fake-project", payload["source"])
+				require.Equal(t, "io.akuity.kargo.PromotionSucceeded", payload["type"])
+				require.Equal(t, "Promotion/fake-promotion", payload["subject"])
+				require.Equal(t, "fake-promotion", payload["data"].(map[string]any)["name"]) // nolint: forcetypeassert
+			},
+		},
+		{
+			name: "Slack",
+			cfg: kargoapi.NotificationSinkConfig{
+				Slack: &kargoapi.SlackNotificationSinkConfig{},
+			},
+			statuses: []int{http.StatusOK},
+			assertions: func(t *testing.T, reqs []*recordedRequest, err error) {
+				require.NoError(t, err)
+				require.Len(t, reqs, 1)
+				require.JSONEq(t, `{"text":"fake message"}`, string(reqs[0].body))
+			},
+		},
+		{
+			name: "Microsoft Teams",
+			cfg: kargoapi.NotificationSinkConfig{
+				MSTeams: &kargoapi.MSTeamsNotificationSinkConfig{},
+			},
+			statuses: []int{http.StatusOK},
+			assertions: func(t *testing.T, reqs []*recordedRequest, err error) {
+				require.NoError(t, err)
+				require.Len(t, reqs, 1)
+				payload := map[string]any{}
+				require.NoError(t, json.Unmarshal(reqs[0].body, &payload))
+				require.Equal(t, "MessageCard", payload["@type"])
+				require.Equal(t, "fake message", payload["text"])
+			},
+		},
+		{
+			name: "transient failures are retried",
+			cfg: kargoapi.NotificationSinkConfig{
+				Slack: &kargoapi.SlackNotificationSinkConfig{},
+			},
+			statuses: []int{
+				http.StatusServiceUnavailable,
+				http.StatusTooManyRequests,
+				http.StatusOK,
+			},
+			assertions: func(t *testing.T, reqs []*recordedRequest, err error) {
+				require.NoError(t, err)
+				require.Len(t, reqs, 3)
+			},
+		},
+		{
+			name: "retries are exhausted",
+			cfg: kargoapi.NotificationSinkConfig{
+				Slack: &kargoapi.SlackNotificationSinkConfig{},
+			},
+			statuses: []int{http.StatusBadGateway},
+			assertions: func(t *testing.T, reqs []*recordedRequest, err error) {
+				require.ErrorContains(t, err, "responded with HTTP status 502")
+				require.False(t, IsPermanentError(err))
+				require.Len(t, reqs, 3)
+			},
+		},
+		{
+			name: "retries stop once the send timeout has elapsed",
+			cfg: kargoapi.NotificationSinkConfig{
+				Slack: &kargoapi.SlackNotificationSinkConfig{},
+			},
+			statuses: []int{http.StatusBadGateway},
+			backoff:  &wait.Backoff{Steps: 3, Duration: time.Hour},
+			assertions: func(t *testing.T, reqs []*recordedRequest, err error) {
+				require.ErrorContains(t, err, "responded with HTTP status 502")
+				require.False(t, IsPermanentError(err))
+				require.Len(t, reqs, 1)
+			},
+		},
+		{
+			name: "permanent failures are not retried",
+			cfg: kargoapi.NotificationSinkConfig{
+				Slack: &kargoapi.SlackNotificationSinkConfig{},
+			},
+			statuses: []int{http.StatusNotFound},
+			assertions: func(t *testing.T, reqs []*recordedRequest, err error) {
+				require.ErrorContains(t, err, "responded with HTTP status 404")
+				require.True(t, IsPermanentError(err))
+				require.Len(t, reqs, 1)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var reqs []*recordedRequest
+			var count atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				reqs = append(reqs, &recordedRequest{
+					contentType:   r.Header.Get("Content-Type"),
+					authorization: r.Header.Get("Authorization"),
+					body:          body,
+				})
+				i := int(count.Add(1)) - 1
+				w.WriteHeader(testCase.statuses[min(i, len(testCase.statuses)-1)])
+			}))
+			t.Cleanup(srv.Close)
+
+			testCase.cfg.Name = "fake-sink"
+			s, err := NewSender(testCase.cfg, map[string][]byte{
+				SecretKeyURL:           []byte(srv.URL),
+				SecretKeyAuthorization: []byte("Bearer fake-token"),
+			})
+			require.NoError(t, err)
+			s.backoff = wait.Backoff{Steps: 3, Duration: time.Millisecond}
+			if testCase.backoff != nil {
+				s.backoff = *testCase.backoff
+			}
+			s.sendTimeout = 100 * time.Millisecond
+
+			err = s.Send(context.Background(), testEvent)
+			testCase.assertions(t, reqs, err)
+		})
+	}
+}
+
+type recordedRequest struct {
+	contentType   string
+	authorization string
+	body          []byte
+}
+
+func newTestEvent() *event.PromotionSucceeded {
+	return &event.PromotionSucceeded{
+		Common: event.Common{
+			Project: "fake-project",
+			Message: "fake message",
+			ID:      "fake-id",
+		},
+		Promotion: event.Promotion{
+			Name:      "fake-promotion",
+			StageName: "test",
+		},
+	}
+}
@@ -420,7 +420,15 @@ func newPromotion(
 	// expressions or convert types, we just skip. Originally we logged this, but this library
 	// doesn't have logging, so this retains the same behavior. If we want to log these errors, we
 	// can add a logger to the context and use that here.
+	steps := make([]kargoapi.PromotionStep, 0, len(promotion.Spec.Steps))
 	for _, step := range promotion.Spec.Steps {
+		if len(step.Parallel) > 0 {
+			steps = append(steps, step.GetParallelSteps()...)
+			continue
+		}
+		steps = append(steps, step)
+	}
+	for _, step := range steps {
 		if step.Uses != "argocd-update" || step.Config == nil {
 			continue
 		}
@@ -442,6 +450,10 @@ func newPromotion(
 				strings.Contains(namespacedName.Name, "${{") {
 				stepEnv := make(map[string]any)
 				maps.Copy(stepEnv, baseEnv)
+				if step.Iteration != nil {
+					stepEnv["item"] = step.Iteration.GetItem()
+					stepEnv["index"] = step.Iteration.Index
+				}
 				stepVars := calculateStepVars(step, stepEnv)
 				setVar(stepEnv, stepVars)
 				var ok bool
@@ -259,6 +259,12 @@ func (p *provider) MergePullRequest(
 	return pr, true, nil
 }
 
+// ListReleases implements gitprovider.Interface. Azure DevOps has no concept of
+// releases, so this always returns an error.
+func (p *provider) ListReleases(context.Context) ([]gitprovider.Release, error) {
+	return nil, fmt.Errorf("listing releases is not supported by Azure DevOps")
+}
+
 // GetCommitURL implements gitprovider.Interface.
 func (p *provider) GetCommitURL(repoURL string, sha string) (string, error) {
 	normalizedURL := urls.NormalizeGit(repoURL)
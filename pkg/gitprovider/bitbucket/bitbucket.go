@@ -377,6 +377,12 @@ func (p *provider) MergePullRequest(
 	return toProviderPR(mergedBBPR, mergeResp), true, nil
 }
 
+// ListReleases implements gitprovider.Interface. Bitbucket has no concept of
+// releases, so this always returns an error.
+func (p *provider) ListReleases(context.Context) ([]gitprovider.Release, error) {
+	return nil, fmt.Errorf("listing releases is not supported by Bitbucket")
+}
+
 // GetCommitURL implements gitprovider.Interface.
 func (p *provider) GetCommitURL(repoURL string, sha string) (string, error) {
 	normalizedURL := urls.NormalizeGit(repoURL)
@@ -75,6 +75,13 @@ type giteaClient interface {
 		number int,
 		labels []string,
 	) ([]*gitea.Label, *gitea.Response, error)
+
+	ListReleases(
+		ctx context.Context,
+		owner string,
+		repo string,
+		opts *gitea.ListReleasesOptions,
+	) ([]*gitea.Release, *gitea.Response, error)
 }
 
 // provider is a Gitea implementation of gitprovider.Interface.
@@ -178,6 +185,15 @@ func (g giteaClientWrapper) AddLabelsToIssue(
 	return g.client.AddIssueLabels(owner, repo, int64(number), gitea.IssueLabelsOption{})
 }
 
+func (g giteaClientWrapper) ListReleases(
+	_ context.Context,
+	owner string,
+	repo string,
+	opts *gitea.ListReleasesOptions,
+) ([]*gitea.Release, *gitea.Response, error) {
+	return g.client.ListReleases(owner, repo, *opts)
+}
+
 // CreatePullRequest implements gitprovider.Interface.
 func (p *provider) CreatePullRequest(
 	ctx context.Context,
@@ -334,6 +350,59 @@ func (p *provider) GetCommitURL(repoURL string, sha string) (string, error) {
 	return commitURL, nil
 }
 
+// ListReleases implements gitprovider.Interface.
+func (p *provider) ListReleases(
+	ctx context.Context,
+) ([]gitprovider.Release, error) {
+	listOpts := gitea.ListReleasesOptions{
+		ListOptions: gitea.ListOptions{},
+	}
+	var releases []gitprovider.Release
+	for {
+		giteaReleases, res, err := p.client.ListReleases(ctx, p.owner, p.repo, &listOpts)
+		if err != nil {
+			return nil, err
+		}
+		for _, giteaRelease := range giteaReleases {
+			if giteaRelease != nil {
+				releases = append(releases, convertGiteaRelease(*giteaRelease))
+			}
+		}
+		if res == nil || res.NextPage == 0 {
+			break
+		}
+		listOpts.Page = res.NextPage
+	}
+	return releases, nil
+}
+
+func convertGiteaRelease(giteaRelease gitea.Release) gitprovider.Release {
+	release := gitprovider.Release{
+		TagName:    giteaRelease.TagName,
+		Name:       giteaRelease.Title,
+		URL:        giteaRelease.HTMLURL,
+		Draft:      giteaRelease.IsDraft,
+		Prerelease: giteaRelease.IsPrerelease,
+	}
+	switch {
+	case !giteaRelease.PublishedAt.IsZero():
+		release.PublishedAt = &giteaRelease.PublishedAt
+	case !giteaRelease.CreatedAt.IsZero():
+		release.PublishedAt = &giteaRelease.CreatedAt
+	}
+	for _, attachment := range giteaRelease.Attachments {
+		if attachment == nil {
+			continue
+		}
+		release.Assets = append(release.Assets, gitprovider.ReleaseAsset{
+			Name:        attachment.Name,
+			DownloadURL: attachment.DownloadURL,
+			Size:        attachment.Size,
+		})
+	}
+	return release
+}
+
 func convertGiteaPR(giteaPR gitea.PullRequest) gitprovider.PullRequest {
 	pr := gitprovider.PullRequest{
 		Number:  giteaPR.Index,
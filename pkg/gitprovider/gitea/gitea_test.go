@@ -187,6 +187,26 @@ func (m *mockGiteaClient) CreatePullRequest(
 	return pr, resp, args.Error(2)
 }
 
+func (m *mockGiteaClient) ListReleases(
+	ctx context.Context,
+	owner string,
+	repo string,
+	opts *gitea.ListReleasesOptions,
+) ([]*gitea.Release, *gitea.Response, error) {
+	args := m.Called(ctx, owner, repo, opts.Page)
+	m.owner = owner
+	m.repo = repo
+	releases, ok := args.Get(0).([]*gitea.Release)
+	if !ok {
+		return nil, nil, args.Error(2)
+	}
+	resp, ok := args.Get(1).(*gitea.Response)
+	if !ok {
+		return releases, nil, args.Error(2)
+	}
+	return releases, resp, args.Error(2)
+}
+
 func TestCreatePullRequestWithLabels(t *testing.T) {
 	opts := gitprovider.CreatePullRequestOpts{
 		Head:        "feature-branch",
@@ -400,6 +420,84 @@ func TestListPullRequests(t *testing.T) {
 	require.True(t, prs[0].Open)
 }
 
+func TestListReleases(t *testing.T) {
+	publishedAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mockClient := &mockGiteaClient{}
+	mockClient.
+		On("ListReleases", context.Background(), testRepoOwner, testRepoName, 0).
+		Return(
+			[]*gitea.Release{{
+				TagName:     "v1.1.0",
+				Title:       "Release 1.1.0",
+				HTMLURL:     "https://gitea.com/akuity/kargo/releases/tag/v1.1.0",
+				PublishedAt: publishedAt,
+				CreatedAt:   createdAt,
+				Attachments: []*gitea.Attachment{{
+					Name:        "kargo-linux-amd64",
+					DownloadURL: "https://gitea.com/akuity/kargo/releases/download/v1.1.0/kargo-linux-amd64",
+					Size:        42,
+				}},
+			}},
+			&gitea.Response{NextPage: 2},
+			nil,
+		)
+	mockClient.
+		On("ListReleases", context.Background(), testRepoOwner, testRepoName, 2).
+		Return(
+			[]*gitea.Release{{
+				TagName:      "v1.0.0-rc.1",
+				IsDraft:      true,
+				IsPrerelease: true,
+				CreatedAt:    createdAt,
+			}},
+			&gitea.Response{},
+			nil,
+		)
+
+	g := provider{
+		owner:  testRepoOwner,
+		repo:   testRepoName,
+		client: mockClient,
+	}
+
+	releases, err := g.ListReleases(context.Background())
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]gitprovider.Release{
+			{
+				TagName:     "v1.1.0",
+				Name:        "Release 1.1.0",
+				URL:         "https://gitea.com/akuity/kargo/releases/tag/v1.1.0",
+				PublishedAt: &publishedAt,
+				Assets: []gitprovider.ReleaseAsset{{
+					Name:        "kargo-linux-amd64",
+					DownloadURL: "https://gitea.com/akuity/kargo/releases/download/v1.1.0/kargo-linux-amd64",
+					Size:        42,
+				}},
+			},
+			{
+				TagName:     "v1.0.0-rc.1",
+				Draft:       true,
+				Prerelease:  true,
+				PublishedAt: &createdAt,
+			},
+		},
+		releases,
+	)
+	mockClient.AssertExpectations(t)
+
+	mockClient = &mockGiteaClient{}
+	mockClient.
+		On("ListReleases", context.Background(), testRepoOwner, testRepoName, 0).
+		Return(nil, nil, errors.New("something went wrong"))
+	g.client = mockClient
+	_, err = g.ListReleases(context.Background())
+	require.ErrorContains(t, err, "something went wrong")
+}
+
 func TestMergePullRequest(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -86,6 +86,13 @@ type githubClient interface {
 		number int,
 		labels []string,
 	) ([]*github.Label, *github.Response, error)
+
+	ListReleases(
+		ctx context.Context,
+		owner string,
+		repo string,
+		opts *github.ListOptions,
+	) ([]*github.RepositoryRelease, *github.Response, error)
 }
 
 // provider is a GitHub implementation of gitprovider.Interface.
@@ -191,6 +198,15 @@ func (g githubClientWrapper) AddLabelsToIssue(
 	return g.client.Issues.AddLabelsToIssue(ctx, owner, repo, number, labels)
 }
 
+func (g githubClientWrapper) ListReleases(
+	ctx context.Context,
+	owner string,
+	repo string,
+	opts *github.ListOptions,
+) ([]*github.RepositoryRelease, *github.Response, error) {
+	return g.client.Repositories.ListReleases(ctx, owner, repo, opts)
+}
+
 // CreatePullRequest implements gitprovider.Interface.
 func (p *provider) CreatePullRequest(
 	ctx context.Context,
@@ -366,6 +382,61 @@ func (p *provider) GetCommitURL(
 	return commitURL, nil
 }
 
+// ListReleases implements gitprovider.Interface.
+func (p *provider) ListReleases(
+	ctx context.Context,
+) ([]gitprovider.Release, error) {
+	listOpts := github.ListOptions{
+		PerPage: 100, // Max
+	}
+	var releases []gitprovider.Release
+	for {
+		ghReleases, res, err := p.client.ListReleases(ctx, p.owner, p.repo, &listOpts)
+		if err != nil {
+			return nil, err
+		}
+		for _, ghRelease := range ghReleases {
+			if ghRelease != nil {
+				releases = append(releases, convertGithubRelease(*ghRelease))
+			}
+		}
+		if res == nil || res.NextPage == 0 {
+			break
+		}
+		listOpts.Page = res.NextPage
+	}
+	return releases, nil
+}
+
+func convertGithubRelease(ghRelease github.RepositoryRelease) gitprovider.Release {
+	release := gitprovider.Release{
+		TagName:    ptr.Deref(ghRelease.TagName, ""),
+		Name:       ptr.Deref(ghRelease.Name, ""),
+		URL:        ptr.Deref(ghRelease.HTMLURL, ""),
+		Draft:      ptr.Deref(ghRelease.Draft, false),
+		Prerelease: ptr.Deref(ghRelease.Prerelease, false),
+	}
+	switch {
+	case ghRelease.PublishedAt != nil:
+		release.PublishedAt = &ghRelease.PublishedAt.Time
+	case ghRelease.CreatedAt != nil:
+		release.PublishedAt = &ghRelease.CreatedAt.Time
+	}
+	for _, asset := range ghRelease.Assets {
+		if asset == nil {
+			continue
+		}
+		release.Assets = append(release.Assets, gitprovider.ReleaseAsset{
+			Name:        ptr.Deref(asset.Name, ""),
+			DownloadURL: ptr.Deref(asset.BrowserDownloadURL, ""),
+			Size:        int64(ptr.Deref(asset.Size, 0)),
+			ContentType: ptr.Deref(asset.ContentType, ""),
+			Digest:      ptr.Deref(asset.Digest, ""),
+		})
+	}
+	return release
+}
+
 func convertGithubPR(ghPR github.PullRequest) gitprovider.PullRequest {
 	pr := gitprovider.PullRequest{
 		Number:         int64(ptr.Deref(ghPR.Number, 0)),
@@ -217,6 +217,26 @@ func (m *mockGithubClient) AddLabelsToIssue(
 	return labelsResp, resp, args.Error(2)
 }
 
+func (m *mockGithubClient) ListReleases(
+	ctx context.Context,
+	owner string,
+	repo string,
+	opts *github.ListOptions,
+) ([]*github.RepositoryRelease, *github.Response, error) {
+	args := m.Called(ctx, owner, repo, opts)
+	m.owner = owner
+	m.repo = repo
+	releases, ok := args.Get(0).([]*github.RepositoryRelease)
+	if !ok {
+		return nil, nil, args.Error(2)
+	}
+	resp, ok := args.Get(1).(*github.Response)
+	if !ok {
+		return releases, nil, args.Error(2)
+	}
+	return releases, resp, args.Error(2)
+}
+
 func (m *mockGithubClient) CreatePullRequest(
 	ctx context.Context,
 	owner string,
@@ -701,6 +721,85 @@ func TestMergePullRequest(t *testing.T) {
 	}
 }
 
+func TestListReleases(t *testing.T) {
+	publishedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mockClient := &mockGithubClient{}
+	mockClient.
+		On("ListReleases", context.Background(), testRepoOwner, testRepoName, &github.ListOptions{PerPage: 100}).
+		Return(
+			[]*github.RepositoryRelease{{
+				TagName:     github.Ptr("v1.0.0"),
+				Name:        github.Ptr("First release"),
+				HTMLURL:     github.Ptr("https://github.com/akuity/kargo/releases/tag/v1.0.0"),
+				PublishedAt: &github.Timestamp{Time: publishedAt},
+				Assets: []*github.ReleaseAsset{{
+					Name:               github.Ptr("tool-linux-amd64"),
+					BrowserDownloadURL: github.Ptr("https://example.com/tool-linux-amd64"),
+					Size:               github.Ptr(42),
+					ContentType:        github.Ptr("application/octet-stream"),
+					Digest:             github.Ptr("sha256:abc123"),
+				}},
+			}},
+			&github.Response{NextPage: 2},
+			nil,
+		).Once()
+	mockClient.
+		On(
+			"ListReleases",
+			context.Background(),
+			testRepoOwner,
+			testRepoName,
+			&github.ListOptions{PerPage: 100, Page: 2},
+		).
+		Return(
+			[]*github.RepositoryRelease{{
+				TagName:    github.Ptr("v2.0.0-rc.1"),
+				Draft:      github.Ptr(true),
+				Prerelease: github.Ptr(true),
+				CreatedAt:  &github.Timestamp{Time: createdAt},
+			}},
+			&github.Response{},
+			nil,
+		).Once()
+
+	g := provider{
+		owner:  testRepoOwner,
+		repo:   testRepoName,
+		client: mockClient,
+	}
+	releases, err := g.ListReleases(context.Background())
+	require.NoError(t, err)
+	mockClient.AssertExpectations(t)
+
+	require.Equal(
+		t,
+		[]gitprovider.Release{
+			{
+				TagName:     "v1.0.0",
+				Name:        "First release",
+				URL:         "https://github.com/akuity/kargo/releases/tag/v1.0.0",
+				PublishedAt: &publishedAt,
+				Assets: []gitprovider.ReleaseAsset{{
+					Name:        "tool-linux-amd64",
+					DownloadURL: "https://example.com/tool-linux-amd64",
+					Size:        42,
+					ContentType: "application/octet-stream",
+					Digest:      "sha256:abc123",
+				}},
+			},
+			{
+				TagName:     "v2.0.0-rc.1",
+				Draft:       true,
+				Prerelease:  true,
+				PublishedAt: &createdAt,
+			},
+		},
+		releases,
+	)
+}
+
 func TestGetCommitURL(t *testing.T) {
 	testCases := []struct {
 		repoURL           string
@@ -68,10 +68,19 @@ type mergeRequestClient interface {
 	) (*gitlab.MergeRequest, *gitlab.Response, error)
 }
 
+type releasesClient interface {
+	ListReleases(
+		pid any,
+		opt *gitlab.ListReleasesOptions,
+		options ...gitlab.RequestOptionFunc,
+	) ([]*gitlab.Release, *gitlab.Response, error)
+}
+
 // provider is a GitLab-based implementation of gitprovider.Interface.
 type provider struct { // nolint: revive
-	projectName string
-	client      mergeRequestClient
+	projectName    string
+	client         mergeRequestClient
+	releasesClient releasesClient
 }
 
 // NewProvider returns a GitLab-based implementation of gitprovider.Interface.
@@ -113,8 +122,9 @@ func NewProvider(
 	}
 
 	return &provider{
-		projectName: projectName,
-		client:      client.MergeRequests,
+		projectName:    projectName,
+		client:         client.MergeRequests,
+		releasesClient: client.Releases,
 	}, nil
 }
 
@@ -259,6 +269,63 @@ func (p *provider) GetCommitURL(repoURL string, sha string) (string, error) {
 	return commitURL, nil
 }
 
+// ListReleases implements gitprovider.Interface.
+func (p *provider) ListReleases(
+	context.Context,
+) ([]gitprovider.Release, error) {
+	listOpts := &gitlab.ListReleasesOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+		},
+	}
+	var releases []gitprovider.Release
+	for {
+		glReleases, res, err := p.releasesClient.ListReleases(p.projectName, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		for _, glRelease := range glReleases {
+			if glRelease != nil {
+				releases = append(releases, convertGitlabRelease(*glRelease))
+			}
+		}
+		if res == nil || res.NextPage == 0 {
+			break
+		}
+		listOpts.Page = res.NextPage
+	}
+	return releases, nil
+}
+
+// convertGitlabRelease converts a GitLab release to a gitprovider.Release.
+// GitLab has no notion of draft releases or pre-releases, so neither is ever
+// indicated. A GitLab release's assets are the links attached to it.
+func convertGitlabRelease(glRelease gitlab.Release) gitprovider.Release {
+	release := gitprovider.Release{
+		TagName:     glRelease.TagName,
+		Name:        glRelease.Name,
+		URL:         glRelease.Links.Self,
+		PublishedAt: glRelease.ReleasedAt,
+	}
+	if release.PublishedAt == nil {
+		release.PublishedAt = glRelease.CreatedAt
+	}
+	for _, link := range glRelease.Assets.Links {
+		if link == nil {
+			continue
+		}
+		downloadURL := link.DirectAssetURL
+		if downloadURL == "" {
+			downloadURL = link.URL
+		}
+		release.Assets = append(release.Assets, gitprovider.ReleaseAsset{
+			Name:        link.Name,
+			DownloadURL: downloadURL,
+		})
+	}
+	return release
+}
+
 func convertGitlabMR(glMR gitlab.BasicMergeRequest) gitprovider.PullRequest {
 	return gitprovider.PullRequest{
 		Number:         glMR.IID,
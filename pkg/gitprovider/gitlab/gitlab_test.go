@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
@@ -73,6 +74,22 @@ func (m *mockGitLabClient) AcceptMergeRequest(
 	return m.mr, nil, nil
 }
 
+type mockReleasesClient struct {
+	releases []*gitlab.Release
+	pid      any
+	listOpts *gitlab.ListReleasesOptions
+}
+
+func (m *mockReleasesClient) ListReleases(
+	pid any,
+	opt *gitlab.ListReleasesOptions,
+	_ ...gitlab.RequestOptionFunc,
+) ([]*gitlab.Release, *gitlab.Response, error) {
+	m.pid = pid
+	m.listOpts = opt
+	return m.releases, nil, nil
+}
+
 func TestCreatePullRequest(t *testing.T) {
 	mockClient := &mockGitLabClient{
 		mr: &gitlab.MergeRequest{
@@ -170,6 +187,76 @@ func TestListPullRequests(t *testing.T) {
 	require.False(t, prs[0].Open)
 }
 
+func TestListReleases(t *testing.T) {
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	releasedAt := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	mockClient := &mockReleasesClient{
+		releases: []*gitlab.Release{
+			{
+				TagName:    "v1.0.0",
+				Name:       "First release",
+				CreatedAt:  &createdAt,
+				ReleasedAt: &releasedAt,
+				Links: gitlab.ReleaseLinks{
+					Self: "https://gitlab.com/group/project/-/releases/v1.0.0",
+				},
+				Assets: gitlab.ReleaseAssets{
+					Links: []*gitlab.ReleaseLink{
+						{
+							Name:           "tool-linux-amd64",
+							URL:            "https://example.com/tool-linux-amd64",
+							DirectAssetURL: "https://gitlab.com/group/project/-/releases/v1.0.0/downloads/tool",
+						},
+						{
+							Name: "checksums.txt",
+							URL:  "https://example.com/checksums.txt",
+						},
+					},
+				},
+			},
+			{
+				TagName:   "v0.9.0",
+				CreatedAt: &createdAt,
+			},
+		},
+	}
+	g := provider{
+		projectName:    testProjectName,
+		releasesClient: mockClient,
+	}
+
+	releases, err := g.ListReleases(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, testProjectName, mockClient.pid)
+	require.Equal(t, int64(100), mockClient.listOpts.PerPage)
+	require.Equal(
+		t,
+		[]gitprovider.Release{
+			{
+				TagName:     "v1.0.0",
+				Name:        "First release",
+				URL:         "https://gitlab.com/group/project/-/releases/v1.0.0",
+				PublishedAt: &releasedAt,
+				Assets: []gitprovider.ReleaseAsset{
+					{
+						Name:        "tool-linux-amd64",
+						DownloadURL: "https://gitlab.com/group/project/-/releases/v1.0.0/downloads/tool",
+					},
+					{
+						Name:        "checksums.txt",
+						DownloadURL: "https://example.com/checksums.txt",
+					},
+				},
+			},
+			{
+				TagName:     "v0.9.0",
+				PublishedAt: &createdAt,
+			},
+		},
+		releases,
+	)
+}
+
 func TestMergePullRequest(t *testing.T) {
 	testCases := []struct {
 		name         string
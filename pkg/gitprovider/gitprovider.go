@@ -62,6 +62,13 @@ type Interface interface {
 	// GetCommitURL returns a commit URL inferred from the provided repository URL
 	// and commit ID.
 	GetCommitURL(repoURL string, commitID string) (string, error)
+
+	// ListReleases lists all releases of the repository, including their
+	// assets. Implementations have no obligation to sort the results in any
+	// particular order. It is the responsibility of the caller to sort the
+	// results as needed. Implementations for providers without a concept of
+	// releases return an error.
+	ListReleases(context.Context) ([]Release, error)
 }
 
 // CreatePullRequestOpts encapsulates the options used when creating a pull
@@ -120,6 +127,44 @@ type PullRequest struct {
 	CreatedAt *time.Time `json:"createdAt"`
 }
 
+// Release is an abstracted representation of a Git hosting provider's release
+// object.
+type Release struct {
+	// TagName is the name of the tag the release was created from.
+	TagName string `json:"tagName"`
+	// Name is the name (title) of the release.
+	Name string `json:"name,omitempty"`
+	// URL is the URL to the release.
+	URL string `json:"url,omitempty"`
+	// Draft is true if the release is a draft. Not all providers support draft
+	// releases.
+	Draft bool `json:"draft,omitempty"`
+	// Prerelease is true if the release is marked as a pre-release. Not all
+	// providers support pre-releases.
+	Prerelease bool `json:"prerelease,omitempty"`
+	// PublishedAt is the time the release was published. Providers that do not
+	// distinguish publication from creation report the creation time.
+	PublishedAt *time.Time `json:"publishedAt,omitempty"`
+	// Assets are the files attached to the release.
+	Assets []ReleaseAsset `json:"assets,omitempty"`
+}
+
+// ReleaseAsset is an abstracted representation of a file attached to a
+// release.
+type ReleaseAsset struct {
+	// Name is the name of the asset.
+	Name string `json:"name"`
+	// DownloadURL is the URL from which the asset can be downloaded.
+	DownloadURL string `json:"downloadURL"`
+	// Size is the size of the asset in bytes, if known.
+	Size int64 `json:"size,omitempty"`
+	// ContentType is the media type of the asset, if known.
+	ContentType string `json:"contentType,omitempty"`
+	// Digest is a checksum of the asset's content of the form
+	// <algorithm>:<hex>, if the provider supplies one.
+	Digest string `json:"digest,omitempty"`
+}
+
 // Fake is a fake implementation of the provider Interface used to facilitate
 // testing.
 type Fake struct {
@@ -141,6 +186,8 @@ type Fake struct {
 	MergePullRequestFn func(context.Context, int64) (*PullRequest, bool, error)
 	// GetCommitURLFn defines the functionality of the GetCommitURL method.
 	GetCommitURLFn func(repoURL string, commitID string) (string, error)
+	// ListReleasesFn defines the functionality of the ListReleases method.
+	ListReleasesFn func(context.Context) ([]Release, error)
 }
 
 // CreatePullRequest implements gitprovider.Interface.
@@ -179,3 +226,8 @@ func (f *Fake) MergePullRequest(
 func (f *Fake) GetCommitURL(repoURL string, sha string) (string, error) {
 	return f.GetCommitURLFn(repoURL, sha)
 }
+
+// ListReleases implements gitprovider.Interface.
+func (f *Fake) ListReleases(ctx context.Context) ([]Release, error) {
+	return f.ListReleasesFn(ctx)
+}
@@ -0,0 +1,272 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/health"
+)
+
+const fluxResourceStatusesKey = "fluxResourceStatuses"
+
+// FluxHealthInput is the input for a health check associated with the
+// flux-update step.
+type FluxHealthInput struct {
+	// Resources is a list of health checks to perform on Flux resources.
+	Resources []FluxResourceHealthCheck `json:"resources"`
+}
+
+// FluxResourceHealthCheck is the configuration for a health check on a single
+// Flux resource.
+type FluxResourceHealthCheck struct {
+	// APIVersion is the API version of the Flux resource to check.
+	APIVersion string `json:"apiVersion"`
+	// Kind is the kind of the Flux resource to check.
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the Flux resource to check.
+	Namespace string `json:"namespace"`
+	// Name is the name of the Flux resource to check.
+	Name string `json:"name"`
+	// DesiredRevision is the revision the Flux resource is expected to have
+	// reconciled. If empty, any revision is acceptable.
+	DesiredRevision string `json:"desiredRevision,omitempty"`
+	// ReconcileRequestedAt is the value of the reconcile.fluxcd.io/requestedAt
+	// annotation set on the Flux resource when reconciliation was requested.
+	// If non-empty, the Flux resource is not considered healthy until its
+	// controller has handled that request.
+	ReconcileRequestedAt string `json:"reconcileRequestedAt,omitempty"`
+}
+
+// FluxResourceStatus describes the health of a single Flux resource.
+type FluxResourceStatus struct {
+	// APIVersion is the API version of the Flux resource.
+	APIVersion string `json:"apiVersion"`
+	// Kind is the kind of the Flux resource.
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the Flux resource.
+	Namespace string `json:"namespace"`
+	// Name is the name of the Flux resource.
+	Name string `json:"name"`
+	// Health is the health of the Flux resource.
+	Health kargoapi.HealthState `json:"health"`
+	// Revision is the revision last reconciled by the Flux resource.
+	Revision string `json:"revision,omitempty"`
+	// Message explains the health of the Flux resource, if it is not healthy.
+	Message string `json:"message,omitempty"`
+}
+
+type fluxChecker struct {
+	client client.Client
+}
+
+// newFluxChecker returns an implementation of the Checker interface that
+// assesses the readiness of Flux resources, such as Kustomizations,
+// HelmReleases, and sources. Flux resources are read as unstructured objects,
+// so no Flux API types are required.
+func newFluxChecker(kubeClient client.Client) *fluxChecker {
+	return &fluxChecker{
+		client: kubeClient,
+	}
+}
+
+// Name implements the Checker interface.
+func (f *fluxChecker) Name() string {
+	return "flux"
+}
+
+// Check implements the Checker interface.
+func (f *fluxChecker) Check(
+	ctx context.Context,
+	_ string,
+	_ string,
+	criteria health.Criteria,
+) health.Result {
+	cfg, err := health.InputToStruct[FluxHealthInput](criteria.Input)
+	if err != nil {
+		return health.Result{
+			Status: kargoapi.HealthStateUnknown,
+			Issues: []string{
+				fmt.Sprintf(
+					"could not convert opaque input into %s health check input: %s",
+					f.Name(), err.Error(),
+				),
+			},
+		}
+	}
+	return f.check(ctx, cfg)
+}
+
+func (f *fluxChecker) check(
+	ctx context.Context,
+	input FluxHealthInput,
+) health.Result {
+	if f.client == nil {
+		return health.Result{
+			Status: kargoapi.HealthStateUnknown,
+			Issues: []string{
+				"no Kubernetes client is available to this controller; cannot " +
+					"assess the health of Flux resources",
+			},
+		}
+	}
+	res := health.Result{
+		Status: kargoapi.HealthStateHealthy,
+		Issues: make([]string, 0),
+	}
+	statuses := make([]FluxResourceStatus, len(input.Resources))
+	for i, check := range input.Resources {
+		statuses[i] = f.getResourceStatus(ctx, check)
+		res.Status = res.Status.Merge(statuses[i].Health)
+		if statuses[i].Health != kargoapi.HealthStateHealthy {
+			res.Issues = append(
+				res.Issues,
+				fmt.Sprintf(
+					"Flux %s %q in namespace %q is %s: %s",
+					check.Kind, check.Name, check.Namespace,
+					statuses[i].Health, statuses[i].Message,
+				),
+			)
+		}
+	}
+	res.Output = map[string]any{
+		fluxResourceStatusesKey: statuses,
+	}
+	return res
+}
+
+// getResourceStatus retrieves the Flux resource identified by the given
+// FluxResourceHealthCheck and assesses its health.
+func (f *fluxChecker) getResourceStatus(
+	ctx context.Context,
+	check FluxResourceHealthCheck,
+) FluxResourceStatus {
+	status := FluxResourceStatus{
+		APIVersion: check.APIVersion,
+		Kind:       check.Kind,
+		Namespace:  check.Namespace,
+		Name:       check.Name,
+		Health:     kargoapi.HealthStateUnknown,
+	}
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(schema.FromAPIVersionAndKind(check.APIVersion, check.Kind))
+	if err := f.client.Get(
+		ctx,
+		client.ObjectKey{Namespace: check.Namespace, Name: check.Name},
+		obj,
+	); err != nil {
+		if apierrors.IsNotFound(err) {
+			status.Message = "resource not found"
+		} else {
+			status.Message = fmt.Sprintf("error getting resource: %s", err)
+		}
+		return status
+	}
+	status.Revision = getFluxRevision(obj)
+	status.Health, status.Message = getFluxResourceHealth(obj, check)
+	return status
+}
+
+// getFluxResourceHealth assesses the health of the given Flux resource. A Flux
+// resource is healthy once it has handled any outstanding reconciliation
+// request, its latest generation has been observed, its Ready condition is
+// True, and, if a revision is desired, it has reconciled that revision.
+func getFluxResourceHealth(
+	obj *unstructured.Unstructured,
+	check FluxResourceHealthCheck,
+) (kargoapi.HealthState, string) {
+	if suspended, _, _ := unstructured.NestedBool(obj.Object, "spec", "suspend"); suspended {
+		return kargoapi.HealthStateUnhealthy, "reconciliation is suspended"
+	}
+	if check.ReconcileRequestedAt != "" {
+		handledAt, _, _ := unstructured.NestedString(obj.Object, "status", "lastHandledReconcileAt")
+		if handledAt != check.ReconcileRequestedAt {
+			return kargoapi.HealthStateProgressing,
+				"waiting for the reconciliation request to be handled"
+		}
+	}
+	observedGeneration, found, err := unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+	if err != nil || !found || obj.GetGeneration() > observedGeneration {
+		return kargoapi.HealthStateProgressing, "waiting for the latest generation to be observed"
+	}
+
+	conditions := getFluxConditions(obj)
+	if cond, ok := conditions["Stalled"]; ok && cond.Status == metav1.ConditionTrue {
+		return kargoapi.HealthStateUnhealthy, fmt.Sprintf("reconciliation has stalled: %s", cond.Message)
+	}
+	if cond, ok := conditions["Reconciling"]; ok && cond.Status == metav1.ConditionTrue {
+		return kargoapi.HealthStateProgressing, fmt.Sprintf("reconciliation is in progress: %s", cond.Message)
+	}
+	ready, ok := conditions["Ready"]
+	switch {
+	case !ok || ready.Status == metav1.ConditionUnknown:
+		return kargoapi.HealthStateProgressing, "waiting for the resource to become ready"
+	case ready.Status == metav1.ConditionFalse:
+		return kargoapi.HealthStateUnhealthy, fmt.Sprintf("resource is not ready: %s", ready.Message)
+	}
+
+	if check.DesiredRevision != "" {
+		if revision := getFluxRevision(obj); !fluxRevisionMatches(revision, check.DesiredRevision) {
+			return kargoapi.HealthStateProgressing, fmt.Sprintf(
+				"waiting for revision %q to be reconciled; last reconciled revision is %q",
+				check.DesiredRevision, revision,
+			)
+		}
+	}
+	return kargoapi.HealthStateHealthy, ""
+}
+
+// getFluxConditions returns the status conditions of the given Flux resource,
+// indexed by type.
+func getFluxConditions(obj *unstructured.Unstructured) map[string]metav1.Condition {
+	conditions := map[string]metav1.Condition{}
+	rawConditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range rawConditions {
+		rawCond, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+		cond := metav1.Condition{}
+		cond.Type, _ = rawCond["type"].(string)
+		status, _ := rawCond["status"].(string)
+		cond.Status = metav1.ConditionStatus(status)
+		cond.Message, _ = rawCond["message"].(string)
+		conditions[cond.Type] = cond
+	}
+	return conditions
+}
+
+// getFluxRevision returns the revision last reconciled by the given Flux
+// resource. For Kustomizations, this is the last applied revision of their
+// source. For HelmReleases, this is the last attempted chart version. For
+// sources, this is the revision of their latest artifact.
+func getFluxRevision(obj *unstructured.Unstructured) string {
+	var revision string
+	switch obj.GetKind() {
+	case "Kustomization":
+		revision, _, _ = unstructured.NestedString(obj.Object, "status", "lastAppliedRevision")
+	case "HelmRelease":
+		revision, _, _ = unstructured.NestedString(obj.Object, "status", "lastAttemptedRevision")
+	default:
+		revision, _, _ = unstructured.NestedString(obj.Object, "status", "artifact", "revision")
+	}
+	return revision
+}
+
+// fluxRevisionMatches returns whether a revision reported by Flux matches the
+// desired revision. Flux reports revisions in the format
+// "<ref>@<algorithm>:<checksum>" (e.g. "main@sha1:<commit>" or
+// "latest@sha256:<digest>"), so the desired revision matches if it is equal to
+// the reported revision, to the part following the "@", or to the checksum.
+func fluxRevisionMatches(revision, desired string) bool {
+	return revision == desired ||
+		strings.HasSuffix(revision, "@"+desired) ||
+		strings.HasSuffix(revision, ":"+desired)
+}
@@ -0,0 +1,296 @@
+package builtin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/health"
+)
+
+func Test_fluxChecker_check(t *testing.T) {
+	const (
+		testNamespace  = "flux-system"
+		testAPIVersion = "kustomize.toolkit.fluxcd.io/v1"
+		testRevision   = "main@sha1:abc123"
+	)
+
+	scheme := runtime.NewScheme()
+	gvk := schema.FromAPIVersionAndKind(testAPIVersion, "Kustomization")
+	scheme.AddKnownTypeWithName(gvk, &unstructured.Unstructured{})
+	scheme.AddKnownTypeWithName(
+		gvk.GroupVersion().WithKind("KustomizationList"),
+		&unstructured.UnstructuredList{},
+	)
+
+	newKustomization := func(name string, ready string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": testAPIVersion,
+			"kind":       "Kustomization",
+			"metadata": map[string]any{
+				"namespace":  testNamespace,
+				"name":       name,
+				"generation": int64(1),
+			},
+			"status": map[string]any{
+				"observedGeneration":  int64(1),
+				"lastAppliedRevision": testRevision,
+				"conditions": []any{
+					map[string]any{
+						"type":    "Ready",
+						"status":  ready,
+						"message": "fake message",
+					},
+				},
+			},
+		}}
+	}
+
+	testCases := []struct {
+		name       string
+		client     client.Client
+		input      FluxHealthInput
+		assertions func(*testing.T, health.Result)
+	}{
+		{
+			name:  "no client",
+			input: FluxHealthInput{},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], "no Kubernetes client")
+			},
+		},
+		{
+			name: "healthy",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				newKustomization("fake-name", "True"),
+			).Build(),
+			input: FluxHealthInput{
+				Resources: []FluxResourceHealthCheck{{
+					APIVersion:      testAPIVersion,
+					Kind:            "Kustomization",
+					Namespace:       testNamespace,
+					Name:            "fake-name",
+					DesiredRevision: "abc123",
+				}},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateHealthy, res.Status)
+				require.Empty(t, res.Issues)
+				require.Equal(
+					t,
+					[]FluxResourceStatus{{
+						APIVersion: testAPIVersion,
+						Kind:       "Kustomization",
+						Namespace:  testNamespace,
+						Name:       "fake-name",
+						Health:     kargoapi.HealthStateHealthy,
+						Revision:   testRevision,
+					}},
+					res.Output[fluxResourceStatusesKey],
+				)
+			},
+		},
+		{
+			name: "unhealthy and not found",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				newKustomization("unhealthy", "False"),
+			).Build(),
+			input: FluxHealthInput{
+				Resources: []FluxResourceHealthCheck{
+					{
+						APIVersion: testAPIVersion,
+						Kind:       "Kustomization",
+						Namespace:  testNamespace,
+						Name:       "unhealthy",
+					},
+					{
+						APIVersion: testAPIVersion,
+						Kind:       "Kustomization",
+						Namespace:  testNamespace,
+						Name:       "missing",
+					},
+				},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnhealthy, res.Status)
+				require.Equal(
+					t,
+					[]string{
+						`Flux Kustomization "unhealthy" in namespace "flux-system" is ` +
+							`Unhealthy: resource is not ready: fake message`,
+						`Flux Kustomization "missing" in namespace "flux-system" is ` +
+							`Unknown: resource not found`,
+					},
+					res.Issues,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			checker := newFluxChecker(testCase.client)
+			testCase.assertions(t, checker.check(context.Background(), testCase.input))
+		})
+	}
+}
+
+func Test_getFluxResourceHealth(t *testing.T) {
+	testCases := []struct {
+		name           string
+		obj            map[string]any
+		check          FluxResourceHealthCheck
+		expectedHealth kargoapi.HealthState
+		expectedMsg    string
+	}{
+		{
+			name: "suspended",
+			obj: map[string]any{
+				"kind": "Kustomization",
+				"spec": map[string]any{"suspend": true},
+			},
+			expectedHealth: kargoapi.HealthStateUnhealthy,
+			expectedMsg:    "reconciliation is suspended",
+		},
+		{
+			name: "reconciliation request not yet handled",
+			obj: map[string]any{
+				"kind": "GitRepository",
+				"status": map[string]any{
+					"lastHandledReconcileAt": "earlier",
+				},
+			},
+			check:          FluxResourceHealthCheck{ReconcileRequestedAt: "now"},
+			expectedHealth: kargoapi.HealthStateProgressing,
+			expectedMsg:    "waiting for the reconciliation request to be handled",
+		},
+		{
+			name: "latest generation not observed",
+			obj: map[string]any{
+				"kind":     "GitRepository",
+				"metadata": map[string]any{"generation": int64(2)},
+				"status":   map[string]any{"observedGeneration": int64(1)},
+			},
+			expectedHealth: kargoapi.HealthStateProgressing,
+			expectedMsg:    "waiting for the latest generation to be observed",
+		},
+		{
+			name: "stalled",
+			obj: map[string]any{
+				"kind": "HelmRelease",
+				"status": map[string]any{
+					"observedGeneration": int64(0),
+					"conditions": []any{
+						map[string]any{"type": "Ready", "status": "False"},
+						map[string]any{"type": "Stalled", "status": "True", "message": "retries exhausted"},
+					},
+				},
+			},
+			expectedHealth: kargoapi.HealthStateUnhealthy,
+			expectedMsg:    "reconciliation has stalled: retries exhausted",
+		},
+		{
+			name: "reconciling",
+			obj: map[string]any{
+				"kind": "HelmRelease",
+				"status": map[string]any{
+					"observedGeneration": int64(0),
+					"conditions": []any{
+						map[string]any{"type": "Ready", "status": "Unknown"},
+						map[string]any{"type": "Reconciling", "status": "True", "message": "upgrading"},
+					},
+				},
+			},
+			expectedHealth: kargoapi.HealthStateProgressing,
+			expectedMsg:    "reconciliation is in progress: upgrading",
+		},
+		{
+			name: "no Ready condition",
+			obj: map[string]any{
+				"kind":   "OCIRepository",
+				"status": map[string]any{"observedGeneration": int64(0)},
+			},
+			expectedHealth: kargoapi.HealthStateProgressing,
+			expectedMsg:    "waiting for the resource to become ready",
+		},
+		{
+			name: "desired revision not reconciled",
+			obj: map[string]any{
+				"kind": "OCIRepository",
+				"status": map[string]any{
+					"observedGeneration": int64(0),
+					"artifact":           map[string]any{"revision": "v1@sha256:old"},
+					"conditions": []any{
+						map[string]any{"type": "Ready", "status": "True"},
+					},
+				},
+			},
+			check:          FluxResourceHealthCheck{DesiredRevision: "sha256:new"},
+			expectedHealth: kargoapi.HealthStateProgressing,
+			expectedMsg: `waiting for revision "sha256:new" to be reconciled; ` +
+				`last reconciled revision is "v1@sha256:old"`,
+		},
+		{
+			name: "healthy",
+			obj: map[string]any{
+				"kind": "HelmRelease",
+				"status": map[string]any{
+					"observedGeneration":     int64(0),
+					"lastHandledReconcileAt": "now",
+					"lastAttemptedRevision":  "1.2.3",
+					"conditions": []any{
+						map[string]any{"type": "Ready", "status": "True"},
+					},
+				},
+			},
+			check: FluxResourceHealthCheck{
+				DesiredRevision:      "1.2.3",
+				ReconcileRequestedAt: "now",
+			},
+			expectedHealth: kargoapi.HealthStateHealthy,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			state, msg := getFluxResourceHealth(
+				&unstructured.Unstructured{Object: testCase.obj},
+				testCase.check,
+			)
+			require.Equal(t, testCase.expectedHealth, state)
+			require.Equal(t, testCase.expectedMsg, msg)
+		})
+	}
+}
+
+func Test_fluxRevisionMatches(t *testing.T) {
+	testCases := []struct {
+		revision string
+		desired  string
+		matches  bool
+	}{
+		{revision: "1.2.3", desired: "1.2.3", matches: true},
+		{revision: "main@sha1:abc123", desired: "abc123", matches: true},
+		{revision: "main@sha1:abc123", desired: "sha1:abc123", matches: true},
+		{revision: "main@sha1:abc123", desired: "main@sha1:abc123", matches: true},
+		{revision: "main@sha1:abc123", desired: "bc123", matches: false},
+		{revision: "main@sha1:abc123", desired: "main", matches: false},
+		{revision: "", desired: "abc123", matches: false},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.revision+"/"+testCase.desired, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.matches,
+				fluxRevisionMatches(testCase.revision, testCase.desired),
+			)
+		})
+	}
+}
@@ -0,0 +1,249 @@
+package builtin
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/expr-lang/expr"
+	"github.com/hashicorp/go-cleanhttp"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/health"
+	"github.com/akuity/kargo/pkg/io"
+	kargonet "github.com/akuity/kargo/pkg/net"
+)
+
+const (
+	httpStatusKey = "status"
+
+	httpHealthMaxResponseBytes = 2 << 20
+	httpHealthTimeoutDefault   = 10 * time.Second
+)
+
+// HTTPHealthInput is the input for a health check that polls an HTTP endpoint.
+type HTTPHealthInput struct {
+	// URL is the address of the endpoint to poll.
+	URL string `json:"url"`
+	// Method is the HTTP method to use. If empty, GET is used.
+	Method string `json:"method,omitempty"`
+	// Headers is a list of headers to include in the request.
+	Headers []HTTPHealthHeader `json:"headers,omitempty"`
+	// InsecureSkipTLSVerify indicates whether to bypass TLS certificate
+	// verification.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+	// Timeout is the maximum time to wait for a response, expressed as a Go
+	// duration string. If empty, a default of 10 seconds is used.
+	Timeout string `json:"timeout,omitempty"`
+	// HealthyExpression is an expr-lang expression that is evaluated against
+	// the response. If it evaluates to true, the endpoint is considered healthy.
+	// If it evaluates to false, the endpoint is considered to still be
+	// progressing, unless UnhealthyExpression evaluates to true.
+	HealthyExpression string `json:"healthyExpression,omitempty"`
+	// UnhealthyExpression is an expr-lang expression that is evaluated against
+	// the response. If it evaluates to true, the endpoint is considered
+	// unhealthy. It takes precedence over HealthyExpression.
+	UnhealthyExpression string `json:"unhealthyExpression,omitempty"`
+}
+
+// HTTPHealthHeader is a header to include in an HTTP health check request.
+type HTTPHealthHeader struct {
+	// Name is the name of the header.
+	Name string `json:"name"`
+	// Value is the value of the header.
+	Value string `json:"value"`
+}
+
+type httpChecker struct{}
+
+// newHTTPChecker returns an implementation of the Checker interface that polls
+// an HTTP endpoint and evaluates its response using expr-lang expressions.
+func newHTTPChecker() *httpChecker {
+	return &httpChecker{}
+}
+
+// Name implements the Checker interface.
+func (h *httpChecker) Name() string {
+	return "http"
+}
+
+// Check implements the Checker interface.
+func (h *httpChecker) Check(
+	ctx context.Context,
+	_ string,
+	_ string,
+	criteria health.Criteria,
+) health.Result {
+	cfg, err := health.InputToStruct[HTTPHealthInput](criteria.Input)
+	if err != nil {
+		return health.Result{
+			Status: kargoapi.HealthStateUnknown,
+			Issues: []string{
+				fmt.Sprintf(
+					"could not convert opaque input into %s health check input: %s",
+					h.Name(), err.Error(),
+				),
+			},
+		}
+	}
+	return h.check(ctx, cfg)
+}
+
+func (h *httpChecker) check(ctx context.Context, input HTTPHealthInput) health.Result {
+	status, env, err := h.poll(ctx, input)
+	if err != nil {
+		return health.Result{
+			Status: kargoapi.HealthStateUnknown,
+			Issues: []string{err.Error()},
+		}
+	}
+	output := map[string]any{httpStatusKey: status}
+
+	unhealthy, err := evaluateHealthExpression(input.UnhealthyExpression, env)
+	if err != nil {
+		return health.Result{
+			Status: kargoapi.HealthStateUnknown,
+			Output: output,
+			Issues: []string{fmt.Sprintf("error evaluating unhealthy expression: %s", err)},
+		}
+	}
+	healthy, err := evaluateHealthExpression(input.HealthyExpression, env)
+	if err != nil {
+		return health.Result{
+			Status: kargoapi.HealthStateUnknown,
+			Output: output,
+			Issues: []string{fmt.Sprintf("error evaluating healthy expression: %s", err)},
+		}
+	}
+
+	res := health.Result{Output: output}
+	switch {
+	case unhealthy != nil && *unhealthy:
+		res.Status = kargoapi.HealthStateUnhealthy
+		res.Issues = []string{
+			fmt.Sprintf("HTTP (%d) response met unhealthy criteria", status),
+		}
+	case healthy != nil && *healthy:
+		res.Status = kargoapi.HealthStateHealthy
+	case healthy != nil:
+		res.Status = kargoapi.HealthStateProgressing
+		res.Issues = []string{
+			fmt.Sprintf("HTTP (%d) response did not yet meet healthy criteria", status),
+		}
+	case unhealthy != nil:
+		// Only unhealthy criteria were specified and they were not met.
+		res.Status = kargoapi.HealthStateHealthy
+	default:
+		// No criteria were specified; fall back to the response code.
+		if status >= 200 && status < 300 {
+			res.Status = kargoapi.HealthStateHealthy
+			break
+		}
+		res.Status = kargoapi.HealthStateUnhealthy
+		res.Issues = []string{fmt.Sprintf("HTTP (%d) response was not successful", status)}
+	}
+	return res
+}
+
+// poll sends the request described by the given input and returns the
+// response's status code along with an expression environment built from the
+// response.
+func (h *httpChecker) poll(
+	ctx context.Context,
+	input HTTPHealthInput,
+) (int64, map[string]any, error) {
+	timeout := httpHealthTimeoutDefault
+	if input.Timeout != "" {
+		var err error
+		if timeout, err = time.ParseDuration(input.Timeout); err != nil {
+			return 0, nil, fmt.Errorf("error parsing timeout %q: %w", input.Timeout, err)
+		}
+	}
+	method := input.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	req, err := http.NewRequestWithContext(ctx, method, input.URL, nil)
+	if err != nil {
+		return 0, nil, fmt.Errorf("error creating HTTP request: %w", err)
+	}
+	for _, header := range input.Headers {
+		req.Header.Add(header.Name, header.Value)
+	}
+
+	httpTransport := kargonet.SafeTransport(cleanhttp.DefaultTransport())
+	if input.InsecureSkipTLSVerify {
+		httpTransport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: true, // nolint: gosec
+		}
+	}
+	client := &http.Client{
+		Transport: httpTransport,
+		Timeout:   timeout,
+	}
+	// #nosec G704 -- The client is using a custom dialer that mitigates the worst
+	// practical risks of SSRF by refusing to dial link-local addresses.
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, nil, fmt.Errorf("error sending HTTP request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.ContentLength > httpHealthMaxResponseBytes {
+		return 0, nil, fmt.Errorf(
+			"response body size %d exceeds limit of %d bytes",
+			resp.ContentLength, httpHealthMaxResponseBytes,
+		)
+	}
+	bodyBytes, err := io.LimitRead(resp.Body, httpHealthMaxResponseBytes)
+	if err != nil {
+		return 0, nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	// Note: Casting as an int64 because deep copy of the output map will panic
+	// if any value is an int.
+	status := int64(resp.StatusCode)
+
+	response := map[string]any{
+		httpStatusKey: status,
+		"header":      resp.Header.Get,
+		"headers":     resp.Header,
+		"body":        map[string]any{},
+	}
+	if len(bodyBytes) > 0 {
+		// Bodies that are not valid JSON are exposed as plain text.
+		var body any
+		if err = json.Unmarshal(bodyBytes, &body); err != nil {
+			body = string(bodyBytes)
+		}
+		response["body"] = body
+	}
+	return status, map[string]any{"response": response}, nil
+}
+
+// evaluateHealthExpression evaluates the given expression against the given
+// environment. If the expression is empty, it returns nil.
+func evaluateHealthExpression(expression string, env map[string]any) (*bool, error) {
+	if expression == "" {
+		return nil, nil
+	}
+	program, err := expr.Compile(expression)
+	if err != nil {
+		return nil, fmt.Errorf("error compiling expression %q: %w", expression, err)
+	}
+	resAny, err := expr.Run(program, env)
+	if err != nil {
+		return nil, fmt.Errorf("error evaluating expression %q: %w", expression, err)
+	}
+	res, ok := resAny.(bool)
+	if !ok {
+		return nil, fmt.Errorf(
+			"expression %q did not evaluate to a boolean (got %T)",
+			expression, resAny,
+		)
+	}
+	return &res, nil
+}
@@ -0,0 +1,160 @@
+package builtin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/health"
+)
+
+func Test_httpChecker_check(t *testing.T) {
+	testCases := []struct {
+		name       string
+		handler    http.HandlerFunc
+		input      HTTPHealthInput
+		assertions func(*testing.T, health.Result)
+	}{
+		{
+			name: "invalid timeout",
+			input: HTTPHealthInput{
+				Timeout: "invalid",
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], "error parsing timeout")
+			},
+		},
+		{
+			name: "no criteria and successful response",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateHealthy, res.Status)
+				require.Empty(t, res.Issues)
+				require.Equal(t, map[string]any{httpStatusKey: int64(http.StatusOK)}, res.Output)
+			},
+		},
+		{
+			name: "no criteria and unsuccessful response",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnhealthy, res.Status)
+				require.Equal(t, []string{"HTTP (503) response was not successful"}, res.Issues)
+			},
+		},
+		{
+			name: "healthy expression met",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"state":"ready"}`))
+			},
+			input: HTTPHealthInput{
+				HealthyExpression:   `response.body.state == "ready"`,
+				UnhealthyExpression: `response.body.state == "failed"`,
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateHealthy, res.Status)
+				require.Empty(t, res.Issues)
+			},
+		},
+		{
+			name: "healthy expression not yet met",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"state":"provisioning"}`))
+			},
+			input: HTTPHealthInput{
+				HealthyExpression:   `response.body.state == "ready"`,
+				UnhealthyExpression: `response.body.state == "failed"`,
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateProgressing, res.Status)
+				require.Equal(
+					t,
+					[]string{"HTTP (200) response did not yet meet healthy criteria"},
+					res.Issues,
+				)
+			},
+		},
+		{
+			name: "unhealthy expression met",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"state":"failed"}`))
+			},
+			input: HTTPHealthInput{
+				HealthyExpression:   `response.body.state == "ready"`,
+				UnhealthyExpression: `response.body.state == "failed"`,
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnhealthy, res.Status)
+				require.Equal(t, []string{"HTTP (200) response met unhealthy criteria"}, res.Issues)
+			},
+		},
+		{
+			name: "only unhealthy expression specified and not met",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusAccepted)
+			},
+			input: HTTPHealthInput{
+				UnhealthyExpression: `response.status >= 500`,
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateHealthy, res.Status)
+				require.Empty(t, res.Issues)
+			},
+		},
+		{
+			name: "request headers and method are sent",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodHead || r.Header.Get("Authorization") != "Bearer token" {
+					w.WriteHeader(http.StatusUnauthorized)
+				}
+				w.Header().Set("X-Status", "green")
+			},
+			input: HTTPHealthInput{
+				Method: http.MethodHead,
+				Headers: []HTTPHealthHeader{{
+					Name:  "Authorization",
+					Value: "Bearer token",
+				}},
+				HealthyExpression: `response.header("X-Status") == "green"`,
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateHealthy, res.Status)
+				require.Empty(t, res.Issues)
+			},
+		},
+		{
+			name: "expression does not evaluate to a boolean",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte("plain text"))
+			},
+			input: HTTPHealthInput{
+				HealthyExpression: `response.body`,
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], "did not evaluate to a boolean")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			input := testCase.input
+			if testCase.handler != nil {
+				srv := httptest.NewServer(testCase.handler)
+				t.Cleanup(srv.Close)
+				input.URL = srv.URL
+			}
+			testCase.assertions(t, newHTTPChecker().check(context.Background(), input))
+		})
+	}
+}
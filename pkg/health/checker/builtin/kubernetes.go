@@ -0,0 +1,478 @@
+package builtin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/health"
+)
+
+const resourceStatusesKey = "resourceStatuses"
+
+var (
+	deploymentGroupKind  = schema.GroupKind{Group: appsv1.GroupName, Kind: "Deployment"}
+	statefulSetGroupKind = schema.GroupKind{Group: appsv1.GroupName, Kind: "StatefulSet"}
+	daemonSetGroupKind   = schema.GroupKind{Group: appsv1.GroupName, Kind: "DaemonSet"}
+	jobGroupKind         = schema.GroupKind{Group: batchv1.GroupName, Kind: "Job"}
+)
+
+// KubernetesHealthInput is the input for a health check on arbitrary
+// Kubernetes resources.
+type KubernetesHealthInput struct {
+	// Resources is a list of health checks to perform on Kubernetes resources.
+	Resources []KubernetesResourceHealthCheck `json:"resources"`
+}
+
+// KubernetesResourceHealthCheck is the configuration for a health check on one
+// or more Kubernetes resources of the same kind. Resources are either
+// identified by name, or selected using a label selector.
+type KubernetesResourceHealthCheck struct {
+	// APIVersion is the API version of the resource(s) to check.
+	APIVersion string `json:"apiVersion"`
+	// Kind is the kind of the resource(s) to check.
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the resource(s) to check. It must be empty
+	// for cluster-scoped resources. When selecting resources using a label
+	// selector, an empty namespace selects resources in the Project namespace.
+	// Resources outside the Project namespace, including cluster-scoped ones,
+	// must carry a kargo.akuity.io/authorized-stage annotation naming the Stage
+	// being checked.
+	Namespace string `json:"namespace,omitempty"`
+	// Name is the name of the resource to check. Mutually exclusive with
+	// LabelSelector.
+	Name string `json:"name,omitempty"`
+	// LabelSelector selects the resources to check. Mutually exclusive with
+	// Name.
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+}
+
+// KubernetesResourceStatus describes the health of a single Kubernetes
+// resource.
+type KubernetesResourceStatus struct {
+	// APIVersion is the API version of the resource.
+	APIVersion string `json:"apiVersion"`
+	// Kind is the kind of the resource.
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the resource.
+	Namespace string `json:"namespace,omitempty"`
+	// Name is the name of the resource.
+	Name string `json:"name"`
+	// Health is the health of the resource.
+	Health kargoapi.HealthState `json:"health"`
+	// Message explains the health of the resource, if it is not healthy.
+	Message string `json:"message,omitempty"`
+}
+
+type kubernetesChecker struct {
+	client client.Client
+}
+
+// newKubernetesChecker returns an implementation of the Checker interface that
+// assesses the readiness of arbitrary Kubernetes resources using standard
+// status conventions.
+func newKubernetesChecker(kubeClient client.Client) *kubernetesChecker {
+	return &kubernetesChecker{
+		client: kubeClient,
+	}
+}
+
+// Name implements the Checker interface.
+func (k *kubernetesChecker) Name() string {
+	return "kubernetes"
+}
+
+// Check implements the Checker interface.
+func (k *kubernetesChecker) Check(
+	ctx context.Context,
+	project string,
+	stage string,
+	criteria health.Criteria,
+) health.Result {
+	cfg, err := health.InputToStruct[KubernetesHealthInput](criteria.Input)
+	if err != nil {
+		return health.Result{
+			Status: kargoapi.HealthStateUnknown,
+			Issues: []string{
+				fmt.Sprintf(
+					"could not convert opaque input into %s health check input: %s",
+					k.Name(), err.Error(),
+				),
+			},
+		}
+	}
+	return k.check(ctx, project, stage, cfg)
+}
+
+func (k *kubernetesChecker) check(
+	ctx context.Context,
+	project string,
+	stage string,
+	input KubernetesHealthInput,
+) health.Result {
+	if k.client == nil {
+		return health.Result{
+			Status: kargoapi.HealthStateUnknown,
+			Issues: []string{
+				"no Kubernetes client is available to this controller; cannot " +
+					"assess the health of Kubernetes resources",
+			},
+		}
+	}
+	res := health.Result{
+		Status: kargoapi.HealthStateHealthy,
+		Issues: make([]string, 0),
+	}
+	resourceStatuses := make([]KubernetesResourceStatus, 0, len(input.Resources))
+	for _, check := range input.Resources {
+		objs, err := k.getResources(ctx, project, stage, check)
+		if err != nil {
+			res.Status = res.Status.Merge(kargoapi.HealthStateUnknown)
+			res.Issues = append(res.Issues, err.Error())
+			continue
+		}
+		for _, obj := range objs {
+			state, msg := getResourceHealth(&obj)
+			resourceStatuses = append(resourceStatuses, KubernetesResourceStatus{
+				APIVersion: obj.GetAPIVersion(),
+				Kind:       obj.GetKind(),
+				Namespace:  obj.GetNamespace(),
+				Name:       obj.GetName(),
+				Health:     state,
+				Message:    msg,
+			})
+			res.Status = res.Status.Merge(state)
+			if state != kargoapi.HealthStateHealthy {
+				res.Issues = append(
+					res.Issues,
+					fmt.Sprintf("%s is %s: %s", resourceDisplayName(&obj), state, msg),
+				)
+			}
+		}
+	}
+	res.Output = map[string]any{
+		resourceStatusesKey: resourceStatuses,
+	}
+	return res
+}
+
+// getResources retrieves the Kubernetes resources identified by the given
+// KubernetesResourceHealthCheck. It returns an error if no resources could be
+// found or if any of the resources found lies outside the given Project's
+// namespace without permitting health checks by the given Stage.
+func (k *kubernetesChecker) getResources(
+	ctx context.Context,
+	project string,
+	stage string,
+	check KubernetesResourceHealthCheck,
+) ([]unstructured.Unstructured, error) {
+	objs, err := k.findResources(ctx, project, check)
+	if err != nil {
+		return nil, err
+	}
+	for i := range objs {
+		if err = authorizeResourceHealthCheck(project, stage, &objs[i]); err != nil {
+			return nil, err
+		}
+	}
+	return objs, nil
+}
+
+// findResources retrieves the Kubernetes resources identified by the given
+// KubernetesResourceHealthCheck. Resources selected using a label selector are
+// only ever listed within a single namespace, which defaults to the given
+// Project's namespace. It returns an error if no resources could be found.
+func (k *kubernetesChecker) findResources(
+	ctx context.Context,
+	project string,
+	check KubernetesResourceHealthCheck,
+) ([]unstructured.Unstructured, error) {
+	gvk := schema.FromAPIVersionAndKind(check.APIVersion, check.Kind)
+	if gvk.Kind == "" || gvk.Version == "" {
+		return nil, errors.New("apiVersion and kind must be specified for every resource")
+	}
+
+	switch {
+	case check.Name != "" && check.LabelSelector != nil:
+		return nil, fmt.Errorf(
+			"only one of name or labelSelector may be specified for %s resources",
+			gvk.Kind,
+		)
+	case check.Name != "":
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gvk)
+		if err := k.client.Get(
+			ctx,
+			client.ObjectKey{Namespace: check.Namespace, Name: check.Name},
+			obj,
+		); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf(
+					"unable to find %s %q in namespace %q",
+					gvk.Kind, check.Name, check.Namespace,
+				)
+			}
+			return nil, fmt.Errorf(
+				"error getting %s %q in namespace %q: %w",
+				gvk.Kind, check.Name, check.Namespace, err,
+			)
+		}
+		return []unstructured.Unstructured{*obj}, nil
+	case check.LabelSelector != nil:
+		selector, err := metav1.LabelSelectorAsSelector(check.LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid label selector for %s resources: %w", gvk.Kind, err)
+		}
+		// Listing cluster-scoped resources would span the entire cluster, so
+		// only namespaced resources may be selected using a label selector.
+		probe := &unstructured.Unstructured{}
+		probe.SetGroupVersionKind(gvk)
+		namespaced, err := k.client.IsObjectNamespaced(probe)
+		if err != nil {
+			return nil, fmt.Errorf("error determining scope of %s resources: %w", gvk.Kind, err)
+		}
+		if !namespaced {
+			return nil, fmt.Errorf(
+				"labelSelector may only be used to select namespaced resources; "+
+					"%s resources are cluster-scoped",
+				gvk.Kind,
+			)
+		}
+		namespace := check.Namespace
+		if namespace == "" {
+			namespace = project
+		}
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err = k.client.List(
+			ctx,
+			list,
+			client.InNamespace(namespace),
+			client.MatchingLabelsSelector{Selector: selector},
+		); err != nil {
+			return nil, fmt.Errorf(
+				"error listing %s resources in namespace %q: %w",
+				gvk.Kind, namespace, err,
+			)
+		}
+		if len(list.Items) == 0 {
+			return nil, fmt.Errorf(
+				"unable to find any %s resources in namespace %q matching selector %q",
+				gvk.Kind, namespace, selector.String(),
+			)
+		}
+		return list.Items, nil
+	default:
+		return nil, fmt.Errorf(
+			"one of name or labelSelector must be specified for %s resources",
+			gvk.Kind,
+		)
+	}
+}
+
+// authorizeResourceHealthCheck returns an error if the given resource lies
+// outside the given Project's namespace and does not permit health checks by
+// the given Stage. As with the resources updated by the argocd-update and
+// flux-update steps, permission is granted by annotating the resource with
+// kargo.akuity.io/authorized-stage: "<project>:<stage>".
+func authorizeResourceHealthCheck(
+	project string,
+	stage string,
+	obj *unstructured.Unstructured,
+) error {
+	if obj.GetNamespace() == project {
+		return nil
+	}
+	allowedStage, ok := obj.GetAnnotations()[kargoapi.AnnotationKeyAuthorizedStage]
+	if !ok || allowedStage != fmt.Sprintf("%s:%s", project, stage) {
+		return fmt.Errorf(
+			"%s does not permit health checks by Kargo Stage %s in namespace %s",
+			resourceDisplayName(obj), stage, project,
+		)
+	}
+	return nil
+}
+
+// getResourceHealth assesses the health of the given Kubernetes resource. For
+// workload resources (Deployments, StatefulSets, DaemonSets, and Jobs), this
+// is based on the progress of their rollout or completion. For any other
+// resource, it is based on its observed generation and Ready condition.
+func getResourceHealth(obj *unstructured.Unstructured) (kargoapi.HealthState, string) {
+	if obj.GetDeletionTimestamp() != nil {
+		return kargoapi.HealthStateProgressing, "resource is being deleted"
+	}
+	var err error
+	switch obj.GroupVersionKind().GroupKind() {
+	case deploymentGroupKind:
+		deployment := &appsv1.Deployment{}
+		if err = fromUnstructured(obj, deployment); err == nil {
+			return getDeploymentHealth(deployment)
+		}
+	case statefulSetGroupKind:
+		statefulSet := &appsv1.StatefulSet{}
+		if err = fromUnstructured(obj, statefulSet); err == nil {
+			return getStatefulSetHealth(statefulSet)
+		}
+	case daemonSetGroupKind:
+		daemonSet := &appsv1.DaemonSet{}
+		if err = fromUnstructured(obj, daemonSet); err == nil {
+			return getDaemonSetHealth(daemonSet)
+		}
+	case jobGroupKind:
+		job := &batchv1.Job{}
+		if err = fromUnstructured(obj, job); err == nil {
+			return getJobHealth(job)
+		}
+	default:
+		return getGenericResourceHealth(obj)
+	}
+	return kargoapi.HealthStateUnknown, fmt.Sprintf("unable to parse resource: %s", err)
+}
+
+func getDeploymentHealth(deployment *appsv1.Deployment) (kargoapi.HealthState, string) {
+	if deployment.Generation > deployment.Status.ObservedGeneration {
+		return kargoapi.HealthStateProgressing, "waiting for the latest generation to be observed"
+	}
+	for _, cond := range deployment.Status.Conditions {
+		if cond.Type == appsv1.DeploymentProgressing && cond.Reason == "ProgressDeadlineExceeded" {
+			return kargoapi.HealthStateUnhealthy, "rollout has exceeded its progress deadline"
+		}
+	}
+	replicas := ptr.Deref(deployment.Spec.Replicas, 1)
+	status := deployment.Status
+	switch {
+	case status.UpdatedReplicas < replicas:
+		return kargoapi.HealthStateProgressing, fmt.Sprintf(
+			"%d of %d replicas have been updated", status.UpdatedReplicas, replicas,
+		)
+	case status.Replicas > status.UpdatedReplicas:
+		return kargoapi.HealthStateProgressing, fmt.Sprintf(
+			"%d old replicas are pending termination", status.Replicas-status.UpdatedReplicas,
+		)
+	case status.AvailableReplicas < status.UpdatedReplicas:
+		return kargoapi.HealthStateProgressing, fmt.Sprintf(
+			"%d of %d updated replicas are available", status.AvailableReplicas, status.UpdatedReplicas,
+		)
+	}
+	return kargoapi.HealthStateHealthy, ""
+}
+
+func getStatefulSetHealth(statefulSet *appsv1.StatefulSet) (kargoapi.HealthState, string) {
+	if statefulSet.Generation > statefulSet.Status.ObservedGeneration {
+		return kargoapi.HealthStateProgressing, "waiting for the latest generation to be observed"
+	}
+	replicas := ptr.Deref(statefulSet.Spec.Replicas, 1)
+	status := statefulSet.Status
+	if status.ReadyReplicas < replicas {
+		return kargoapi.HealthStateProgressing, fmt.Sprintf(
+			"%d of %d replicas are ready", status.ReadyReplicas, replicas,
+		)
+	}
+	if statefulSet.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType {
+		// Pods are only updated when they are deleted, so the progress of the
+		// rollout cannot be assessed.
+		return kargoapi.HealthStateHealthy, ""
+	}
+	if rollingUpdate := statefulSet.Spec.UpdateStrategy.RollingUpdate; rollingUpdate != nil &&
+		rollingUpdate.Partition != nil {
+		if expected := replicas - *rollingUpdate.Partition; status.UpdatedReplicas < expected {
+			return kargoapi.HealthStateProgressing, fmt.Sprintf(
+				"%d of %d replicas have been updated", status.UpdatedReplicas, expected,
+			)
+		}
+		return kargoapi.HealthStateHealthy, ""
+	}
+	if status.UpdateRevision != status.CurrentRevision {
+		return kargoapi.HealthStateProgressing, fmt.Sprintf(
+			"%d of %d replicas have been updated", status.UpdatedReplicas, replicas,
+		)
+	}
+	return kargoapi.HealthStateHealthy, ""
+}
+
+func getDaemonSetHealth(daemonSet *appsv1.DaemonSet) (kargoapi.HealthState, string) {
+	if daemonSet.Generation > daemonSet.Status.ObservedGeneration {
+		return kargoapi.HealthStateProgressing, "waiting for the latest generation to be observed"
+	}
+	status := daemonSet.Status
+	switch {
+	case status.UpdatedNumberScheduled < status.DesiredNumberScheduled:
+		return kargoapi.HealthStateProgressing, fmt.Sprintf(
+			"%d of %d pods have been updated", status.UpdatedNumberScheduled, status.DesiredNumberScheduled,
+		)
+	case status.NumberAvailable < status.DesiredNumberScheduled:
+		return kargoapi.HealthStateProgressing, fmt.Sprintf(
+			"%d of %d pods are available", status.NumberAvailable, status.DesiredNumberScheduled,
+		)
+	}
+	return kargoapi.HealthStateHealthy, ""
+}
+
+func getJobHealth(job *batchv1.Job) (kargoapi.HealthState, string) {
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			return kargoapi.HealthStateHealthy, ""
+		case batchv1.JobFailed:
+			return kargoapi.HealthStateUnhealthy, fmt.Sprintf("job has failed: %s", cond.Message)
+		}
+	}
+	return kargoapi.HealthStateProgressing, "job has not yet completed"
+}
+
+// getGenericResourceHealth assesses the health of a resource using the
+// conventions followed by most controllers: the resource is healthy once its
+// latest generation has been observed and its Ready condition (if any) is
+// True.
+func getGenericResourceHealth(obj *unstructured.Unstructured) (kargoapi.HealthState, string) {
+	observedGeneration, found, err := unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+	if err == nil && found && obj.GetGeneration() > observedGeneration {
+		return kargoapi.HealthStateProgressing, "waiting for the latest generation to be observed"
+	}
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		cond, ok := c.(map[string]any)
+		if !ok || cond["type"] != "Ready" {
+			continue
+		}
+		msg, _ := cond["message"].(string)
+		switch cond["status"] {
+		case string(metav1.ConditionTrue):
+			return kargoapi.HealthStateHealthy, ""
+		case string(metav1.ConditionFalse):
+			return kargoapi.HealthStateUnhealthy, fmt.Sprintf("resource is not ready: %s", msg)
+		default:
+			return kargoapi.HealthStateProgressing, fmt.Sprintf("resource readiness is unknown: %s", msg)
+		}
+	}
+	return kargoapi.HealthStateHealthy, ""
+}
+
+// fromUnstructured converts the given unstructured resource into the given
+// typed object.
+func fromUnstructured(obj *unstructured.Unstructured, into any) error {
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, into)
+}
+
+// resourceDisplayName returns a human-readable name for the given resource.
+func resourceDisplayName(obj *unstructured.Unstructured) string {
+	if obj.GetNamespace() == "" {
+		return fmt.Sprintf("%s %q", obj.GetKind(), obj.GetName())
+	}
+	return fmt.Sprintf("%s %q in namespace %q", obj.GetKind(), obj.GetName(), obj.GetNamespace())
+}
@@ -0,0 +1,574 @@
+package builtin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta/testrestmapper"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/health"
+)
+
+func Test_kubernetesChecker_check(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, appsv1.AddToScheme(scheme))
+	require.NoError(t, batchv1.AddToScheme(scheme))
+	restMapper := testrestmapper.TestOnlyStaticRESTMapper(scheme)
+
+	const testNamespace = "fake-namespace"
+	const testStage = "fake-stage"
+
+	healthyDeployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  testNamespace,
+			Name:       "healthy",
+			Generation: 2,
+			Labels:     map[string]string{"app": "fake-app"},
+		},
+		Spec: appsv1.DeploymentSpec{Replicas: ptr.To[int32](2)},
+		Status: appsv1.DeploymentStatus{
+			ObservedGeneration: 2,
+			Replicas:           2,
+			UpdatedReplicas:    2,
+			AvailableReplicas:  2,
+		},
+	}
+	progressingDeployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  testNamespace,
+			Name:       "progressing",
+			Generation: 2,
+			Labels:     map[string]string{"app": "fake-app"},
+		},
+		Spec: appsv1.DeploymentSpec{Replicas: ptr.To[int32](2)},
+		Status: appsv1.DeploymentStatus{
+			ObservedGeneration: 2,
+			Replicas:           2,
+			UpdatedReplicas:    1,
+			AvailableReplicas:  1,
+		},
+	}
+	otherNamespaceDeployment := healthyDeployment.DeepCopy()
+	otherNamespaceDeployment.Namespace = "other-namespace"
+	authorizedDeployment := healthyDeployment.DeepCopy()
+	authorizedDeployment.Namespace = "other-namespace"
+	authorizedDeployment.Name = "authorized"
+	authorizedDeployment.Annotations = map[string]string{
+		kargoapi.AnnotationKeyAuthorizedStage: testNamespace + ":" + testStage,
+	}
+	failedJob := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "failed",
+		},
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{{
+				Type:    batchv1.JobFailed,
+				Status:  corev1.ConditionTrue,
+				Message: "backoff limit exceeded",
+			}},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		client     client.Client
+		input      KubernetesHealthInput
+		assertions func(*testing.T, health.Result)
+	}{
+		{
+			name: "no client",
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], "no Kubernetes client is available")
+			},
+		},
+		{
+			name:   "neither name nor label selector specified",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithRESTMapper(restMapper).Build(),
+			input: KubernetesHealthInput{
+				Resources: []KubernetesResourceHealthCheck{{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Namespace:  testNamespace,
+				}},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], "one of name or labelSelector must be specified")
+			},
+		},
+		{
+			name:   "resource not found",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithRESTMapper(restMapper).Build(),
+			input: KubernetesHealthInput{
+				Resources: []KubernetesResourceHealthCheck{{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Namespace:  testNamespace,
+					Name:       "missing",
+				}},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], `unable to find Deployment "missing"`)
+			},
+		},
+		{
+			name:   "no resources match label selector",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithRESTMapper(restMapper).Build(),
+			input: KubernetesHealthInput{
+				Resources: []KubernetesResourceHealthCheck{{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Namespace:  testNamespace,
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"app": "fake-app"},
+					},
+				}},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], "unable to find any Deployment resources")
+			},
+		},
+		{
+			name: "healthy resource by name",
+			client: fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithRESTMapper(restMapper).
+				WithObjects(healthyDeployment).
+				Build(),
+			input: KubernetesHealthInput{
+				Resources: []KubernetesResourceHealthCheck{{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Namespace:  testNamespace,
+					Name:       "healthy",
+				}},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateHealthy, res.Status)
+				require.Empty(t, res.Issues)
+				require.Equal(
+					t,
+					[]KubernetesResourceStatus{{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Namespace:  testNamespace,
+						Name:       "healthy",
+						Health:     kargoapi.HealthStateHealthy,
+					}},
+					res.Output[resourceStatusesKey],
+				)
+			},
+		},
+		{
+			name: "resources selected by label are rolled up",
+			client: fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithRESTMapper(restMapper).
+				WithObjects(healthyDeployment, progressingDeployment).
+				Build(),
+			input: KubernetesHealthInput{
+				Resources: []KubernetesResourceHealthCheck{{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Namespace:  testNamespace,
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"app": "fake-app"},
+					},
+				}},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateProgressing, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], `Deployment "progressing"`)
+				require.Len(t, res.Output[resourceStatusesKey], 2)
+			},
+		},
+		{
+			name: "label selector without namespace selects from project namespace",
+			client: fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithRESTMapper(restMapper).
+				WithObjects(healthyDeployment, otherNamespaceDeployment).
+				Build(),
+			input: KubernetesHealthInput{
+				Resources: []KubernetesResourceHealthCheck{{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"app": "fake-app"},
+					},
+				}},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateHealthy, res.Status)
+				require.Empty(t, res.Issues)
+				statuses, ok := res.Output[resourceStatusesKey].([]KubernetesResourceStatus)
+				require.True(t, ok)
+				require.Len(t, statuses, 1)
+				require.Equal(t, testNamespace, statuses[0].Namespace)
+			},
+		},
+		{
+			name:   "label selector for cluster-scoped resources",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithRESTMapper(restMapper).Build(),
+			input: KubernetesHealthInput{
+				Resources: []KubernetesResourceHealthCheck{{
+					APIVersion: "v1",
+					Kind:       "Namespace",
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"app": "fake-app"},
+					},
+				}},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], "Namespace resources are cluster-scoped")
+			},
+		},
+		{
+			name: "resource outside project namespace without authorization",
+			client: fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithRESTMapper(restMapper).
+				WithObjects(otherNamespaceDeployment).
+				Build(),
+			input: KubernetesHealthInput{
+				Resources: []KubernetesResourceHealthCheck{{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Namespace:  "other-namespace",
+					Name:       "healthy",
+				}},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], "does not permit health checks by Kargo Stage")
+				require.Empty(t, res.Output[resourceStatusesKey])
+			},
+		},
+		{
+			name: "resources outside project namespace selected by label",
+			client: fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithRESTMapper(restMapper).
+				WithObjects(otherNamespaceDeployment, authorizedDeployment).
+				Build(),
+			input: KubernetesHealthInput{
+				Resources: []KubernetesResourceHealthCheck{{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Namespace:  "other-namespace",
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"app": "fake-app"},
+					},
+				}},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], `Deployment "healthy" in namespace "other-namespace"`)
+			},
+		},
+		{
+			name: "authorized resource outside project namespace",
+			client: fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithRESTMapper(restMapper).
+				WithObjects(authorizedDeployment).
+				Build(),
+			input: KubernetesHealthInput{
+				Resources: []KubernetesResourceHealthCheck{{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Namespace:  "other-namespace",
+					Name:       "authorized",
+				}},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateHealthy, res.Status)
+				require.Empty(t, res.Issues)
+			},
+		},
+		{
+			name: "worst health wins across resources",
+			client: fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithRESTMapper(restMapper).
+				WithObjects(healthyDeployment, progressingDeployment, failedJob).
+				Build(),
+			input: KubernetesHealthInput{
+				Resources: []KubernetesResourceHealthCheck{
+					{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Namespace:  testNamespace,
+						Name:       "progressing",
+					},
+					{
+						APIVersion: "batch/v1",
+						Kind:       "Job",
+						Namespace:  testNamespace,
+						Name:       "failed",
+					},
+				},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnhealthy, res.Status)
+				require.Len(t, res.Issues, 2)
+				require.Contains(t, res.Issues[1], "backoff limit exceeded")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			checker := newKubernetesChecker(testCase.client)
+			testCase.assertions(
+				t,
+				checker.check(context.Background(), testNamespace, testStage, testCase.input),
+			)
+		})
+	}
+}
+
+func Test_getResourceHealth(t *testing.T) {
+	testCases := []struct {
+		name           string
+		obj            map[string]any
+		expectedHealth kargoapi.HealthState
+		expectedMsg    string
+	}{
+		{
+			name: "resource being deleted",
+			obj: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+				"metadata": map[string]any{
+					"name":              "fake-pod",
+					"deletionTimestamp": "2024-01-01T00:00:00Z",
+				},
+			},
+			expectedHealth: kargoapi.HealthStateProgressing,
+			expectedMsg:    "resource is being deleted",
+		},
+		{
+			name: "Deployment with unobserved generation",
+			obj: map[string]any{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]any{"name": "fake", "generation": int64(2)},
+				"status":     map[string]any{"observedGeneration": int64(1)},
+			},
+			expectedHealth: kargoapi.HealthStateProgressing,
+			expectedMsg:    "waiting for the latest generation to be observed",
+		},
+		{
+			name: "Deployment exceeding progress deadline",
+			obj: map[string]any{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"status": map[string]any{
+					"conditions": []any{map[string]any{
+						"type":   "Progressing",
+						"status": "False",
+						"reason": "ProgressDeadlineExceeded",
+					}},
+				},
+			},
+			expectedHealth: kargoapi.HealthStateUnhealthy,
+			expectedMsg:    "rollout has exceeded its progress deadline",
+		},
+		{
+			name: "Deployment with old replicas pending termination",
+			obj: map[string]any{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"spec":       map[string]any{"replicas": int64(1)},
+				"status": map[string]any{
+					"replicas":        int64(2),
+					"updatedReplicas": int64(1),
+				},
+			},
+			expectedHealth: kargoapi.HealthStateProgressing,
+			expectedMsg:    "1 old replicas are pending termination",
+		},
+		{
+			name: "StatefulSet with replicas not ready",
+			obj: map[string]any{
+				"apiVersion": "apps/v1",
+				"kind":       "StatefulSet",
+				"spec":       map[string]any{"replicas": int64(3)},
+				"status":     map[string]any{"readyReplicas": int64(2)},
+			},
+			expectedHealth: kargoapi.HealthStateProgressing,
+			expectedMsg:    "2 of 3 replicas are ready",
+		},
+		{
+			name: "StatefulSet with pending revision",
+			obj: map[string]any{
+				"apiVersion": "apps/v1",
+				"kind":       "StatefulSet",
+				"spec":       map[string]any{"replicas": int64(2)},
+				"status": map[string]any{
+					"readyReplicas":   int64(2),
+					"updatedReplicas": int64(1),
+					"currentRevision": "rev-1",
+					"updateRevision":  "rev-2",
+				},
+			},
+			expectedHealth: kargoapi.HealthStateProgressing,
+			expectedMsg:    "1 of 2 replicas have been updated",
+		},
+		{
+			name: "StatefulSet with partitioned rollout complete",
+			obj: map[string]any{
+				"apiVersion": "apps/v1",
+				"kind":       "StatefulSet",
+				"spec": map[string]any{
+					"replicas": int64(3),
+					"updateStrategy": map[string]any{
+						"type":          "RollingUpdate",
+						"rollingUpdate": map[string]any{"partition": int64(2)},
+					},
+				},
+				"status": map[string]any{
+					"readyReplicas":   int64(3),
+					"updatedReplicas": int64(1),
+					"currentRevision": "rev-1",
+					"updateRevision":  "rev-2",
+				},
+			},
+			expectedHealth: kargoapi.HealthStateHealthy,
+		},
+		{
+			name: "DaemonSet with pods unavailable",
+			obj: map[string]any{
+				"apiVersion": "apps/v1",
+				"kind":       "DaemonSet",
+				"status": map[string]any{
+					"desiredNumberScheduled": int64(3),
+					"updatedNumberScheduled": int64(3),
+					"numberAvailable":        int64(2),
+				},
+			},
+			expectedHealth: kargoapi.HealthStateProgressing,
+			expectedMsg:    "2 of 3 pods are available",
+		},
+		{
+			name: "complete Job",
+			obj: map[string]any{
+				"apiVersion": "batch/v1",
+				"kind":       "Job",
+				"status": map[string]any{
+					"conditions": []any{map[string]any{
+						"type":   "Complete",
+						"status": "True",
+					}},
+				},
+			},
+			expectedHealth: kargoapi.HealthStateHealthy,
+		},
+		{
+			name: "running Job",
+			obj: map[string]any{
+				"apiVersion": "batch/v1",
+				"kind":       "Job",
+			},
+			expectedHealth: kargoapi.HealthStateProgressing,
+			expectedMsg:    "job has not yet completed",
+		},
+		{
+			name: "resource with Ready condition True",
+			obj: map[string]any{
+				"apiVersion": "example.com/v1",
+				"kind":       "Widget",
+				"status": map[string]any{
+					"conditions": []any{map[string]any{
+						"type":   "Ready",
+						"status": "True",
+					}},
+				},
+			},
+			expectedHealth: kargoapi.HealthStateHealthy,
+		},
+		{
+			name: "resource with Ready condition False",
+			obj: map[string]any{
+				"apiVersion": "example.com/v1",
+				"kind":       "Widget",
+				"status": map[string]any{
+					"conditions": []any{map[string]any{
+						"type":    "Ready",
+						"status":  "False",
+						"message": "something went wrong",
+					}},
+				},
+			},
+			expectedHealth: kargoapi.HealthStateUnhealthy,
+			expectedMsg:    "resource is not ready: something went wrong",
+		},
+		{
+			name: "resource with Ready condition Unknown",
+			obj: map[string]any{
+				"apiVersion": "example.com/v1",
+				"kind":       "Widget",
+				"status": map[string]any{
+					"conditions": []any{map[string]any{
+						"type":    "Ready",
+						"status":  "Unknown",
+						"message": "reconciling",
+					}},
+				},
+			},
+			expectedHealth: kargoapi.HealthStateProgressing,
+			expectedMsg:    "resource readiness is unknown: reconciling",
+		},
+		{
+			name: "resource with unobserved generation",
+			obj: map[string]any{
+				"apiVersion": "example.com/v1",
+				"kind":       "Widget",
+				"metadata":   map[string]any{"name": "fake", "generation": int64(3)},
+				"status":     map[string]any{"observedGeneration": int64(2)},
+			},
+			expectedHealth: kargoapi.HealthStateProgressing,
+			expectedMsg:    "waiting for the latest generation to be observed",
+		},
+		{
+			name: "resource without status conventions",
+			obj: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+			},
+			expectedHealth: kargoapi.HealthStateHealthy,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			state, msg := getResourceHealth(&unstructured.Unstructured{Object: testCase.obj})
+			require.Equal(t, testCase.expectedHealth, state)
+			require.Equal(t, testCase.expectedMsg, msg)
+		})
+	}
+}
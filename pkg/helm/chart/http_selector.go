@@ -21,6 +21,9 @@ type httpSelector struct {
 	indexURL  string
 	chartName string
 	creds     *helm.Credentials
+	// appVersions maps versions of the chart to their appVersions, as found in
+	// the repository index the last time it was retrieved.
+	appVersions map[string]string
 }
 
 func newHTTPSelector(
@@ -43,8 +46,56 @@ func newHTTPSelector(
 }
 
 // Select implements Selector.
-func (h *httpSelector) Select(context.Context) ([]string, error) {
-	req, err := http.NewRequest(http.MethodGet, h.indexURL, nil)
+func (h *httpSelector) Select(ctx context.Context) ([]string, error) {
+	entries, err := h.getIndexEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	semvers := make(semver.Collection, 0, len(entries))
+	for _, entry := range entries {
+		sv, err := semver.NewVersion(entry.Version)
+		if err == nil {
+			semvers = append(semvers, sv)
+		}
+	}
+	semvers = h.filterSemvers(semvers)
+	h.sort(semvers)
+	return h.semversToVersionStrings(semvers), nil
+}
+
+// AppVersions implements Selector.
+func (h *httpSelector) AppVersions(
+	ctx context.Context,
+	versions []string,
+) (map[string]string, error) {
+	if h.appVersions == nil {
+		if _, err := h.getIndexEntries(ctx); err != nil {
+			return nil, err
+		}
+	}
+	appVersions := make(map[string]string, len(versions))
+	for _, version := range versions {
+		if appVersion, ok := h.appVersions[version]; ok {
+			appVersions[version] = appVersion
+		}
+	}
+	return appVersions, nil
+}
+
+// httpIndexEntry is an entry for a single version of a chart in the index of
+// a classic (http/s-based) Helm chart repository.
+type httpIndexEntry struct {
+	Version    string `yaml:"version,omitempty"`
+	AppVersion string `yaml:"appVersion,omitempty"`
+}
+
+// getIndexEntries retrieves the repository index and returns the entries for
+// all versions of the chart. As a side effect, the appVersions of all versions
+// of the chart are recorded.
+func (h *httpSelector) getIndexEntries(
+	ctx context.Context,
+) ([]httpIndexEntry, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, h.indexURL, nil)
 	if err != nil {
 		return nil,
 			fmt.Errorf("error preparing HTTP/S request to %q: %w", h.indexURL, err)
@@ -75,9 +126,7 @@ func (h *httpSelector) Select(context.Context) ([]string, error) {
 			fmt.Errorf("error reading repository index from %q: %w", h.indexURL, err)
 	}
 	index := struct {
-		Entries map[string][]struct {
-			Version string `json:"version,omitempty"`
-		} `json:"entries,omitempty"`
+		Entries map[string][]httpIndexEntry `json:"entries,omitempty"`
 	}{}
 	if err = yaml.Unmarshal(resBodyBytes, &index); err != nil {
 		return nil, fmt.Errorf(
@@ -85,18 +134,12 @@ func (h *httpSelector) Select(context.Context) ([]string, error) {
 			h.indexURL, err,
 		)
 	}
-	entries, ok := index.Entries[h.chartName]
-	if !ok {
-		return nil, nil
-	}
-	semvers := make(semver.Collection, 0, len(entries))
+	entries := index.Entries[h.chartName]
+	h.appVersions = make(map[string]string, len(entries))
 	for _, entry := range entries {
-		sv, err := semver.NewVersion(entry.Version)
-		if err == nil {
-			semvers = append(semvers, sv)
+		if entry.AppVersion != "" {
+			h.appVersions[entry.Version] = entry.AppVersion
 		}
 	}
-	semvers = h.filterSemvers(semvers)
-	h.sort(semvers)
-	return h.semversToVersionStrings(semvers), nil
+	return entries, nil
 }
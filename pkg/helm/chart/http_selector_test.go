@@ -152,3 +152,44 @@ func Test_httpSelector_Select(t *testing.T) {
 		})
 	}
 }
+
+func Test_httpSelector_AppVersions(t *testing.T) {
+	testServer := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				if r.URL.Path != "/fake-repo/index.yaml" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+				_, err := w.Write([]byte(`entries:
+  fake-chart:
+    - version: 1.0.0
+      appVersion: abc123
+    - version: 1.1.0
+    - version: 1.2.0
+      appVersion: def456
+`))
+				require.NoError(t, err)
+			},
+		),
+	)
+	defer testServer.Close()
+
+	s := &httpSelector{
+		baseSelector: &baseSelector{},
+		indexURL:     fmt.Sprintf("%s/fake-repo/index.yaml", testServer.URL),
+		chartName:    "fake-chart",
+	}
+	appVersions, err := s.AppVersions(
+		context.Background(),
+		[]string{"1.2.0", "1.1.0", "1.0.0"},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		map[string]string{"1.2.0": "def456", "1.0.0": "abc123"},
+		appVersions,
+	)
+}
@@ -2,17 +2,39 @@ package chart
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"github.com/Masterminds/semver/v3"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
 	"oras.land/oras-go/v2/registry"
 	"oras.land/oras-go/v2/registry/remote"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/cache"
 	"github.com/akuity/kargo/pkg/helm"
+	"github.com/akuity/kargo/pkg/os"
+	"github.com/akuity/kargo/pkg/types"
 )
 
+// appVersionCache caches the appVersions of versions of charts from OCI
+// repositories, which are expensive to look up. Cache keys are of the form
+// <repo URL>:<version>.
+var appVersionCache cache.Cache[string]
+
+func init() {
+	var err error
+	appVersionCache, err = cache.NewInMemoryCache[string](
+		types.MustParseInt(os.GetEnv("MAX_CHART_APP_VERSION_CACHE_ENTRIES", "10000")),
+	)
+	if err != nil {
+		panic("failed to initialize chart appVersion cache: " + err.Error())
+	}
+}
+
 // ociSelector is an implementation of Selector that interacts with OCI Helm
 // chart repositories.
 type ociSelector struct {
@@ -79,3 +101,76 @@ func (o *ociSelector) Select(ctx context.Context) ([]string, error) {
 	o.sort(semvers)
 	return o.semversToVersionStrings(semvers), nil
 }
+
+// AppVersions implements Selector.
+func (o *ociSelector) AppVersions(
+	ctx context.Context,
+	versions []string,
+) (map[string]string, error) {
+	appVersions := make(map[string]string, len(versions))
+	for _, version := range versions {
+		cacheKey := fmt.Sprintf("%s:%s", o.repoURL, version)
+		appVersion, found, err := appVersionCache.Get(ctx, cacheKey)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error retrieving appVersion of chart version %q from cache: %w",
+				version, err,
+			)
+		}
+		if !found {
+			if appVersion, err = o.getAppVersion(ctx, version); err != nil {
+				return nil, err
+			}
+			if err = appVersionCache.Set(ctx, cacheKey, appVersion); err != nil {
+				return nil, fmt.Errorf(
+					"error caching appVersion of chart version %q: %w",
+					version, err,
+				)
+			}
+		}
+		if appVersion != "" {
+			appVersions[version] = appVersion
+		}
+	}
+	return appVersions, nil
+}
+
+// getAppVersion retrieves the appVersion of the specified version of the chart
+// from the chart's configuration blob, which holds the contents of its
+// Chart.yaml.
+func (o *ociSelector) getAppVersion(
+	ctx context.Context,
+	version string,
+) (string, error) {
+	// See Select() for why "+" is replaced with "_".
+	tag := strings.ReplaceAll(version, "+", "_")
+	_, manifestBytes, err := oras.FetchBytes(ctx, o.repo, tag, oras.DefaultFetchBytesOptions)
+	if err != nil {
+		return "", fmt.Errorf(
+			"error retrieving manifest of chart version %q from repository %q: %w",
+			version, o.repoURL, err,
+		)
+	}
+	manifest := ocispec.Manifest{}
+	if err = json.Unmarshal(manifestBytes, &manifest); err != nil {
+		return "", fmt.Errorf(
+			"error unmarshaling manifest of chart version %q: %w", version, err,
+		)
+	}
+	configBytes, err := content.FetchAll(ctx, o.repo, manifest.Config)
+	if err != nil {
+		return "", fmt.Errorf(
+			"error retrieving configuration of chart version %q from repository %q: %w",
+			version, o.repoURL, err,
+		)
+	}
+	config := struct {
+		AppVersion string `json:"appVersion,omitempty"`
+	}{}
+	if err = json.Unmarshal(configBytes, &config); err != nil {
+		return "", fmt.Errorf(
+			"error unmarshaling configuration of chart version %q: %w", version, err,
+		)
+	}
+	return config.AppVersion, nil
+}
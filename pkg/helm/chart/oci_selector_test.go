@@ -2,9 +2,19 @@ package chart
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 
+	ociregistry "github.com/google/go-containerregistry/pkg/registry"
+	"github.com/opencontainers/image-spec/specs-go"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 )
@@ -70,3 +80,66 @@ func Test_ociSelector_Select(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEmpty(t, versions)
 }
+
+func Test_ociSelector_AppVersions(t *testing.T) {
+	srv := httptest.NewServer(
+		ociregistry.New(ociregistry.Logger(log.New(io.Discard, "", 0))),
+	)
+	t.Cleanup(srv.Close)
+	srvURL, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	repoURL := fmt.Sprintf("oci://%s/example/chart", srvURL.Host)
+
+	s, err := newOCISelector(kargoapi.ChartSubscription{RepoURL: repoURL}, nil)
+	require.NoError(t, err)
+	o, ok := s.(*ociSelector)
+	require.True(t, ok)
+	o.repo.PlainHTTP = true
+
+	// pushChart pushes a minimal chart having the provided metadata.
+	pushChart := func(tag string, metadata string) {
+		t.Helper()
+		ctx := context.Background()
+		config, err := oras.PushBytes(
+			ctx,
+			o.repo,
+			"application/vnd.cncf.helm.config.v1+json",
+			[]byte(metadata),
+		)
+		require.NoError(t, err)
+		layer, err := oras.PushBytes(
+			ctx,
+			o.repo,
+			"application/vnd.cncf.helm.chart.content.v1.tar+gzip",
+			[]byte("fake-chart-content"),
+		)
+		require.NoError(t, err)
+		manifest, err := json.Marshal(ocispec.Manifest{
+			Versioned: specs.Versioned{SchemaVersion: 2},
+			MediaType: ocispec.MediaTypeImageManifest,
+			Config:    config,
+			Layers:    []ocispec.Descriptor{layer},
+		})
+		require.NoError(t, err)
+		_, err = oras.TagBytes(ctx, o.repo, ocispec.MediaTypeImageManifest, manifest, tag)
+		require.NoError(t, err)
+	}
+	pushChart("1.0.0", `{"name":"chart","version":"1.0.0","appVersion":"abc123"}`)
+	pushChart("1.1.0_build.1", `{"name":"chart","version":"1.1.0+build.1","appVersion":"def456"}`)
+	pushChart("1.2.0", `{"name":"chart","version":"1.2.0"}`)
+
+	versions, err := s.Select(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"1.2.0", "1.1.0+build.1", "1.0.0"}, versions)
+
+	appVersions, err := s.AppVersions(context.Background(), versions)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		map[string]string{"1.1.0+build.1": "def456", "1.0.0": "abc123"},
+		appVersions,
+	)
+
+	_, err = s.AppVersions(context.Background(), []string{"2.0.0"})
+	require.ErrorContains(t, err, "error retrieving manifest of chart version")
+}
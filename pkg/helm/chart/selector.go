@@ -18,6 +18,10 @@ type Selector interface {
 	MatchesVersion(string) bool
 	// Select selects charts from a Helm chart repository.
 	Select(context.Context) ([]string, error)
+	// AppVersions returns the appVersions of the specified versions of the
+	// chart, keyed by version. Versions for which no appVersion is known are
+	// omitted.
+	AppVersions(ctx context.Context, versions []string) (map[string]string, error)
 }
 
 // NewSelector returns some implementation of the Selector interface that
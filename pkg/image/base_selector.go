@@ -1,6 +1,7 @@
 package image
 
 import (
+	"context"
 	"fmt"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -15,15 +16,17 @@ import (
 type baseSelector struct {
 	platformConstraint *platformConstraint
 	repoClient         *repositoryClient
+	verifier           *Verifier
 }
 
 func newBaseSelector(
 	sub kargoapi.ImageSubscription,
 	creds *Credentials,
+	verifier *Verifier,
 	cacheByTag bool,
 ) (*baseSelector, error) {
 	var err error
-	s := &baseSelector{}
+	s := &baseSelector{verifier: verifier}
 	if sub.Platform != "" {
 		if s.platformConstraint, err = parsePlatformConstraint(sub.Platform); err != nil {
 			return nil, fmt.Errorf(
@@ -55,9 +58,20 @@ func (b *baseSelector) getLoggerContext() []any {
 		"registry", b.repoClient.registry.name,
 		"image", b.repoClient.repoURL,
 		"platformConstrained", b.platformConstraint != nil,
+		"verified", b.verifier != nil,
 	}
 }
 
+// verify returns a boolean indicating whether the provided image satisfies the
+// selector's verification policy, if any. Images that do not are recorded as
+// rejected by the selector's Verifier.
+func (b *baseSelector) verify(ctx context.Context, img image) (bool, error) {
+	if b.verifier == nil {
+		return true, nil
+	}
+	return b.verifier.verify(ctx, b.repoClient, img)
+}
+
 // imagesToAPIImages converts a slice of internal image to a slice of
 // kargoapi.DiscoveredImageReference, which can be directly used by a caller
 // performing artifact discovery. If the number of tags provided exceeds the
@@ -57,7 +57,7 @@ func TestNewBaseSelector(t *testing.T) {
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			s, err := newBaseSelector(testCase.sub, nil, true)
+			s, err := newBaseSelector(testCase.sub, nil, nil, true)
 			testCase.assertions(t, s, err)
 		})
 	}
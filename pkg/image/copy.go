@@ -0,0 +1,107 @@
+package image
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+// CopyOptions represents options for copying an image from one repository to
+// another.
+type CopyOptions struct {
+	// SourceCredentials are the credentials for reading from the source
+	// repository. If nil, the source repository is accessed anonymously.
+	SourceCredentials *Credentials
+	// TargetCredentials are the credentials for writing to the target
+	// repository. If nil, the target repository is accessed anonymously.
+	TargetCredentials *Credentials
+	// TargetTag is the tag to apply to the image in the target repository. If
+	// empty, the image is pushed to the target repository by digest only.
+	TargetTag string
+	// InsecureSkipTLSVerify indicates whether to skip TLS verification when
+	// interacting with either repository.
+	InsecureSkipTLSVerify bool
+}
+
+// Copy copies the image referenced by srcRef, which must include a tag or
+// digest, to the repository identified by targetRepoURL. If the image is a
+// multi-platform image, the image index and the images for all platforms are
+// copied. Manifests are copied verbatim, so the digest of the image is
+// preserved. The digest of the copied image is returned.
+func Copy(
+	ctx context.Context,
+	srcRef string,
+	targetRepoURL string,
+	opts *CopyOptions,
+) (string, error) {
+	if opts == nil {
+		opts = &CopyOptions{}
+	}
+
+	src, err := name.ParseReference(srcRef)
+	if err != nil {
+		return "", fmt.Errorf("error parsing image reference %s: %w", srcRef, err)
+	}
+	targetRepo, err := name.NewRepository(targetRepoURL)
+	if err != nil {
+		return "", fmt.Errorf("error parsing image repo URL %s: %w", targetRepoURL, err)
+	}
+
+	srcOpts := append(
+		newRemoteOptions(
+			getRegistry(src.Context().RegistryStr()),
+			opts.InsecureSkipTLSVerify,
+			opts.SourceCredentials,
+		),
+		remote.WithContext(ctx),
+	)
+	desc, err := remote.Get(src, srcOpts...)
+	if err != nil {
+		return "", fmt.Errorf("error retrieving image %s: %w", srcRef, err)
+	}
+	digest := desc.Digest.String()
+
+	var target name.Reference = targetRepo.Digest(digest)
+	if opts.TargetTag != "" {
+		if target, err = name.NewTag(
+			fmt.Sprintf("%s:%s", targetRepo.String(), opts.TargetTag),
+		); err != nil {
+			return "", fmt.Errorf("error parsing image tag %s: %w", opts.TargetTag, err)
+		}
+	}
+	targetOpts := append(
+		newRemoteOptions(
+			getRegistry(targetRepo.RegistryStr()),
+			opts.InsecureSkipTLSVerify,
+			opts.TargetCredentials,
+		),
+		remote.WithContext(ctx),
+	)
+
+	switch {
+	case desc.MediaType.IsIndex():
+		idx, err := desc.ImageIndex()
+		if err != nil {
+			return "", fmt.Errorf("error reading image index %s: %w", srcRef, err)
+		}
+		if err = remote.WriteIndex(target, idx, targetOpts...); err != nil {
+			return "", fmt.Errorf("error writing image index to %s: %w", target, err)
+		}
+	case desc.MediaType.IsImage():
+		img, err := desc.Image()
+		if err != nil {
+			return "", fmt.Errorf("error reading image %s: %w", srcRef, err)
+		}
+		if err = remote.Write(target, img, targetOpts...); err != nil {
+			return "", fmt.Errorf("error writing image to %s: %w", target, err)
+		}
+	default:
+		return "", fmt.Errorf(
+			"image %s has unsupported media type %q", srcRef, desc.MediaType,
+		)
+	}
+
+	return digest, nil
+}
@@ -0,0 +1,125 @@
+package image
+
+import (
+	"context"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrregistry "github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCopy(t *testing.T) {
+	srv := httptest.NewServer(ggcrregistry.New())
+	t.Cleanup(srv.Close)
+	srvURL, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	registryHost := srvURL.Host
+
+	idx, err := random.Index(64, 1, 2)
+	require.NoError(t, err)
+	idxDigest, err := idx.Digest()
+	require.NoError(t, err)
+	idxRef, err := name.ParseReference(registryHost + "/src/index:v1")
+	require.NoError(t, err)
+	require.NoError(t, remote.WriteIndex(idxRef, idx))
+
+	img, err := random.Image(64, 1)
+	require.NoError(t, err)
+	imgDigest, err := img.Digest()
+	require.NoError(t, err)
+	imgRef, err := name.ParseReference(registryHost + "/src/image:v1")
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(imgRef, img))
+
+	testCases := []struct {
+		name          string
+		srcRef        string
+		targetRepoURL string
+		opts          *CopyOptions
+		assertions    func(*testing.T, string, error)
+	}{
+		{
+			name:          "invalid source reference",
+			srcRef:        "invalid reference!",
+			targetRepoURL: registryHost + "/target/image",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "error parsing image reference")
+			},
+		},
+		{
+			name:          "invalid target repo URL",
+			srcRef:        imgRef.String(),
+			targetRepoURL: "invalid repo!",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "error parsing image repo URL")
+			},
+		},
+		{
+			name:          "source image not found",
+			srcRef:        registryHost + "/src/missing:v1",
+			targetRepoURL: registryHost + "/target/image",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "error retrieving image")
+			},
+		},
+		{
+			name:          "copies image index by digest with tag",
+			srcRef:        registryHost + "/src/index@" + idxDigest.String(),
+			targetRepoURL: registryHost + "/target/index",
+			opts:          &CopyOptions{TargetTag: "prod"},
+			assertions: func(t *testing.T, digest string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, idxDigest.String(), digest)
+
+				ref, err := name.ParseReference(registryHost + "/target/index:prod")
+				require.NoError(t, err)
+				copied, err := remote.Index(ref)
+				require.NoError(t, err)
+				copiedDigest, err := copied.Digest()
+				require.NoError(t, err)
+				require.Equal(t, idxDigest, copiedDigest)
+
+				manifest, err := idx.IndexManifest()
+				require.NoError(t, err)
+				require.Len(t, manifest.Manifests, 2)
+				for _, m := range manifest.Manifests {
+					_, err = remote.Image(ref.Context().Digest(m.Digest.String()))
+					require.NoError(t, err)
+				}
+			},
+		},
+		{
+			name:          "copies image by tag without target tag",
+			srcRef:        imgRef.String(),
+			targetRepoURL: registryHost + "/target/image",
+			assertions: func(t *testing.T, digest string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, imgDigest.String(), digest)
+
+				repo, err := name.NewRepository(registryHost + "/target/image")
+				require.NoError(t, err)
+				_, err = remote.Image(repo.Digest(digest))
+				require.NoError(t, err)
+				tags, err := remote.List(repo)
+				require.NoError(t, err)
+				require.Empty(t, tags)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			digest, err := Copy(
+				context.Background(),
+				testCase.srcRef,
+				testCase.targetRepoURL,
+				testCase.opts,
+			)
+			testCase.assertions(t, digest, err)
+		})
+	}
+}
@@ -33,10 +33,12 @@ type digestSelector struct {
 func newDigestSelector(
 	sub kargoapi.ImageSubscription,
 	creds *Credentials,
+	verifier *Verifier,
 ) (Selector, error) {
 	base, err := newBaseSelector(
 		sub,
 		creds,
+		verifier,
 		false, // Do not use cached tags; this selector assumes tags are mutable
 	)
 	if err != nil {
@@ -84,6 +86,14 @@ func (d *digestSelector) Select(
 		return nil, nil
 	}
 
+	verified, err := d.verify(ctx, *img)
+	if err != nil {
+		return nil, err
+	}
+	if !verified {
+		return nil, nil
+	}
+
 	logger.Trace("found image with tag")
 	return d.imagesToAPIImages([]image{*img}, 0), nil
 }
@@ -37,7 +37,7 @@ func TestNewDigestSelector(t *testing.T) {
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			s, err := newDigestSelector(testCase.sub, nil)
+			s, err := newDigestSelector(testCase.sub, nil, nil)
 			testCase.assertions(t, s, err)
 		})
 	}
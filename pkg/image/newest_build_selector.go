@@ -31,8 +31,9 @@ type newestBuildSelector struct {
 func newNewestBuildSelector(
 	sub kargoapi.ImageSubscription,
 	creds *Credentials,
+	verifier *Verifier,
 ) (Selector, error) {
-	tagBased, err := newTagBasedSelector(sub, creds)
+	tagBased, err := newTagBasedSelector(sub, creds, verifier)
 	if err != nil {
 		return nil, fmt.Errorf("error building tag based selector: %w", err)
 	}
@@ -85,6 +86,17 @@ func (n *newestBuildSelector) Select(
 	logger.Trace("sorting images by date")
 	n.sort(images)
 
+	if n.verifier != nil {
+		logger.Trace("verifying images")
+		if images, err = n.verifyImages(ctx, images); err != nil {
+			return nil, err
+		}
+		if len(images) == 0 {
+			logger.Trace("no images passed verification")
+			return nil, nil
+		}
+	}
+
 	limit := n.discoveryLimit
 	if limit == 0 || limit > len(images) {
 		limit = len(images)
@@ -181,6 +193,35 @@ func (n *newestBuildSelector) getImagesByTags(
 	return images, nil
 }
 
+// verifyImages verifies the provided images SEQUENTIALLY and in order,
+// returning only those that pass verification. This repeats until the list of
+// provided images has been exhausted or the number of verified images equals
+// the selector's discovery limit. Verification is deferred until after images
+// have been sorted so that no more images than necessary are verified.
+func (n *newestBuildSelector) verifyImages(
+	ctx context.Context,
+	images []image,
+) ([]image, error) {
+	limit := n.discoveryLimit
+	if limit == 0 || limit > len(images) {
+		limit = len(images)
+	}
+	verified := make([]image, 0, limit)
+	for _, img := range images {
+		if len(verified) >= limit {
+			break
+		}
+		ok, err := n.verify(ctx, img)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			verified = append(verified, img)
+		}
+	}
+	return verified, nil
+}
+
 // sort sorts the provided images in place, in chronologically descending order,
 // breaking ties lexically by tag.
 func (n *newestBuildSelector) sort(images []image) {
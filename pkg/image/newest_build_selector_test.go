@@ -38,7 +38,7 @@ func TestNewNewestBuildSelector(t *testing.T) {
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			s, err := newNewestBuildSelector(testCase.sub, nil)
+			s, err := newNewestBuildSelector(testCase.sub, nil, nil)
 			testCase.assertions(t, s, err)
 		})
 	}
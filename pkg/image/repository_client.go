@@ -99,34 +99,13 @@ func newRepositoryClient(
 	}
 	reg := getRegistry(repoRef.Context().RegistryStr())
 
-	httpTransport := cleanhttp.DefaultTransport()
-	if insecureSkipTLSVerify {
-		httpTransport.TLSClientConfig = &tls.Config{
-			InsecureSkipVerify: insecureSkipTLSVerify, // nolint: gosec
-		}
-	}
-
-	if creds == nil {
-		creds = &Credentials{}
-	}
-	var auth authn.Authenticator = &authn.Basic{
-		Username: creds.Username,
-		Password: creds.Password,
-	}
-
 	r := &repositoryClient{
-		imageCache: imageCache,
-		cacheByTag: cacheByTag,
-		registry:   reg,
-		repoURL:    repoURL,
-		repoRef:    repoRef,
-		remoteOptions: []remote.Option{
-			remote.WithTransport(&rateLimitedRoundTripper{
-				limiter:              reg.rateLimiter,
-				internalRoundTripper: httpTransport,
-			}),
-			remote.WithAuth(auth),
-		},
+		imageCache:    imageCache,
+		cacheByTag:    cacheByTag,
+		registry:      reg,
+		repoURL:       repoURL,
+		repoRef:       repoRef,
+		remoteOptions: newRemoteOptions(reg, insecureSkipTLSVerify, creds),
 	}
 
 	r.getImageByTagFn = r.getImageByTag
@@ -451,6 +430,37 @@ func getCreationTime(sources []map[string]string, fallback *time.Time) *time.Tim
 	return fallback
 }
 
+// newRemoteOptions returns options for interacting with the given registry.
+// All interactions are subject to the registry's rate limit.
+func newRemoteOptions(
+	reg *registry,
+	insecureSkipTLSVerify bool,
+	creds *Credentials,
+) []remote.Option {
+	httpTransport := cleanhttp.DefaultTransport()
+	if insecureSkipTLSVerify {
+		httpTransport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: insecureSkipTLSVerify, // nolint: gosec
+		}
+	}
+
+	if creds == nil {
+		creds = &Credentials{}
+	}
+	var auth authn.Authenticator = &authn.Basic{
+		Username: creds.Username,
+		Password: creds.Password,
+	}
+
+	return []remote.Option{
+		remote.WithTransport(&rateLimitedRoundTripper{
+			limiter:              reg.rateLimiter,
+			internalRoundTripper: httpTransport,
+		}),
+		remote.WithAuth(auth),
+	}
+}
+
 // rateLimitedRoundTripper is a rate limited implementation of
 // http.RoundTripper.
 type rateLimitedRoundTripper struct {
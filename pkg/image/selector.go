@@ -19,11 +19,13 @@ type Selector interface {
 
 // NewSelector returns some implementation of the Selector interface that
 // selects images from a container image repository based on the provided
-// subscription.
+// subscription. If a non-nil Verifier is provided, only images it verifies are
+// selected.
 func NewSelector(
 	ctx context.Context,
 	sub kargoapi.ImageSubscription,
 	creds *Credentials,
+	verifier *Verifier,
 ) (Selector, error) {
 	// Pick an appropriate Selector implementation based on the subscription
 	// provided.
@@ -32,5 +34,5 @@ func NewSelector(
 		return nil, fmt.Errorf("error getting selector factory")
 	}
 	factory := reg.Value
-	return factory(sub, creds)
+	return factory(sub, creds, verifier)
 }
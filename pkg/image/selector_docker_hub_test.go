@@ -42,6 +42,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 					DiscoveryLimit:         1,
 				},
 				getDockerHubCreds(),
+				nil,
 			)
 			require.NoError(t, err)
 
@@ -60,6 +61,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 					DiscoveryLimit:         1,
 				},
 				getDockerHubCreds(),
+				nil,
 			)
 			require.NoError(t, err)
 
@@ -85,6 +87,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 					DiscoveryLimit:         1,
 				},
 				getDockerHubCreds(),
+				nil,
 			)
 			require.NoError(t, err)
 
@@ -104,6 +107,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 					DiscoveryLimit:         1,
 				},
 				getDockerHubCreds(),
+				nil,
 			)
 			require.NoError(t, err)
 
@@ -132,6 +136,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 					CacheByTag:             true,
 				},
 				getDockerHubCreds(),
+				nil,
 			)
 			require.NoError(t, err)
 
@@ -157,6 +162,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 					CacheByTag: true,
 				},
 				getDockerHubCreds(),
+				nil,
 			)
 			require.NoError(t, err)
 
@@ -187,6 +193,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 					CacheByTag:     true,
 				},
 				getDockerHubCreds(),
+				nil,
 			)
 			require.NoError(t, err)
 
@@ -213,6 +220,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 					CacheByTag:     true,
 				},
 				getDockerHubCreds(),
+				nil,
 			)
 			require.NoError(t, err)
 
@@ -241,6 +249,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 					CacheByTag:             true,
 				},
 				getDockerHubCreds(),
+				nil,
 			)
 			require.NoError(t, err)
 
@@ -260,6 +269,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 					CacheByTag:             true,
 				},
 				getDockerHubCreds(),
+				nil,
 			)
 			require.NoError(t, err)
 
@@ -285,6 +295,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 					CacheByTag:             true,
 				},
 				getDockerHubCreds(),
+				nil,
 			)
 			require.NoError(t, err)
 
@@ -305,6 +316,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 					CacheByTag:             true,
 				},
 				getDockerHubCreds(),
+				nil,
 			)
 			require.NoError(t, err)
 
@@ -333,6 +345,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 					CacheByTag:             true,
 				},
 				getDockerHubCreds(),
+				nil,
 			)
 			require.NoError(t, err)
 
@@ -352,6 +365,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 					CacheByTag:             true,
 				},
 				getDockerHubCreds(),
+				nil,
 			)
 			require.NoError(t, err)
 
@@ -381,6 +395,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 					CacheByTag:             true,
 				},
 				getDockerHubCreds(),
+				nil,
 			)
 			require.NoError(t, err)
 
@@ -401,6 +416,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 					CacheByTag:             true,
 				},
 				getDockerHubCreds(),
+				nil,
 			)
 			require.NoError(t, err)
 
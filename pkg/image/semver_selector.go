@@ -36,8 +36,9 @@ type semverSelector struct {
 func newSemverSelector(
 	sub kargoapi.ImageSubscription,
 	creds *Credentials,
+	verifier *Verifier,
 ) (Selector, error) {
-	tagBased, err := newTagBasedSelector(sub, creds)
+	tagBased, err := newTagBasedSelector(sub, creds, verifier)
 	if err != nil {
 		return nil, fmt.Errorf("error building tag based selector: %w", err)
 	}
@@ -39,8 +39,9 @@ func compileRegexes(regexStrs []string) ([]*regexp.Regexp, error) {
 func newTagBasedSelector(
 	sub kargoapi.ImageSubscription,
 	creds *Credentials,
+	verifier *Verifier,
 ) (*tagBasedSelector, error) {
-	base, err := newBaseSelector(sub, creds, sub.CacheByTag)
+	base, err := newBaseSelector(sub, creds, verifier, sub.CacheByTag)
 	if err != nil {
 		return nil, fmt.Errorf("error building base selector: %w", err)
 	}
@@ -135,7 +136,8 @@ func (t *tagBasedSelector) filterTags(tags []string) []string {
 }
 
 // getImagesByTags retrieves image metadata for the provided tags SEQUENTIALLY.
-// It discards any that does not match the selector's criteria. This repeats
+// It discards any that does not match the selector's criteria or fails
+// verification. This repeats
 // until the list of provided tags has been exhausted or it has found an amount
 // of image metadata equal to the selector's discovery limit.
 func (t *tagBasedSelector) getImagesByTags(
@@ -166,6 +168,14 @@ func (t *tagBasedSelector) getImagesByTags(
 			continue
 		}
 
+		verified, err := t.verify(ctx, *image)
+		if err != nil {
+			return nil, err
+		}
+		if !verified {
+			continue
+		}
+
 		logger.Trace(
 			"discovered image",
 			"tag", image.Tag,
@@ -0,0 +1,426 @@
+package image
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/logging"
+)
+
+const (
+	// cosignSignatureAnnotation is the annotation on a layer of a cosign
+	// signature manifest that holds the base64-encoded signature of the layer's
+	// content.
+	cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+	// dsseEnvelopeMediaType is the media type of layers of a cosign attestation
+	// manifest. Each such layer is a DSSE envelope wrapping an in-toto
+	// statement.
+	dsseEnvelopeMediaType = "application/vnd.dsse.envelope.v1+json"
+	// inTotoPayloadType is the payload type of DSSE envelopes wrapping in-toto
+	// statements.
+	inTotoPayloadType = "application/vnd.in-toto+json"
+)
+
+// Verifier verifies that images bear a valid signature and, optionally,
+// attestations satisfying a kargoapi.ImageVerification policy. Signatures and
+// attestations are expected to be stored in the image's repository using the
+// same conventions as cosign and to verify with a single public key. A
+// Verifier records every image it rejects. It is intended to be used for a
+// single discovery operation.
+type Verifier struct {
+	publicKey    crypto.PublicKey
+	attestations []attestationPolicy
+
+	mu       sync.Mutex
+	rejected []kargoapi.RejectedImageReference
+}
+
+// attestationPolicy is a compiled representation of a
+// kargoapi.ImageAttestationPolicy.
+type attestationPolicy struct {
+	predicateType string
+	conditions    []*vm.Program
+}
+
+// simpleSigningPayload is the subset of a cosign "simple signing" payload that
+// is relevant to verification.
+type simpleSigningPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// dsseEnvelope is a DSSE envelope.
+type dsseEnvelope struct {
+	PayloadType string `json:"payloadType"`
+	Payload     string `json:"payload"`
+	Signatures  []struct {
+		KeyID string `json:"keyid"`
+		Sig   string `json:"sig"`
+	} `json:"signatures"`
+}
+
+// inTotoStatement is an in-toto attestation statement.
+type inTotoStatement struct {
+	Type    string `json:"_type"`
+	Subject []struct {
+		Name   string            `json:"name"`
+		Digest map[string]string `json:"digest"`
+	} `json:"subject"`
+	PredicateType string `json:"predicateType"`
+	Predicate     any    `json:"predicate"`
+}
+
+// NewVerifier returns a Verifier that enforces the provided policy using the
+// provided PEM-encoded public key.
+func NewVerifier(
+	policy kargoapi.ImageVerification,
+	publicKeyPEM []byte,
+) (*Verifier, error) {
+	block, _ := pem.Decode(publicKeyPEM)
+	if block == nil {
+		return nil, errors.New("public key is not PEM-encoded")
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing public key: %w", err)
+	}
+	switch publicKey.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+	v := &Verifier{
+		publicKey:    publicKey,
+		attestations: make([]attestationPolicy, len(policy.Attestations)),
+	}
+	for i, att := range policy.Attestations {
+		v.attestations[i].predicateType = att.PredicateType
+		for _, cond := range att.Conditions {
+			program, err := expr.Compile(cond, expr.AsBool())
+			if err != nil {
+				return nil, fmt.Errorf(
+					"error compiling condition %q for attestations of type %q: %w",
+					cond, att.PredicateType, err,
+				)
+			}
+			v.attestations[i].conditions = append(v.attestations[i].conditions, program)
+		}
+	}
+	return v, nil
+}
+
+// Rejected returns references to all images rejected by the Verifier so far.
+func (v *Verifier) Rejected() []kargoapi.RejectedImageReference {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.rejected
+}
+
+// verify returns a boolean indicating whether the provided image, retrieved
+// using the provided repositoryClient, satisfies the Verifier's policy. If it
+// does not, the image is recorded as rejected. An error is returned only if
+// verification could not be completed.
+func (v *Verifier) verify(
+	ctx context.Context,
+	rc *repositoryClient,
+	img image,
+) (bool, error) {
+	logger := logging.LoggerFromContext(ctx).WithValues(
+		"tag", img.Tag,
+		"digest", img.Digest,
+	)
+	reason, err := v.getRejectionReason(ctx, rc, img.Digest)
+	if err != nil {
+		return false, fmt.Errorf(
+			"error verifying image with digest %s: %w", img.Digest, err,
+		)
+	}
+	if reason == "" {
+		logger.Trace("image verified")
+		return true, nil
+	}
+	logger.Debug("image rejected by verification policy", "reason", reason)
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.rejected = append(v.rejected, kargoapi.RejectedImageReference{
+		Tag:    img.Tag,
+		Digest: img.Digest,
+		Reason: reason,
+	})
+	return false, nil
+}
+
+// getRejectionReason returns the reason the image with the provided digest
+// does not satisfy the Verifier's policy. An empty string is returned if the
+// policy is satisfied.
+func (v *Verifier) getRejectionReason(
+	ctx context.Context,
+	rc *repositoryClient,
+	digest string,
+) (string, error) {
+	hash, err := v1.NewHash(digest)
+	if err != nil {
+		return "", fmt.Errorf("error parsing digest: %w", err)
+	}
+
+	sigLayers, err := getArtifactLayers(ctx, rc, hash, "sig")
+	if err != nil {
+		return "", err
+	}
+	if len(sigLayers) == 0 {
+		return "no signature found", nil
+	}
+	var signed bool
+	for _, layer := range sigLayers {
+		if signed = v.verifySignatureLayer(layer, digest); signed {
+			break
+		}
+	}
+	if !signed {
+		return "no signature verifies with the public key", nil
+	}
+
+	if len(v.attestations) == 0 {
+		return "", nil
+	}
+	attLayers, err := getArtifactLayers(ctx, rc, hash, "att")
+	if err != nil {
+		return "", err
+	}
+	statements := make([]inTotoStatement, 0, len(attLayers))
+	for _, layer := range attLayers {
+		if layer.mediaType != dsseEnvelopeMediaType {
+			continue
+		}
+		if statement := v.verifyAttestationLayer(layer, hash); statement != nil {
+			statements = append(statements, *statement)
+		}
+	}
+	for _, policy := range v.attestations {
+		if !policy.satisfiedBy(statements) {
+			return fmt.Sprintf(
+				"no verified attestation of type %q satisfies the policy",
+				policy.predicateType,
+			), nil
+		}
+	}
+	return "", nil
+}
+
+// verifySignatureLayer returns a boolean indicating whether the provided layer
+// of a signature manifest holds a simple signing payload for the provided
+// digest that verifies with the Verifier's public key.
+func (v *Verifier) verifySignatureLayer(layer artifactLayer, digest string) bool {
+	sig, err := base64.StdEncoding.DecodeString(layer.annotations[cosignSignatureAnnotation])
+	if err != nil || len(sig) == 0 {
+		return false
+	}
+	if !v.verifySignature(layer.content, sig) {
+		return false
+	}
+	var payload simpleSigningPayload
+	if err = json.Unmarshal(layer.content, &payload); err != nil {
+		return false
+	}
+	return payload.Critical.Image.DockerManifestDigest == digest
+}
+
+// verifyAttestationLayer returns the in-toto statement held by the provided
+// layer of an attestation manifest if it verifies with the Verifier's public
+// key and has the image with the provided digest as a subject. Otherwise, nil
+// is returned.
+func (v *Verifier) verifyAttestationLayer(
+	layer artifactLayer,
+	hash v1.Hash,
+) *inTotoStatement {
+	var envelope dsseEnvelope
+	if err := json.Unmarshal(layer.content, &envelope); err != nil {
+		return nil
+	}
+	if envelope.PayloadType != inTotoPayloadType {
+		return nil
+	}
+	payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return nil
+	}
+	pae := dssePAE(envelope.PayloadType, payload)
+	var signed bool
+	for _, s := range envelope.Signatures {
+		sig, err := base64.StdEncoding.DecodeString(s.Sig)
+		if err != nil {
+			continue
+		}
+		if signed = v.verifySignature(pae, sig); signed {
+			break
+		}
+	}
+	if !signed {
+		return nil
+	}
+	statement := &inTotoStatement{}
+	if err = json.Unmarshal(payload, statement); err != nil {
+		return nil
+	}
+	for _, subject := range statement.Subject {
+		if subject.Digest[hash.Algorithm] == hash.Hex {
+			return statement
+		}
+	}
+	return nil
+}
+
+// verifySignature returns a boolean indicating whether the provided signature
+// of the provided message verifies with the Verifier's public key.
+func (v *Verifier) verifySignature(msg, sig []byte) bool {
+	digest := sha256.Sum256(msg)
+	switch key := v.publicKey.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(key, digest[:], sig)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig) == nil
+	case ed25519.PublicKey:
+		return ed25519.Verify(key, msg, sig)
+	default:
+		return false
+	}
+}
+
+// satisfiedBy returns a boolean indicating whether any of the provided
+// statements satisfies the policy.
+func (a attestationPolicy) satisfiedBy(statements []inTotoStatement) bool {
+	for _, statement := range statements {
+		if statement.PredicateType != a.predicateType {
+			continue
+		}
+		subjects := make([]any, len(statement.Subject))
+		for i, subject := range statement.Subject {
+			subjects[i] = map[string]any{
+				"name":   subject.Name,
+				"digest": subject.Digest,
+			}
+		}
+		env := map[string]any{
+			"predicateType": statement.PredicateType,
+			"predicate":     statement.Predicate,
+			"subjects":      subjects,
+		}
+		satisfied := true
+		for _, cond := range a.conditions {
+			result, err := expr.Run(cond, env)
+			if err != nil {
+				// A predicate that lacks a field referenced by a condition simply
+				// fails to satisfy it.
+				satisfied = false
+				break
+			}
+			if ok, _ := result.(bool); !ok {
+				satisfied = false
+				break
+			}
+		}
+		if satisfied {
+			return true
+		}
+	}
+	return false
+}
+
+// artifactLayer is a layer of a signature or attestation manifest.
+type artifactLayer struct {
+	mediaType   string
+	annotations map[string]string
+	content     []byte
+}
+
+// getArtifactLayers retrieves the layers of the signature ("sig") or
+// attestation ("att") manifest associated with the image having the provided
+// digest. If no such manifest exists, nil is returned.
+func getArtifactLayers(
+	ctx context.Context,
+	rc *repositoryClient,
+	hash v1.Hash,
+	suffix string,
+) ([]artifactLayer, error) {
+	ref := rc.repoRef.Context().Tag(
+		fmt.Sprintf("%s-%s.%s", hash.Algorithm, hash.Hex, suffix),
+	)
+	opts := append(rc.remoteOptions, remote.WithContext(ctx))
+	desc, err := rc.remoteGetFn(ref, opts...)
+	if err != nil {
+		var te *transport.Error
+		if errors.As(err, &te) && te.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting manifest %q: %w", ref.String(), err)
+	}
+	img, err := desc.Image()
+	if err != nil {
+		return nil, fmt.Errorf("error getting image %q: %w", ref.String(), err)
+	}
+	manifest, err := img.Manifest()
+	if err != nil {
+		return nil, fmt.Errorf("error getting manifest %q: %w", ref.String(), err)
+	}
+	layers := make([]artifactLayer, 0, len(manifest.Layers))
+	for _, layerDesc := range manifest.Layers {
+		layer, err := img.LayerByDigest(layerDesc.Digest)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error getting layer %s of %q: %w", layerDesc.Digest, ref.String(), err,
+			)
+		}
+		r, err := layer.Compressed()
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error reading layer %s of %q: %w", layerDesc.Digest, ref.String(), err,
+			)
+		}
+		content, err := io.ReadAll(r)
+		_ = r.Close()
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error reading layer %s of %q: %w", layerDesc.Digest, ref.String(), err,
+			)
+		}
+		layers = append(layers, artifactLayer{
+			mediaType:   string(layerDesc.MediaType),
+			annotations: layerDesc.Annotations,
+			content:     content,
+		})
+	}
+	return layers, nil
+}
+
+// dssePAE returns the DSSE pre-authentication encoding of the provided payload
+// type and payload. This, and not the payload itself, is what is signed.
+func dssePAE(payloadType string, payload []byte) []byte {
+	var buf bytes.Buffer
+	_, _ = fmt.Fprintf(&buf, "DSSEv1 %d %s %d ", len(payloadType), payloadType, len(payload))
+	buf.Write(payload)
+	return buf.Bytes()
+}
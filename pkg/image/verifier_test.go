@@ -0,0 +1,329 @@
+package image
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"log"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	ociregistry "github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestNewVerifier(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	publicKeyPEM := marshalPublicKey(t, &key.PublicKey)
+
+	testCases := []struct {
+		name         string
+		policy       kargoapi.ImageVerification
+		publicKeyPEM []byte
+		assertions   func(*testing.T, *Verifier, error)
+	}{
+		{
+			name:         "public key not PEM-encoded",
+			publicKeyPEM: []byte("not a key"),
+			assertions: func(t *testing.T, _ *Verifier, err error) {
+				require.ErrorContains(t, err, "public key is not PEM-encoded")
+			},
+		},
+		{
+			name: "invalid public key",
+			publicKeyPEM: pem.EncodeToMemory(&pem.Block{
+				Type:  "PUBLIC KEY",
+				Bytes: []byte("garbage"),
+			}),
+			assertions: func(t *testing.T, _ *Verifier, err error) {
+				require.ErrorContains(t, err, "error parsing public key")
+			},
+		},
+		{
+			name: "invalid condition",
+			policy: kargoapi.ImageVerification{
+				Attestations: []kargoapi.ImageAttestationPolicy{{
+					PredicateType: "https://slsa.dev/provenance/v1",
+					Conditions:    []string{"predicate.("},
+				}},
+			},
+			publicKeyPEM: publicKeyPEM,
+			assertions: func(t *testing.T, _ *Verifier, err error) {
+				require.ErrorContains(t, err, "error compiling condition")
+			},
+		},
+		{
+			name: "success",
+			policy: kargoapi.ImageVerification{
+				Attestations: []kargoapi.ImageAttestationPolicy{{
+					PredicateType: "https://slsa.dev/provenance/v1",
+					Conditions:    []string{`predicate.builder.id == "ci"`},
+				}},
+			},
+			publicKeyPEM: publicKeyPEM,
+			assertions: func(t *testing.T, v *Verifier, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, v.publicKey)
+				require.Len(t, v.attestations, 1)
+				require.Len(t, v.attestations[0].conditions, 1)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			v, err := NewVerifier(testCase.policy, testCase.publicKeyPEM)
+			testCase.assertions(t, v, err)
+		})
+	}
+}
+
+func TestVerifier_verify(t *testing.T) {
+	const provenanceType = "https://slsa.dev/provenance/v1"
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(
+		ociregistry.New(ociregistry.Logger(log.New(io.Discard, "", 0))),
+	)
+	t.Cleanup(srv.Close)
+	srvURL, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	repoURL := fmt.Sprintf("%s/example/app", srvURL.Host)
+
+	// pushImage pushes a random image with the provided tag and returns its
+	// digest.
+	pushImage := func(tag string) v1.Hash {
+		t.Helper()
+		img, err := random.Image(64, 1)
+		require.NoError(t, err)
+		ref, err := name.ParseReference(fmt.Sprintf("%s:%s", repoURL, tag))
+		require.NoError(t, err)
+		require.NoError(t, remote.Write(ref, img))
+		hash, err := img.Digest()
+		require.NoError(t, err)
+		return hash
+	}
+	// pushArtifact pushes an image consisting of the provided layer to the tag
+	// cosign would use for a signature or attestation of the image having the
+	// provided digest.
+	pushArtifact := func(
+		hash v1.Hash,
+		suffix string,
+		layer v1.Layer,
+		annotations map[string]string,
+	) {
+		t.Helper()
+		img, err := mutate.Append(
+			empty.Image,
+			mutate.Addendum{Layer: layer, Annotations: annotations},
+		)
+		require.NoError(t, err)
+		ref, err := name.ParseReference(
+			fmt.Sprintf("%s:%s-%s.%s", repoURL, hash.Algorithm, hash.Hex, suffix),
+		)
+		require.NoError(t, err)
+		require.NoError(t, remote.Write(ref, img))
+	}
+	sign := func(signer *ecdsa.PrivateKey, hash v1.Hash) {
+		t.Helper()
+		payload, err := json.Marshal(map[string]any{
+			"critical": map[string]any{
+				"identity": map[string]any{"docker-reference": repoURL},
+				"image":    map[string]any{"docker-manifest-digest": hash.String()},
+				"type":     "cosign container image signature",
+			},
+		})
+		require.NoError(t, err)
+		pushArtifact(
+			hash,
+			"sig",
+			static.NewLayer(payload, "application/vnd.dev.cosign.simplesigning.v1+json"),
+			map[string]string{
+				cosignSignatureAnnotation: base64.StdEncoding.EncodeToString(
+					signECDSA(t, signer, payload),
+				),
+			},
+		)
+	}
+	attest := func(signer *ecdsa.PrivateKey, hash v1.Hash, predicate any) {
+		t.Helper()
+		statement, err := json.Marshal(map[string]any{
+			"_type": "https://in-toto.io/Statement/v1",
+			"subject": []any{map[string]any{
+				"name":   repoURL,
+				"digest": map[string]string{hash.Algorithm: hash.Hex},
+			}},
+			"predicateType": provenanceType,
+			"predicate":     predicate,
+		})
+		require.NoError(t, err)
+		envelope, err := json.Marshal(map[string]any{
+			"payloadType": inTotoPayloadType,
+			"payload":     base64.StdEncoding.EncodeToString(statement),
+			"signatures": []any{map[string]any{
+				"sig": base64.StdEncoding.EncodeToString(
+					signECDSA(t, signer, dssePAE(inTotoPayloadType, statement)),
+				),
+			}},
+		})
+		require.NoError(t, err)
+		pushArtifact(
+			hash,
+			"att",
+			static.NewLayer(envelope, types.MediaType(dsseEnvelopeMediaType)),
+			nil,
+		)
+	}
+
+	unsigned := pushImage("unsigned")
+	wrongKey := pushImage("wrong-key")
+	sign(otherKey, wrongKey)
+	signed := pushImage("signed")
+	sign(key, signed)
+	attested := pushImage("attested")
+	sign(key, attested)
+	attest(key, attested, map[string]any{"builder": map[string]any{"id": "ci"}})
+	badAttestation := pushImage("bad-attestation")
+	sign(key, badAttestation)
+	attest(otherKey, badAttestation, map[string]any{"builder": map[string]any{"id": "ci"}})
+	wrongBuilder := pushImage("wrong-builder")
+	sign(key, wrongBuilder)
+	attest(key, wrongBuilder, map[string]any{"builder": map[string]any{"id": "laptop"}})
+
+	attestationPolicy := kargoapi.ImageVerification{
+		Attestations: []kargoapi.ImageAttestationPolicy{{
+			PredicateType: provenanceType,
+			Conditions:    []string{`predicate.builder.id == "ci"`},
+		}},
+	}
+
+	testCases := []struct {
+		name           string
+		policy         kargoapi.ImageVerification
+		tag            string
+		hash           v1.Hash
+		expectVerified bool
+		expectReason   string
+	}{
+		{
+			name:         "no signature",
+			tag:          "unsigned",
+			hash:         unsigned,
+			expectReason: "no signature found",
+		},
+		{
+			name:         "signed with another key",
+			tag:          "wrong-key",
+			hash:         wrongKey,
+			expectReason: "no signature verifies with the public key",
+		},
+		{
+			name:           "signed",
+			tag:            "signed",
+			hash:           signed,
+			expectVerified: true,
+		},
+		{
+			name:   "signed but attestation missing",
+			policy: attestationPolicy,
+			tag:    "signed",
+			hash:   signed,
+			expectReason: fmt.Sprintf(
+				"no verified attestation of type %q satisfies the policy",
+				provenanceType,
+			),
+		},
+		{
+			name:   "attestation signed with another key",
+			policy: attestationPolicy,
+			tag:    "bad-attestation",
+			hash:   badAttestation,
+			expectReason: fmt.Sprintf(
+				"no verified attestation of type %q satisfies the policy",
+				provenanceType,
+			),
+		},
+		{
+			name:   "attestation does not satisfy conditions",
+			policy: attestationPolicy,
+			tag:    "wrong-builder",
+			hash:   wrongBuilder,
+			expectReason: fmt.Sprintf(
+				"no verified attestation of type %q satisfies the policy",
+				provenanceType,
+			),
+		},
+		{
+			name:           "attestation satisfies conditions",
+			policy:         attestationPolicy,
+			tag:            "attested",
+			hash:           attested,
+			expectVerified: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			v, err := NewVerifier(testCase.policy, marshalPublicKey(t, &key.PublicKey))
+			require.NoError(t, err)
+			rc, err := newRepositoryClient(repoURL, false, nil, false)
+			require.NoError(t, err)
+			verified, err := v.verify(
+				t.Context(),
+				rc,
+				image{Tag: testCase.tag, Digest: testCase.hash.String()},
+			)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectVerified, verified)
+			if testCase.expectVerified {
+				require.Empty(t, v.Rejected())
+				return
+			}
+			require.Equal(
+				t,
+				[]kargoapi.RejectedImageReference{{
+					Tag:    testCase.tag,
+					Digest: testCase.hash.String(),
+					Reason: testCase.expectReason,
+				}},
+				v.Rejected(),
+			)
+		})
+	}
+}
+
+func marshalPublicKey(t *testing.T, key *ecdsa.PublicKey) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+func signECDSA(t *testing.T, key *ecdsa.PrivateKey, msg []byte) []byte {
+	t.Helper()
+	digest := sha256.Sum256(msg)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	require.NoError(t, err)
+	return sig
+}
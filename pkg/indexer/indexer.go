@@ -180,87 +180,115 @@ func RunningPromotionsByArgoCDApplications(
 		// TODO(hidde): This is not ideal as it requires parsing the step configs
 		// and treating some of them as special cases. We should consider a more
 		// general approach in the future.
+		//
+		// We are only interested in steps that have already been executed or
+		// are about to be.
+		executedSteps := promo.Spec.Steps[:min(promo.Status.CurrentStep+1, int64(len(promo.Spec.Steps)))]
+		if onFailure := promo.Status.OnFailure; onFailure != nil {
+			executedSteps = slices.Concat(
+				executedSteps,
+				promo.Spec.OnFailure[:min(onFailure.CurrentStep+1, int64(len(promo.Spec.OnFailure)))],
+			)
+		}
 		var res []string
-		for i, step := range promo.Spec.Steps {
-			if int64(i) > promo.Status.CurrentStep {
-				// We are only interested in steps that have already been executed or
-				// are about to be.
-				break
-			}
-			if step.Uses != "argocd-update" || step.Config == nil {
-				continue
+		for i, promoStep := range executedSteps {
+			steps := []kargoapi.PromotionStep{promoStep}
+			if len(promoStep.Parallel) > 0 {
+				// The steps of a parallel group are all executed at the same time.
+				steps = promoStep.GetParallelSteps()
 			}
+			for _, step := range steps {
+				if step.Uses != "argocd-update" || step.Config == nil {
+					continue
+				}
 
-			var rawConfig []byte
-			if step.Config != nil {
-				rawConfig = step.Config.Raw
-			}
+				var rawConfig []byte
+				if step.Config != nil {
+					rawConfig = step.Config.Raw
+				}
 
-			dirStep := promotion.Step{
-				Kind:   step.Uses,
-				Alias:  step.As,
-				Vars:   step.Vars,
-				Config: rawConfig,
-			}
+				dirStep := promotion.Step{
+					Kind:      step.Uses,
+					Alias:     step.As,
+					Vars:      step.Vars,
+					Config:    rawConfig,
+					Iteration: step.Iteration,
+				}
 
-			evaluator := promotion.NewStepEvaluator(cl, nil)
-
-			// As step-level variables are allowed to reference to output, we
-			// need to provide the state.
-			vars, err := evaluator.Vars(ctx, promoCtx, dirStep)
-			if err != nil {
-				logger.Error(
-					err,
-					fmt.Sprintf(
-						"failed to extract relevant config from Promotion step %d:"+
-							"ignoring any Argo CD Applications from this step",
-						i,
-					),
-					"promo", promo.Name,
-					"namespace", promo.Namespace,
-				)
-				continue
-			}
-			// Unpack the raw config into a map. We're not unpacking it into a struct
-			// because:
-			// 1. We don't want to evaluate expressions throughout the entire config
-			//    because we may not have all context required to do so available.
-			//    We will only evaluate expressions in specific fields.
-			// 2. If there are expressions in the config, some fields that may not be
-			//    strings in the struct may be strings in the unevaluated config and
-			//    this could lead to unmarshaling errors.
-			cfgMap := map[string]any{}
-			if err = json.Unmarshal(step.Config.Raw, &cfgMap); err != nil {
-				logger.Error(
-					err,
-					fmt.Sprintf(
-						"failed to extract relevant config from Promotion step %d:"+
-							"ignoring any Argo CD Applications from this step",
-						i,
-					),
-					"promo", promo.Name,
-					"namespace", promo.Namespace,
-				)
-				continue
-			}
-			// Dig through the map to find the names and namespaces of related Argo CD
-			// Applications. Treat these as templates and evaluate expressions in
-			// these individual fields without evaluating the entire config.
-			if apps, ok := cfgMap["apps"]; ok {
-				if appsList, ok := apps.([]any); ok {
-					for _, app := range appsList {
-						if app, ok := app.(map[string]any); ok {
-							if nameTemplate, ok := app["name"].(string); ok {
-								env := evaluator.BuildExprEnv(
-									promoCtx,
-									promotion.ExprEnvWithOutputs(promoCtx.State),
-									promotion.ExprEnvWithTaskOutputs(dirStep.Alias, promoCtx.State),
-									promotion.ExprEnvWithVars(vars),
-								)
-
-								var namespace any = libargocd.Namespace()
-								if namespaceTemplate, ok := app["namespace"].(string); ok {
-									if namespace, err = expressions.EvaluateTemplate(namespaceTemplate, env); err != nil {
+				evaluator := promotion.NewStepEvaluator(cl, nil)
+
+				// As step-level variables are allowed to reference to output, we
+				// need to provide the state.
+				vars, err := evaluator.Vars(ctx, promoCtx, dirStep)
+				if err != nil {
+					logger.Error(
+						err,
+						fmt.Sprintf(
+							"failed to extract relevant config from Promotion step %d:"+
+								"ignoring any Argo CD Applications from this step",
+							i,
+						),
+						"promo", promo.Name,
+						"namespace", promo.Namespace,
+					)
+					continue
+				}
+				// Unpack the raw config into a map. We're not unpacking it into a struct
+				// because:
+				// 1. We don't want to evaluate expressions throughout the entire config
+				//    because we may not have all context required to do so available.
+				//    We will only evaluate expressions in specific fields.
+				// 2. If there are expressions in the config, some fields that may not be
+				//    strings in the struct may be strings in the unevaluated config and
+				//    this could lead to unmarshaling errors.
+				cfgMap := map[string]any{}
+				if err = json.Unmarshal(step.Config.Raw, &cfgMap); err != nil {
+					logger.Error(
+						err,
+						fmt.Sprintf(
+							"failed to extract relevant config from Promotion step %d:"+
+								"ignoring any Argo CD Applications from this step",
+							i,
+						),
+						"promo", promo.Name,
+						"namespace", promo.Namespace,
+					)
+					continue
+				}
+				// Dig through the map to find the names and namespaces of related Argo CD
+				// Applications. Treat these as templates and evaluate expressions in
+				// these individual fields without evaluating the entire config.
+				if apps, ok := cfgMap["apps"]; ok {
+					if appsList, ok := apps.([]any); ok {
+						for _, app := range appsList {
+							if app, ok := app.(map[string]any); ok {
+								if nameTemplate, ok := app["name"].(string); ok {
+									env := evaluator.BuildExprEnv(
+										promoCtx,
+										promotion.ExprEnvWithOutputs(promoCtx.State),
+										promotion.ExprEnvWithTaskOutputs(dirStep.Alias, promoCtx.State),
+										promotion.ExprEnvWithIteration(dirStep),
+										promotion.ExprEnvWithVars(vars),
+									)
+
+									var namespace any = libargocd.Namespace()
+									if namespaceTemplate, ok := app["namespace"].(string); ok {
+										if namespace, err = expressions.EvaluateTemplate(namespaceTemplate, env); err != nil {
+											logger.Error(
+												err,
+												fmt.Sprintf(
+													"failed to extract relevant config from Promotion step %d:"+
+														"ignoring any Argo CD Applications from this step",
+													i,
+												),
+												"promo", promo.Name,
+												"namespace", promo.Namespace,
+											)
+											continue
+										}
+									}
+									name, err := expressions.EvaluateTemplate(nameTemplate, env)
+									if err != nil {
 										logger.Error(
 											err,
 											fmt.Sprintf(
@@ -273,22 +301,8 @@ func RunningPromotionsByArgoCDApplications(
 										)
 										continue
 									}
+									res = append(res, fmt.Sprintf("%s:%s", namespace, name))
 								}
-								name, err := expressions.EvaluateTemplate(nameTemplate, env)
-								if err != nil {
-									logger.Error(
-										err,
-										fmt.Sprintf(
-											"failed to extract relevant config from Promotion step %d:"+
-												"ignoring any Argo CD Applications from this step",
-											i,
-										),
-										"promo", promo.Name,
-										"namespace", promo.Namespace,
-									)
-									continue
-								}
-								res = append(res, fmt.Sprintf("%s:%s", namespace, name))
 							}
 						}
 					}
@@ -300,10 +314,11 @@ func RunningPromotionsByArgoCDApplications(
 }
 
 // PromotionsByStageAndFreight is a client.IndexerFunc that indexes Promotions
-// by the Freight and Stage they reference.
+// by the Freight and Stage they reference. Promotions that are dry runs are
+// not indexed, as they do not affect the Stage they reference.
 func PromotionsByStageAndFreight(obj client.Object) []string {
 	promo, ok := obj.(*kargoapi.Promotion)
-	if !ok {
+	if !ok || promo.Spec.DryRun {
 		return nil
 	}
 
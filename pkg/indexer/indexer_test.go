@@ -489,6 +489,50 @@ func TestRunningPromotionsByArgoCDApplications(t *testing.T) {
 				fmt.Sprintf("%s:%s", argocd.Namespace(), "fake-app-from-task"),
 			},
 		},
+		{
+			name: "Promotion is executing onFailure steps",
+			obj: &kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+				},
+				Spec: kargoapi.PromotionSpec{
+					Stage: fakeStage.Name,
+					Steps: []kargoapi.PromotionStep{
+						{
+							Uses: "argocd-update",
+							Config: &apiextensionsv1.JSON{
+								Raw: []byte(`{"apps":[{"namespace":"fake-namespace","name":"fake-app"}]}`),
+							},
+						},
+					},
+					OnFailure: []kargoapi.PromotionStep{
+						{
+							Uses: "argocd-update",
+							Config: &apiextensionsv1.JSON{
+								Raw: []byte(`{"apps":[{"namespace":"fake-namespace","name":"fake-rollback-app"}]}`),
+							},
+						},
+						{
+							Uses: "argocd-update",
+							Config: &apiextensionsv1.JSON{
+								Raw: []byte(`{"apps":[{"namespace":"fake-namespace","name":"fake-later-app"}]}`),
+							},
+						},
+					},
+				},
+				Status: kargoapi.PromotionStatus{
+					Phase:       kargoapi.PromotionPhaseRunning,
+					CurrentStep: 0,
+					OnFailure: &kargoapi.PromotionOnFailureStatus{
+						CurrentStep: 0, // Ensure only the first onFailure step is considered
+					},
+				},
+			},
+			expected: []string{
+				"fake-namespace:fake-app",
+				"fake-namespace:fake-rollback-app",
+			},
+		},
 		{
 			name: "Promotion has directive steps without Applications",
 			obj: &kargoapi.Promotion{
@@ -547,6 +591,9 @@ func TestPromotionsByStageAndFreight(t *testing.T) {
 	}
 	res := PromotionsByStageAndFreight(promo)
 	require.Equal(t, []string{"fake-stage:fake-freight"}, res)
+
+	promo.Spec.DryRun = true
+	require.Nil(t, PromotionsByStageAndFreight(promo))
 }
 
 func TestFreightByWarehouse(t *testing.T) {
@@ -2,16 +2,22 @@ package kargo
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/oklog/ulid/v2"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/api"
+	"github.com/akuity/kargo/pkg/expressions"
 	"github.com/akuity/kargo/pkg/server/user"
 )
 
@@ -83,71 +89,268 @@ func (b *PromotionBuilder) Build(
 			Annotations: annotations,
 		},
 		Spec: kargoapi.PromotionSpec{
-			Stage:   stage.Name,
-			Freight: freight,
-			Vars:    vars,
-			Steps:   stage.Spec.PromotionTemplate.Spec.Steps,
+			Stage:     stage.Name,
+			Freight:   freight,
+			Vars:      vars,
+			Steps:     stage.Spec.PromotionTemplate.Spec.Steps,
+			OnFailure: stage.Spec.PromotionTemplate.Spec.OnFailure,
 		},
 	}
 	return &promotion, nil
 }
 
-// InflateSteps inflates the Promotion steps by resolving any references to
-// PromotionTasks and expanding them into their individual steps. The inflated
-// steps are then set on the Promotion, replacing the original steps.
+// InflateSteps inflates the Promotion steps by expanding any steps with a
+// ForEach expression into one step per item, and by resolving any references
+// to PromotionTasks and expanding them into their individual steps. The
+// inflated steps are then set on the Promotion, replacing the original steps.
+// The same applies to the OnFailure steps of the Promotion, which are
+// followed by the OnFailure steps of any referenced PromotionTasks.
 func (b *PromotionBuilder) InflateSteps(ctx context.Context, promo *kargoapi.Promotion) error {
-	steps := make([]kargoapi.PromotionStep, 0, len(promo.Spec.Steps))
-	for i, step := range promo.Spec.Steps {
-		switch {
-		case step.Task != nil:
-			alias := step.GetAlias(i)
-			taskSteps, err := b.inflateTaskSteps(
-				ctx,
-				promo.Namespace,
-				alias,
-				promo.Spec.Vars,
-				step,
-			)
-			if err != nil {
-				return fmt.Errorf("inflate tasks steps for task %q (%q): %w", step.Task.Name, alias, err)
-			}
-			steps = append(steps, taskSteps...)
-		default:
-			step.As = step.GetAlias(i)
-			steps = append(steps, step)
-		}
+	steps, taskOnFailure, err := b.inflateSteps(ctx, promo, promo.Spec.Steps, 0)
+	if err != nil {
+		return err
+	}
+	// Default aliases of OnFailure steps continue the numbering of the steps,
+	// to ensure they are unique within the context of the Promotion.
+	onFailure, onFailureTaskOnFailure, err := b.inflateSteps(
+		ctx,
+		promo,
+		promo.Spec.OnFailure,
+		len(promo.Spec.Steps),
+	)
+	if err != nil {
+		return err
 	}
 	promo.Spec.Steps = steps
+	promo.Spec.OnFailure = slices.Concat(onFailure, taskOnFailure, onFailureTaskOnFailure)
 	return nil
 }
 
+// inflateSteps inflates the given PromotionSteps of the Promotion. The index
+// of each step is offset by the given offset when determining its default
+// alias. It returns the inflated steps, and the inflated OnFailure steps of
+// any PromotionTasks referenced by them.
+func (b *PromotionBuilder) inflateSteps(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+	promoSteps []kargoapi.PromotionStep,
+	offset int,
+) ([]kargoapi.PromotionStep, []kargoapi.PromotionStep, error) {
+	steps := make([]kargoapi.PromotionStep, 0, len(promoSteps))
+	var taskOnFailure []kargoapi.PromotionStep
+	for i, promoStep := range promoSteps {
+		i += offset
+		expandedSteps := []kargoapi.PromotionStep{promoStep}
+		if promoStep.ForEach != "" {
+			var err error
+			if expandedSteps, err = expandForEachStep(promo, promoStep.GetAlias(i), promoStep); err != nil {
+				return nil, nil, fmt.Errorf("expand step %q: %w", promoStep.GetAlias(i), err)
+			}
+		}
+		for _, step := range expandedSteps {
+			switch {
+			case step.Task != nil:
+				alias := step.GetAlias(i)
+				taskSteps, onFailureSteps, err := b.inflateTaskSteps(
+					ctx,
+					promo.Namespace,
+					alias,
+					promo.Spec.Vars,
+					step,
+				)
+				if err != nil {
+					return nil, nil, fmt.Errorf(
+						"inflate tasks steps for task %q (%q): %w", step.Task.Name, alias, err,
+					)
+				}
+				steps = append(steps, taskSteps...)
+				taskOnFailure = append(taskOnFailure, onFailureSteps...)
+			default:
+				step.As = step.GetAlias(i)
+				step.Parallel = slices.Clone(step.Parallel)
+				for j := range step.Parallel {
+					step.Parallel[j].As = step.Parallel[j].GetAlias(step.As, j)
+				}
+				steps = append(steps, step)
+			}
+		}
+	}
+	return steps, taskOnFailure, nil
+}
+
+// expandForEachStep expands the given PromotionStep into one PromotionStep per
+// item of the list its ForEach expression evaluates to. The expanded steps are
+// aliased "<alias>-<index>" and record the item they were expanded for.
+func expandForEachStep(
+	promo *kargoapi.Promotion,
+	alias string,
+	step kargoapi.PromotionStep,
+) ([]kargoapi.PromotionStep, error) {
+	env := map[string]any{
+		"ctx": map[string]any{
+			"project":   promo.Namespace,
+			"promotion": promo.Name,
+			"stage":     promo.Spec.Stage,
+			"targetFreight": map[string]any{
+				"name": promo.Spec.Freight,
+			},
+		},
+	}
+
+	// The ForEach expression is evaluated before the Promotion is executed, so
+	// the variables available to it must not make use of functions which are
+	// only available during the execution of the Promotion. Only the variables
+	// the expression (transitively) references are evaluated, so that other
+	// variables are free to make use of such functions.
+	allVars := slices.Concat(promo.Spec.Vars, step.Vars)
+	needed := forEachVars(step.ForEach, allVars)
+	vars := make(map[string]any, len(allVars))
+	env["vars"] = vars
+	for i, v := range allVars {
+		if !needed[i] {
+			continue
+		}
+		val, err := expressions.EvaluateTemplate(v.Value, env)
+		if err != nil {
+			return nil, fmt.Errorf("error evaluating var %q: %w", v.Name, err)
+		}
+		vars[v.Name] = val
+	}
+
+	res, err := expressions.EvaluateTemplate(step.ForEach, env)
+	if err != nil {
+		return nil, fmt.Errorf("error evaluating forEach expression: %w", err)
+	}
+	items := reflect.ValueOf(res)
+	if items.Kind() != reflect.Slice && items.Kind() != reflect.Array {
+		return nil, fmt.Errorf("forEach expression must evaluate to a list, got %T", res)
+	}
+
+	steps := make([]kargoapi.PromotionStep, items.Len())
+	for i := range items.Len() {
+		item, err := json.Marshal(items.Index(i).Interface())
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling item %d: %w", i, err)
+		}
+		expanded := step.DeepCopy()
+		expanded.ForEach = ""
+		expanded.As = fmt.Sprintf("%s-%d", alias, i)
+		expanded.Iteration = &kargoapi.PromotionStepIteration{
+			Index: int64(i),
+			Item:  &apiextensionsv1.JSON{Raw: item},
+		}
+		steps[i] = *expanded
+	}
+	return steps, nil
+}
+
+// varReferenceRegex matches references to variables within an expression,
+// i.e. vars.<name>, vars['<name>'] and vars["<name>"]. References to vars
+// that do not name a variable are matched with empty submatches.
+var varReferenceRegex = regexp.MustCompile(
+	`\bvars\b(?:\s*\.\s*([A-Za-z_][A-Za-z0-9_]*)|\s*\[\s*["']([^"']*)["']\s*\])?`,
+)
+
+// forEachVars determines which of the provided variables, in the order in
+// which they are evaluated, are needed to evaluate the provided forEach
+// expression. A variable is needed if it is referenced by the expression or
+// by a later variable that is needed. If a variable cannot be identified by
+// name, e.g. because vars is indexed dynamically, all preceding variables are
+// considered needed.
+func forEachVars(forEach string, vars []kargoapi.ExpressionVariable) []bool {
+	needed := make([]bool, len(vars))
+	referenced := map[string]struct{}{}
+	var all bool
+	addReferences := func(template string) {
+		for _, match := range varReferenceRegex.FindAllStringSubmatch(template, -1) {
+			switch name := match[1] + match[2]; name {
+			case "":
+				all = true
+			default:
+				referenced[name] = struct{}{}
+			}
+		}
+	}
+	addReferences(forEach)
+	// Later definitions of a variable shadow earlier ones, so the variables are
+	// considered in reverse.
+	for i := len(vars) - 1; i >= 0; i-- {
+		if _, ok := referenced[vars[i].Name]; !ok && !all {
+			continue
+		}
+		needed[i] = true
+		delete(referenced, vars[i].Name)
+		addReferences(vars[i].Value)
+	}
+	return needed
+}
+
 // inflateTaskSteps inflates the PromotionSteps for the given PromotionStep
 // that references a (Cluster)PromotionTask. The task is retrieved and its
-// steps are inflated with the given task inputs.
+// steps and OnFailure steps are inflated with the given task inputs.
 func (b *PromotionBuilder) inflateTaskSteps(
 	ctx context.Context,
 	project, taskAlias string,
 	promoVars []kargoapi.ExpressionVariable,
 	taskStep kargoapi.PromotionStep,
-) ([]kargoapi.PromotionStep, error) {
+) ([]kargoapi.PromotionStep, []kargoapi.PromotionStep, error) {
 	task, err := b.getTaskSpec(ctx, project, taskStep.Task)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	vars, err := promotionTaskVarsToStepVars(task.Vars, promoVars, taskStep.Vars)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	steps, err := inflateTaskStepList(taskAlias, vars, taskStep.Iteration, task.Steps, 0)
+	if err != nil {
+		return nil, nil, err
 	}
+	onFailure, err := inflateTaskStepList(taskAlias, vars, taskStep.Iteration, task.OnFailure, len(task.Steps))
+	if err != nil {
+		return nil, nil, err
+	}
+	return steps, onFailure, nil
+}
 
+// inflateTaskStepList inflates the given steps of a PromotionTask referenced
+// under the given alias. The index of each step is offset by the given offset
+// when determining its default alias.
+func inflateTaskStepList(
+	taskAlias string,
+	vars []kargoapi.ExpressionVariable,
+	iteration *kargoapi.PromotionStepIteration,
+	taskSteps []kargoapi.PromotionStep,
+	offset int,
+) ([]kargoapi.PromotionStep, error) {
 	var steps []kargoapi.PromotionStep
-	for i := range task.Steps {
+	for i := range taskSteps {
 		// Copy the step as-is.
-		step := &task.Steps[i]
+		step := &taskSteps[i]
+
+		if step.ForEach != "" {
+			return nil, fmt.Errorf(
+				"step %q of task uses forEach, which is not supported within tasks",
+				step.GetAlias(i+offset),
+			)
+		}
+
+		// Steps of a task that is expanded for an item of a list have access to
+		// the item.
+		step.Iteration = iteration
 
 		// Ensures we have a unique alias for each step within the context of
 		// the Promotion.
-		step.As = generatePromotionTaskStepAlias(taskAlias, step.GetAlias(i))
+		stepAlias := step.GetAlias(i + offset)
+		step.As = generatePromotionTaskStepAlias(taskAlias, stepAlias)
+		for j := range step.Parallel {
+			step.Parallel[j].As = generatePromotionTaskStepAlias(
+				taskAlias,
+				step.Parallel[j].GetAlias(stepAlias, j),
+			)
+		}
 
 		// With the variables validated and mapped, they are now available to
 		// the Config of the step during the Promotion execution.
@@ -384,6 +384,238 @@ func TestPromotionBuilder_InflateSteps(t *testing.T) {
 				}, steps[1].Vars)
 			},
 		},
+		{
+			name: "parallel steps",
+			promo: kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-promotion",
+					Namespace: "test-project",
+				},
+				Spec: kargoapi.PromotionSpec{
+					Steps: []kargoapi.PromotionStep{
+						{
+							Parallel: []kargoapi.ParallelPromotionStep{
+								{Uses: "fake-step"},
+								{As: "named-step", Uses: "fake-step"},
+							},
+						},
+						{
+							As: "task-step",
+							Task: &kargoapi.PromotionTaskReference{
+								Name: "test-task",
+							},
+						},
+					},
+				},
+			},
+			objects: []client.Object{
+				&kargoapi.PromotionTask{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-task",
+						Namespace: "test-project",
+					},
+					Spec: kargoapi.PromotionTaskSpec{
+						Steps: []kargoapi.PromotionStep{
+							{
+								As: "group",
+								Parallel: []kargoapi.ParallelPromotionStep{
+									{Uses: "other-fake-step"},
+									{As: "sub-step", Uses: "other-fake-step"},
+								},
+							},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, steps []kargoapi.PromotionStep, err error) {
+				require.NoError(t, err)
+				require.Len(t, steps, 2)
+
+				assert.Equal(t, "step-1", steps[0].As)
+				require.Len(t, steps[0].Parallel, 2)
+				assert.Equal(t, "step-1-1", steps[0].Parallel[0].As)
+				assert.Equal(t, "named-step", steps[0].Parallel[1].As)
+
+				assert.Equal(t, "task-step::group", steps[1].As)
+				require.Len(t, steps[1].Parallel, 2)
+				assert.Equal(t, "task-step::group-1", steps[1].Parallel[0].As)
+				assert.Equal(t, "task-step::sub-step", steps[1].Parallel[1].As)
+			},
+		},
+		{
+			name: "forEach step",
+			promo: kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-promotion",
+					Namespace: "test-project",
+				},
+				Spec: kargoapi.PromotionSpec{
+					Vars: []kargoapi.ExpressionVariable{
+						{Name: "regions", Value: `${{ ["us", "eu"] }}`},
+					},
+					Steps: []kargoapi.PromotionStep{
+						{
+							Uses:    "fake-step",
+							ForEach: "${{ vars.regions }}",
+						},
+						{
+							As:      "task-step",
+							ForEach: "${{ [1] }}",
+							Task: &kargoapi.PromotionTaskReference{
+								Name: "test-task",
+							},
+						},
+					},
+				},
+			},
+			objects: []client.Object{
+				&kargoapi.PromotionTask{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-task",
+						Namespace: "test-project",
+					},
+					Spec: kargoapi.PromotionTaskSpec{
+						Steps: []kargoapi.PromotionStep{
+							{
+								As:   "sub-step",
+								Uses: "other-fake-step",
+							},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, steps []kargoapi.PromotionStep, err error) {
+				require.NoError(t, err)
+				require.Len(t, steps, 3)
+
+				assert.Equal(t, "step-1-0", steps[0].As)
+				assert.Empty(t, steps[0].ForEach)
+				require.NotNil(t, steps[0].Iteration)
+				assert.Equal(t, int64(0), steps[0].Iteration.Index)
+				assert.JSONEq(t, `"us"`, string(steps[0].Iteration.Item.Raw))
+
+				assert.Equal(t, "step-1-1", steps[1].As)
+				require.NotNil(t, steps[1].Iteration)
+				assert.Equal(t, int64(1), steps[1].Iteration.Index)
+				assert.JSONEq(t, `"eu"`, string(steps[1].Iteration.Item.Raw))
+
+				assert.Equal(t, "task-step-0::sub-step", steps[2].As)
+				require.NotNil(t, steps[2].Iteration)
+				assert.JSONEq(t, `1`, string(steps[2].Iteration.Item.Raw))
+			},
+		},
+		{
+			name: "forEach expression not evaluating to a list",
+			promo: kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-promotion",
+					Namespace: "test-project",
+				},
+				Spec: kargoapi.PromotionSpec{
+					Steps: []kargoapi.PromotionStep{
+						{
+							Uses:    "fake-step",
+							ForEach: "${{ ctx.stage }}",
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ []kargoapi.PromotionStep, err error) {
+				assert.ErrorContains(t, err, "must evaluate to a list")
+			},
+		},
+		{
+			name: "forEach step with unrelated var that cannot be evaluated yet",
+			promo: kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-promotion",
+					Namespace: "test-project",
+				},
+				Spec: kargoapi.PromotionSpec{
+					Vars: []kargoapi.ExpressionVariable{
+						{Name: "repo", Value: "fake-image"},
+						{Name: "image", Value: `${{ imageFrom(vars.repo).Tag }}`},
+						{Name: "regions", Value: `${{ ["us", "eu"] }}`},
+					},
+					Steps: []kargoapi.PromotionStep{
+						{
+							Uses:    "fake-step",
+							ForEach: "${{ vars.regions }}",
+							Vars: []kargoapi.ExpressionVariable{
+								{Name: "commit", Value: `${{ outputs.commit.commit }}`},
+							},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, steps []kargoapi.PromotionStep, err error) {
+				require.NoError(t, err)
+				require.Len(t, steps, 2)
+				assert.JSONEq(t, `"us"`, string(steps[0].Iteration.Item.Raw))
+				assert.JSONEq(t, `"eu"`, string(steps[1].Iteration.Item.Raw))
+			},
+		},
+		{
+			name: "forEach step with var that cannot be evaluated",
+			promo: kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-promotion",
+					Namespace: "test-project",
+				},
+				Spec: kargoapi.PromotionSpec{
+					Vars: []kargoapi.ExpressionVariable{
+						{Name: "image", Value: `${{ imageFrom("fake-image").Tag }}`},
+						{Name: "tags", Value: `${{ [vars.image] }}`},
+					},
+					Steps: []kargoapi.PromotionStep{
+						{
+							Uses:    "fake-step",
+							ForEach: "${{ vars.tags }}",
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ []kargoapi.PromotionStep, err error) {
+				assert.ErrorContains(t, err, `error evaluating var "image"`)
+			},
+		},
+		{
+			name: "forEach within task returns error",
+			promo: kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-promotion",
+					Namespace: "test-project",
+				},
+				Spec: kargoapi.PromotionSpec{
+					Steps: []kargoapi.PromotionStep{
+						{
+							Task: &kargoapi.PromotionTaskReference{
+								Name: "test-task",
+							},
+						},
+					},
+				},
+			},
+			objects: []client.Object{
+				&kargoapi.PromotionTask{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-task",
+						Namespace: "test-project",
+					},
+					Spec: kargoapi.PromotionTaskSpec{
+						Steps: []kargoapi.PromotionStep{
+							{
+								Uses:    "fake-step",
+								ForEach: "${{ [1, 2] }}",
+							},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ []kargoapi.PromotionStep, err error) {
+				assert.ErrorContains(t, err, "not supported within tasks")
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -401,6 +633,65 @@ func TestPromotionBuilder_InflateSteps(t *testing.T) {
 	}
 }
 
+func TestPromotionBuilder_InflateSteps_onFailure(t *testing.T) {
+	s := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(s))
+
+	c := fake.NewClientBuilder().
+		WithScheme(s).
+		WithObjects(
+			&kargoapi.PromotionTask{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-task",
+					Namespace: "test-project",
+				},
+				Spec: kargoapi.PromotionTaskSpec{
+					Steps: []kargoapi.PromotionStep{
+						{As: "push", Uses: "fake-step"},
+					},
+					OnFailure: []kargoapi.PromotionStep{
+						{Uses: "other-fake-step"},
+					},
+				},
+			},
+		).
+		Build()
+
+	promo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-promotion",
+			Namespace: "test-project",
+		},
+		Spec: kargoapi.PromotionSpec{
+			Steps: []kargoapi.PromotionStep{
+				{Uses: "fake-step"},
+				{
+					As: "task-step",
+					Task: &kargoapi.PromotionTaskReference{
+						Name: "test-task",
+					},
+				},
+			},
+			OnFailure: []kargoapi.PromotionStep{
+				{Uses: "fake-step"},
+				{As: "revert", Uses: "fake-step"},
+			},
+		},
+	}
+
+	require.NoError(t, NewPromotionBuilder(c).InflateSteps(context.Background(), promo))
+
+	require.Len(t, promo.Spec.Steps, 2)
+	assert.Equal(t, "step-1", promo.Spec.Steps[0].As)
+	assert.Equal(t, "task-step::push", promo.Spec.Steps[1].As)
+
+	require.Len(t, promo.Spec.OnFailure, 3)
+	assert.Equal(t, "step-3", promo.Spec.OnFailure[0].As)
+	assert.Equal(t, "revert", promo.Spec.OnFailure[1].As)
+	assert.Equal(t, "task-step::step-2", promo.Spec.OnFailure[2].As)
+	assert.Equal(t, "other-fake-step", promo.Spec.OnFailure[2].Uses)
+}
+
 func TestPromotionBuilder_inflateTaskSteps(t *testing.T) {
 	s := runtime.NewScheme()
 	require.NoError(t, kargoapi.AddToScheme(s))
@@ -611,7 +902,7 @@ func TestPromotionBuilder_inflateTaskSteps(t *testing.T) {
 				Build()
 
 			b := NewPromotionBuilder(c)
-			steps, err := b.inflateTaskSteps(context.Background(), tt.project, tt.taskAlias, tt.promoVars, tt.taskStep)
+			steps, _, err := b.inflateTaskSteps(context.Background(), tt.project, tt.taskAlias, tt.promoVars, tt.taskStep)
 			tt.assertions(t, steps, err)
 		})
 	}
@@ -1054,3 +1345,44 @@ func Test_promotionTaskVarsToStepVars(t *testing.T) {
 		})
 	}
 }
+
+func Test_forEachVars(t *testing.T) {
+	vars := []kargoapi.ExpressionVariable{
+		{Name: "a", Value: "a"},
+		{Name: "b", Value: "${{ vars.a }}"},
+		{Name: "c", Value: "c"},
+		{Name: "b", Value: "${{ vars['b'] + vars.c }}"},
+		{Name: "d", Value: `${{ imageFrom("fake-image").Tag }}`},
+	}
+	tests := []struct {
+		name     string
+		forEach  string
+		expected []bool
+	}{
+		{
+			name:     "no variables referenced",
+			forEach:  "${{ [1, 2] }}",
+			expected: []bool{false, false, false, false, false},
+		},
+		{
+			name:     "variables referenced transitively",
+			forEach:  `${{ [vars["b"]] }}`,
+			expected: []bool{true, true, true, true, false},
+		},
+		{
+			name:     "variable referenced directly",
+			forEach:  "${{ [vars.c] }}",
+			expected: []bool{false, false, true, false, false},
+		},
+		{
+			name:     "variables indexed dynamically",
+			forEach:  "${{ [vars[ctx.stage]] }}",
+			expected: []bool{true, true, true, true, true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, forEachVars(tt.forEach, vars))
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package promotion
 
 import (
+	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/akuity/kargo/pkg/credentials"
@@ -13,4 +14,12 @@ type StepRunnerCapabilities struct {
 	KargoClient  client.Client
 	ArgoCDClient client.Client
 	CredsDB      credentials.Database
+	// FluxClient is a client for the Kubernetes cluster in which Flux
+	// resources are managed. It reads and writes those resources as
+	// unstructured objects, so no Flux API types are required.
+	FluxClient client.Client
+	// LocalClusterClient is a clientset for the Kubernetes cluster in which the
+	// engine is running, which is not necessarily the cluster hosting the Kargo
+	// control plane.
+	LocalClusterClient kubernetes.Interface
 }
@@ -0,0 +1,209 @@
+// Package container contains utilities shared by the step runner that executes
+// user-specified container images as Kubernetes Jobs and the API server, which
+// streams the logs of those Jobs.
+package container
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/kelseyhightower/envconfig"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// ContainerName is the name of the container that executes a container step
+// within the Pod created by its Job.
+const ContainerName = "step"
+
+// ErrNoPod is returned when a Job has not yet created a Pod.
+var ErrNoPod = errors.New("job has no pods")
+
+// Config represents configuration for executing container steps.
+type Config struct {
+	// Namespace is the namespace in which the Jobs executing container steps
+	// are created. This must be the namespace in which the controller is
+	// running, since Jobs must be able to mount the claim named by
+	// WorkDirClaim.
+	Namespace string `envconfig:"CONTAINER_STEPS_NAMESPACE"`
+	// WorkDirClaim is the name of a PersistentVolumeClaim that is mounted by
+	// the controller at WorkDirRoot. Jobs executing container steps mount the
+	// subdirectory of the claim that holds the working directory of the
+	// Promotion. If empty, container steps are disabled.
+	WorkDirClaim string `envconfig:"CONTAINER_STEPS_WORK_DIR_CLAIM"`
+	// WorkDirRoot is the path at which the controller mounts the claim named by
+	// WorkDirClaim. The working directory of every Promotion must be beneath
+	// it. If empty, the operating system's temporary directory is assumed.
+	WorkDirRoot string `envconfig:"CONTAINER_STEPS_WORK_DIR_ROOT"`
+	// ServiceAccount is the optional name of the ServiceAccount used by Jobs
+	// executing container steps. Regardless, the ServiceAccount's token is not
+	// mounted.
+	ServiceAccount string `envconfig:"CONTAINER_STEPS_SERVICE_ACCOUNT"`
+	// RunAsUser is the ID of the user the containers executing container steps
+	// run as. It should be the user the controller runs as, so that the
+	// containers may write to the working directory of the Promotion.
+	RunAsUser int64 `envconfig:"CONTAINER_STEPS_RUN_AS_USER" default:"1000"`
+	// CPURequest is the amount of CPU requested by the containers executing
+	// container steps. If empty, no CPU is requested.
+	CPURequest string `envconfig:"CONTAINER_STEPS_CPU_REQUEST" default:"100m"`
+	// MemoryRequest is the amount of memory requested by the containers
+	// executing container steps. If empty, no memory is requested.
+	MemoryRequest string `envconfig:"CONTAINER_STEPS_MEMORY_REQUEST" default:"128Mi"`
+	// CPULimit is the maximum amount of CPU the containers executing container
+	// steps may use. If empty, CPU is not limited.
+	CPULimit string `envconfig:"CONTAINER_STEPS_CPU_LIMIT" default:"1"`
+	// MemoryLimit is the maximum amount of memory the containers executing
+	// container steps may use. If empty, memory is not limited.
+	MemoryLimit string `envconfig:"CONTAINER_STEPS_MEMORY_LIMIT" default:"1Gi"`
+}
+
+// ConfigFromEnv returns a Config populated from environment variables.
+func ConfigFromEnv() Config {
+	cfg := Config{}
+	envconfig.MustProcess("", &cfg)
+	if cfg.WorkDirRoot == "" {
+		cfg.WorkDirRoot = os.TempDir()
+	}
+	return cfg
+}
+
+// Enabled returns whether container steps are enabled.
+func (c Config) Enabled() bool {
+	return c.Namespace != "" && c.WorkDirClaim != ""
+}
+
+// Resources returns the compute resources of the containers executing
+// container steps. An error is returned if any of the configured quantities
+// cannot be parsed.
+func (c Config) Resources() (corev1.ResourceRequirements, error) {
+	var res corev1.ResourceRequirements
+	for _, q := range []struct {
+		list  *corev1.ResourceList
+		name  corev1.ResourceName
+		value string
+	}{
+		{list: &res.Requests, name: corev1.ResourceCPU, value: c.CPURequest},
+		{list: &res.Requests, name: corev1.ResourceMemory, value: c.MemoryRequest},
+		{list: &res.Limits, name: corev1.ResourceCPU, value: c.CPULimit},
+		{list: &res.Limits, name: corev1.ResourceMemory, value: c.MemoryLimit},
+	} {
+		if q.value == "" {
+			continue
+		}
+		quantity, err := resource.ParseQuantity(q.value)
+		if err != nil {
+			return corev1.ResourceRequirements{}, fmt.Errorf(
+				"error parsing %s quantity %q: %w", q.name, q.value, err,
+			)
+		}
+		if *q.list == nil {
+			*q.list = corev1.ResourceList{}
+		}
+		(*q.list)[q.name] = quantity
+	}
+	return res, nil
+}
+
+// JobName deterministically derives the name of the Job that executes the
+// container step with the provided alias as part of the specified Promotion.
+// This permits the Job to be found again on subsequent executions of the step
+// and by the API server when streaming its logs.
+func JobName(project, promotion, stepAlias string) string {
+	sum := sha256.Sum256([]byte(project + "/" + promotion + "/" + stepAlias))
+	return "kargo-step-" + hex.EncodeToString(sum[:])[:16]
+}
+
+// GetLogs returns a stream of the logs of the most recently created Pod of
+// the specified Job. If the Job has not yet created a Pod, ErrNoPod is
+// returned.
+func GetLogs(
+	ctx context.Context,
+	c kubernetes.Interface,
+	namespace string,
+	jobName string,
+	opts *corev1.PodLogOptions,
+) (io.ReadCloser, error) {
+	pods, err := c.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Set{
+			batchv1.JobNameLabel: jobName,
+		}.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing Pods of Job %q: %w", jobName, err)
+	}
+	var pod *corev1.Pod
+	for i := range pods.Items {
+		if pod == nil ||
+			pods.Items[i].CreationTimestamp.After(pod.CreationTimestamp.Time) {
+			pod = &pods.Items[i]
+		}
+	}
+	if pod == nil {
+		return nil, ErrNoPod
+	}
+	logs, err := c.CoreV1().Pods(namespace).GetLogs(pod.Name, opts).Stream(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting logs of Pod %q: %w", pod.Name, err)
+	}
+	return logs, nil
+}
+
+// DeleteJob deletes the specified Job. Deletion uses foreground propagation,
+// so the Job is not removed until its Pods have been. It is not an error if
+// the Job does not exist.
+func DeleteJob(
+	ctx context.Context,
+	c kubernetes.Interface,
+	namespace string,
+	jobName string,
+) error {
+	if err := c.BatchV1().Jobs(namespace).Delete(ctx, jobName, metav1.DeleteOptions{
+		PropagationPolicy: ptr.To(metav1.DeletePropagationForeground),
+	}); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("error deleting Job %q: %w", jobName, err)
+	}
+	return nil
+}
+
+// DeletePromotionJobs deletes all Jobs executing container steps of the
+// specified Promotion. Deletion uses foreground propagation, so the Jobs are
+// not removed until their Pods have been.
+func DeletePromotionJobs(
+	ctx context.Context,
+	c kubernetes.Interface,
+	namespace string,
+	project string,
+	promotion string,
+) error {
+	jobs, err := c.BatchV1().Jobs(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Set{
+			kargoapi.LabelKeyProject: project,
+		}.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("error listing Jobs of Promotion %q: %w", promotion, err)
+	}
+	var errs []error
+	for _, job := range jobs.Items {
+		if job.Annotations[kargoapi.AnnotationKeyPromotion] != promotion {
+			continue
+		}
+		if err = DeleteJob(ctx, c, namespace, job.Name); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,238 @@
+package container
+
+import (
+	"context"
+	"io"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestConfig_Enabled(t *testing.T) {
+	assert.False(t, Config{}.Enabled())
+	assert.False(t, Config{Namespace: "kargo"}.Enabled())
+	assert.False(t, Config{WorkDirClaim: "work-dirs"}.Enabled())
+	assert.True(t, Config{Namespace: "kargo", WorkDirClaim: "work-dirs"}.Enabled())
+}
+
+func TestConfig_Resources(t *testing.T) {
+	tests := []struct {
+		name       string
+		cfg        Config
+		assertions func(*testing.T, corev1.ResourceRequirements, error)
+	}{
+		{
+			name: "no resources configured",
+			assertions: func(t *testing.T, res corev1.ResourceRequirements, err error) {
+				require.NoError(t, err)
+				assert.Empty(t, res.Requests)
+				assert.Empty(t, res.Limits)
+			},
+		},
+		{
+			name: "resources configured",
+			cfg: Config{
+				CPURequest:    "100m",
+				MemoryRequest: "128Mi",
+				MemoryLimit:   "1Gi",
+			},
+			assertions: func(t *testing.T, res corev1.ResourceRequirements, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("100m"),
+					corev1.ResourceMemory: resource.MustParse("128Mi"),
+				}, res.Requests)
+				assert.Equal(t, corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("1Gi"),
+				}, res.Limits)
+			},
+		},
+		{
+			name: "invalid quantity",
+			cfg:  Config{CPULimit: "lots"},
+			assertions: func(t *testing.T, _ corev1.ResourceRequirements, err error) {
+				require.ErrorContains(t, err, `error parsing cpu quantity "lots"`)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := tt.cfg.Resources()
+			tt.assertions(t, res, err)
+		})
+	}
+}
+
+func TestJobName(t *testing.T) {
+	name := JobName("project", "promotion", "step-1")
+	assert.Len(t, name, len("kargo-step-")+16)
+	// Names are deterministic...
+	assert.Equal(t, name, JobName("project", "promotion", "step-1"))
+	// ...and distinct
+	assert.NotEqual(t, name, JobName("project", "promotion", "step-2"))
+	assert.NotEqual(t, name, JobName("project", "other-promotion", "step-1"))
+}
+
+func TestGetLogs(t *testing.T) {
+	const testNamespace = "kargo"
+	const testJobName = "kargo-step-abc"
+	now := time.Now()
+
+	tests := []struct {
+		name       string
+		objects    []runtime.Object
+		assertions func(*testing.T, io.ReadCloser, error)
+	}{
+		{
+			name: "Job has no Pods",
+			objects: []runtime.Object{
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "unrelated",
+						Namespace: testNamespace,
+						Labels: map[string]string{
+							batchv1.JobNameLabel: "other-job",
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ io.ReadCloser, err error) {
+				require.ErrorIs(t, err, ErrNoPod)
+			},
+		},
+		{
+			name: "success",
+			objects: []runtime.Object{
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "older",
+						Namespace:         testNamespace,
+						CreationTimestamp: metav1.NewTime(now.Add(-time.Minute)),
+						Labels: map[string]string{
+							batchv1.JobNameLabel: testJobName,
+						},
+					},
+				},
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "newer",
+						Namespace:         testNamespace,
+						CreationTimestamp: metav1.NewTime(now),
+						Labels: map[string]string{
+							batchv1.JobNameLabel: testJobName,
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, logs io.ReadCloser, err error) {
+				require.NoError(t, err)
+				defer logs.Close()
+				data, err := io.ReadAll(logs)
+				require.NoError(t, err)
+				assert.Equal(t, "fake logs", string(data))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logs, err := GetLogs(
+				context.Background(),
+				kubefake.NewClientset(tt.objects...),
+				testNamespace,
+				testJobName,
+				&corev1.PodLogOptions{},
+			)
+			tt.assertions(t, logs, err)
+		})
+	}
+}
+
+func TestDeleteJob(t *testing.T) {
+	const testNamespace = "kargo"
+
+	c := kubefake.NewClientset(&batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-job",
+			Namespace: testNamespace,
+		},
+	})
+	require.NoError(t, DeleteJob(context.Background(), c, testNamespace, "fake-job"))
+
+	_, err := c.BatchV1().Jobs(testNamespace).Get(
+		context.Background(), "fake-job", metav1.GetOptions{},
+	)
+	require.True(t, apierrors.IsNotFound(err))
+	assertForegroundDeletes(t, c, "fake-job")
+
+	// Deleting a Job that does not exist is not an error
+	require.NoError(t, DeleteJob(context.Background(), c, testNamespace, "fake-job"))
+}
+
+func TestDeletePromotionJobs(t *testing.T) {
+	const testNamespace = "kargo"
+
+	newJob := func(name, project, promotion string) *batchv1.Job {
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+				Labels: map[string]string{
+					kargoapi.LabelKeyProject: project,
+				},
+				Annotations: map[string]string{
+					kargoapi.AnnotationKeyPromotion: promotion,
+				},
+			},
+		}
+	}
+	c := kubefake.NewClientset(
+		newJob("job-1", "fake-project", "fake-promotion"),
+		newJob("job-2", "fake-project", "fake-promotion"),
+		newJob("job-3", "fake-project", "other-promotion"),
+		newJob("job-4", "other-project", "fake-promotion"),
+	)
+	require.NoError(t, DeletePromotionJobs(
+		context.Background(), c, testNamespace, "fake-project", "fake-promotion",
+	))
+
+	jobs, err := c.BatchV1().Jobs(testNamespace).List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	var remaining []string
+	for _, job := range jobs.Items {
+		remaining = append(remaining, job.Name)
+	}
+	assert.ElementsMatch(t, []string{"job-3", "job-4"}, remaining)
+	assertForegroundDeletes(t, c, "job-1", "job-2")
+}
+
+// assertForegroundDeletes asserts that the Jobs with the provided names, and
+// only those Jobs, were deleted using foreground propagation.
+func assertForegroundDeletes(t *testing.T, c *kubefake.Clientset, names ...string) {
+	t.Helper()
+	var deleted []string
+	for _, action := range c.Actions() {
+		del, ok := action.(k8stesting.DeleteActionImpl)
+		if !ok || del.GetResource().Resource != "jobs" {
+			continue
+		}
+		require.NotNil(t, del.GetDeleteOptions().PropagationPolicy)
+		assert.Equal(t, metav1.DeletePropagationForeground, *del.GetDeleteOptions().PropagationPolicy)
+		if !slices.Contains(deleted, del.GetName()) {
+			deleted = append(deleted, del.GetName())
+		}
+	}
+	assert.ElementsMatch(t, names, deleted)
+}
@@ -56,8 +56,9 @@ func ExprEnvWithVars(vars map[string]any) ExprEnvOption {
 // ExprEnvWithStepMetas returns a ExprEnvOption that adds StepExecutionMetadata
 // indexed by alias to the expression language environment of the Step.
 func ExprEnvWithStepMetas(promoCtx Context) ExprEnvOption {
-	metas := make(map[string]any, len(promoCtx.StepExecutionMetadata))
-	for _, stepMeta := range promoCtx.StepExecutionMetadata {
+	stepMetas := promoCtx.precedingStepExecutionMetadata()
+	metas := make(map[string]any, len(stepMetas))
+	for _, stepMeta := range stepMetas {
 		metas[stepMeta.Alias] = stepMeta
 	}
 	return func(env map[string]any) {
@@ -89,6 +90,19 @@ func ExprEnvWithTaskOutputs(alias string, outputs State) ExprEnvOption {
 	}
 }
 
+// ExprEnvWithIteration returns a ExprEnvOption that adds the item and index
+// of the list the Step was expanded for, if any, to the expression language
+// environment of the Step.
+func ExprEnvWithIteration(step Step) ExprEnvOption {
+	return func(env map[string]any) {
+		if step.Iteration == nil {
+			return
+		}
+		env["item"] = step.Iteration.GetItem()
+		env["index"] = step.Iteration.Index
+	}
+}
+
 // BuildExprEnv builds an environment map for evaluating expressions in
 // Promotion steps. The environment includes context information, such as the
 // Project, Promotion, Stage, and target Freight reference.
@@ -170,6 +184,7 @@ func (p *StepEvaluator) Vars(ctx context.Context, promoCtx Context, step Step) (
 				ExprEnvWithStepMetas(promoCtx),
 				ExprEnvWithOutputs(promoCtx.State),
 				ExprEnvWithTaskOutputs(step.Alias, promoCtx.State),
+				ExprEnvWithIteration(step),
 				ExprEnvWithVars(vars),
 			),
 			exprOpts...,
@@ -187,7 +202,8 @@ func (p *StepEvaluator) Vars(ctx context.Context, promoCtx Context, step Step) (
 // condition defined in the Step. If the "if" condition evaluates to false, the
 // Step is skipped. If the "if" condition is not defined, the Step is skipped
 // if any of the previous Steps have failed or errored and are not skipped
-// otherwise.
+// otherwise. When executing OnFailure steps, only the previous OnFailure steps
+// are considered for this.
 func (p *StepEvaluator) ShouldSkip(ctx context.Context, promoCtx Context, step Step) (bool, error) {
 	// If no "if" condition is provided, then this step is automatically skipped
 	// if any of the previous steps have errored or failed and is not skipped
@@ -206,6 +222,7 @@ func (p *StepEvaluator) ShouldSkip(ctx context.Context, promoCtx Context, step S
 		ExprEnvWithStepMetas(promoCtx),
 		ExprEnvWithOutputs(promoCtx.State),
 		ExprEnvWithTaskOutputs(step.Alias, promoCtx.State),
+		ExprEnvWithIteration(step),
 		ExprEnvWithVars(vars),
 	)
 
@@ -221,7 +238,7 @@ func (p *StepEvaluator) ShouldSkip(ctx context.Context, promoCtx Context, step S
 				promoCtx.FreightRequests,
 				promoCtx.Freight.References(),
 			),
-			exprfn.StatusOperations(step.Alias, promoCtx.StepExecutionMetadata),
+			exprfn.StatusOperations(step.Alias, promoCtx.precedingStepExecutionMetadata()),
 			exprfn.UtilityOperations(),
 		)...,
 	)
@@ -257,6 +274,7 @@ func (p *StepEvaluator) Config(ctx context.Context, promoCtx Context, step Step)
 		ExprEnvWithStepMetas(promoCtx),
 		ExprEnvWithOutputs(promoCtx.State),
 		ExprEnvWithTaskOutputs(step.Alias, promoCtx.State),
+		ExprEnvWithIteration(step),
 		ExprEnvWithVars(vars),
 	)
 
@@ -272,7 +290,7 @@ func (p *StepEvaluator) Config(ctx context.Context, promoCtx Context, step Step)
 				promoCtx.Freight.References(),
 			),
 			exprfn.DataOperations(ctx, p.client, p.cache, promoCtx.Project),
-			exprfn.StatusOperations(step.Alias, promoCtx.StepExecutionMetadata),
+			exprfn.StatusOperations(step.Alias, promoCtx.precedingStepExecutionMetadata()),
 			exprfn.UtilityOperations(),
 		)...,
 	)
@@ -322,6 +340,7 @@ func (p *StepEvaluator) BuildStepContext(
 		FreightRequests:  freightRequests,
 		Freight:          *promoCtx.Freight.DeepCopy(),
 		TargetFreightRef: promoCtx.TargetFreightRef,
+		DryRun:           promoCtx.DryRun,
 	}, nil
 }
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -947,6 +948,36 @@ func TestStepEvaluator_ShouldSkip(t *testing.T) {
 				assert.False(t, b) // Should not skip when condition is true
 			},
 		},
+		{
+			name: "no if condition with failed steps before onFailure steps",
+			step: Step{},
+			promoCtx: Context{
+				FailedStepExecutionMetadata: kargoapi.StepExecutionMetadataList{{
+					Alias:  "push",
+					Status: kargoapi.PromotionStepStatusFailed,
+				}},
+			},
+			assertions: func(t *testing.T, b bool, err error) {
+				assert.NoError(t, err)
+				assert.False(t, b)
+			},
+		},
+		{
+			name: "if condition uses status of failed steps before onFailure steps",
+			step: Step{
+				If: "${{ failure() && status('push') == 'Failed' }}",
+			},
+			promoCtx: Context{
+				FailedStepExecutionMetadata: kargoapi.StepExecutionMetadataList{{
+					Alias:  "push",
+					Status: kargoapi.PromotionStepStatusFailed,
+				}},
+			},
+			assertions: func(t *testing.T, b bool, err error) {
+				assert.NoError(t, err)
+				assert.False(t, b)
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1046,6 +1077,21 @@ func TestStepEvaluator_Config(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "test iteration item and index",
+			step: Step{
+				Iteration: &kargoapi.PromotionStepIteration{
+					Index: 1,
+					Item:  &apiextensionsv1.JSON{Raw: []byte(`{"region":"eu"}`)},
+				},
+				Config: []byte(`{
+					"path": "${{ item.region }}/${{ index }}"
+				}`),
+			},
+			expectedCfg: Config{
+				"path": "eu/1",
+			},
+		},
 		{
 			name: "test vars with literal values",
 			// Test that vars can be assigned literal values
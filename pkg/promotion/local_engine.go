@@ -2,20 +2,27 @@ package promotion
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
+	"path/filepath"
 	"regexp"
+	"strings"
 
 	gocache "github.com/patrickmn/go-cache"
+	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/controller/git"
 	"github.com/akuity/kargo/pkg/credentials"
+	"github.com/akuity/kargo/pkg/logging"
 )
 
 // ReservedStepAliasRegex is a regular expression that matches step aliases that
 // are reserved for internal use.
-var ReservedStepAliasRegex = regexp.MustCompile(`^(step|task)-\d+$`)
+var ReservedStepAliasRegex = regexp.MustCompile(`^(step|task)-\d+(-\d+)?$`)
 
 // ExprDataCacheFn is a function that returns a new cache to use in expression
 // functions that consult the Kubernetes API.
@@ -38,24 +45,33 @@ func DefaultExprDataCacheFn() *gocache.Cache {
 }
 
 // LocalEngine is an implementation of the Engine interface that uses
-// built-in StepRunners locally.
+// StepRunners from a registry locally.
 type LocalEngine struct {
 	orchestator Orchestrator
 }
 
 // NewLocalEngine returns an implementation of the Engine interface that
-// uses built-in StepRunners locally.
+// uses StepRunners from the provided registry locally. If the registry is
+// nil, the DefaultStepRunnerRegistry of built-in StepRunners is used.
 func NewLocalEngine(
+	registry StepRunnerRegistry,
 	kargoClient client.Client,
 	argocdClient client.Client,
+	fluxClient client.Client,
+	localClusterClient kubernetes.Interface,
 	credsDB credentials.Database,
 	cacheFunc ExprDataCacheFn,
 ) *LocalEngine {
+	if registry == nil {
+		registry = DefaultStepRunnerRegistry
+	}
 	return &LocalEngine{
 		orchestator: NewLocalOrchestrator(
-			DefaultStepRunnerRegistry,
+			registry,
 			kargoClient,
 			argocdClient,
+			fluxClient,
+			localClusterClient,
 			credsDB,
 			cacheFunc,
 		),
@@ -76,7 +92,67 @@ func (e *LocalEngine) Promote(
 		defer os.RemoveAll(promoCtx.WorkDir)
 	}
 
-	return e.orchestator.ExecuteSteps(ctx, promoCtx, steps)
+	res, err := e.orchestator.ExecuteSteps(ctx, promoCtx, steps)
+	if promoCtx.DryRun && res.Status.IsTerminal() {
+		// Once a dry run has run its course, the changes it made to the Git
+		// working trees in the working directory are what it would have
+		// pushed.
+		if res.Diff, err = diffWorkDir(promoCtx.WorkDir); err != nil {
+			logging.LoggerFromContext(ctx).Error(
+				err, "error computing diff of dry run working directory",
+			)
+			err = nil
+		}
+	}
+	return res, err
+}
+
+// diffWorkDir returns a unified diff of the changes made to all Git working
+// trees found in the provided working directory since they were checked out.
+// The paths in the diff are relative to the working directory.
+func diffWorkDir(workDir string) (string, error) {
+	var diff strings.Builder
+	var errs []error
+	err := filepath.WalkDir(workDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if d.Name() == ".git" {
+			return filepath.SkipDir
+		}
+		// The working trees of a bare repository contain a .git file rather
+		// than a .git directory.
+		if fi, err := os.Stat(filepath.Join(path, ".git")); err != nil || !fi.Mode().IsRegular() {
+			return nil
+		}
+		relPath, err := filepath.Rel(workDir, path)
+		if err != nil {
+			return err
+		}
+		var prefix string
+		if relPath != "." {
+			prefix = filepath.ToSlash(relPath) + "/"
+		}
+		workTree, err := git.LoadWorkTree(path, nil)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error loading working tree at %q: %w", relPath, err))
+			return filepath.SkipDir
+		}
+		workTreeDiff, err := workTree.Diff(prefix)
+		if err != nil {
+			errs = append(errs, err)
+			return filepath.SkipDir
+		}
+		diff.WriteString(workTreeDiff)
+		return filepath.SkipDir
+	})
+	if err != nil {
+		return "", fmt.Errorf("error walking working directory: %w", err)
+	}
+	return diff.String(), errors.Join(errs...)
 }
 
 // setupWorkDir creates a temporary working directory if one is not provided.
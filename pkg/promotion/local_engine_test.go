@@ -4,12 +4,16 @@ import (
 	"context"
 	"errors"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/controller/git"
 )
 
 func TestSimpleEngine_Promote(t *testing.T) {
@@ -151,6 +155,8 @@ func TestSimpleEngine_Promote(t *testing.T) {
 					fake.NewClientBuilder().Build(),
 					nil,
 					nil,
+					nil,
+					nil,
 				),
 			}
 
@@ -197,3 +203,45 @@ func TestSimpleEngine_setupWorkDir(t *testing.T) {
 		})
 	}
 }
+
+func Test_diffWorkDir(t *testing.T) {
+	// Create a repository to clone from.
+	srcDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "values.yaml"), []byte("tag: v1\n"), 0o600))
+	for _, args := range [][]string{
+		{"init", "--initial-branch=main"},
+		{"add", "--all"},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-m", "initial commit"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = srcDir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+
+	workDir := t.TempDir()
+	repo, err := git.CloneBare(srcDir, nil, &git.BareCloneOptions{BaseDir: workDir})
+	require.NoError(t, err)
+	workTree, err := repo.AddWorkTree(
+		filepath.Join(workDir, "out"),
+		&git.AddWorkTreeOptions{Ref: "main", RecordBase: true},
+	)
+	require.NoError(t, err)
+
+	diff, err := diffWorkDir(workDir)
+	require.NoError(t, err)
+	require.Empty(t, diff)
+
+	require.NoError(
+		t,
+		os.WriteFile(filepath.Join(workTree.Dir(), "values.yaml"), []byte("tag: v2\n"), 0o600),
+	)
+	require.NoError(t, workTree.AddAllAndCommit("update tag", nil))
+
+	diff, err = diffWorkDir(workDir)
+	require.NoError(t, err)
+	require.Contains(t, diff, "--- a/out/values.yaml")
+	require.Contains(t, diff, "+++ b/out/values.yaml")
+	require.Contains(t, diff, "-tag: v1")
+	require.Contains(t, diff, "+tag: v2")
+}
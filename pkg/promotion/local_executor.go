@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
@@ -15,9 +16,11 @@ import (
 type LocalStepExecutor struct {
 	registry StepRunnerRegistry
 
-	kargoClient  client.Client
-	argoCDClient client.Client
-	credsDB      credentials.Database
+	kargoClient        client.Client
+	argoCDClient       client.Client
+	fluxClient         client.Client
+	localClusterClient kubernetes.Interface
+	credsDB            credentials.Database
 }
 
 // NewLocalStepExecutor creates a new LocalStepExecutor with the provided
@@ -25,20 +28,25 @@ type LocalStepExecutor struct {
 // execute steps in the promotion process.
 func NewLocalStepExecutor(
 	registry StepRunnerRegistry,
-	kargoClient, argoCDClient client.Client,
+	kargoClient, argoCDClient, fluxClient client.Client,
+	localClusterClient kubernetes.Interface,
 	credsDB credentials.Database,
 ) *LocalStepExecutor {
 	return &LocalStepExecutor{
-		registry:     registry,
-		kargoClient:  kargoClient,
-		argoCDClient: argoCDClient,
-		credsDB:      credsDB,
+		registry:           registry,
+		kargoClient:        kargoClient,
+		argoCDClient:       argoCDClient,
+		fluxClient:         fluxClient,
+		localClusterClient: localClusterClient,
+		credsDB:            credsDB,
 	}
 }
 
 // ExecuteStep executes a single promotion Step using the registered step
 // runner for the Step's kind. It handles any errors that occur during execution
-// and returns a StepResult indicating the outcome of the step execution.
+// and returns a StepResult indicating the outcome of the step execution. If
+// the Promotion is a dry run and executing the Step would have side effects,
+// the Step is planned instead of executed.
 func (e *LocalStepExecutor) ExecuteStep(
 	ctx context.Context,
 	req StepExecutionRequest,
@@ -59,11 +67,20 @@ func (e *LocalStepExecutor) ExecuteStep(
 			capabilities.ArgoCDClient = e.argoCDClient
 		case StepCapabilityAccessCredentials:
 			capabilities.CredsDB = e.credsDB
+		case StepCapabilityAccessFlux:
+			capabilities.FluxClient = e.fluxClient
+		case StepCapabilityAccessLocalCluster:
+			capabilities.LocalClusterClient = e.localClusterClient
 		}
 	}
 
-	factory := reg.Value
-	runner := factory(capabilities)
+	var runner StepRunner
+	if req.Context.DryRun && reg.Metadata.IsSideEffecting(req.Context.Config) {
+		runner = newPlanner(req.Step.Kind)
+	} else {
+		factory := reg.Value
+		runner = factory(capabilities)
+	}
 
 	func() {
 		defer func() {
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	kubefake "k8s.io/client-go/kubernetes/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
@@ -25,12 +26,16 @@ func TestNewLocalStepExecutor(t *testing.T) {
 
 	kargoClient := fake.NewClientBuilder().Build()
 	argoCDClient := fake.NewClientBuilder().Build()
+	fluxClient := fake.NewClientBuilder().Build()
+	localClusterClient := kubefake.NewClientset()
 	credsDB := &credentials.FakeDB{}
 
 	executor := NewLocalStepExecutor(
 		registry,
 		kargoClient,
 		argoCDClient,
+		fluxClient,
+		localClusterClient,
 		credsDB,
 	)
 
@@ -39,6 +44,8 @@ func TestNewLocalStepExecutor(t *testing.T) {
 	require.Equal(t, registry, executor.registry)
 	require.Equal(t, kargoClient, executor.kargoClient)
 	require.Equal(t, argoCDClient, executor.argoCDClient)
+	require.Equal(t, fluxClient, executor.fluxClient)
+	require.Equal(t, localClusterClient, executor.localClusterClient)
 	require.Equal(t, credsDB, executor.credsDB)
 }
 
@@ -93,6 +100,76 @@ func TestLocalStepExecutor_ExecuteStep(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+		{
+			name: "side-effecting step is planned in dry run",
+			registry: MustNewStepRunnerRegistry(
+				StepRunnerRegistration{
+					Name: "test-step",
+					Value: func(_ StepRunnerCapabilities) StepRunner {
+						return &MockStepRunner{
+							RunErr: errors.New("step must not be executed"),
+						}
+					},
+					Metadata: StepRunnerMetadata{SideEffecting: true},
+				},
+			),
+			request: StepExecutionRequest{
+				Context: StepContext{
+					Alias:  "push",
+					Config: Config{"path": "./out"},
+					DryRun: true,
+				},
+				Step: Step{
+					Kind: "test-step",
+				},
+			},
+			assertions: func(t *testing.T, result StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.Status)
+				require.Contains(t, result.Message, "dry run")
+				require.Equal(t, &PlannedStep{
+					Kind:   "test-step",
+					Alias:  "push",
+					Config: Config{"path": "./out"},
+				}, result.PlannedStep)
+			},
+		},
+		{
+			name: "step without side effects for its config is executed in dry run",
+			registry: MustNewStepRunnerRegistry(
+				StepRunnerRegistration{
+					Name: "test-step",
+					Value: func(_ StepRunnerCapabilities) StepRunner {
+						return &MockStepRunner{
+							RunResult: StepResult{
+								Status: kargoapi.PromotionStepStatusSucceeded,
+							},
+						}
+					},
+					Metadata: StepRunnerMetadata{
+						SideEffecting: true,
+						SideEffectingFn: func(cfg Config) bool {
+							return cfg["method"] != "GET"
+						},
+					},
+				},
+			),
+			request: StepExecutionRequest{
+				Context: StepContext{
+					Config: Config{"method": "GET"},
+					DryRun: true,
+				},
+				Step: Step{
+					Kind: "test-step",
+				},
+			},
+			assertions: func(t *testing.T, result StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, StepResult{
+					Status: kargoapi.PromotionStepStatusSucceeded,
+				}, result)
+			},
+		},
 		{
 			name: "step execution returns error",
 			registry: MustNewStepRunnerRegistry(
@@ -155,7 +232,7 @@ func TestLocalStepExecutor_ExecuteStep(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			executor := NewLocalStepExecutor(tt.registry, nil, nil, nil)
+			executor := NewLocalStepExecutor(tt.registry, nil, nil, nil, nil, nil)
 			result, err := executor.ExecuteStep(context.Background(), tt.request)
 			tt.assertions(t, result, err)
 		})
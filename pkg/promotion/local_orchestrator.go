@@ -2,11 +2,15 @@ package promotion
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
+	"sync"
+	"time"
 
 	gocache "github.com/patrickmn/go-cache"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
@@ -28,12 +32,20 @@ type LocalOrchestrator struct {
 // provided client, step runner registry, and cache function.
 func NewLocalOrchestrator(
 	registry StepRunnerRegistry,
-	kargoClient, argoCDClient client.Client,
+	kargoClient, argoCDClient, fluxClient client.Client,
+	localClusterClient kubernetes.Interface,
 	credsDB credentials.Database,
 	cacheFunc ExprDataCacheFn,
 ) *LocalOrchestrator {
 	return &LocalOrchestrator{
-		executor:  NewLocalStepExecutor(registry, kargoClient, argoCDClient, credsDB),
+		executor: NewLocalStepExecutor(
+			registry,
+			kargoClient,
+			argoCDClient,
+			fluxClient,
+			localClusterClient,
+			credsDB,
+		),
 		registry:  registry,
 		client:    kargoClient,
 		cacheFunc: cacheFunc,
@@ -58,6 +70,7 @@ func (o *LocalOrchestrator) ExecuteSteps(
 	}
 
 	var healthChecks []health.Criteria
+	var plannedSteps []PlannedStep
 
 	// Execute each step in sequence, starting from the step specified in
 	// the Context if provided.
@@ -75,6 +88,16 @@ func (o *LocalOrchestrator) ExecuteSteps(
 					"step %q was canceled due to context cancellation: %s",
 					step.Alias, ctx.Err(),
 				).Finished()
+				for j := range meta.Parallel {
+					if childMeta := &meta.Parallel[j]; childMeta.StartedAt != nil && childMeta.FinishedAt == nil {
+						childMeta.Status = kargoapi.PromotionStepStatusErrored
+						childMeta.Message = fmt.Sprintf(
+							"step %q was canceled due to context cancellation: %s",
+							childMeta.Alias, ctx.Err(),
+						)
+						childMeta.FinishedAt = meta.FinishedAt
+					}
+				}
 			}
 			return Result{
 				Status:                kargoapi.PromotionPhaseErrored,
@@ -83,91 +106,20 @@ func (o *LocalOrchestrator) ExecuteSteps(
 				StepExecutionMetadata: promoCtx.StepExecutionMetadata,
 				State:                 promoCtx.State,
 				HealthChecks:          healthChecks,
+				PlannedSteps:          plannedSteps,
 			}, nil
 		default:
 			// Continue execution if the context is still active.
 		}
 
-		processor := NewStepEvaluator(o.client, o.newCache())
-
-		// Only evaluate the "if" conditio when the step has not yet started.
-		// If the step has already started (on a previous reconciliation), we
-		// should not re-evaluate whether to skip it. Re-evaluating could cause
-		// a step's own Failed status from a previous attempt to incorrectly
-		// trigger the skip condition.
-		if meta.StartedAt == nil {
-			// Evaluate the "if" condition for the step to determine if it should
-			// be executed.
-			skip, err := processor.ShouldSkip(ctx, promoCtx, step)
-			switch {
-			case err != nil:
-				meta.WithStatus(kargoapi.PromotionStepStatusErrored).WithMessagef(
-					"error checking if step %q should be skipped: %s", step.Alias, err,
-				)
-				// Skip the step, because despite this failure, some steps' "if"
-				// conditions may still allow them to run.
-				continue
-			case skip:
-				meta.WithStatus(kargoapi.PromotionStepStatusSkipped)
-				continue
-			}
-		}
-
-		// Get the reg for the step (for validation purposes).
-		//
-		// NOTE(hidde): We primarily do this to ensure we do not mark the step
-		// as started if we cannot find a runner for it. In the future, we
-		// should consider validating the steps existence during the creation
-		// of the Promotion, or e.g. work with a typed within the executor to
-		// identify the lack of a registered runner.
-		reg, err := o.registry.Get(step.Kind)
-		if err != nil {
-			meta.WithStatus(kargoapi.PromotionStepStatusErrored).WithMessagef(
-				"error getting runner for step kind %q", step.Kind,
-			)
-			// Continue, because despite this failure, some steps' "if" conditions may
-			// still allow them to run.
-			//
-			// TODO(hidde): Arguably, we should return a TerminalError here. As
-			// it is an obvious misconfiguration that could have been caught
-			// if our validation webhook was aware of registered steps.
-			continue
-		}
-
-		// Mark the step as started.
-		meta.Started()
-
-		// Build step context for the step execution.
-		stepCtx, err := processor.BuildStepContext(ctx, promoCtx, step)
-		if err != nil {
-			meta.WithStatus(kargoapi.PromotionStepStatusErrored).WithMessagef(
-				"failed to build step context: %s", err,
-			)
-			continue
-		}
-
-		// Execute the step.
-		result, err := o.executor.ExecuteStep(ctx, StepExecutionRequest{
-			Context: *stepCtx,
-			Step:    step,
-		})
-
-		// Propagate the step output to the state.
-		o.propagateStepOutput(promoCtx, step, reg.Metadata, result)
-
-		// Confirm the step has a valid status.
-		if !result.Status.Valid() {
-			meta.WithStatus(kargoapi.PromotionStepStatusErrored).WithMessagef(
-				"step %q returned an invalid status: %s", step.Alias, result.Status,
-			).Finished()
-			continue
-		}
+		outcome := o.executeStep(ctx, promoCtx, step)
 
-		// Update the step execution metadata with the result.
-		err = o.reconcileResultWithMetadata(promoCtx, step, result, err)
+		// Add any health checks of succeeded steps to the list.
+		healthChecks = append(healthChecks, outcome.healthChecks...)
+		// Add any steps that were planned instead of executed to the list.
+		plannedSteps = append(plannedSteps, outcome.plannedSteps...)
 
-		// Determine the completion of the step based on the metadata.
-		if !o.determineStepCompletion(promoCtx, step, reg.Metadata, err) {
+		if !outcome.complete {
 			// Step incomplete; return error (if any) for progressive backoff.
 			return Result{
 				Status:                kargoapi.PromotionPhaseRunning,
@@ -175,15 +127,9 @@ func (o *LocalOrchestrator) ExecuteSteps(
 				StepExecutionMetadata: promoCtx.StepExecutionMetadata,
 				State:                 promoCtx.State,
 				HealthChecks:          healthChecks,
-				RetryAfter:            result.RetryAfter,
-			}, err
-		}
-
-		// If the step succeeded, we can add any health checks to the list.
-		if meta.Status == kargoapi.PromotionStepStatusSucceeded {
-			if result.HealthCheck != nil {
-				healthChecks = append(healthChecks, *result.HealthCheck)
-			}
+				PlannedSteps:          plannedSteps,
+				RetryAfter:            outcome.retryAfter,
+			}, outcome.err
 		}
 	}
 
@@ -197,9 +143,263 @@ func (o *LocalOrchestrator) ExecuteSteps(
 		StepExecutionMetadata: promoCtx.StepExecutionMetadata,
 		State:                 promoCtx.State,
 		HealthChecks:          healthChecks,
+		PlannedSteps:          plannedSteps,
 	}, nil
 }
 
+// stepOutcome is the outcome of an attempt at executing a single Step.
+type stepOutcome struct {
+	// complete indicates whether the Step has reached a terminal state.
+	complete bool
+	// output is the output of the Step, if it was executed.
+	output map[string]any
+	// runnerMeta is the metadata of the StepRunner that executed the Step.
+	runnerMeta StepRunnerMetadata
+	// healthChecks collects the health.Criteria returned by the Step, or by
+	// the Steps of a parallel group, upon success.
+	healthChecks []health.Criteria
+	// plannedSteps collects the Steps that were planned instead of executed
+	// because the Promotion is a dry run.
+	plannedSteps []PlannedStep
+	// retryAfter is the suggested duration after which an incomplete Step
+	// should be retried.
+	retryAfter *time.Duration
+	// err is any error encountered by an incomplete Step.
+	err error
+}
+
+// executeStep executes the provided Step, which must be the current step of
+// the provided Promotion context. It evaluates the "if" condition of the
+// Step, executes it, and reconciles the result with the execution metadata of
+// the Step.
+func (o *LocalOrchestrator) executeStep(
+	ctx context.Context,
+	promoCtx Context,
+	step Step,
+) stepOutcome {
+	meta := promoCtx.GetCurrentStep()
+	processor := NewStepEvaluator(o.client, o.newCache())
+
+	// Only evaluate the "if" conditio when the step has not yet started.
+	// If the step has already started (on a previous reconciliation), we
+	// should not re-evaluate whether to skip it. Re-evaluating could cause
+	// a step's own Failed status from a previous attempt to incorrectly
+	// trigger the skip condition.
+	if meta.StartedAt == nil {
+		// Evaluate the "if" condition for the step to determine if it should
+		// be executed.
+		skip, err := processor.ShouldSkip(ctx, promoCtx, step)
+		switch {
+		case err != nil:
+			meta.WithStatus(kargoapi.PromotionStepStatusErrored).WithMessagef(
+				"error checking if step %q should be skipped: %s", step.Alias, err,
+			)
+			// Skip the step, because despite this failure, some steps' "if"
+			// conditions may still allow them to run.
+			return stepOutcome{complete: true}
+		case skip:
+			meta.WithStatus(kargoapi.PromotionStepStatusSkipped)
+			return stepOutcome{complete: true}
+		}
+	}
+
+	if len(step.Parallel) > 0 {
+		return o.executeParallelSteps(ctx, promoCtx, step)
+	}
+
+	// Get the reg for the step (for validation purposes).
+	//
+	// NOTE(hidde): We primarily do this to ensure we do not mark the step
+	// as started if we cannot find a runner for it. In the future, we
+	// should consider validating the steps existence during the creation
+	// of the Promotion, or e.g. work with a typed within the executor to
+	// identify the lack of a registered runner.
+	reg, err := o.registry.Get(step.Kind)
+	if err != nil {
+		meta.WithStatus(kargoapi.PromotionStepStatusErrored).WithMessagef(
+			"error getting runner for step kind %q", step.Kind,
+		)
+		// Continue, because despite this failure, some steps' "if" conditions may
+		// still allow them to run.
+		//
+		// TODO(hidde): Arguably, we should return a TerminalError here. As
+		// it is an obvious misconfiguration that could have been caught
+		// if our validation webhook was aware of registered steps.
+		return stepOutcome{complete: true}
+	}
+
+	// Mark the step as started.
+	meta.Started()
+
+	// Build step context for the step execution.
+	stepCtx, err := processor.BuildStepContext(ctx, promoCtx, step)
+	if err != nil {
+		meta.WithStatus(kargoapi.PromotionStepStatusErrored).WithMessagef(
+			"failed to build step context: %s", err,
+		)
+		return stepOutcome{complete: true}
+	}
+	stepCtx.Timeout = step.Retry.GetTimeout(reg.Metadata.DefaultTimeout)
+
+	// Execute the step.
+	result, err := o.executor.ExecuteStep(ctx, StepExecutionRequest{
+		Context: *stepCtx,
+		Step:    step,
+	})
+
+	// Propagate the step output to the state.
+	o.propagateStepOutput(promoCtx, step, reg.Metadata, result)
+
+	// Confirm the step has a valid status.
+	if !result.Status.Valid() {
+		meta.WithStatus(kargoapi.PromotionStepStatusErrored).WithMessagef(
+			"step %q returned an invalid status: %s", step.Alias, result.Status,
+		).Finished()
+		return stepOutcome{
+			complete:   true,
+			output:     result.Output,
+			runnerMeta: reg.Metadata,
+		}
+	}
+
+	// Update the step execution metadata with the result.
+	err = o.reconcileResultWithMetadata(promoCtx, step, result, err)
+
+	// Determine the completion of the step based on the metadata.
+	if !o.determineStepCompletion(promoCtx, step, reg.Metadata, err) {
+		return stepOutcome{
+			output:     result.Output,
+			runnerMeta: reg.Metadata,
+			retryAfter: result.RetryAfter,
+			err:        err,
+		}
+	}
+
+	outcome := stepOutcome{
+		complete:   true,
+		output:     result.Output,
+		runnerMeta: reg.Metadata,
+	}
+	if result.PlannedStep != nil {
+		outcome.plannedSteps = []PlannedStep{*result.PlannedStep}
+	}
+	// If the step succeeded, we can add any health checks to the list.
+	if meta.Status == kargoapi.PromotionStepStatusSucceeded {
+		if result.HealthCheck != nil {
+			outcome.healthChecks = []health.Criteria{*result.HealthCheck}
+		}
+	}
+	return outcome
+}
+
+// executeParallelSteps executes the Steps of the provided parallel group
+// concurrently. Each Step is executed against its own copy of the Promotion
+// context, so Steps within the group cannot observe each other's output.
+// Once all Steps have been executed, their output is merged into the shared
+// state in the order the Steps are defined in, and their execution metadata
+// is recorded on the metadata of the group. The group is complete once all
+// of its Steps are, at which point its status is determined from the
+// statuses of its Steps using DetermineFinalPhase.
+func (o *LocalOrchestrator) executeParallelSteps(
+	ctx context.Context,
+	promoCtx Context,
+	group Step,
+) stepOutcome {
+	meta := promoCtx.GetCurrentStep()
+	meta.Started()
+
+	childMetas := make(kargoapi.StepExecutionMetadataList, len(group.Parallel))
+	for i, child := range group.Parallel {
+		childMetas[i] = kargoapi.StepExecutionMetadata{
+			Alias:           child.Alias,
+			ContinueOnError: child.ContinueOnError,
+		}
+		for _, m := range meta.Parallel {
+			if m.Alias == child.Alias {
+				childMetas[i] = fromParallelStepExecutionMetadata(m)
+				break
+			}
+		}
+	}
+
+	outcomes := make([]*stepOutcome, len(group.Parallel))
+	var wg sync.WaitGroup
+	for i, child := range group.Parallel {
+		if childMetas[i].FinishedAt != nil {
+			// This step was completed during a previous reconciliation.
+			continue
+		}
+		// The execution metadata of the step is added to that of the steps
+		// that came before the group, so that the step can observe their
+		// outcome but not that of the other steps in the group.
+		childCtx := promoCtx.DeepCopy()
+		childCtx.StepExecutionMetadata = append(childCtx.StepExecutionMetadata, childMetas[i])
+		childMeta := childCtx.SetCurrentStep(child)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			outcome := o.executeStep(ctx, childCtx, child)
+			if outcome.complete {
+				childMeta.Finished()
+			}
+			childMetas[i] = kargoapi.StepExecutionMetadata(*childMeta)
+			outcomes[i] = &outcome
+		}()
+	}
+	wg.Wait()
+
+	groupOutcome := stepOutcome{complete: true}
+	var incomplete int
+	var errs []error
+	for i, child := range group.Parallel {
+		outcome := outcomes[i]
+		if outcome == nil {
+			continue
+		}
+		// Merge the output of the step into the shared state. As this happens
+		// in the order the steps are defined in, later steps take precedence
+		// over earlier ones when both write to the same task namespace.
+		if outcome.output != nil {
+			o.propagateStepOutput(
+				promoCtx,
+				child,
+				outcome.runnerMeta,
+				StepResult{Output: outcome.output},
+			)
+		}
+		groupOutcome.healthChecks = append(groupOutcome.healthChecks, outcome.healthChecks...)
+		groupOutcome.plannedSteps = append(groupOutcome.plannedSteps, outcome.plannedSteps...)
+		if !outcome.complete {
+			groupOutcome.complete = false
+			incomplete++
+			if outcome.err != nil {
+				errs = append(errs, outcome.err)
+			}
+			if outcome.retryAfter != nil &&
+				(groupOutcome.retryAfter == nil || *outcome.retryAfter < *groupOutcome.retryAfter) {
+				groupOutcome.retryAfter = outcome.retryAfter
+			}
+		}
+	}
+
+	meta.Parallel = make([]kargoapi.ParallelStepExecutionMetadata, len(childMetas))
+	for i := range childMetas {
+		meta.Parallel[i] = toParallelStepExecutionMetadata(childMetas[i])
+	}
+
+	if !groupOutcome.complete {
+		groupOutcome.err = errors.Join(errs...)
+		meta.WithStatus(kargoapi.PromotionStepStatusRunning).WithMessagef(
+			"%d of %d parallel steps have not yet completed", incomplete, len(group.Parallel),
+		)
+		return groupOutcome
+	}
+
+	phase, msg := DetermineFinalPhase(group.Parallel, childMetas)
+	meta.WithStatus(phaseToStepStatus(phase)).WithMessage(msg).Finished()
+	return groupOutcome
+}
+
 func (o *LocalOrchestrator) propagateStepOutput(
 	promoCtx Context,
 	step Step,
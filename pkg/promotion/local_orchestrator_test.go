@@ -3,6 +3,8 @@ package promotion
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -91,6 +93,37 @@ func TestLocalOrchestrator_ExecuteSteps(t *testing.T) {
 				}, result.State)
 			},
 		},
+		{
+			name: "effective timeout is provided to step",
+			registrations: []StepRunnerRegistration{{
+				Name: "timeout-step",
+				Metadata: StepRunnerMetadata{
+					DefaultTimeout: 5 * time.Minute,
+				},
+				Value: func(_ StepRunnerCapabilities) StepRunner {
+					return &MockStepRunner{
+						RunFunc: func(_ context.Context, stepCtx *StepContext) (StepResult, error) {
+							if stepCtx.Timeout != 2*time.Minute {
+								return StepResult{Status: kargoapi.PromotionStepStatusErrored},
+									&TerminalError{Err: fmt.Errorf("unexpected timeout %s", stepCtx.Timeout)}
+							}
+							return StepResult{Status: kargoapi.PromotionStepStatusSucceeded}, nil
+						},
+					}
+				},
+			}},
+			steps: []Step{{
+				Kind:  "timeout-step",
+				Alias: "step1",
+				Retry: &kargoapi.PromotionStepRetry{
+					Timeout: &metav1.Duration{Duration: 2 * time.Minute},
+				},
+			}},
+			assertions: func(t *testing.T, result Result, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionPhaseSucceeded, result.Status)
+			},
+		},
 		{
 			name: "execute the skipped step",
 			steps: []Step{
@@ -499,6 +532,347 @@ func TestLocalOrchestrator_ExecuteSteps(t *testing.T) {
 				assert.NotNil(t, result.StepExecutionMetadata[0].FinishedAt)
 			},
 		},
+		{
+			name: "parallel steps execute successfully",
+			steps: []Step{
+				{
+					Alias: "group",
+					Parallel: []Step{
+						{Kind: "success-step", Alias: "step1"},
+						{Kind: "skipped-step", Alias: "step2"},
+						{Kind: "error-step", Alias: "step3", If: "${{ false }}"},
+					},
+				},
+				{Kind: "success-step", Alias: "step4"},
+			},
+			assertions: func(t *testing.T, result Result, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionPhaseSucceeded, result.Status)
+				assert.Empty(t, result.Message)
+				assert.Equal(t, int64(1), result.CurrentStep)
+
+				require.Len(t, result.StepExecutionMetadata, 2)
+
+				groupMeta := result.StepExecutionMetadata[0]
+				assert.Equal(t, "group", groupMeta.Alias)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, groupMeta.Status)
+				assert.NotNil(t, groupMeta.StartedAt)
+				assert.NotNil(t, groupMeta.FinishedAt)
+
+				require.Len(t, groupMeta.Parallel, 3)
+				assert.Equal(t, "step1", groupMeta.Parallel[0].Alias)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, groupMeta.Parallel[0].Status)
+				assert.NotNil(t, groupMeta.Parallel[0].StartedAt)
+				assert.NotNil(t, groupMeta.Parallel[0].FinishedAt)
+				assert.Equal(t, "step2", groupMeta.Parallel[1].Alias)
+				assert.Equal(t, kargoapi.PromotionStepStatusSkipped, groupMeta.Parallel[1].Status)
+				assert.Equal(t, "step3", groupMeta.Parallel[2].Alias)
+				assert.Equal(t, kargoapi.PromotionStepStatusSkipped, groupMeta.Parallel[2].Status)
+				assert.Nil(t, groupMeta.Parallel[2].StartedAt)
+
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.StepExecutionMetadata[1].Status)
+
+				assert.Equal(t, State{
+					"step1": map[string]any{
+						"key": "value",
+					},
+					"step2": map[string]any{
+						"key": "value",
+					},
+					"step4": map[string]any{
+						"key": "value",
+					},
+				}, result.State)
+			},
+		},
+		{
+			name: "parallel steps are executed concurrently",
+			registrations: func() []StepRunnerRegistration {
+				// Each step waits for the other to have started. If the steps
+				// were executed sequentially, neither would ever succeed.
+				var started sync.WaitGroup
+				started.Add(2)
+				return []StepRunnerRegistration{{
+					Name: "barrier-step",
+					Value: func(_ StepRunnerCapabilities) StepRunner {
+						return &MockStepRunner{
+							RunFunc: func(context.Context, *StepContext) (StepResult, error) {
+								started.Done()
+								done := make(chan struct{})
+								go func() {
+									started.Wait()
+									close(done)
+								}()
+								select {
+								case <-done:
+									return StepResult{Status: kargoapi.PromotionStepStatusSucceeded}, nil
+								case <-time.After(10 * time.Second):
+									return StepResult{Status: kargoapi.PromotionStepStatusErrored},
+										&TerminalError{Err: errors.New("timed out waiting for other step")}
+								}
+							},
+						}
+					},
+				}}
+			}(),
+			steps: []Step{{
+				Alias: "group",
+				Parallel: []Step{
+					{Kind: "barrier-step", Alias: "step1"},
+					{Kind: "barrier-step", Alias: "step2"},
+				},
+			}},
+			assertions: func(t *testing.T, result Result, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionPhaseSucceeded, result.Status)
+
+				require.Len(t, result.StepExecutionMetadata, 1)
+				require.Len(t, result.StepExecutionMetadata[0].Parallel, 2)
+				for _, metadata := range result.StepExecutionMetadata[0].Parallel {
+					assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, metadata.Status)
+				}
+			},
+		},
+		{
+			name: "parallel step fails",
+			steps: []Step{
+				{
+					Alias: "group",
+					Parallel: []Step{
+						{Kind: "success-step", Alias: "step1"},
+						{Kind: "terminal-error-step", Alias: "step2"},
+						{Kind: "error-step", Alias: "step3", ContinueOnError: true},
+					},
+				},
+				{Kind: "success-step", Alias: "step4"},
+			},
+			assertions: func(t *testing.T, result Result, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionPhaseErrored, result.Status)
+				assert.Contains(t, result.Message, "an unrecoverable error occurred")
+				assert.Equal(t, int64(1), result.CurrentStep)
+
+				require.Len(t, result.StepExecutionMetadata, 2)
+
+				groupMeta := result.StepExecutionMetadata[0]
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, groupMeta.Status)
+				assert.Contains(t, groupMeta.Message, "an unrecoverable error occurred")
+				assert.NotNil(t, groupMeta.FinishedAt)
+
+				require.Len(t, groupMeta.Parallel, 3)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, groupMeta.Parallel[0].Status)
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, groupMeta.Parallel[1].Status)
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, groupMeta.Parallel[2].Status)
+				assert.True(t, groupMeta.Parallel[2].ContinueOnError)
+				for _, metadata := range groupMeta.Parallel {
+					assert.NotNil(t, metadata.FinishedAt)
+				}
+
+				// The step following the group is skipped because of the failure.
+				assert.Equal(t, kargoapi.PromotionStepStatusSkipped, result.StepExecutionMetadata[1].Status)
+			},
+		},
+		{
+			name: "parallel step with continueOnError fails",
+			steps: []Step{{
+				Alias: "group",
+				Parallel: []Step{
+					{Kind: "success-step", Alias: "step1"},
+					{Kind: "terminal-error-step", Alias: "step2", ContinueOnError: true},
+				},
+			}},
+			assertions: func(t *testing.T, result Result, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionPhaseSucceeded, result.Status)
+
+				require.Len(t, result.StepExecutionMetadata, 1)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.StepExecutionMetadata[0].Status)
+			},
+		},
+		{
+			name: "parallel step is still running",
+			steps: []Step{
+				{
+					Alias: "group",
+					Parallel: []Step{
+						{Kind: "success-step", Alias: "step1"},
+						{Kind: "running-step", Alias: "step2"},
+					},
+				},
+				{Kind: "success-step", Alias: "step3"},
+			},
+			assertions: func(t *testing.T, result Result, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionPhaseRunning, result.Status)
+				assert.Equal(t, int64(0), result.CurrentStep)
+
+				require.Len(t, result.StepExecutionMetadata, 1)
+
+				groupMeta := result.StepExecutionMetadata[0]
+				assert.Equal(t, kargoapi.PromotionStepStatusRunning, groupMeta.Status)
+				assert.Equal(t, "1 of 2 parallel steps have not yet completed", groupMeta.Message)
+				assert.NotNil(t, groupMeta.StartedAt)
+				assert.Nil(t, groupMeta.FinishedAt)
+
+				require.Len(t, groupMeta.Parallel, 2)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, groupMeta.Parallel[0].Status)
+				assert.NotNil(t, groupMeta.Parallel[0].FinishedAt)
+				assert.Equal(t, kargoapi.PromotionStepStatusRunning, groupMeta.Parallel[1].Status)
+				assert.NotNil(t, groupMeta.Parallel[1].StartedAt)
+				assert.Nil(t, groupMeta.Parallel[1].FinishedAt)
+
+				// Output of the completed step is already available.
+				assert.Equal(t, State{
+					"step1": map[string]any{
+						"key": "value",
+					},
+				}, result.State)
+			},
+		},
+		{
+			name: "parallel steps resume from previous reconciliation",
+			promoCtx: Context{
+				StepExecutionMetadata: kargoapi.StepExecutionMetadataList{{
+					Alias:     "group",
+					StartedAt: ptr.To(metav1.NewTime(time.Now().Add(-time.Minute))),
+					Status:    kargoapi.PromotionStepStatusRunning,
+					Parallel: []kargoapi.ParallelStepExecutionMetadata{
+						{
+							Alias:      "step1",
+							StartedAt:  ptr.To(metav1.NewTime(time.Now().Add(-time.Minute))),
+							FinishedAt: ptr.To(metav1.NewTime(time.Now().Add(-time.Minute))),
+							Status:     kargoapi.PromotionStepStatusSucceeded,
+						},
+						{
+							Alias:     "step2",
+							StartedAt: ptr.To(metav1.NewTime(time.Now().Add(-time.Minute))),
+							Status:    kargoapi.PromotionStepStatusRunning,
+						},
+					},
+				}},
+			},
+			steps: []Step{{
+				Alias: "group",
+				Parallel: []Step{
+					// Would fail if it was executed again.
+					{Kind: "terminal-error-step", Alias: "step1"},
+					{Kind: "success-step", Alias: "step2"},
+				},
+			}},
+			assertions: func(t *testing.T, result Result, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionPhaseSucceeded, result.Status)
+
+				require.Len(t, result.StepExecutionMetadata, 1)
+
+				groupMeta := result.StepExecutionMetadata[0]
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, groupMeta.Status)
+				require.Len(t, groupMeta.Parallel, 2)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, groupMeta.Parallel[0].Status)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, groupMeta.Parallel[1].Status)
+				assert.NotNil(t, groupMeta.Parallel[1].FinishedAt)
+
+				assert.Equal(t, State{
+					"step2": map[string]any{
+						"key": "value",
+					},
+				}, result.State)
+			},
+		},
+		{
+			name: "parallel step output propagation to task namespace",
+			registrations: []StepRunnerRegistration{{
+				Name: "task-level-output-step",
+				Value: func(_ StepRunnerCapabilities) StepRunner {
+					return &MockStepRunner{
+						RunFunc: func(_ context.Context, stepCtx *StepContext) (StepResult, error) {
+							return StepResult{
+								Status: kargoapi.PromotionStepStatusSucceeded,
+								Output: map[string]any{
+									"shared":      stepCtx.Alias,
+									stepCtx.Alias: true,
+								},
+							}, nil
+						},
+					}
+				},
+				Metadata: StepRunnerMetadata{
+					RequiredCapabilities: []StepRunnerCapability{
+						StepCapabilityTaskOutputPropagation,
+					},
+				},
+			}},
+			steps: []Step{{
+				Alias: "task-1::group",
+				Parallel: []Step{
+					{Kind: "task-level-output-step", Alias: "task-1::step1"},
+					{Kind: "task-level-output-step", Alias: "task-1::step2"},
+				},
+			}},
+			assertions: func(t *testing.T, result Result, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionPhaseSucceeded, result.Status)
+				assert.Equal(t, State{
+					"task-1::step1": map[string]any{
+						"shared":        "task-1::step1",
+						"task-1::step1": true,
+					},
+					"task-1::step2": map[string]any{
+						"shared":        "task-1::step2",
+						"task-1::step2": true,
+					},
+					// Output of later steps takes precedence.
+					"task-1": map[string]any{
+						"shared":        "task-1::step2",
+						"task-1::step1": true,
+						"task-1::step2": true,
+					},
+				}, result.State)
+			},
+		},
+		{
+			name: "dry run plans side-effecting steps",
+			registrations: []StepRunnerRegistration{{
+				Name: "side-effecting-step",
+				Value: func(_ StepRunnerCapabilities) StepRunner {
+					return &MockStepRunner{
+						RunFunc: func(context.Context, *StepContext) (StepResult, error) {
+							panic("side-effecting step must not be executed")
+						},
+					}
+				},
+				Metadata: StepRunnerMetadata{SideEffecting: true},
+			}},
+			promoCtx: Context{DryRun: true},
+			steps: []Step{
+				{Kind: "success-step", Alias: "step1"},
+				{Kind: "side-effecting-step", Alias: "step2", Config: []byte(`{"key":"value"}`)},
+				{
+					Alias: "group",
+					Parallel: []Step{
+						{Kind: "side-effecting-step", Alias: "step3"},
+						{Kind: "success-step", Alias: "step4"},
+					},
+				},
+			},
+			assertions: func(t *testing.T, result Result, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionPhaseSucceeded, result.Status)
+				assert.Equal(t, []PlannedStep{
+					{
+						Kind:   "side-effecting-step",
+						Alias:  "step2",
+						Config: Config{"key": "value"},
+					},
+					{
+						Kind:  "side-effecting-step",
+						Alias: "step3",
+					},
+				}, result.PlannedSteps)
+				assert.Equal(t, State{"key": "value"}, State(result.State["step1"].(map[string]any)))
+				assert.Nil(t, result.State["step2"])
+			},
+		},
 		{
 			name: "panic during step execution",
 			registrations: []StepRunnerRegistration{
@@ -636,6 +1010,8 @@ func TestLocalOrchestrator_ExecuteSteps(t *testing.T) {
 				fake.NewClientBuilder().Build(),
 				nil,
 				nil,
+				nil,
+				nil,
 			)
 
 			tt.promoCtx.WorkDir = t.TempDir()
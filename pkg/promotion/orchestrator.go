@@ -59,3 +59,18 @@ func DetermineFinalPhase(
 		return kargoapi.PromotionPhaseErrored, worstMsg
 	}
 }
+
+// phaseToStepStatus maps a final PromotionPhase, as returned by
+// DetermineFinalPhase, to the equivalent PromotionStepStatus.
+func phaseToStepStatus(phase kargoapi.PromotionPhase) kargoapi.PromotionStepStatus {
+	switch phase {
+	case kargoapi.PromotionPhaseSucceeded:
+		return kargoapi.PromotionStepStatusSucceeded
+	case kargoapi.PromotionPhaseAborted:
+		return kargoapi.PromotionStepStatusAborted
+	case kargoapi.PromotionPhaseFailed:
+		return kargoapi.PromotionStepStatusFailed
+	default:
+		return kargoapi.PromotionStepStatusErrored
+	}
+}
@@ -0,0 +1,94 @@
+package promotion
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// redacted is the value that replaces any value in the configuration of a
+// PlannedStep that may be sensitive.
+const redacted = "*** REDACTED ***"
+
+var (
+	// sensitiveKeyRegex matches configuration keys whose values may be
+	// sensitive.
+	sensitiveKeyRegex = regexp.MustCompile(
+		`(?i)(password|passwd|secret|token|credential|private[-_]?key|signing[-_]?key|api[-_]?key)`,
+	)
+	// sensitiveNameRegex matches the names of name/value pairs, such as HTTP
+	// headers or query parameters, whose values may be sensitive.
+	sensitiveNameRegex = regexp.MustCompile(
+		`(?i)(authorization|cookie|password|secret|token|credential|key)`,
+	)
+)
+
+// planner is an implementation of the StepRunner interface that replaces a
+// side-effecting StepRunner when the Promotion is a dry run. Instead of
+// executing the Step, it records what would have been executed.
+type planner struct {
+	kind string
+}
+
+// newPlanner returns a planner for Steps of the provided kind.
+func newPlanner(kind string) StepRunner {
+	return &planner{kind: kind}
+}
+
+// Run implements the StepRunner interface.
+func (p *planner) Run(_ context.Context, stepCtx *StepContext) (StepResult, error) {
+	return StepResult{
+		Status: kargoapi.PromotionStepStatusSucceeded,
+		Message: fmt.Sprintf(
+			"dry run: step of kind %q was planned but not executed", p.kind,
+		),
+		PlannedStep: &PlannedStep{
+			Kind:   p.kind,
+			Alias:  stepCtx.Alias,
+			Config: redactConfig(stepCtx.Config),
+		},
+	}, nil
+}
+
+// redactConfig returns a copy of the provided Config in which any values that
+// may be sensitive have been replaced.
+func redactConfig(cfg Config) Config {
+	if cfg == nil {
+		return nil
+	}
+	return Config(redactValue(map[string]any(cfg)).(map[string]any)) // nolint: forcetypeassert
+}
+
+// redactValue returns a copy of the provided configuration value in which any
+// values that may be sensitive have been replaced. A value is considered to
+// be sensitive if its key matches sensitiveKeyRegex, or if it is the value of
+// a name/value pair whose name matches sensitiveNameRegex.
+func redactValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		res := make(map[string]any, len(v))
+		for k, val := range v {
+			if val != nil && sensitiveKeyRegex.MatchString(k) {
+				res[k] = redacted
+				continue
+			}
+			res[k] = redactValue(val)
+		}
+		if name, ok := v["name"].(string); ok && sensitiveNameRegex.MatchString(name) {
+			if _, ok = v["value"]; ok {
+				res["value"] = redacted
+			}
+		}
+		return res
+	case []any:
+		res := make([]any, len(v))
+		for i, val := range v {
+			res[i] = redactValue(val)
+		}
+		return res
+	default:
+		return v
+	}
+}
@@ -0,0 +1,133 @@
+// Package plugin implements the protocol by which Kargo delegates the
+// execution of promotion steps to out-of-process plugins registered using
+// StepPlugin resources.
+//
+// A plugin is a gRPC server implementing the StepExecutor service, which has a
+// single unary method, ExecuteStep, that accepts a StepExecutionRequest and
+// returns a StepResult. Messages are encoded as JSON (i.e. with a content type
+// of application/grpc+json) so that plugins may be implemented in any language
+// without relying on generated code.
+package plugin
+
+import (
+	"encoding/json"
+
+	"google.golang.org/grpc/encoding"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+const (
+	// ServiceName is the fully qualified name of the gRPC service implemented
+	// by plugins.
+	ServiceName = "kargo.plugin.v1alpha1.StepExecutor"
+
+	// executeStepMethod is the full name of the gRPC method invoked to execute
+	// a step.
+	executeStepMethod = "/" + ServiceName + "/ExecuteStep"
+
+	// codecName is the name of the codec used to encode messages exchanged
+	// with plugins. It is also the content subtype of those messages.
+	codecName = "json"
+)
+
+func init() {
+	encoding.RegisterCodec(jsonCodec{})
+}
+
+// StepExecutionRequest is the message sent to a plugin to request the
+// execution of a step.
+type StepExecutionRequest struct {
+	// Kind is the kind of step to execute.
+	Kind string `json:"kind"`
+	// Alias is the alias of the step.
+	Alias string `json:"alias,omitempty"`
+	// Config is the configuration of the step, with all expressions already
+	// evaluated.
+	Config map[string]any `json:"config,omitempty"`
+	// Context is the context in which the step is executed.
+	Context StepContext `json:"context"`
+}
+
+// StepContext is the context in which a step is executed by a plugin.
+type StepContext struct {
+	// UIBaseURL may be used to construct deeper URLs for interacting with the
+	// Kargo UI.
+	UIBaseURL string `json:"uiBaseURL,omitempty"`
+	// WorkDir is the working directory of the Promotion. Plugins that operate
+	// on files must have the same directory mounted at the same path.
+	WorkDir string `json:"workDir"`
+	// SharedState is the state shared between the steps of the Promotion.
+	SharedState map[string]any `json:"sharedState,omitempty"`
+	// Project is the Project that the Promotion is associated with.
+	Project string `json:"project"`
+	// Stage is the Stage that the Promotion is targeting.
+	Stage string `json:"stage"`
+	// Promotion is the name of the Promotion.
+	Promotion string `json:"promotion"`
+	// PromotionActor is the name of the actor triggering the Promotion.
+	PromotionActor string `json:"promotionActor,omitempty"`
+	// FreightRequests is the list of Freight requested by the Stage.
+	FreightRequests []kargoapi.FreightRequest `json:"freightRequests,omitempty"`
+	// Freight is the collection of all Freight referenced by the Promotion.
+	Freight kargoapi.FreightCollection `json:"freight"`
+	// TargetFreightRef is the Freight that triggered the Promotion.
+	TargetFreightRef kargoapi.FreightReference `json:"targetFreightRef"`
+	// DryRun indicates whether the Promotion is a dry run. Steps declared to
+	// have side effects are never sent to a plugin as part of a dry run, so
+	// this is only informational.
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// StepResult is the message returned by a plugin describing the outcome of a
+// step.
+type StepResult struct {
+	// Status is the high-level outcome of the step.
+	Status kargoapi.PromotionStepStatus `json:"status"`
+	// Message optionally provides additional context about the outcome of the
+	// step.
+	Message string `json:"message,omitempty"`
+	// Output is the output of the step, which is made available to subsequent
+	// steps.
+	Output map[string]any `json:"output,omitempty"`
+	// HealthCheck optionally identifies criteria for a health check to be
+	// performed by one of Kargo's built-in health checkers.
+	HealthCheck *HealthCheck `json:"healthCheck,omitempty"`
+	// RetryAfter is an optional, suggested duration after which a step
+	// reporting a Running status should be retried.
+	RetryAfter *metav1.Duration `json:"retryAfter,omitempty"`
+	// Error optionally describes an error encountered while executing the
+	// step.
+	Error string `json:"error,omitempty"`
+	// Terminal indicates whether the error described by Error is one that
+	// retrying the step cannot resolve.
+	Terminal bool `json:"terminal,omitempty"`
+}
+
+// HealthCheck identifies criteria for a health check performed by one of
+// Kargo's built-in health checkers.
+type HealthCheck struct {
+	// Kind identifies the health checker.
+	Kind string `json:"kind"`
+	// Input is the input to the health checker.
+	Input map[string]any `json:"input,omitempty"`
+}
+
+// jsonCodec is a gRPC codec that encodes messages as JSON.
+type jsonCodec struct{}
+
+// Marshal implements the encoding.Codec interface.
+func (jsonCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// Unmarshal implements the encoding.Codec interface.
+func (jsonCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+// Name implements the encoding.Codec interface.
+func (jsonCodec) Name() string {
+	return codecName
+}
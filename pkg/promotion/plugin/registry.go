@@ -0,0 +1,159 @@
+package plugin
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/component"
+	"github.com/akuity/kargo/pkg/promotion"
+)
+
+// registry is an implementation of the promotion.StepRunnerRegistry interface
+// that resolves any step kind not found in a wrapped registry to the
+// StepPlugin that executes it.
+type registry struct {
+	promotion.StepRunnerRegistry
+	client client.Reader
+
+	connsMu sync.Mutex
+	// conns holds one connection per plugin endpoint. Connections are
+	// established lazily by gRPC and are reused for the life of the process.
+	conns map[endpoint]*grpc.ClientConn
+}
+
+// endpoint identifies a plugin's gRPC server and how to connect to it.
+type endpoint struct {
+	address   string
+	plaintext bool
+}
+
+// NewStepRunnerRegistry returns a promotion.StepRunnerRegistry that first
+// looks up step kinds in the provided registry and falls back to the
+// StepPlugins retrievable using the provided client. Step kinds registered in
+// the provided registry, such as those of built-in steps, therefore take
+// precedence over step kinds executed by plugins.
+func NewStepRunnerRegistry(
+	builtins promotion.StepRunnerRegistry,
+	c client.Reader,
+) promotion.StepRunnerRegistry {
+	return &registry{
+		StepRunnerRegistry: builtins,
+		client:             c,
+		conns:              make(map[endpoint]*grpc.ClientConn),
+	}
+}
+
+// Get implements the promotion.StepRunnerRegistry interface.
+func (r *registry) Get(kind string) (promotion.StepRunnerRegistration, error) {
+	reg, err := r.StepRunnerRegistry.Get(kind)
+	if err == nil || !component.IsNotFoundError(err) {
+		return reg, err
+	}
+
+	// The registry interface does not accept a context. StepPlugins are
+	// expected to be read from a cache, so this is not a blocking operation in
+	// practice.
+	plugin, step, err := GetStepPlugin(context.Background(), r.client, kind)
+	if err != nil {
+		return promotion.StepRunnerRegistration{}, err
+	}
+	if plugin == nil {
+		return promotion.StepRunnerRegistration{},
+			component.NamedRegistrationNotFoundError{Name: kind}
+	}
+
+	conn, err := r.getConn(endpoint{
+		address:   plugin.Spec.Address,
+		plaintext: plugin.Spec.Plaintext,
+	})
+	if err != nil {
+		return promotion.StepRunnerRegistration{}, fmt.Errorf(
+			"error connecting to StepPlugin %q: %w", plugin.Name, err,
+		)
+	}
+
+	var schema []byte
+	if step.ConfigSchema != nil {
+		schema = step.ConfigSchema.Raw
+	}
+	metadata := promotion.StepRunnerMetadata{
+		DefaultErrorThreshold: step.DefaultErrorThreshold,
+		// Plugin steps are assumed to have side effects unless they explicitly
+		// say otherwise, so that dry runs never execute them by accident.
+		SideEffecting: step.SideEffecting == nil || *step.SideEffecting,
+	}
+	if metadata.DefaultErrorThreshold == 0 {
+		metadata.DefaultErrorThreshold = 1
+	}
+	if step.DefaultTimeout != nil {
+		metadata.DefaultTimeout = step.DefaultTimeout.Duration
+	}
+	return promotion.StepRunnerRegistration{
+		Name:     kind,
+		Metadata: metadata,
+		Value: func(promotion.StepRunnerCapabilities) promotion.StepRunner {
+			return newStepRunner(conn, kind, schema)
+		},
+	}, nil
+}
+
+// getConn returns a connection to the provided endpoint, creating one if
+// necessary.
+func (r *registry) getConn(e endpoint) (*grpc.ClientConn, error) {
+	r.connsMu.Lock()
+	defer r.connsMu.Unlock()
+	if conn, ok := r.conns[e]; ok {
+		return conn, nil
+	}
+	creds := credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	if e.plaintext {
+		creds = insecure.NewCredentials()
+	}
+	conn, err := grpc.NewClient(e.address, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, err
+	}
+	r.conns[e] = conn
+	return conn, nil
+}
+
+// GetStepPlugin returns the StepPlugin that executes steps of the provided
+// kind, along with the description of those steps. If no StepPlugin executes
+// steps of the provided kind, nil is returned. If more than one does, an error
+// is returned.
+func GetStepPlugin(
+	ctx context.Context,
+	c client.Reader,
+	kind string,
+) (*kargoapi.StepPlugin, *kargoapi.StepPluginStep, error) {
+	plugins := &kargoapi.StepPluginList{}
+	if err := c.List(ctx, plugins); err != nil {
+		return nil, nil, fmt.Errorf("error listing StepPlugins: %w", err)
+	}
+	var plugin *kargoapi.StepPlugin
+	var step *kargoapi.StepPluginStep
+	for i := range plugins.Items {
+		for j := range plugins.Items[i].Spec.Steps {
+			if plugins.Items[i].Spec.Steps[j].Kind != kind {
+				continue
+			}
+			if plugin != nil {
+				return nil, nil, fmt.Errorf(
+					"steps of kind %q are executed by more than one StepPlugin: %q and %q",
+					kind, plugin.Name, plugins.Items[i].Name,
+				)
+			}
+			plugin = &plugins.Items[i]
+			step = &plugins.Items[i].Spec.Steps[j]
+		}
+	}
+	return plugin, step, nil
+}
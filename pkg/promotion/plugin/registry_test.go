@@ -0,0 +1,172 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/component"
+	"github.com/akuity/kargo/pkg/promotion"
+)
+
+func Test_registry_Get(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	builtins := promotion.MustNewStepRunnerRegistry(
+		promotion.StepRunnerRegistration{
+			Name: "builtin-step",
+			Value: func(promotion.StepRunnerCapabilities) promotion.StepRunner {
+				return &promotion.MockStepRunner{}
+			},
+		},
+	)
+
+	testCases := []struct {
+		name        string
+		objects     []client.Object
+		interceptor interceptor.Funcs
+		kind        string
+		assertions  func(*testing.T, promotion.StepRunnerRegistration, error)
+	}{
+		{
+			name: "built-in step",
+			interceptor: interceptor.Funcs{
+				List: func(context.Context, client.WithWatch, client.ObjectList, ...client.ListOption) error {
+					return errors.New("StepPlugins should not have been listed")
+				},
+			},
+			kind: "builtin-step",
+			assertions: func(t *testing.T, reg promotion.StepRunnerRegistration, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "builtin-step", reg.Name)
+			},
+		},
+		{
+			name: "error listing StepPlugins",
+			interceptor: interceptor.Funcs{
+				List: func(context.Context, client.WithWatch, client.ObjectList, ...client.ListOption) error {
+					return errors.New("something went wrong")
+				},
+			},
+			kind: "plugin-step",
+			assertions: func(t *testing.T, _ promotion.StepRunnerRegistration, err error) {
+				require.ErrorContains(t, err, "error listing StepPlugins")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "step not executed by any StepPlugin",
+			kind: "plugin-step",
+			assertions: func(t *testing.T, _ promotion.StepRunnerRegistration, err error) {
+				require.True(t, component.IsNotFoundError(err))
+			},
+		},
+		{
+			name: "step executed by more than one StepPlugin",
+			objects: []client.Object{
+				&kargoapi.StepPlugin{
+					ObjectMeta: metav1.ObjectMeta{Name: "plugin-a"},
+					Spec: kargoapi.StepPluginSpec{
+						Address: "localhost:50051",
+						Steps:   []kargoapi.StepPluginStep{{Kind: "plugin-step"}},
+					},
+				},
+				&kargoapi.StepPlugin{
+					ObjectMeta: metav1.ObjectMeta{Name: "plugin-b"},
+					Spec: kargoapi.StepPluginSpec{
+						Address: "localhost:50052",
+						Steps:   []kargoapi.StepPluginStep{{Kind: "plugin-step"}},
+					},
+				},
+			},
+			kind: "plugin-step",
+			assertions: func(t *testing.T, _ promotion.StepRunnerRegistration, err error) {
+				require.ErrorContains(t, err, "executed by more than one StepPlugin")
+			},
+		},
+		{
+			name: "step executed by a StepPlugin",
+			objects: []client.Object{
+				&kargoapi.StepPlugin{
+					ObjectMeta: metav1.ObjectMeta{Name: "plugin-a"},
+					Spec: kargoapi.StepPluginSpec{
+						Address:   "localhost:50051",
+						Plaintext: true,
+						Steps: []kargoapi.StepPluginStep{
+							{Kind: "other-step"},
+							{
+								Kind:           "plugin-step",
+								DefaultTimeout: &metav1.Duration{Duration: 5 * time.Minute},
+							},
+						},
+					},
+				},
+			},
+			kind: "plugin-step",
+			assertions: func(t *testing.T, reg promotion.StepRunnerRegistration, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "plugin-step", reg.Name)
+				require.Equal(
+					t,
+					promotion.StepRunnerMetadata{
+						DefaultTimeout:        5 * time.Minute,
+						DefaultErrorThreshold: 1,
+						SideEffecting:         true,
+					},
+					reg.Metadata,
+				)
+				require.NotNil(t, reg.Value)
+				runner, ok := reg.Value(promotion.StepRunnerCapabilities{}).(*stepRunner)
+				require.True(t, ok)
+				require.Equal(t, "plugin-step", runner.kind)
+			},
+		},
+		{
+			name: "step executed by a StepPlugin without side effects",
+			objects: []client.Object{
+				&kargoapi.StepPlugin{
+					ObjectMeta: metav1.ObjectMeta{Name: "plugin-a"},
+					Spec: kargoapi.StepPluginSpec{
+						Address: "localhost:50051",
+						Steps: []kargoapi.StepPluginStep{{
+							Kind:                  "plugin-step",
+							SideEffecting:         ptr.To(false),
+							DefaultErrorThreshold: 3,
+						}},
+					},
+				},
+			},
+			kind: "plugin-step",
+			assertions: func(t *testing.T, reg promotion.StepRunnerRegistration, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					promotion.StepRunnerMetadata{DefaultErrorThreshold: 3},
+					reg.Metadata,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(testCase.objects...).
+				WithInterceptorFuncs(testCase.interceptor).
+				Build()
+			reg, err := NewStepRunnerRegistry(builtins, c).Get(testCase.kind)
+			testCase.assertions(t, reg, err)
+		})
+	}
+}
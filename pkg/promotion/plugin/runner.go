@@ -0,0 +1,141 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/xeipuuv/gojsonschema"
+	"google.golang.org/grpc"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/health"
+	"github.com/akuity/kargo/pkg/promotion"
+)
+
+// stepRunner is an implementation of the promotion.StepRunner interface that
+// delegates the execution of steps of a single kind to a plugin.
+type stepRunner struct {
+	conn grpc.ClientConnInterface
+	kind string
+	// schemaLoader, if non-nil, loads the JSON schema advertised by the plugin
+	// for the configuration of the step.
+	schemaLoader gojsonschema.JSONLoader
+}
+
+// newStepRunner returns a promotion.StepRunner that delegates the execution of
+// steps of the provided kind to the plugin reachable over the provided
+// connection. If the provided schema is non-empty, the configuration of each
+// step is validated against it before the step is sent to the plugin.
+func newStepRunner(
+	conn grpc.ClientConnInterface,
+	kind string,
+	schema []byte,
+) promotion.StepRunner {
+	r := &stepRunner{
+		conn: conn,
+		kind: kind,
+	}
+	if len(schema) > 0 {
+		r.schemaLoader = gojsonschema.NewBytesLoader(schema)
+	}
+	return r
+}
+
+// Run implements the promotion.StepRunner interface.
+func (r *stepRunner) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	if err := r.validate(stepCtx.Config); err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+
+	res := &StepResult{}
+	if err := r.conn.Invoke(
+		ctx,
+		executeStepMethod,
+		newStepExecutionRequest(r.kind, stepCtx),
+		res,
+		grpc.CallContentSubtype(codecName),
+	); err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusErrored,
+		}, fmt.Errorf("error executing %s step using plugin: %w", r.kind, err)
+	}
+
+	result := promotion.StepResult{
+		Status:  res.Status,
+		Message: res.Message,
+		Output:  res.Output,
+	}
+	if res.HealthCheck != nil {
+		result.HealthCheck = &health.Criteria{
+			Kind:  res.HealthCheck.Kind,
+			Input: res.HealthCheck.Input,
+		}
+	}
+	if res.RetryAfter != nil {
+		result.RetryAfter = &res.RetryAfter.Duration
+	}
+	if res.Error == "" {
+		return result, nil
+	}
+	err := errors.New(res.Error)
+	if res.Terminal {
+		return result, &promotion.TerminalError{Err: err}
+	}
+	return result, err
+}
+
+// validate validates the provided configuration against the JSON schema
+// advertised by the plugin, if any.
+func (r *stepRunner) validate(cfg promotion.Config) error {
+	if r.schemaLoader == nil {
+		return nil
+	}
+	doc := map[string]any(cfg)
+	if doc == nil {
+		doc = map[string]any{}
+	}
+	result, err := gojsonschema.Validate(r.schemaLoader, gojsonschema.NewGoLoader(doc))
+	if err != nil {
+		return fmt.Errorf("could not validate %s config: %w", r.kind, err)
+	}
+	if !result.Valid() {
+		errs := make([]error, len(result.Errors()))
+		for i, err := range result.Errors() {
+			errs[i] = errors.New(err.String())
+		}
+		return fmt.Errorf("invalid %s config: %w", r.kind, errors.Join(errs...))
+	}
+	return nil
+}
+
+// newStepExecutionRequest returns the StepExecutionRequest sent to a plugin
+// to execute a step of the provided kind in the provided context.
+func newStepExecutionRequest(
+	kind string,
+	stepCtx *promotion.StepContext,
+) *StepExecutionRequest {
+	return &StepExecutionRequest{
+		Kind:   kind,
+		Alias:  stepCtx.Alias,
+		Config: stepCtx.Config,
+		Context: StepContext{
+			UIBaseURL:        stepCtx.UIBaseURL,
+			WorkDir:          stepCtx.WorkDir,
+			SharedState:      stepCtx.SharedState,
+			Project:          stepCtx.Project,
+			Stage:            stepCtx.Stage,
+			Promotion:        stepCtx.Promotion,
+			PromotionActor:   stepCtx.PromotionActor,
+			FreightRequests:  stepCtx.FreightRequests,
+			Freight:          stepCtx.Freight,
+			TargetFreightRef: stepCtx.TargetFreightRef,
+			DryRun:           stepCtx.DryRun,
+		},
+	}
+}
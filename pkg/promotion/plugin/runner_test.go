@@ -0,0 +1,189 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/health"
+	"github.com/akuity/kargo/pkg/promotion"
+)
+
+// newTestConn starts an in-memory gRPC server that executes steps using the
+// provided StepRunners and returns a connection to it.
+func newTestConn(
+	t *testing.T,
+	runners map[string]promotion.StepRunner,
+) grpc.ClientConnInterface {
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	RegisterStepExecutorServer(srv, NewStepRunnerServer(runners))
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	t.Cleanup(srv.Stop)
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	return conn
+}
+
+func Test_stepRunner_Run(t *testing.T) {
+	const testKind = "fake-step"
+	testSchema := []byte(
+		`{"type":"object","required":["path"],"properties":{"path":{"type":"string"}}}`,
+	)
+
+	testCases := []struct {
+		name       string
+		kind       string
+		schema     []byte
+		runner     *promotion.MockStepRunner
+		stepCtx    *promotion.StepContext
+		assertions func(*testing.T, promotion.StepResult, error)
+	}{
+		{
+			name:   "config does not match schema",
+			kind:   testKind,
+			schema: testSchema,
+			runner: &promotion.MockStepRunner{
+				RunErr: errors.New("step should not have been executed"),
+			},
+			stepCtx: &promotion.StepContext{
+				Config: promotion.Config{"path": 42},
+			},
+			assertions: func(t *testing.T, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "invalid fake-step config")
+				require.True(t, promotion.IsTerminal(err))
+				require.Equal(t, kargoapi.PromotionStepStatusFailed, res.Status)
+			},
+		},
+		{
+			name: "step kind not implemented by plugin",
+			kind: "unknown-step",
+			runner: &promotion.MockStepRunner{
+				RunErr: errors.New("step should not have been executed"),
+			},
+			stepCtx: &promotion.StepContext{},
+			assertions: func(t *testing.T, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "error executing unknown-step step using plugin")
+				require.ErrorContains(t, err, "not implemented")
+				require.False(t, promotion.IsTerminal(err))
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "transient error",
+			kind: testKind,
+			runner: &promotion.MockStepRunner{
+				RunResult: promotion.StepResult{
+					Status: kargoapi.PromotionStepStatusErrored,
+				},
+				RunErr: errors.New("something went wrong"),
+			},
+			stepCtx: &promotion.StepContext{},
+			assertions: func(t *testing.T, res promotion.StepResult, err error) {
+				require.EqualError(t, err, "something went wrong")
+				require.False(t, promotion.IsTerminal(err))
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "terminal error",
+			kind: testKind,
+			runner: &promotion.MockStepRunner{
+				RunResult: promotion.StepResult{
+					Status: kargoapi.PromotionStepStatusFailed,
+				},
+				RunErr: &promotion.TerminalError{Err: errors.New("something went wrong")},
+			},
+			stepCtx: &promotion.StepContext{},
+			assertions: func(t *testing.T, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.True(t, promotion.IsTerminal(err))
+				require.Equal(t, kargoapi.PromotionStepStatusFailed, res.Status)
+			},
+		},
+		{
+			name:   "success",
+			kind:   testKind,
+			schema: testSchema,
+			runner: &promotion.MockStepRunner{
+				RunFunc: func(
+					_ context.Context,
+					stepCtx *promotion.StepContext,
+				) (promotion.StepResult, error) {
+					retryAfter := time.Minute
+					return promotion.StepResult{
+						Status: kargoapi.PromotionStepStatusRunning,
+						Output: map[string]any{
+							"path":    stepCtx.Config["path"],
+							"project": stepCtx.Project,
+							"workDir": stepCtx.WorkDir,
+						},
+						HealthCheck: &health.Criteria{
+							Kind:  "fake-health-check",
+							Input: map[string]any{"foo": "bar"},
+						},
+						RetryAfter: &retryAfter,
+					}, nil
+				},
+			},
+			stepCtx: &promotion.StepContext{
+				WorkDir: "/tmp/fake-work-dir",
+				Project: "fake-project",
+				Config:  promotion.Config{"path": "foo"},
+			},
+			assertions: func(t *testing.T, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusRunning, res.Status)
+				require.Equal(
+					t,
+					map[string]any{
+						"path":    "foo",
+						"project": "fake-project",
+						"workDir": "/tmp/fake-work-dir",
+					},
+					res.Output,
+				)
+				require.Equal(
+					t,
+					&health.Criteria{
+						Kind:  "fake-health-check",
+						Input: map[string]any{"foo": "bar"},
+					},
+					res.HealthCheck,
+				)
+				require.NotNil(t, res.RetryAfter)
+				require.Equal(t, time.Minute, *res.RetryAfter)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			conn := newTestConn(
+				t,
+				map[string]promotion.StepRunner{testKind: testCase.runner},
+			)
+			res, err := newStepRunner(conn, testCase.kind, testCase.schema).
+				Run(t.Context(), testCase.stepCtx)
+			testCase.assertions(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package plugin
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/akuity/kargo/pkg/promotion"
+)
+
+// StepExecutorServer is the interface implemented by plugins to execute
+// steps.
+type StepExecutorServer interface {
+	// ExecuteStep executes the step described by the provided request and
+	// returns its outcome. Errors returned by this method are treated as
+	// transient. Errors that retrying the step cannot resolve should instead
+	// be described by the Error and Terminal fields of the StepResult.
+	ExecuteStep(context.Context, *StepExecutionRequest) (*StepResult, error)
+}
+
+// stepExecutorServiceDesc describes the StepExecutor service to gRPC.
+var stepExecutorServiceDesc = grpc.ServiceDesc{
+	ServiceName: ServiceName,
+	HandlerType: (*StepExecutorServer)(nil),
+	Methods: []grpc.MethodDesc{{
+		MethodName: "ExecuteStep",
+		Handler:    executeStepHandler,
+	}},
+}
+
+// RegisterStepExecutorServer registers the provided StepExecutorServer with
+// the provided gRPC server.
+func RegisterStepExecutorServer(s grpc.ServiceRegistrar, srv StepExecutorServer) {
+	s.RegisterService(&stepExecutorServiceDesc, srv)
+}
+
+func executeStepHandler(
+	srv any,
+	ctx context.Context,
+	dec func(any) error,
+	interceptor grpc.UnaryServerInterceptor,
+) (any, error) {
+	req := &StepExecutionRequest{}
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	server := srv.(StepExecutorServer) // nolint: forcetypeassert
+	if interceptor == nil {
+		return server.ExecuteStep(ctx, req)
+	}
+	return interceptor(
+		ctx,
+		req,
+		&grpc.UnaryServerInfo{
+			Server:     srv,
+			FullMethod: executeStepMethod,
+		},
+		func(ctx context.Context, req any) (any, error) {
+			return server.ExecuteStep(ctx, req.(*StepExecutionRequest)) // nolint: forcetypeassert
+		},
+	)
+}
+
+// stepRunnerServer is an implementation of the StepExecutorServer interface
+// that executes steps using promotion.StepRunners.
+type stepRunnerServer struct {
+	runners map[string]promotion.StepRunner
+}
+
+// NewStepRunnerServer returns a StepExecutorServer that executes steps using
+// the provided promotion.StepRunners, indexed by the kind of step they
+// execute. This permits plugins written in Go to implement steps in the same
+// manner as Kargo's built-in steps.
+func NewStepRunnerServer(runners map[string]promotion.StepRunner) StepExecutorServer {
+	return &stepRunnerServer{runners: runners}
+}
+
+// ExecuteStep implements the StepExecutorServer interface.
+func (s *stepRunnerServer) ExecuteStep(
+	ctx context.Context,
+	req *StepExecutionRequest,
+) (*StepResult, error) {
+	runner, ok := s.runners[req.Kind]
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "step kind %q is not implemented", req.Kind)
+	}
+
+	res, err := runner.Run(ctx, &promotion.StepContext{
+		UIBaseURL:        req.Context.UIBaseURL,
+		WorkDir:          req.Context.WorkDir,
+		SharedState:      req.Context.SharedState,
+		Alias:            req.Alias,
+		Config:           req.Config,
+		Project:          req.Context.Project,
+		Stage:            req.Context.Stage,
+		Promotion:        req.Context.Promotion,
+		PromotionActor:   req.Context.PromotionActor,
+		FreightRequests:  req.Context.FreightRequests,
+		Freight:          req.Context.Freight,
+		TargetFreightRef: req.Context.TargetFreightRef,
+		DryRun:           req.Context.DryRun,
+	})
+
+	result := &StepResult{
+		Status:  res.Status,
+		Message: res.Message,
+		Output:  res.Output,
+	}
+	if res.HealthCheck != nil {
+		result.HealthCheck = &HealthCheck{
+			Kind:  res.HealthCheck.Kind,
+			Input: res.HealthCheck.Input,
+		}
+	}
+	if res.RetryAfter != nil {
+		result.RetryAfter = &metav1.Duration{Duration: *res.RetryAfter}
+	}
+	if err != nil {
+		result.Error = err.Error()
+		result.Terminal = promotion.IsTerminal(err)
+	}
+	return result, nil
+}
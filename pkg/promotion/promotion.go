@@ -60,6 +60,11 @@ type Context struct {
 	// StepExecutionMetadata tracks metadata pertaining to the execution
 	// of individual promotion steps.
 	StepExecutionMetadata kargoapi.StepExecutionMetadataList
+	// FailedStepExecutionMetadata tracks metadata pertaining to the execution
+	// of the Steps of a Promotion that did not succeed. It is only set when
+	// executing the OnFailure steps of the Promotion, and allows these to
+	// assess the outcome of the Steps that came before them.
+	FailedStepExecutionMetadata kargoapi.StepExecutionMetadataList
 	// State is the current state of the promotion process.
 	State State
 	// Vars is a list of variable definitions that can be used by the
@@ -67,6 +72,9 @@ type Context struct {
 	Vars []kargoapi.ExpressionVariable
 	// Actor is the name of the actor triggering the Promotion.
 	Actor string
+	// DryRun indicates whether the Promotion is a dry run, in which case Steps
+	// executed by side-effecting StepRunners are planned instead of executed.
+	DryRun bool
 
 	// currentStepMetadata is a pointer to the StepMetadata for the
 	// current step being executed. It is used to track the execution state of
@@ -101,6 +109,13 @@ type Result struct {
 	// reporting itself to be in a Running status should be retried. Note: This is
 	// unrelated to retrying upon non-terminal failures.
 	RetryAfter *time.Duration
+	// PlannedSteps collects the Steps that were planned instead of executed
+	// because the promotion process is a dry run.
+	PlannedSteps []PlannedStep
+	// Diff is a unified diff of the changes made to the Git working trees in
+	// the working directory of a promotion process that is a dry run. It is
+	// only set once the process has reached a terminal status.
+	Diff string
 }
 
 // ContextOption is a function that configures a Context.
@@ -143,6 +158,7 @@ func NewContext(
 		StepExecutionMetadata: promo.Status.StepExecutionMetadata,
 		State:                 State(promo.Status.GetState()),
 		Vars:                  promo.Spec.Vars,
+		DryRun:                promo.Spec.DryRun,
 	}
 
 	if stage != nil && len(stage.Spec.RequestedFreight) > 0 {
@@ -214,22 +230,34 @@ func (c *Context) GetStepExecutionMetadata(step Step) *kargoapi.StepExecutionMet
 	return &c.StepExecutionMetadata[len(c.StepExecutionMetadata)-1]
 }
 
+// precedingStepExecutionMetadata returns the StepExecutionMetadata of all
+// steps executed in the promotion process, including those of any Steps that
+// did not succeed before the execution of OnFailure steps.
+func (c *Context) precedingStepExecutionMetadata() kargoapi.StepExecutionMetadataList {
+	if len(c.FailedStepExecutionMetadata) == 0 {
+		return c.StepExecutionMetadata
+	}
+	return slices.Concat(c.FailedStepExecutionMetadata, c.StepExecutionMetadata)
+}
+
 // DeepCopy creates a deep copy of the Context. It can be used to ensure that
 // modifications to the Context do not affect the original Context.
 func (c *Context) DeepCopy() Context {
 	newC := Context{
-		UIBaseURL:             c.UIBaseURL,
-		WorkDir:               c.WorkDir,
-		Project:               c.Project,
-		Stage:                 c.Stage,
-		Promotion:             c.Promotion,
-		Freight:               *c.Freight.DeepCopy(),
-		TargetFreightRef:      *c.TargetFreightRef.DeepCopy(),
-		StartFromStep:         c.StartFromStep,
-		StepExecutionMetadata: c.StepExecutionMetadata.DeepCopy(),
-		State:                 c.State.DeepCopy(),
-		Vars:                  slices.Clone(c.Vars),
-		Actor:                 c.Actor,
+		UIBaseURL:                   c.UIBaseURL,
+		WorkDir:                     c.WorkDir,
+		Project:                     c.Project,
+		Stage:                       c.Stage,
+		Promotion:                   c.Promotion,
+		Freight:                     *c.Freight.DeepCopy(),
+		TargetFreightRef:            *c.TargetFreightRef.DeepCopy(),
+		StartFromStep:               c.StartFromStep,
+		StepExecutionMetadata:       c.StepExecutionMetadata.DeepCopy(),
+		FailedStepExecutionMetadata: c.FailedStepExecutionMetadata.DeepCopy(),
+		State:                       c.State.DeepCopy(),
+		Vars:                        slices.Clone(c.Vars),
+		Actor:                       c.Actor,
+		DryRun:                      c.DryRun,
 	}
 
 	if c.FreightRequests != nil {
@@ -271,13 +299,30 @@ type Step struct {
 	// Config is an opaque JSON to be passed to the StepRunner executing this
 	// step.
 	Config []byte
+	// Parallel is a list of Steps to be executed concurrently. When specified,
+	// this Step is a group of Steps and has no Kind or Config of its own.
+	Parallel []Step
+	// Iteration is the item of a list this Step was expanded for, if any. The
+	// item and its index are made available to expressions of the Step.
+	Iteration *kargoapi.PromotionStepIteration
 }
 
 // NewSteps creates a slice of Steps from the provided Promotion. Each Step in
 // the slice corresponds to a step defined in the Promotion's spec.
 func NewSteps(promo *kargoapi.Promotion) []Step {
-	result := make([]Step, len(promo.Spec.Steps))
-	for i, step := range promo.Spec.Steps {
+	return newSteps(promo.Spec.Steps)
+}
+
+// NewOnFailureSteps creates a slice of Steps from the OnFailure steps of the
+// provided Promotion. These Steps are to be executed when the Steps created by
+// NewSteps do not succeed.
+func NewOnFailureSteps(promo *kargoapi.Promotion) []Step {
+	return newSteps(promo.Spec.OnFailure)
+}
+
+func newSteps(steps []kargoapi.PromotionStep) []Step {
+	result := make([]Step, len(steps))
+	for i, step := range steps {
 		var rawConfig []byte
 		if step.Config != nil {
 			rawConfig = step.Config.Raw
@@ -290,6 +335,10 @@ func NewSteps(promo *kargoapi.Promotion) []Step {
 			Retry:           step.Retry,
 			Vars:            step.Vars,
 			Config:          rawConfig,
+			Iteration:       step.Iteration,
+		}
+		if len(step.Parallel) > 0 {
+			result[i].Parallel = newSteps(step.GetParallelSteps())
 		}
 	}
 	return result
@@ -362,6 +411,40 @@ func (m *StepMetadata) Finished() *StepMetadata {
 	return m
 }
 
+// toParallelStepExecutionMetadata converts the StepExecutionMetadata of a
+// step of a parallel group to the ParallelStepExecutionMetadata it is recorded
+// as.
+func toParallelStepExecutionMetadata(
+	m kargoapi.StepExecutionMetadata,
+) kargoapi.ParallelStepExecutionMetadata {
+	return kargoapi.ParallelStepExecutionMetadata{
+		Alias:           m.Alias,
+		StartedAt:       m.StartedAt,
+		FinishedAt:      m.FinishedAt,
+		ErrorCount:      m.ErrorCount,
+		Status:          m.Status,
+		Message:         m.Message,
+		ContinueOnError: m.ContinueOnError,
+	}
+}
+
+// fromParallelStepExecutionMetadata converts the recorded
+// ParallelStepExecutionMetadata of a step of a parallel group to
+// StepExecutionMetadata.
+func fromParallelStepExecutionMetadata(
+	m kargoapi.ParallelStepExecutionMetadata,
+) kargoapi.StepExecutionMetadata {
+	return kargoapi.StepExecutionMetadata{
+		Alias:           m.Alias,
+		StartedAt:       m.StartedAt,
+		FinishedAt:      m.FinishedAt,
+		ErrorCount:      m.ErrorCount,
+		Status:          m.Status,
+		Message:         m.Message,
+		ContinueOnError: m.ContinueOnError,
+	}
+}
+
 // StepContext is a type that represents the context in which a
 // single promotion step is executed by a StepRunner.
 type StepContext struct {
@@ -377,6 +460,10 @@ type StepContext struct {
 	// Config is the configuration of the step that is currently being
 	// executed.
 	Config Config
+	// Timeout is the effective soft maximum interval in which the step that is
+	// currently being executed is expected to complete. A non-positive value
+	// indicates no timeout.
+	Timeout time.Duration
 	// Project is the Project that the Promotion is associated with.
 	Project string
 	// Stage is the Stage that the Promotion is targeting.
@@ -409,6 +496,8 @@ type StepContext struct {
 	Freight kargoapi.FreightCollection
 	// TargetFreightRef is the actual Freight that triggered this Promotion.
 	TargetFreightRef kargoapi.FreightReference
+	// DryRun indicates whether the Promotion is a dry run.
+	DryRun bool
 }
 
 // StepResult represents the results of a single Step of a user-defined promotion
@@ -431,4 +520,19 @@ type StepResult struct {
 	// itself to be in a Running status should be retried. Note: This is unrelated
 	// to retrying upon non-terminal failures.
 	RetryAfter *time.Duration
+	// PlannedStep describes the Step if it was planned instead of executed
+	// because the Promotion is a dry run.
+	PlannedStep *PlannedStep
+}
+
+// PlannedStep describes a Step that was planned instead of executed because
+// the Promotion is a dry run.
+type PlannedStep struct {
+	// Kind identifies the StepRunner that would have executed the Step.
+	Kind string
+	// Alias is the alias of the Step.
+	Alias string
+	// Config is the configuration the Step would have been executed with, with
+	// any values that may be sensitive redacted.
+	Config Config
 }
@@ -92,6 +92,28 @@ type StepRunnerMetadata struct {
 	// factory function. By default, StepRunners are not granted any special
 	// capabilities.
 	RequiredCapabilities []StepRunnerCapability
+	// SideEffecting indicates whether the StepRunner affects, or awaits the
+	// effects of other steps on, anything outside of the working directory of
+	// the Promotion, such as a remote Git repository, an Argo CD Application,
+	// or the Kargo control plane. When a Promotion is a dry run, steps executed
+	// by a side-effecting StepRunner are planned instead of executed.
+	SideEffecting bool
+	// SideEffectingFn optionally refines SideEffecting for StepRunners that
+	// only have side effects when configured in a particular way. When
+	// non-nil, it takes precedence over SideEffecting and is invoked with the
+	// configuration of the step to determine whether executing it would have
+	// side effects.
+	SideEffectingFn func(Config) bool
+}
+
+// IsSideEffecting returns whether executing a step with the provided
+// configuration would have side effects outside of the working directory of
+// the Promotion.
+func (m StepRunnerMetadata) IsSideEffecting(cfg Config) bool {
+	if m.SideEffectingFn != nil {
+		return m.SideEffectingFn(cfg)
+	}
+	return m.SideEffecting
 }
 
 // StepRunnerCapability is a type representing special capabilities that may be
@@ -111,6 +133,14 @@ const (
 	// repository credentials through a lookup by credential type and repository
 	// URL.
 	StepCapabilityAccessCredentials StepRunnerCapability = "access-credentials"
+	// StepCapabilityAccessFlux represents the capability of interacting with
+	// Flux resources in the Kubernetes cluster in which the engine is running,
+	// via a Kubernetes client.
+	StepCapabilityAccessFlux StepRunnerCapability = "access-flux"
+	// StepCapabilityAccessLocalCluster represents the capability of interacting
+	// with the Kubernetes cluster in which the engine is running, e.g. to
+	// execute workloads, via a Kubernetes clientset.
+	StepCapabilityAccessLocalCluster StepRunnerCapability = "access-local-cluster"
 	// StepCapabilityTaskOutputPropagation represents the capability of a step,
 	// when executed as part of a task, to propagate its output directly to the
 	// Promotion's shared state, in addition to the task's own state.
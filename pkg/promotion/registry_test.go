@@ -52,3 +52,46 @@ func Test_stepRunnerRegistry(t *testing.T) {
 		})
 	}
 }
+
+func TestStepRunnerMetadata_IsSideEffecting(t *testing.T) {
+	testCases := []struct {
+		name     string
+		metadata StepRunnerMetadata
+		cfg      Config
+		expected bool
+	}{
+		{
+			name: "not side-effecting",
+		},
+		{
+			name:     "side-effecting",
+			metadata: StepRunnerMetadata{SideEffecting: true},
+			expected: true,
+		},
+		{
+			name: "side-effecting depending on config",
+			metadata: StepRunnerMetadata{
+				SideEffectingFn: func(cfg Config) bool {
+					return cfg["method"] != "GET"
+				},
+			},
+			cfg:      Config{"method": "POST"},
+			expected: true,
+		},
+		{
+			name: "function takes precedence",
+			metadata: StepRunnerMetadata{
+				SideEffecting: true,
+				SideEffectingFn: func(cfg Config) bool {
+					return cfg["method"] != "GET"
+				},
+			},
+			cfg: Config{"method": "GET"},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, testCase.metadata.IsSideEffecting(testCase.cfg))
+		})
+	}
+}
@@ -44,6 +44,7 @@ func init() {
 				RequiredCapabilities: []promotion.StepRunnerCapability{
 					promotion.StepCapabilityAccessArgoCD,
 				},
+				SideEffecting: true,
 			},
 			Value: newArgocdUpdater,
 		},
@@ -0,0 +1,392 @@
+package builtin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"path/filepath"
+	"strings"
+	"time"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/xeipuuv/gojsonschema"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libk8s "github.com/akuity/kargo/pkg/kubernetes"
+	"github.com/akuity/kargo/pkg/logging"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/promotion/container"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const (
+	stepKindContainerRun = "container-run"
+
+	// containerRunWorkDirVolume is the name of the volume through which the
+	// working directory of the Promotion is mounted into the container.
+	containerRunWorkDirVolume = "work-dir"
+
+	// containerRunJobTTL is how long a finished Job is retained, which permits
+	// its logs to be retrieved after the step has completed.
+	containerRunJobTTL = time.Hour
+
+	// containerRunMaxOutputBytes is the maximum number of bytes of the
+	// container's logs that are read when collecting the step's output.
+	containerRunMaxOutputBytes = 1024 * 1024
+
+	// containerRunFailureLogLines is the number of lines of the container's
+	// logs that are included in the error returned when the container fails.
+	containerRunFailureLogLines = 10
+)
+
+func init() {
+	promotion.DefaultStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name: stepKindContainerRun,
+			Metadata: promotion.StepRunnerMetadata{
+				DefaultTimeout: 30 * time.Minute,
+				RequiredCapabilities: []promotion.StepRunnerCapability{
+					promotion.StepCapabilityAccessLocalCluster,
+				},
+				// The container may do anything at all.
+				SideEffecting: true,
+			},
+			Value: newContainerRunner,
+		},
+	)
+}
+
+// containerRunner is an implementation of the promotion.StepRunner interface
+// that executes a user-specified container image as a Kubernetes Job with the
+// working directory of the Promotion mounted.
+type containerRunner struct {
+	schemaLoader gojsonschema.JSONLoader
+	client       kubernetes.Interface
+	cfg          container.Config
+}
+
+// newContainerRunner returns an implementation of the promotion.StepRunner
+// interface that executes a user-specified container image as a Kubernetes
+// Job with the working directory of the Promotion mounted.
+func newContainerRunner(caps promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &containerRunner{
+		schemaLoader: getConfigSchemaLoader(stepKindContainerRun),
+		client:       caps.LocalClusterClient,
+		cfg:          container.ConfigFromEnv(),
+	}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (c *containerRunner) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := c.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return c.run(ctx, stepCtx, cfg)
+}
+
+// convert validates containerRunner configuration against a JSON schema and
+// converts it into a builtin.ContainerRunConfig struct.
+func (c *containerRunner) convert(cfg promotion.Config) (builtin.ContainerRunConfig, error) {
+	return validateAndConvert[builtin.ContainerRunConfig](c.schemaLoader, cfg, stepKindContainerRun)
+}
+
+func (c *containerRunner) run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.ContainerRunConfig,
+) (promotion.StepResult, error) {
+	if !c.cfg.Enabled() {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed},
+			&promotion.TerminalError{
+				Err: errors.New(
+					"container steps are not enabled; an operator must configure a " +
+						"volume claim for Promotion working directories",
+				),
+			}
+	}
+
+	logger := logging.LoggerFromContext(ctx)
+	jobName := container.JobName(stepCtx.Project, stepCtx.Promotion, stepCtx.Alias)
+	jobs := c.client.BatchV1().Jobs(c.cfg.Namespace)
+
+	job, err := jobs.Get(ctx, jobName, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error getting Job %q: %w", jobName, err)
+	}
+	if apierrors.IsNotFound(err) {
+		if job, err = c.buildJob(jobName, stepCtx, cfg); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed},
+				&promotion.TerminalError{Err: err}
+		}
+		if _, err = jobs.Create(ctx, job, metav1.CreateOptions{}); err != nil &&
+			!apierrors.IsAlreadyExists(err) {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("error creating Job %q: %w", jobName, err)
+		}
+		logger.Debug("created Job for container step", "job", jobName)
+		return promotion.StepResult{
+			Status:     kargoapi.PromotionStepStatusRunning,
+			Message:    fmt.Sprintf("Waiting for Job %q to complete", jobName),
+			RetryAfter: ptr.To(10 * time.Second),
+		}, nil
+	}
+
+	switch {
+	case isJobConditionTrue(job, batchv1.JobComplete):
+		logs, err := c.getLogs(ctx, jobName, &corev1.PodLogOptions{
+			Container:  container.ContainerName,
+			LimitBytes: ptr.To[int64](containerRunMaxOutputBytes),
+		})
+		if err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+		}
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusSucceeded,
+			Output: parseContainerOutput(logs),
+		}, nil
+	case isJobConditionTrue(job, batchv1.JobFailed):
+		msg := getJobConditionMessage(job, batchv1.JobFailed)
+		// The logs are a best effort to explain the failure, so an error getting
+		// them is not itself reported.
+		if logs, err := c.getLogs(ctx, jobName, &corev1.PodLogOptions{
+			Container: container.ContainerName,
+			TailLines: ptr.To[int64](containerRunFailureLogLines),
+		}); err == nil && len(bytes.TrimSpace(logs)) > 0 {
+			msg = fmt.Sprintf("%s; last lines of output:\n%s", msg, bytes.TrimSpace(logs))
+		}
+		if err = container.DeleteJob(ctx, c.client, c.cfg.Namespace, jobName); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+		}
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed},
+			&promotion.TerminalError{
+				Err: fmt.Errorf("container step Job %q failed: %s", jobName, msg),
+			}
+	case stepCtx.Timeout > 0 && time.Since(job.CreationTimestamp.Time) >= stepCtx.Timeout:
+		// Kubernetes terminates the Job's Pod once the Job's active deadline has
+		// elapsed, but the Job itself is not removed until its TTL expires.
+		if err = container.DeleteJob(ctx, c.client, c.cfg.Namespace, jobName); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+		}
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed},
+			&promotion.TerminalError{
+				Err: fmt.Errorf(
+					"container step Job %q did not complete within %s",
+					jobName, stepCtx.Timeout,
+				),
+			}
+	default:
+		return promotion.StepResult{
+			Status:     kargoapi.PromotionStepStatusRunning,
+			Message:    fmt.Sprintf("Waiting for Job %q to complete", jobName),
+			RetryAfter: ptr.To(10 * time.Second),
+		}, nil
+	}
+}
+
+// buildJob returns a Job that executes the container described by the
+// provided configuration with the working directory of the Promotion mounted
+// at the same path it has in the controller.
+func (c *containerRunner) buildJob(
+	jobName string,
+	stepCtx *promotion.StepContext,
+	cfg builtin.ContainerRunConfig,
+) (*batchv1.Job, error) {
+	subPath, err := filepath.Rel(c.cfg.WorkDirRoot, stepCtx.WorkDir)
+	if err != nil || subPath == ".." || strings.HasPrefix(subPath, ".."+string(filepath.Separator)) {
+		return nil, fmt.Errorf(
+			"working directory %q is not beneath %q", stepCtx.WorkDir, c.cfg.WorkDirRoot,
+		)
+	}
+	workingDir := stepCtx.WorkDir
+	if cfg.Path != "" {
+		if workingDir, err = securejoin.SecureJoin(stepCtx.WorkDir, cfg.Path); err != nil {
+			return nil, fmt.Errorf("error joining path %q: %w", cfg.Path, err)
+		}
+	}
+
+	env := []corev1.EnvVar{
+		{Name: "KARGO_PROJECT", Value: stepCtx.Project},
+		{Name: "KARGO_STAGE", Value: stepCtx.Stage},
+		{Name: "KARGO_PROMOTION", Value: stepCtx.Promotion},
+		{Name: "KARGO_WORK_DIR", Value: stepCtx.WorkDir},
+	}
+	for _, e := range cfg.Env {
+		env = append(env, corev1.EnvVar{Name: e.Name, Value: e.Value})
+	}
+
+	var runAsUser *int64
+	if c.cfg.RunAsUser != 0 {
+		runAsUser = ptr.To(c.cfg.RunAsUser)
+	}
+
+	resources, err := c.cfg.Resources()
+	if err != nil {
+		return nil, err
+	}
+
+	// The Job's active deadline is the step's timeout, so the container does not
+	// outlive the step even if the step is never run again.
+	var activeDeadlineSeconds *int64
+	if stepCtx.Timeout > 0 {
+		activeDeadlineSeconds = ptr.To(int64(math.Ceil(stepCtx.Timeout.Seconds())))
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      jobName,
+			Namespace: c.cfg.Namespace,
+			Labels: map[string]string{
+				kargoapi.LabelKeyProject: stepCtx.Project,
+				kargoapi.LabelKeyStage:   libk8s.ShortenLabelValue(stepCtx.Stage),
+			},
+			Annotations: map[string]string{
+				kargoapi.AnnotationKeyStage:     stepCtx.Stage,
+				kargoapi.AnnotationKeyPromotion: stepCtx.Promotion,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            ptr.To[int32](0),
+			ActiveDeadlineSeconds:   activeDeadlineSeconds,
+			TTLSecondsAfterFinished: ptr.To(int32(containerRunJobTTL.Seconds())),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						kargoapi.LabelKeyProject: stepCtx.Project,
+					},
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy:                corev1.RestartPolicyNever,
+					ServiceAccountName:           c.cfg.ServiceAccount,
+					AutomountServiceAccountToken: ptr.To(false),
+					// The image is chosen by the user, so it is run with the
+					// restrictions of the "restricted" Pod Security Standard.
+					SecurityContext: &corev1.PodSecurityContext{
+						RunAsNonRoot: ptr.To(true),
+						RunAsUser:    runAsUser,
+						SeccompProfile: &corev1.SeccompProfile{
+							Type: corev1.SeccompProfileTypeRuntimeDefault,
+						},
+					},
+					Containers: []corev1.Container{{
+						Name:       container.ContainerName,
+						Image:      cfg.Image,
+						Command:    cfg.Command,
+						Args:       cfg.Args,
+						Env:        env,
+						WorkingDir: workingDir,
+						Resources:  resources,
+						SecurityContext: &corev1.SecurityContext{
+							RunAsNonRoot:             ptr.To(true),
+							AllowPrivilegeEscalation: ptr.To(false),
+							Capabilities: &corev1.Capabilities{
+								Drop: []corev1.Capability{"ALL"},
+							},
+							SeccompProfile: &corev1.SeccompProfile{
+								Type: corev1.SeccompProfileTypeRuntimeDefault,
+							},
+						},
+						VolumeMounts: []corev1.VolumeMount{{
+							Name:      containerRunWorkDirVolume,
+							MountPath: stepCtx.WorkDir,
+							SubPath:   subPath,
+						}},
+					}},
+					Volumes: []corev1.Volume{{
+						Name: containerRunWorkDirVolume,
+						VolumeSource: corev1.VolumeSource{
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+								ClaimName: c.cfg.WorkDirClaim,
+							},
+						},
+					}},
+				},
+			},
+		},
+	}, nil
+}
+
+// getLogs returns the logs of the container executing the step.
+func (c *containerRunner) getLogs(
+	ctx context.Context,
+	jobName string,
+	opts *corev1.PodLogOptions,
+) ([]byte, error) {
+	stream, err := container.GetLogs(ctx, c.client, c.cfg.Namespace, jobName, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error getting logs of Job %q: %w", jobName, err)
+	}
+	defer stream.Close()
+	logs, err := io.ReadAll(stream)
+	if err != nil {
+		return nil, fmt.Errorf("error reading logs of Job %q: %w", jobName, err)
+	}
+	return logs, nil
+}
+
+// parseContainerOutput extracts the output of a container step from the
+// container's logs. If the logs, in their entirety, are a JSON object, that
+// object is the output. Otherwise, if the last non-empty line of the logs is
+// a JSON object, that object is the output. If neither is the case, the step
+// has no output.
+func parseContainerOutput(logs []byte) map[string]any {
+	logs = bytes.TrimSpace(logs)
+	if len(logs) == 0 {
+		return nil
+	}
+	var output map[string]any
+	if err := json.Unmarshal(logs, &output); err == nil {
+		return output
+	}
+	lastLine := logs
+	if i := bytes.LastIndexByte(logs, '\n'); i >= 0 {
+		lastLine = bytes.TrimSpace(logs[i+1:])
+	}
+	output = nil
+	if err := json.Unmarshal(lastLine, &output); err != nil {
+		return nil
+	}
+	return output
+}
+
+// isJobConditionTrue returns whether the provided Job has a condition of the
+// provided type with a status of True.
+func isJobConditionTrue(job *batchv1.Job, condType batchv1.JobConditionType) bool {
+	for _, cond := range job.Status.Conditions {
+		if cond.Type == condType && cond.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// getJobConditionMessage returns a description of the provided Job's condition
+// of the provided type.
+func getJobConditionMessage(job *batchv1.Job, condType batchv1.JobConditionType) string {
+	for _, cond := range job.Status.Conditions {
+		if cond.Type != condType {
+			continue
+		}
+		if cond.Message != "" {
+			return fmt.Sprintf("%s: %s", cond.Reason, cond.Message)
+		}
+		return cond.Reason
+	}
+	return string(condType)
+}
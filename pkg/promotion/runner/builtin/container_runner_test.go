@@ -0,0 +1,454 @@
+package builtin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/promotion/container"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_newContainerRunner(t *testing.T) {
+	r := newContainerRunner(promotion.StepRunnerCapabilities{
+		LocalClusterClient: kubefake.NewClientset(),
+	})
+	runner, ok := r.(*containerRunner)
+	require.True(t, ok)
+	assert.NotNil(t, runner.schemaLoader)
+	assert.NotNil(t, runner.client)
+	assert.NotEmpty(t, runner.cfg.WorkDirRoot)
+}
+
+func Test_containerRunner_convert(t *testing.T) {
+	tests := []validationTestCase{
+		{
+			name:   "image not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): image is required",
+			},
+		},
+		{
+			name: "image is empty string",
+			config: promotion.Config{
+				"image": "",
+			},
+			expectedProblems: []string{
+				"image: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name: "env var name not specified",
+			config: promotion.Config{
+				"image": "alpine",
+				"env": []promotion.Config{{
+					"value": "bar",
+				}},
+			},
+			expectedProblems: []string{
+				"env.0: name is required",
+			},
+		},
+		{
+			name: "env var value not specified",
+			config: promotion.Config{
+				"image": "alpine",
+				"env": []promotion.Config{{
+					"name": "FOO",
+				}},
+			},
+			expectedProblems: []string{
+				"env.0: value is required",
+			},
+		},
+		{
+			name: "valid kitchen sink",
+			config: promotion.Config{
+				"image":   "alpine",
+				"command": []string{"sh", "-c"},
+				"args":    []string{"echo hello"},
+				"env": []promotion.Config{{
+					"name":  "FOO",
+					"value": "bar",
+				}},
+				"path": "src",
+			},
+		},
+	}
+
+	r := newContainerRunner(promotion.StepRunnerCapabilities{})
+	runner, ok := r.(*containerRunner)
+	require.True(t, ok)
+
+	runValidationTests(t, runner.convert, tests)
+}
+
+func Test_containerRunner_run(t *testing.T) {
+	const testNamespace = "kargo"
+
+	testCfg := container.Config{
+		Namespace:    testNamespace,
+		WorkDirClaim: "work-dirs",
+		WorkDirRoot:  "/tmp",
+	}
+	testStepCtx := &promotion.StepContext{
+		Project:   "fake-project",
+		Stage:     "fake-stage",
+		Promotion: "fake-promotion",
+		Alias:     "step-1",
+		WorkDir:   "/tmp/promotion-abc",
+	}
+	testJobName := container.JobName(
+		testStepCtx.Project,
+		testStepCtx.Promotion,
+		testStepCtx.Alias,
+	)
+	testPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testJobName + "-xyz",
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				batchv1.JobNameLabel: testJobName,
+			},
+		},
+	}
+	newJob := func(conds ...batchv1.JobCondition) *batchv1.Job {
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              testJobName,
+				Namespace:         testNamespace,
+				CreationTimestamp: metav1.Now(),
+			},
+			Status: batchv1.JobStatus{Conditions: conds},
+		}
+	}
+	assertJobDeleted := func(t *testing.T, c *kubefake.Clientset) {
+		t.Helper()
+		_, err := c.BatchV1().Jobs(testNamespace).Get(
+			context.Background(), testJobName, metav1.GetOptions{},
+		)
+		require.True(t, apierrors.IsNotFound(err))
+		var deleted bool
+		for _, action := range c.Actions() {
+			if del, ok := action.(k8stesting.DeleteActionImpl); ok && del.GetName() == testJobName {
+				require.NotNil(t, del.GetDeleteOptions().PropagationPolicy)
+				assert.Equal(
+					t,
+					metav1.DeletePropagationForeground,
+					*del.GetDeleteOptions().PropagationPolicy,
+				)
+				deleted = true
+			}
+		}
+		assert.True(t, deleted)
+	}
+
+	tests := []struct {
+		name       string
+		cfg        container.Config
+		timeout    time.Duration
+		stepCfg    builtin.ContainerRunConfig
+		objects    []runtime.Object
+		reactor    k8stesting.ReactionFunc
+		assertions func(*testing.T, *kubefake.Clientset, promotion.StepResult, error)
+	}{
+		{
+			name:    "container steps not enabled",
+			cfg:     container.Config{WorkDirRoot: "/tmp"},
+			stepCfg: builtin.ContainerRunConfig{Image: "alpine"},
+			assertions: func(t *testing.T, _ *kubefake.Clientset, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "container steps are not enabled")
+				assert.True(t, promotion.IsTerminal(err))
+				assert.Equal(t, kargoapi.PromotionStepStatusFailed, res.Status)
+			},
+		},
+		{
+			name:    "error getting Job",
+			cfg:     testCfg,
+			stepCfg: builtin.ContainerRunConfig{Image: "alpine"},
+			reactor: func(k8stesting.Action) (bool, runtime.Object, error) {
+				return true, nil, errors.New("something went wrong")
+			},
+			assertions: func(t *testing.T, _ *kubefake.Clientset, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "error getting Job")
+				require.ErrorContains(t, err, "something went wrong")
+				assert.False(t, promotion.IsTerminal(err))
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "path escapes working directory",
+			cfg:  container.Config{Namespace: testNamespace, WorkDirClaim: "work-dirs", WorkDirRoot: "/data"},
+			stepCfg: builtin.ContainerRunConfig{
+				Image: "alpine",
+			},
+			assertions: func(t *testing.T, _ *kubefake.Clientset, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "is not beneath")
+				assert.True(t, promotion.IsTerminal(err))
+				assert.Equal(t, kargoapi.PromotionStepStatusFailed, res.Status)
+			},
+		},
+		{
+			name: "Job created",
+			cfg: func() container.Config {
+				cfg := testCfg
+				cfg.RunAsUser = 1000
+				cfg.CPURequest = "100m"
+				cfg.MemoryLimit = "1Gi"
+				return cfg
+			}(),
+			timeout: 30 * time.Minute,
+			stepCfg: builtin.ContainerRunConfig{
+				Image:   "alpine",
+				Command: []string{"sh", "-c"},
+				Args:    []string{"echo hello"},
+				Env:     []builtin.EnvVar{{Name: "FOO", Value: "bar"}},
+				Path:    "src",
+			},
+			assertions: func(t *testing.T, c *kubefake.Clientset, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionStepStatusRunning, res.Status)
+				assert.NotNil(t, res.RetryAfter)
+
+				job, err := c.BatchV1().Jobs(testNamespace).Get(
+					context.Background(), testJobName, metav1.GetOptions{},
+				)
+				require.NoError(t, err)
+				assert.Equal(t, "fake-project", job.Labels[kargoapi.LabelKeyProject])
+				assert.Equal(t, "fake-promotion", job.Annotations[kargoapi.AnnotationKeyPromotion])
+				require.NotNil(t, job.Spec.ActiveDeadlineSeconds)
+				assert.Equal(t, int64(1800), *job.Spec.ActiveDeadlineSeconds)
+
+				podSpec := job.Spec.Template.Spec
+				assert.Equal(t, corev1.RestartPolicyNever, podSpec.RestartPolicy)
+				require.Len(t, podSpec.Volumes, 1)
+				assert.Equal(t, "work-dirs", podSpec.Volumes[0].PersistentVolumeClaim.ClaimName)
+				require.Len(t, podSpec.Containers, 1)
+
+				c0 := podSpec.Containers[0]
+				assert.Equal(t, container.ContainerName, c0.Name)
+				assert.Equal(t, "alpine", c0.Image)
+				assert.Equal(t, []string{"sh", "-c"}, c0.Command)
+				assert.Equal(t, []string{"echo hello"}, c0.Args)
+				assert.Equal(t, "/tmp/promotion-abc/src", c0.WorkingDir)
+				assert.Contains(t, c0.Env, corev1.EnvVar{Name: "FOO", Value: "bar"})
+				assert.Contains(t, c0.Env, corev1.EnvVar{Name: "KARGO_WORK_DIR", Value: "/tmp/promotion-abc"})
+				require.Len(t, c0.VolumeMounts, 1)
+				assert.Equal(t, "/tmp/promotion-abc", c0.VolumeMounts[0].MountPath)
+				assert.Equal(t, "promotion-abc", c0.VolumeMounts[0].SubPath)
+
+				assert.Equal(t, corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("100m"),
+					},
+					Limits: corev1.ResourceList{
+						corev1.ResourceMemory: resource.MustParse("1Gi"),
+					},
+				}, c0.Resources)
+				assert.Equal(t, &corev1.PodSecurityContext{
+					RunAsNonRoot: ptr.To(true),
+					RunAsUser:    ptr.To[int64](1000),
+					SeccompProfile: &corev1.SeccompProfile{
+						Type: corev1.SeccompProfileTypeRuntimeDefault,
+					},
+				}, podSpec.SecurityContext)
+				assert.Equal(t, &corev1.SecurityContext{
+					RunAsNonRoot:             ptr.To(true),
+					AllowPrivilegeEscalation: ptr.To(false),
+					Capabilities: &corev1.Capabilities{
+						Drop: []corev1.Capability{"ALL"},
+					},
+					SeccompProfile: &corev1.SeccompProfile{
+						Type: corev1.SeccompProfileTypeRuntimeDefault,
+					},
+				}, c0.SecurityContext)
+			},
+		},
+		{
+			name: "invalid resource quantity",
+			cfg: func() container.Config {
+				cfg := testCfg
+				cfg.MemoryLimit = "lots"
+				return cfg
+			}(),
+			stepCfg: builtin.ContainerRunConfig{Image: "alpine"},
+			assertions: func(t *testing.T, _ *kubefake.Clientset, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, `error parsing memory quantity "lots"`)
+				assert.True(t, promotion.IsTerminal(err))
+				assert.Equal(t, kargoapi.PromotionStepStatusFailed, res.Status)
+			},
+		},
+		{
+			name:    "Job created without timeout",
+			cfg:     testCfg,
+			stepCfg: builtin.ContainerRunConfig{Image: "alpine"},
+			assertions: func(t *testing.T, c *kubefake.Clientset, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionStepStatusRunning, res.Status)
+
+				job, err := c.BatchV1().Jobs(testNamespace).Get(
+					context.Background(), testJobName, metav1.GetOptions{},
+				)
+				require.NoError(t, err)
+				assert.Nil(t, job.Spec.ActiveDeadlineSeconds)
+			},
+		},
+		{
+			name:    "Job still running",
+			cfg:     testCfg,
+			timeout: 30 * time.Minute,
+			stepCfg: builtin.ContainerRunConfig{Image: "alpine"},
+			objects: []runtime.Object{newJob()},
+			assertions: func(t *testing.T, c *kubefake.Clientset, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionStepStatusRunning, res.Status)
+
+				_, err = c.BatchV1().Jobs(testNamespace).Get(
+					context.Background(), testJobName, metav1.GetOptions{},
+				)
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:    "Job timed out",
+			cfg:     testCfg,
+			timeout: 30 * time.Minute,
+			stepCfg: builtin.ContainerRunConfig{Image: "alpine"},
+			objects: []runtime.Object{
+				func() *batchv1.Job {
+					job := newJob()
+					job.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+					return job
+				}(),
+			},
+			assertions: func(t *testing.T, c *kubefake.Clientset, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "did not complete within 30m0s")
+				assert.True(t, promotion.IsTerminal(err))
+				assert.Equal(t, kargoapi.PromotionStepStatusFailed, res.Status)
+				assertJobDeleted(t, c)
+			},
+		},
+		{
+			name:    "Job complete",
+			cfg:     testCfg,
+			stepCfg: builtin.ContainerRunConfig{Image: "alpine"},
+			objects: []runtime.Object{
+				newJob(batchv1.JobCondition{
+					Type:   batchv1.JobComplete,
+					Status: corev1.ConditionTrue,
+				}),
+				testPod,
+			},
+			assertions: func(t *testing.T, _ *kubefake.Clientset, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+				// The fake clientset always returns "fake logs", which is not JSON
+				assert.Nil(t, res.Output)
+			},
+		},
+		{
+			name:    "Job complete without Pod",
+			cfg:     testCfg,
+			stepCfg: builtin.ContainerRunConfig{Image: "alpine"},
+			objects: []runtime.Object{
+				newJob(batchv1.JobCondition{
+					Type:   batchv1.JobComplete,
+					Status: corev1.ConditionTrue,
+				}),
+			},
+			assertions: func(t *testing.T, _ *kubefake.Clientset, res promotion.StepResult, err error) {
+				require.ErrorIs(t, err, container.ErrNoPod)
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name:    "Job failed",
+			cfg:     testCfg,
+			stepCfg: builtin.ContainerRunConfig{Image: "alpine"},
+			objects: []runtime.Object{
+				newJob(batchv1.JobCondition{
+					Type:    batchv1.JobFailed,
+					Status:  corev1.ConditionTrue,
+					Reason:  "BackoffLimitExceeded",
+					Message: "Job has reached the specified backoff limit",
+				}),
+				testPod,
+			},
+			assertions: func(t *testing.T, c *kubefake.Clientset, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "BackoffLimitExceeded")
+				require.ErrorContains(t, err, "fake logs")
+				assert.True(t, promotion.IsTerminal(err))
+				assert.Equal(t, kargoapi.PromotionStepStatusFailed, res.Status)
+				assertJobDeleted(t, c)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := kubefake.NewClientset(tt.objects...)
+			if tt.reactor != nil {
+				c.PrependReactor("get", "jobs", tt.reactor)
+			}
+			r := &containerRunner{
+				client: c,
+				cfg:    tt.cfg,
+			}
+			stepCtx := *testStepCtx
+			stepCtx.Timeout = tt.timeout
+			res, err := r.run(context.Background(), &stepCtx, tt.stepCfg)
+			tt.assertions(t, c, res, err)
+		})
+	}
+}
+
+func Test_parseContainerOutput(t *testing.T) {
+	tests := []struct {
+		name     string
+		logs     string
+		expected map[string]any
+	}{
+		{
+			name: "empty logs",
+			logs: "  \n",
+		},
+		{
+			name: "logs are not JSON",
+			logs: "hello\nworld\n",
+		},
+		{
+			name:     "logs are a JSON object",
+			logs:     "{\n  \"foo\": \"bar\"\n}\n",
+			expected: map[string]any{"foo": "bar"},
+		},
+		{
+			name:     "last line is a JSON object",
+			logs:     "doing things\ndone\n{\"foo\": \"bar\"}\n",
+			expected: map[string]any{"foo": "bar"},
+		},
+		{
+			name: "last line is a JSON array",
+			logs: "doing things\n[1, 2, 3]\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parseContainerOutput([]byte(tt.logs)))
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package builtin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cuelang.org/go/cue"
+	"cuelang.org/go/cue/cuecontext"
+	"cuelang.org/go/cue/load"
+	"cuelang.org/go/mod/module"
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/xeipuuv/gojsonschema"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/io/fs"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const stepKindCueExport = "cue-export"
+
+func init() {
+	promotion.DefaultStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name:  stepKindCueExport,
+			Value: newCueExporter,
+		},
+	)
+}
+
+// cueExporter is an implementation of the promotion.StepRunner interface that
+// exports a CUE package as JSON or YAML.
+type cueExporter struct {
+	schemaLoader gojsonschema.JSONLoader
+}
+
+// newCueExporter returns an implementation of the promotion.StepRunner
+// interface that exports a CUE package as JSON or YAML.
+func newCueExporter(promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &cueExporter{
+		schemaLoader: getConfigSchemaLoader(stepKindCueExport),
+	}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (c *cueExporter) Run(
+	_ context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := c.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return c.run(stepCtx, cfg)
+}
+
+// convert validates cueExporter configuration against a JSON schema and
+// converts it into a builtin.CueExportConfig struct.
+func (c *cueExporter) convert(cfg promotion.Config) (builtin.CueExportConfig, error) {
+	return validateAndConvert[builtin.CueExportConfig](c.schemaLoader, cfg, stepKindCueExport)
+}
+
+func (c *cueExporter) run(
+	stepCtx *promotion.StepContext,
+	cfg builtin.CueExportConfig,
+) (promotion.StepResult, error) {
+	dir, err := securejoin.SecureJoin(stepCtx.WorkDir, cfg.Path)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("failed to join path %q: %w", cfg.Path, err)
+	}
+
+	// Errors in CUE code will not resolve themselves on retry.
+	data, err := cueExport(dir, cfg)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed},
+			&promotion.TerminalError{
+				Err: fmt.Errorf(
+					"error exporting %q: %w", cfg.Path,
+					fs.SanitizePathError(err, stepCtx.WorkDir),
+				),
+			}
+	}
+
+	outPath, err := securejoin.SecureJoin(stepCtx.WorkDir, cfg.OutPath)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("failed to join path %q: %w", cfg.OutPath, err)
+	}
+	if err = writeRenderedJSON(outPath, data); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, fmt.Errorf(
+			"failed to write exported output to %q: %w", cfg.OutPath,
+			fs.SanitizePathError(err, stepCtx.WorkDir),
+		)
+	}
+	return promotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded}, nil
+}
+
+// cueExport loads the CUE package in the provided directory and returns the
+// value selected by the configured expression, or the entire package, as
+// JSON. The value must be concrete.
+func cueExport(dir string, cfg builtin.CueExportConfig) ([]byte, error) {
+	tags := make([]string, len(cfg.Tags))
+	for i, tag := range cfg.Tags {
+		tags[i] = tag.Name + "=" + tag.Value
+	}
+	insts := load.Instances([]string{"."}, &load.Config{
+		Dir:     dir,
+		Package: cfg.Package,
+		Tags:    tags,
+		// Module dependencies are never fetched, so exporting a package cannot
+		// cause requests to arbitrary registries.
+		Registry: cueNoRegistry{},
+	})
+	if len(insts) != 1 {
+		return nil, fmt.Errorf("expected exactly one CUE instance; found %d", len(insts))
+	}
+	if err := insts[0].Err; err != nil {
+		return nil, err
+	}
+
+	val := cuecontext.New().BuildInstance(insts[0])
+	if err := val.Err(); err != nil {
+		return nil, err
+	}
+	if cfg.Expression != "" {
+		path := cue.ParsePath(cfg.Expression)
+		if err := path.Err(); err != nil {
+			return nil, fmt.Errorf("error parsing expression %q: %w", cfg.Expression, err)
+		}
+		if val = val.LookupPath(path); !val.Exists() {
+			return nil, fmt.Errorf("expression %q does not select a value", cfg.Expression)
+		}
+	}
+	if err := val.Validate(cue.Concrete(true)); err != nil {
+		return nil, err
+	}
+	return val.MarshalJSON()
+}
+
+// errCueRegistryDisabled is returned by cueNoRegistry for any attempt to
+// resolve a CUE module dependency.
+var errCueRegistryDisabled = errors.New(
+	"fetching CUE module dependencies from a registry is not permitted",
+)
+
+// cueNoRegistry is an implementation of the modconfig.Registry interface that
+// refuses to resolve any CUE module dependency.
+type cueNoRegistry struct{}
+
+// Requirements implements the modconfig.Registry interface.
+func (cueNoRegistry) Requirements(context.Context, module.Version) ([]module.Version, error) {
+	return nil, errCueRegistryDisabled
+}
+
+// Fetch implements the modconfig.Registry interface.
+func (cueNoRegistry) Fetch(context.Context, module.Version) (module.SourceLoc, error) {
+	return module.SourceLoc{}, errCueRegistryDisabled
+}
+
+// ModuleVersions implements the modconfig.Registry interface.
+func (cueNoRegistry) ModuleVersions(context.Context, string) ([]string, error) {
+	return nil, errCueRegistryDisabled
+}
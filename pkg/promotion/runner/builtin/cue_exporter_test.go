@@ -0,0 +1,274 @@
+package builtin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_cueExporter_convert(t *testing.T) {
+	tests := []validationTestCase{
+		{
+			name:   "path not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): path is required",
+			},
+		},
+		{
+			name: "path is empty string",
+			config: promotion.Config{
+				"path": "",
+			},
+			expectedProblems: []string{
+				"path: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name:   "outPath not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): outPath is required",
+			},
+		},
+		{
+			name: "expression is empty string",
+			config: promotion.Config{
+				"path":       ".",
+				"outPath":    "out.yaml",
+				"expression": "",
+			},
+			expectedProblems: []string{
+				"expression: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name: "tag name not specified",
+			config: promotion.Config{
+				"path":    ".",
+				"outPath": "out.yaml",
+				"tags": []promotion.Config{{
+					"value": "prod",
+				}},
+			},
+			expectedProblems: []string{
+				"tags.0: name is required",
+			},
+		},
+		{
+			name: "valid kitchen sink",
+			config: promotion.Config{
+				"path":       "./config",
+				"outPath":    "out.yaml",
+				"package":    "prod",
+				"expression": "objects",
+				"tags": []promotion.Config{
+					{"name": "env", "value": "prod"},
+				},
+			},
+		},
+	}
+
+	r := newCueExporter(promotion.StepRunnerCapabilities{})
+	runner, ok := r.(*cueExporter)
+	require.True(t, ok)
+
+	runValidationTests(t, runner.convert, tests)
+}
+
+func Test_cueExporter_run(t *testing.T) {
+	const testPackage = `package app
+
+env: string @tag(env)
+
+objects: [{
+	apiVersion: "v1"
+	kind:       "ConfigMap"
+	metadata: name: "app-\(env)"
+}, {
+	apiVersion: "v1"
+	kind:       "Service"
+	metadata: name: "app-\(env)"
+}]
+`
+
+	tests := []struct {
+		name       string
+		files      map[string]string
+		config     builtin.CueExportConfig
+		assertions func(*testing.T, string, promotion.StepResult, error)
+	}{
+		{
+			name:  "exports expression with tags as YAML stream",
+			files: map[string]string{"config/app.cue": testPackage},
+			config: builtin.CueExportConfig{
+				Path:       "config",
+				OutPath:    "out/manifests.yaml",
+				Expression: "objects",
+				Tags:       []builtin.CueTag{{Name: "env", Value: "prod"}},
+			},
+			assertions: func(t *testing.T, dir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.Status)
+				b, err := os.ReadFile(filepath.Join(dir, "out", "manifests.yaml"))
+				require.NoError(t, err)
+				assert.Equal(t, `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: app-prod
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: app-prod
+`, string(b))
+			},
+		},
+		{
+			name:  "exports package as JSON",
+			files: map[string]string{"app.cue": "package app\n\nfoo: \"bar\"\n"},
+			config: builtin.CueExportConfig{
+				Path:    ".",
+				OutPath: "out.json",
+			},
+			assertions: func(t *testing.T, dir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.Status)
+				b, err := os.ReadFile(filepath.Join(dir, "out.json"))
+				require.NoError(t, err)
+				assert.Equal(t, "{\n  \"foo\": \"bar\"\n}\n", string(b))
+			},
+		},
+		{
+			name:  "value is not concrete",
+			files: map[string]string{"config/app.cue": testPackage},
+			config: builtin.CueExportConfig{
+				Path:    "config",
+				OutPath: "out.yaml",
+			},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "error exporting")
+				assert.True(t, promotion.IsTerminal(err))
+				assert.Equal(t, kargoapi.PromotionStepStatusFailed, result.Status)
+			},
+		},
+		{
+			name:  "expression does not select a value",
+			files: map[string]string{"config/app.cue": testPackage},
+			config: builtin.CueExportConfig{
+				Path:       "config",
+				OutPath:    "out.yaml",
+				Expression: "missing",
+				Tags:       []builtin.CueTag{{Name: "env", Value: "prod"}},
+			},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "does not select a value")
+				assert.True(t, promotion.IsTerminal(err))
+				assert.Equal(t, kargoapi.PromotionStepStatusFailed, result.Status)
+			},
+		},
+		{
+			name: "module dependencies are not fetched",
+			files: map[string]string{
+				"cue.mod/module.cue": `module: "example.com/app@v0"
+language: version: "v0.9.0"
+deps: "example.com/lib@v0": v: "v0.1.0"
+`,
+				"app.cue": `package app
+
+import "example.com/lib@v0:lib"
+
+foo: lib.foo
+`,
+			},
+			config: builtin.CueExportConfig{
+				Path:    ".",
+				OutPath: "out.json",
+			},
+			assertions: func(t *testing.T, dir string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, errCueRegistryDisabled.Error())
+				assert.True(t, promotion.IsTerminal(err))
+				assert.Equal(t, kargoapi.PromotionStepStatusFailed, result.Status)
+				assert.NoFileExists(t, filepath.Join(dir, "out.json"))
+			},
+		},
+		{
+			name: "packages within the module are imported",
+			files: map[string]string{
+				"cue.mod/module.cue": `module: "example.com/app@v0"
+language: version: "v0.9.0"
+deps: "example.com/lib@v0": v: "v0.1.0"
+`,
+				"app.cue": `package app
+
+import "example.com/app/lib"
+
+foo: lib.foo
+`,
+				"lib/lib.cue": "package lib\n\nfoo: \"bar\"\n",
+			},
+			config: builtin.CueExportConfig{
+				Path:    ".",
+				OutPath: "out.json",
+			},
+			assertions: func(t *testing.T, dir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.Status)
+				b, err := os.ReadFile(filepath.Join(dir, "out.json"))
+				require.NoError(t, err)
+				assert.Equal(t, "{\n  \"foo\": \"bar\"\n}\n", string(b))
+			},
+		},
+		{
+			name: "directory contains no CUE files",
+			config: builtin.CueExportConfig{
+				Path:    ".",
+				OutPath: "out.yaml",
+			},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "error exporting")
+				assert.Equal(t, kargoapi.PromotionStepStatusFailed, result.Status)
+			},
+		},
+	}
+
+	runner := &cueExporter{}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Any request to the registry named by the environment would be a
+			// failure to disable the registry.
+			var registryRequests atomic.Int32
+			registry := httptest.NewServer(http.HandlerFunc(
+				func(w http.ResponseWriter, _ *http.Request) {
+					registryRequests.Add(1)
+					w.WriteHeader(http.StatusNotFound)
+				},
+			))
+			t.Cleanup(registry.Close)
+			t.Setenv("CUE_REGISTRY", strings.TrimPrefix(registry.URL, "http://")+"+insecure")
+			t.Setenv("CUE_CACHE_DIR", t.TempDir())
+
+			dir := t.TempDir()
+			for name, content := range tt.files {
+				path := filepath.Join(dir, name)
+				require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+				require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+			}
+			result, err := runner.run(&promotion.StepContext{WorkDir: dir}, tt.config)
+			tt.assertions(t, dir, result, err)
+			assert.Zero(t, registryRequests.Load())
+		})
+	}
+}
@@ -0,0 +1,288 @@
+package builtin
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/xeipuuv/gojsonschema"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const stepKindDotenvUpdate = "dotenv-update"
+
+var (
+	// dotenvVarRegex matches the beginning of a variable assignment in a .env
+	// file, up to and including the "=" separating the name of the variable
+	// from its value.
+	dotenvVarRegex = regexp.MustCompile(`^[ \t]*(?:export[ \t]+)?([A-Za-z_][A-Za-z0-9_.-]*)[ \t]*=[ \t]*`)
+	// dotenvNameRegex matches valid names for new variables.
+	dotenvNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+func init() {
+	promotion.DefaultStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name:  stepKindDotenvUpdate,
+			Value: newDotenvUpdater,
+		},
+	)
+}
+
+// dotenvUpdater is an implementation of the promotion.StepRunner interface
+// that updates the values of specified variables in a .env file.
+type dotenvUpdater struct {
+	schemaLoader gojsonschema.JSONLoader
+}
+
+// newDotenvUpdater returns an implementation of the promotion.StepRunner
+// interface that updates the values of specified variables in a .env file.
+func newDotenvUpdater(promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &dotenvUpdater{schemaLoader: getConfigSchemaLoader(stepKindDotenvUpdate)}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (d *dotenvUpdater) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := d.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return d.run(ctx, stepCtx, cfg)
+}
+
+// convert validates dotenvUpdater configuration against a JSON schema and
+// converts it into a builtin.DotenvUpdateConfig struct.
+func (d *dotenvUpdater) convert(cfg promotion.Config) (builtin.DotenvUpdateConfig, error) {
+	return validateAndConvert[builtin.DotenvUpdateConfig](d.schemaLoader, cfg, stepKindDotenvUpdate)
+}
+
+func (d *dotenvUpdater) run(
+	_ context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.DotenvUpdateConfig,
+) (promotion.StepResult, error) {
+	result := promotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded}
+
+	if len(cfg.Updates) > 0 {
+		if err := d.updateFile(stepCtx.WorkDir, cfg.Path, cfg.Updates); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf(".env file update failed: %w", err)
+		}
+
+		if commitMsg := d.generateCommitMessage(cfg.Path, cfg.Updates); commitMsg != "" {
+			result.Output = map[string]any{
+				"commitMessage": commitMsg,
+			}
+		}
+	}
+	return result, nil
+}
+
+func (d *dotenvUpdater) updateFile(workDir string, path string, updates []builtin.DotenvUpdate) error {
+	absFilePath, err := securejoin.SecureJoin(workDir, path)
+	if err != nil {
+		return fmt.Errorf("error joining path %q: %w", path, err)
+	}
+
+	fileContent, err := os.ReadFile(absFilePath)
+	if err != nil {
+		return fmt.Errorf("error reading .env file %q: %w", absFilePath, err)
+	}
+
+	for _, update := range updates {
+		if !isValidScalar(update.Value) {
+			return fmt.Errorf("value for key %q is not a scalar type", update.Key)
+		}
+		if fileContent, err = setDotenvValue(fileContent, update.Key, update.Value); err != nil {
+			return fmt.Errorf("error setting key %q in .env file: %w", update.Key, err)
+		}
+	}
+
+	if err = os.WriteFile(absFilePath, fileContent, 0600); err != nil {
+		return fmt.Errorf("error writing updated .env file %q: %w", absFilePath, err)
+	}
+
+	return nil
+}
+
+func (d *dotenvUpdater) generateCommitMessage(path string, updates []builtin.DotenvUpdate) string {
+	if len(updates) == 0 {
+		return ""
+	}
+
+	var commitMsg strings.Builder
+	_, _ = fmt.Fprintf(&commitMsg, "Updated %s\n", path)
+
+	for _, update := range updates {
+		switch v := update.Value.(type) {
+		case string:
+			_, _ = fmt.Fprintf(&commitMsg, "\n- %s: %q", update.Key, v)
+		default:
+			_, _ = fmt.Fprintf(&commitMsg, "\n- %s: %v", update.Key, v)
+		}
+	}
+
+	return commitMsg.String()
+}
+
+// setDotenvValue sets the value of the specified variable in the provided .env
+// document and returns the updated document. Every assignment of the variable
+// is updated. Only the bytes of existing values are replaced, so comments and
+// formatting are preserved, and values are quoted the same way as the values
+// they replace where possible. If the variable is not assigned, an assignment
+// is added to the end of the document.
+func setDotenvValue(doc []byte, name string, value any) ([]byte, error) {
+	str, err := formatDotenvValue(value)
+	if err != nil {
+		return nil, err
+	}
+
+	type edit struct {
+		start, end int
+		value      string
+	}
+	var edits []edit
+	for offset := 0; offset < len(doc); {
+		lineEnd := len(doc)
+		if i := bytes.IndexByte(doc[offset:], '\n'); i >= 0 {
+			lineEnd = offset + i
+		}
+		match := dotenvVarRegex.FindSubmatchIndex(doc[offset:lineEnd])
+		if match == nil {
+			offset = lineEnd + 1
+			continue
+		}
+		valueStart := offset + match[1]
+		valueEnd, quote, err := scanDotenvValue(doc, valueStart, lineEnd)
+		if err != nil {
+			return nil, err
+		}
+		if string(doc[offset+match[2]:offset+match[3]]) == name {
+			edits = append(edits, edit{
+				start: valueStart,
+				end:   valueEnd,
+				value: quoteDotenvValue(str, quote),
+			})
+		}
+		// Resume scanning after the line the value ends on, which may differ
+		// from the line it started on if it is a multiline quoted value.
+		if i := bytes.IndexByte(doc[valueEnd:], '\n'); i >= 0 {
+			offset = valueEnd + i + 1
+		} else {
+			offset = len(doc)
+		}
+	}
+
+	if len(edits) == 0 {
+		if !dotenvNameRegex.MatchString(name) {
+			return nil, fmt.Errorf("%q is not a valid variable name", name)
+		}
+		line := fmt.Sprintf("%s=%s\n", name, quoteDotenvValue(str, 0))
+		if len(doc) > 0 && doc[len(doc)-1] != '\n' {
+			line = "\n" + line
+		}
+		return slices.Concat(doc, []byte(line)), nil
+	}
+
+	updated := slices.Clone(doc)
+	for _, e := range slices.Backward(edits) {
+		updated = slices.Concat(updated[:e.start], []byte(e.value), updated[e.end:])
+	}
+	return updated, nil
+}
+
+// scanDotenvValue returns the offset at which the value starting at the
+// specified offset ends, along with the quote character enclosing the value,
+// if any. Quoted values may span multiple lines. The value of an unquoted
+// value excludes trailing whitespace and any trailing comment.
+func scanDotenvValue(doc []byte, start, lineEnd int) (int, byte, error) {
+	if start < len(doc) {
+		switch quote := doc[start]; quote {
+		case '"', '\'':
+			for i := start + 1; i < len(doc); i++ {
+				switch doc[i] {
+				case '\\':
+					if quote == '"' {
+						i++
+					}
+				case quote:
+					return i + 1, quote, nil
+				}
+			}
+			return 0, 0, fmt.Errorf(
+				"unterminated quoted value at offset %d", start,
+			)
+		}
+	}
+	end := lineEnd
+	for i := start; i < lineEnd; i++ {
+		if doc[i] == '#' && (i == start || doc[i-1] == ' ' || doc[i-1] == '\t') {
+			end = i
+			break
+		}
+	}
+	return start + len(bytes.TrimRight(doc[start:end], " \t\r")), 0, nil
+}
+
+// formatDotenvValue formats the provided scalar value as a string.
+func formatDotenvValue(value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", v), nil
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	default:
+		return "", fmt.Errorf("unsupported value type %T", value)
+	}
+}
+
+// quoteDotenvValue quotes the provided value using the provided quote
+// character, if it is non-zero and can represent the value. Otherwise, the
+// value is left unquoted if possible, or is enclosed in single quotes, which
+// prevent interpolation, or double quotes, in that order of preference.
+func quoteDotenvValue(value string, quote byte) string {
+	canSingleQuote := !strings.ContainsAny(value, "'\n\r")
+	switch {
+	case quote == '\'' && canSingleQuote:
+		return "'" + value + "'"
+	case quote == '"':
+		return doubleQuoteDotenvValue(value)
+	case !strings.ContainsAny(value, " \t\n\r#'\"\\$`"):
+		return value
+	case canSingleQuote:
+		return "'" + value + "'"
+	default:
+		return doubleQuoteDotenvValue(value)
+	}
+}
+
+// doubleQuoteDotenvValue encloses the provided value in double quotes,
+// escaping characters as necessary.
+func doubleQuoteDotenvValue(value string) string {
+	return `"` + strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		"\n", `\n`,
+		"\r", `\r`,
+	).Replace(value) + `"`
+}
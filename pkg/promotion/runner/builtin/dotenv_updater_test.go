@@ -0,0 +1,302 @@
+package builtin
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_dotenvUpdater_convert(t *testing.T) {
+	tests := []validationTestCase{
+		{
+			name:   "path is not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): path is required",
+			},
+		},
+		{
+			name:   "updates is null",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): updates is required",
+			},
+		},
+		{
+			name: "updates is empty",
+			config: promotion.Config{
+				"updates": []promotion.Config{},
+			},
+			expectedProblems: []string{
+				"updates: Array must have at least 1 items",
+			},
+		},
+		{
+			name: "key not specified",
+			config: promotion.Config{
+				"updates": []promotion.Config{{}},
+			},
+			expectedProblems: []string{
+				"updates.0: key is required",
+			},
+		},
+		{
+			name: "value not specified",
+			config: promotion.Config{
+				"updates": []promotion.Config{{}},
+			},
+			expectedProblems: []string{
+				"updates.0: value is required",
+			},
+		},
+		{
+			name: "valid config",
+			config: promotion.Config{
+				"path": "fake-path",
+				"updates": []promotion.Config{
+					{
+						"key":   "IMAGE_TAG",
+						"value": "v1.0.0",
+					},
+				},
+			},
+		},
+	}
+
+	r := newDotenvUpdater(promotion.StepRunnerCapabilities{})
+	runner, ok := r.(*dotenvUpdater)
+	require.True(t, ok)
+
+	runValidationTests(t, runner.convert, tests)
+}
+
+func Test_dotenvUpdater_run(t *testing.T) {
+	tests := []struct {
+		name       string
+		cfg        builtin.DotenvUpdateConfig
+		files      map[string]string
+		assertions func(*testing.T, string, promotion.StepResult, error)
+	}{
+		{
+			name: "successful run with updates",
+			cfg: builtin.DotenvUpdateConfig{
+				Path: ".env",
+				Updates: []builtin.DotenvUpdate{
+					{Key: "IMAGE_TAG", Value: "v1.0.1"},
+					{Key: "REPLICAS", Value: float64(3)},
+				},
+			},
+			files: map[string]string{
+				".env": "# Managed by Kargo\nIMAGE_TAG=v1.0.0 # the tag\nREPLICAS=1\n",
+			},
+			assertions: func(t *testing.T, workDir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, promotion.StepResult{
+					Status: kargoapi.PromotionStepStatusSucceeded,
+					Output: map[string]any{
+						"commitMessage": "Updated .env\n\n" +
+							"- IMAGE_TAG: \"v1.0.1\"\n" +
+							"- REPLICAS: 3",
+					},
+				}, result)
+				content, err := os.ReadFile(path.Join(workDir, ".env"))
+				require.NoError(t, err)
+				assert.Equal(
+					t,
+					"# Managed by Kargo\nIMAGE_TAG=v1.0.1 # the tag\nREPLICAS=3\n",
+					string(content),
+				)
+			},
+		},
+		{
+			name: "value is not a scalar",
+			cfg: builtin.DotenvUpdateConfig{
+				Path: ".env",
+				Updates: []builtin.DotenvUpdate{
+					{Key: "KEY", Value: []any{"a"}},
+				},
+			},
+			files: map[string]string{
+				".env": "KEY=1\n",
+			},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "is not a scalar type")
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.Status)
+			},
+		},
+		{
+			name: "file does not exist",
+			cfg: builtin.DotenvUpdateConfig{
+				Path: ".env",
+				Updates: []builtin.DotenvUpdate{
+					{Key: "KEY", Value: "value"},
+				},
+			},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, ".env file update failed")
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.Status)
+			},
+		},
+	}
+
+	runner := &dotenvUpdater{}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workDir := t.TempDir()
+			for p, c := range tt.files {
+				require.NoError(t, os.WriteFile(path.Join(workDir, p), []byte(c), 0o600))
+			}
+			result, err := runner.run(
+				context.Background(),
+				&promotion.StepContext{Project: "test-project", WorkDir: workDir},
+				tt.cfg,
+			)
+			tt.assertions(t, workDir, result, err)
+		})
+	}
+}
+
+func Test_setDotenvValue(t *testing.T) {
+	tests := []struct {
+		name       string
+		doc        string
+		key        string
+		value      any
+		assertions func(*testing.T, string, error)
+	}{
+		{
+			name:  "updates unquoted value",
+			doc:   "A=1\nIMAGE=app:v1\nB=2\n",
+			key:   "IMAGE",
+			value: "app:v2",
+			assertions: func(t *testing.T, doc string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "A=1\nIMAGE=app:v2\nB=2\n", doc)
+			},
+		},
+		{
+			name:  "updates exported value with spaces around separator",
+			doc:   "export IMAGE = app:v1\n",
+			key:   "IMAGE",
+			value: "app:v2",
+			assertions: func(t *testing.T, doc string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "export IMAGE = app:v2\n", doc)
+			},
+		},
+		{
+			name:  "preserves single quotes",
+			doc:   "IMAGE='app:v1' # comment\n",
+			key:   "IMAGE",
+			value: "app:v2",
+			assertions: func(t *testing.T, doc string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "IMAGE='app:v2' # comment\n", doc)
+			},
+		},
+		{
+			name:  "preserves double quotes and escapes",
+			doc:   "GREETING=\"hello\"\n",
+			key:   "GREETING",
+			value: "say \"hi\"\n",
+			assertions: func(t *testing.T, doc string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "GREETING=\"say \\\"hi\\\"\\n\"\n", doc)
+			},
+		},
+		{
+			name:  "quotes value when necessary",
+			doc:   "MESSAGE=hello\n",
+			key:   "MESSAGE",
+			value: "hello world $USER",
+			assertions: func(t *testing.T, doc string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "MESSAGE='hello world $USER'\n", doc)
+			},
+		},
+		{
+			name:  "replaces multiline value",
+			doc:   "CERT=\"line1\nline2\"\nNEXT=1\n",
+			key:   "CERT",
+			value: "line3",
+			assertions: func(t *testing.T, doc string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "CERT=\"line3\"\nNEXT=1\n", doc)
+			},
+		},
+		{
+			name:  "ignores assignments within multiline values",
+			doc:   "TEXT=\"IMAGE=app:v1\"\n",
+			key:   "IMAGE",
+			value: "app:v2",
+			assertions: func(t *testing.T, doc string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "TEXT=\"IMAGE=app:v1\"\nIMAGE=app:v2\n", doc)
+			},
+		},
+		{
+			name:  "updates every assignment",
+			doc:   "IMAGE=app:v1\n#IMAGE=commented\nIMAGE=app:v1\n",
+			key:   "IMAGE",
+			value: "app:v2",
+			assertions: func(t *testing.T, doc string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "IMAGE=app:v2\n#IMAGE=commented\nIMAGE=app:v2\n", doc)
+			},
+		},
+		{
+			name:  "updates empty value",
+			doc:   "IMAGE=\nNEXT=1",
+			key:   "IMAGE",
+			value: true,
+			assertions: func(t *testing.T, doc string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "IMAGE=true\nNEXT=1", doc)
+			},
+		},
+		{
+			name:  "adds variable",
+			doc:   "A=1",
+			key:   "B",
+			value: float64(2.5),
+			assertions: func(t *testing.T, doc string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "A=1\nB=2.5\n", doc)
+			},
+		},
+		{
+			name:  "invalid variable name",
+			doc:   "A=1\n",
+			key:   "NOT VALID",
+			value: "value",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "is not a valid variable name")
+			},
+		},
+		{
+			name:  "unterminated quoted value",
+			doc:   "A=\"unterminated\n",
+			key:   "A",
+			value: "value",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "unterminated quoted value")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := setDotenvValue([]byte(tt.doc), tt.key, tt.value)
+			tt.assertions(t, string(doc), err)
+		})
+	}
+}
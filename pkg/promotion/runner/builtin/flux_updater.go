@@ -0,0 +1,344 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/xeipuuv/gojsonschema"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/health"
+	checkers "github.com/akuity/kargo/pkg/health/checker/builtin"
+	"github.com/akuity/kargo/pkg/logging"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const (
+	stepKindFluxUpdate = "flux-update"
+
+	// fluxHealthCheckKind is the name of the health checker that assesses the
+	// health of Flux resources.
+	fluxHealthCheckKind = "flux"
+
+	fluxDefaultNamespace = "flux-system"
+
+	// fluxReconcileRequestAnnotation is the annotation Flux controllers watch
+	// for changes to in order to reconcile a resource on demand.
+	fluxReconcileRequestAnnotation = "reconcile.fluxcd.io/requestedAt"
+)
+
+// fluxDefaultAPIVersions maps each kind of Flux resource the flux-update step
+// supports to the latest stable API version of that kind.
+var fluxDefaultAPIVersions = map[builtin.FluxResourceKind]string{
+	builtin.GitRepository: "source.toolkit.fluxcd.io/v1",
+	builtin.HelmRelease:   "helm.toolkit.fluxcd.io/v2",
+	builtin.Kustomization: "kustomize.toolkit.fluxcd.io/v1",
+	builtin.OCIRepository: "source.toolkit.fluxcd.io/v1",
+}
+
+func init() {
+	promotion.DefaultStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name: stepKindFluxUpdate,
+			Metadata: promotion.StepRunnerMetadata{
+				RequiredCapabilities: []promotion.StepRunnerCapability{
+					promotion.StepCapabilityAccessFlux,
+				},
+				SideEffecting: true,
+			},
+			Value: newFluxUpdater,
+		},
+	)
+}
+
+// fluxUpdater is an implementation of the promotion.StepRunner interface that
+// pins Flux resources to a revision and requests their reconciliation.
+type fluxUpdater struct {
+	schemaLoader gojsonschema.JSONLoader
+	client       client.Client
+	nowFn        func() time.Time
+}
+
+// newFluxUpdater returns an implementation of the promotion.StepRunner
+// interface that pins Flux resources to a revision and requests their
+// reconciliation.
+func newFluxUpdater(caps promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &fluxUpdater{
+		schemaLoader: getConfigSchemaLoader(stepKindFluxUpdate),
+		client:       caps.FluxClient,
+		nowFn:        time.Now,
+	}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (f *fluxUpdater) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := f.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return f.run(ctx, stepCtx, cfg)
+}
+
+// convert validates fluxUpdater configuration against a JSON schema and
+// converts it into a builtin.FluxUpdateConfig struct.
+func (f *fluxUpdater) convert(cfg promotion.Config) (builtin.FluxUpdateConfig, error) {
+	return validateAndConvert[builtin.FluxUpdateConfig](f.schemaLoader, cfg, stepKindFluxUpdate)
+}
+
+func (f *fluxUpdater) run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.FluxUpdateConfig,
+) (promotion.StepResult, error) {
+	if f.client == nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed},
+			&promotion.TerminalError{
+				Err: fmt.Errorf("no Kubernetes client is available to the %s step", stepKindFluxUpdate),
+			}
+	}
+
+	for _, update := range cfg.Resources {
+		if err := validateFluxResourceUpdate(update); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed},
+				&promotion.TerminalError{Err: err}
+		}
+	}
+
+	// Retrieve, authorize, and update every resource before patching any of
+	// them to avoid partial updates.
+	requestedAt := f.nowFn().UTC().Format(time.RFC3339Nano)
+	objs := make([]*unstructured.Unstructured, len(cfg.Resources))
+	patches := make([]client.Patch, len(cfg.Resources))
+	for i, update := range cfg.Resources {
+		obj, err := f.getResource(ctx, update)
+		if err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+		}
+		if err = authorizeFluxResourceUpdate(stepCtx, obj); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed},
+				&promotion.TerminalError{Err: err}
+		}
+		patches[i] = client.MergeFrom(obj.DeepCopy())
+		if err = applyFluxResourceUpdate(obj, update, requestedAt); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed},
+				&promotion.TerminalError{Err: err}
+		}
+		objs[i] = obj
+	}
+
+	logger := logging.LoggerFromContext(ctx)
+	healthChecks := make([]checkers.FluxResourceHealthCheck, len(cfg.Resources))
+	for i, update := range cfg.Resources {
+		obj := objs[i]
+		if err := f.client.Patch(ctx, obj, patches[i]); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, fmt.Errorf(
+				"error patching Flux %s %q in namespace %q: %w",
+				update.Kind, obj.GetName(), obj.GetNamespace(), err,
+			)
+		}
+		logger.Debug(
+			"requested reconciliation of Flux resource",
+			"kind", update.Kind,
+			"namespace", obj.GetNamespace(),
+			"name", obj.GetName(),
+		)
+		healthChecks[i] = checkers.FluxResourceHealthCheck{
+			APIVersion:           obj.GetAPIVersion(),
+			Kind:                 string(update.Kind),
+			Namespace:            obj.GetNamespace(),
+			Name:                 obj.GetName(),
+			DesiredRevision:      getFluxDesiredRevision(update),
+			ReconcileRequestedAt: requestedAt,
+		}
+	}
+
+	return promotion.StepResult{
+		Status: kargoapi.PromotionStepStatusSucceeded,
+		HealthCheck: &health.Criteria{
+			Kind: fluxHealthCheckKind,
+			Input: health.Input{
+				"resources": healthChecks,
+			},
+		},
+	}, nil
+}
+
+// getResource retrieves the Flux resource described by the given update.
+func (f *fluxUpdater) getResource(
+	ctx context.Context,
+	update builtin.FluxResourceUpdate,
+) (*unstructured.Unstructured, error) {
+	apiVersion := update.APIVersion
+	if apiVersion == "" {
+		apiVersion = fluxDefaultAPIVersions[update.Kind]
+	}
+	namespace := update.Namespace
+	if namespace == "" {
+		namespace = fluxDefaultNamespace
+	}
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(schema.FromAPIVersionAndKind(apiVersion, string(update.Kind)))
+	if err := f.client.Get(
+		ctx,
+		client.ObjectKey{Namespace: namespace, Name: update.Name},
+		obj,
+	); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf(
+				"unable to find Flux %s %q in namespace %q",
+				update.Kind, update.Name, namespace,
+			)
+		}
+		return nil, fmt.Errorf(
+			"error finding Flux %s %q in namespace %q: %w",
+			update.Kind, update.Name, namespace, err,
+		)
+	}
+	return obj, nil
+}
+
+// validateFluxResourceUpdate returns an error if the given update specifies
+// changes that are not applicable to the kind of Flux resource it targets.
+func validateFluxResourceUpdate(update builtin.FluxResourceUpdate) error {
+	if update.ChartVersion != "" && update.Kind != builtin.HelmRelease {
+		return fmt.Errorf(
+			"chartVersion cannot be specified for Flux %s %q; it is only applicable to HelmReleases",
+			update.Kind, update.Name,
+		)
+	}
+	ref := update.Ref
+	if ref == nil {
+		return nil
+	}
+	var unsupported []string
+	switch update.Kind {
+	case builtin.GitRepository:
+		if ref.Digest != "" {
+			unsupported = append(unsupported, "digest")
+		}
+	case builtin.OCIRepository:
+		if ref.Branch != "" {
+			unsupported = append(unsupported, "branch")
+		}
+		if ref.Commit != "" {
+			unsupported = append(unsupported, "commit")
+		}
+		if ref.Name != "" {
+			unsupported = append(unsupported, "name")
+		}
+	default:
+		return fmt.Errorf(
+			"ref cannot be specified for Flux %s %q; it is only applicable to "+
+				"GitRepositories and OCIRepositories",
+			update.Kind, update.Name,
+		)
+	}
+	if len(unsupported) > 0 {
+		return fmt.Errorf(
+			"ref for Flux %s %q specifies fields that are not applicable to its kind: %s",
+			update.Kind, update.Name, strings.Join(unsupported, ", "),
+		)
+	}
+	return nil
+}
+
+// authorizeFluxResourceUpdate returns an error if the given Flux resource does
+// not permit mutation by the Stage a step is being executed for. As with Argo
+// CD Applications, permission is granted by annotating the resource with
+// kargo.akuity.io/authorized-stage: "<project>:<stage>".
+func authorizeFluxResourceUpdate(
+	stepCtx *promotion.StepContext,
+	obj *unstructured.Unstructured,
+) error {
+	allowedStage, ok := obj.GetAnnotations()[kargoapi.AnnotationKeyAuthorizedStage]
+	if !ok || allowedStage != fmt.Sprintf("%s:%s", stepCtx.Project, stepCtx.Stage) {
+		// nolint:staticcheck
+		return fmt.Errorf(
+			"Flux %s %q in namespace %q does not permit mutation by "+
+				"Kargo Stage %s in namespace %s",
+			obj.GetKind(), obj.GetName(), obj.GetNamespace(),
+			stepCtx.Stage, stepCtx.Project,
+		)
+	}
+	return nil
+}
+
+// applyFluxResourceUpdate applies the given update to the given Flux resource
+// and annotates it to request its reconciliation.
+func applyFluxResourceUpdate(
+	obj *unstructured.Unstructured,
+	update builtin.FluxResourceUpdate,
+	requestedAt string,
+) error {
+	if ref := update.Ref; ref != nil {
+		newRef := map[string]any{}
+		for field, value := range map[string]string{
+			"branch": ref.Branch,
+			"commit": ref.Commit,
+			"digest": ref.Digest,
+			"name":   ref.Name,
+			"semver": ref.Semver,
+			"tag":    ref.Tag,
+		} {
+			if value != "" {
+				newRef[field] = value
+			}
+		}
+		if err := unstructured.SetNestedMap(obj.Object, newRef, "spec", "ref"); err != nil {
+			return fmt.Errorf("error setting ref of Flux %s %q: %w", update.Kind, obj.GetName(), err)
+		}
+	}
+	if update.ChartVersion != "" {
+		if _, found, _ := unstructured.NestedMap(obj.Object, "spec", "chart", "spec"); !found {
+			// nolint:staticcheck
+			return fmt.Errorf(
+				"Flux HelmRelease %q in namespace %q does not reference a chart by name, "+
+					"so its chart version cannot be pinned",
+				obj.GetName(), obj.GetNamespace(),
+			)
+		}
+		if err := unstructured.SetNestedField(
+			obj.Object, update.ChartVersion, "spec", "chart", "spec", "version",
+		); err != nil {
+			return fmt.Errorf(
+				"error setting chart version of Flux HelmRelease %q: %w", obj.GetName(), err,
+			)
+		}
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[fluxReconcileRequestAnnotation] = requestedAt
+	obj.SetAnnotations(annotations)
+	return nil
+}
+
+// getFluxDesiredRevision returns the revision the Flux resource described by
+// the given update is expected to reconcile. If one is not explicitly
+// specified, it is derived from the commit, digest, or chart version being
+// pinned, if any.
+func getFluxDesiredRevision(update builtin.FluxResourceUpdate) string {
+	switch {
+	case update.DesiredRevision != "":
+		return update.DesiredRevision
+	case update.Ref != nil && update.Ref.Commit != "":
+		return update.Ref.Commit
+	case update.Ref != nil && update.Ref.Digest != "":
+		return update.Ref.Digest
+	default:
+		return update.ChartVersion
+	}
+}
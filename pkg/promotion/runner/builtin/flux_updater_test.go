@@ -0,0 +1,406 @@
+package builtin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/health"
+	checkers "github.com/akuity/kargo/pkg/health/checker/builtin"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_fluxUpdater_convert(t *testing.T) {
+	tests := []validationTestCase{
+		{
+			name:   "resources not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): resources is required",
+			},
+		},
+		{
+			name: "resources is empty",
+			config: promotion.Config{
+				"resources": []promotion.Config{},
+			},
+			expectedProblems: []string{
+				"resources: Array must have at least 1 items",
+			},
+		},
+		{
+			name: "kind and name not specified",
+			config: promotion.Config{
+				"resources": []promotion.Config{{}},
+			},
+			expectedProblems: []string{
+				"resources.0: kind is required",
+				"resources.0: name is required",
+			},
+		},
+		{
+			name: "unsupported kind",
+			config: promotion.Config{
+				"resources": []promotion.Config{{
+					"kind": "Bucket",
+					"name": "fake-name",
+				}},
+			},
+			expectedProblems: []string{
+				"resources.0.kind: resources.0.kind must be one of the following",
+			},
+		},
+		{
+			name: "ref is empty",
+			config: promotion.Config{
+				"resources": []promotion.Config{{
+					"kind": "GitRepository",
+					"name": "fake-name",
+					"ref":  promotion.Config{},
+				}},
+			},
+			expectedProblems: []string{
+				"resources.0.ref: Must have at least 1 properties",
+			},
+		},
+		{
+			name: "valid kitchen sink",
+			config: promotion.Config{
+				"resources": []promotion.Config{
+					{
+						"kind":       "GitRepository",
+						"apiVersion": "source.toolkit.fluxcd.io/v1",
+						"name":       "fake-name",
+						"namespace":  "fake-namespace",
+						"ref": promotion.Config{
+							"commit": "abc123",
+						},
+					},
+					{
+						"kind":         "HelmRelease",
+						"name":         "fake-name",
+						"chartVersion": "1.2.3",
+					},
+					{
+						"kind":            "Kustomization",
+						"name":            "fake-name",
+						"desiredRevision": "abc123",
+					},
+				},
+			},
+		},
+	}
+
+	r := newFluxUpdater(promotion.StepRunnerCapabilities{})
+	runner, ok := r.(*fluxUpdater)
+	require.True(t, ok)
+
+	runValidationTests(t, runner.convert, tests)
+}
+
+func Test_fluxUpdater_run(t *testing.T) {
+	const (
+		testProject = "fake-project"
+		testStage   = "fake-stage"
+	)
+	testNow := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	testRequestedAt := testNow.Format(time.RFC3339Nano)
+
+	scheme := runtime.NewScheme()
+	for kind, apiVersion := range fluxDefaultAPIVersions {
+		gvk := schema.FromAPIVersionAndKind(apiVersion, string(kind))
+		scheme.AddKnownTypeWithName(gvk, &unstructured.Unstructured{})
+		scheme.AddKnownTypeWithName(
+			gvk.GroupVersion().WithKind(gvk.Kind+"List"),
+			&unstructured.UnstructuredList{},
+		)
+	}
+
+	newResource := func(
+		kind builtin.FluxResourceKind,
+		name string,
+		authorized bool,
+		spec map[string]any,
+	) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: map[string]any{"spec": spec}}
+		obj.SetAPIVersion(fluxDefaultAPIVersions[kind])
+		obj.SetKind(string(kind))
+		obj.SetNamespace(fluxDefaultNamespace)
+		obj.SetName(name)
+		if authorized {
+			obj.SetAnnotations(map[string]string{
+				kargoapi.AnnotationKeyAuthorizedStage: testProject + ":" + testStage,
+			})
+		}
+		return obj
+	}
+
+	getResource := func(
+		t *testing.T,
+		c client.Client,
+		kind builtin.FluxResourceKind,
+		name string,
+	) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(
+			schema.FromAPIVersionAndKind(fluxDefaultAPIVersions[kind], string(kind)),
+		)
+		require.NoError(t, c.Get(
+			context.Background(),
+			client.ObjectKey{Namespace: fluxDefaultNamespace, Name: name},
+			obj,
+		))
+		return obj
+	}
+
+	tests := []struct {
+		name       string
+		objects    []client.Object
+		config     builtin.FluxUpdateConfig
+		assertions func(*testing.T, client.Client, promotion.StepResult, error)
+	}{
+		{
+			name: "updates and requests reconciliation",
+			objects: []client.Object{
+				newResource(builtin.GitRepository, "repo", true, map[string]any{
+					"url": "https://github.com/example/repo.git",
+					"ref": map[string]any{"branch": "main"},
+				}),
+				newResource(builtin.HelmRelease, "release", true, map[string]any{
+					"chart": map[string]any{
+						"spec": map[string]any{"chart": "my-chart", "version": "1.0.0"},
+					},
+				}),
+				newResource(builtin.Kustomization, "app", true, map[string]any{
+					"path": "./deploy",
+				}),
+			},
+			config: builtin.FluxUpdateConfig{
+				Resources: []builtin.FluxResourceUpdate{
+					{
+						Kind: builtin.GitRepository,
+						Name: "repo",
+						Ref:  &builtin.FluxSourceRef{Commit: "abc123"},
+					},
+					{
+						Kind:         builtin.HelmRelease,
+						Name:         "release",
+						ChartVersion: "1.2.3",
+					},
+					{
+						Kind:            builtin.Kustomization,
+						Name:            "app",
+						DesiredRevision: "abc123",
+					},
+				},
+			},
+			assertions: func(t *testing.T, c client.Client, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.Status)
+
+				repo := getResource(t, c, builtin.GitRepository, "repo")
+				ref, _, _ := unstructured.NestedMap(repo.Object, "spec", "ref")
+				assert.Equal(t, map[string]any{"commit": "abc123"}, ref)
+				assert.Equal(t, testRequestedAt, repo.GetAnnotations()[fluxReconcileRequestAnnotation])
+
+				release := getResource(t, c, builtin.HelmRelease, "release")
+				version, _, _ := unstructured.NestedString(release.Object, "spec", "chart", "spec", "version")
+				assert.Equal(t, "1.2.3", version)
+				assert.Equal(t, testRequestedAt, release.GetAnnotations()[fluxReconcileRequestAnnotation])
+
+				app := getResource(t, c, builtin.Kustomization, "app")
+				assert.Equal(t, testRequestedAt, app.GetAnnotations()[fluxReconcileRequestAnnotation])
+				// Authorization is retained
+				assert.Equal(
+					t,
+					testProject+":"+testStage,
+					app.GetAnnotations()[kargoapi.AnnotationKeyAuthorizedStage],
+				)
+
+				assert.Equal(t, &health.Criteria{
+					Kind: fluxHealthCheckKind,
+					Input: health.Input{
+						"resources": []checkers.FluxResourceHealthCheck{
+							{
+								APIVersion:           "source.toolkit.fluxcd.io/v1",
+								Kind:                 "GitRepository",
+								Namespace:            fluxDefaultNamespace,
+								Name:                 "repo",
+								DesiredRevision:      "abc123",
+								ReconcileRequestedAt: testRequestedAt,
+							},
+							{
+								APIVersion:           "helm.toolkit.fluxcd.io/v2",
+								Kind:                 "HelmRelease",
+								Namespace:            fluxDefaultNamespace,
+								Name:                 "release",
+								DesiredRevision:      "1.2.3",
+								ReconcileRequestedAt: testRequestedAt,
+							},
+							{
+								APIVersion:           "kustomize.toolkit.fluxcd.io/v1",
+								Kind:                 "Kustomization",
+								Namespace:            fluxDefaultNamespace,
+								Name:                 "app",
+								DesiredRevision:      "abc123",
+								ReconcileRequestedAt: testRequestedAt,
+							},
+						},
+					},
+				}, result.HealthCheck)
+			},
+		},
+		{
+			name: "resource not found",
+			config: builtin.FluxUpdateConfig{
+				Resources: []builtin.FluxResourceUpdate{{
+					Kind: builtin.Kustomization,
+					Name: "app",
+				}},
+			},
+			assertions: func(t *testing.T, _ client.Client, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, `unable to find Flux Kustomization "app"`)
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.Status)
+			},
+		},
+		{
+			name: "resource not authorized",
+			objects: []client.Object{
+				newResource(builtin.Kustomization, "authorized", true, map[string]any{}),
+				newResource(builtin.Kustomization, "unauthorized", false, map[string]any{}),
+			},
+			config: builtin.FluxUpdateConfig{
+				Resources: []builtin.FluxResourceUpdate{
+					{Kind: builtin.Kustomization, Name: "authorized"},
+					{Kind: builtin.Kustomization, Name: "unauthorized"},
+				},
+			},
+			assertions: func(t *testing.T, c client.Client, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "does not permit mutation")
+				assert.True(t, promotion.IsTerminal(err))
+				assert.Equal(t, kargoapi.PromotionStepStatusFailed, result.Status)
+				// No resource was updated
+				app := getResource(t, c, builtin.Kustomization, "authorized")
+				assert.NotContains(t, app.GetAnnotations(), fluxReconcileRequestAnnotation)
+			},
+		},
+		{
+			name: "HelmRelease does not reference a chart by name",
+			objects: []client.Object{
+				newResource(builtin.HelmRelease, "release", true, map[string]any{
+					"chartRef": map[string]any{"kind": "OCIRepository", "name": "chart"},
+				}),
+			},
+			config: builtin.FluxUpdateConfig{
+				Resources: []builtin.FluxResourceUpdate{{
+					Kind:         builtin.HelmRelease,
+					Name:         "release",
+					ChartVersion: "1.2.3",
+				}},
+			},
+			assertions: func(t *testing.T, _ client.Client, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "does not reference a chart by name")
+				assert.True(t, promotion.IsTerminal(err))
+				assert.Equal(t, kargoapi.PromotionStepStatusFailed, result.Status)
+			},
+		},
+		{
+			name: "ref not applicable to kind",
+			config: builtin.FluxUpdateConfig{
+				Resources: []builtin.FluxResourceUpdate{{
+					Kind: builtin.OCIRepository,
+					Name: "repo",
+					Ref:  &builtin.FluxSourceRef{Commit: "abc123"},
+				}},
+			},
+			assertions: func(t *testing.T, _ client.Client, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "not applicable to its kind: commit")
+				assert.True(t, promotion.IsTerminal(err))
+				assert.Equal(t, kargoapi.PromotionStepStatusFailed, result.Status)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.objects...).Build()
+			runner := &fluxUpdater{
+				client: c,
+				nowFn:  func() time.Time { return testNow },
+			}
+			result, err := runner.run(
+				context.Background(),
+				&promotion.StepContext{Project: testProject, Stage: testStage},
+				tt.config,
+			)
+			tt.assertions(t, c, result, err)
+		})
+	}
+}
+
+func Test_validateFluxResourceUpdate(t *testing.T) {
+	tests := []struct {
+		name   string
+		update builtin.FluxResourceUpdate
+		errMsg string
+	}{
+		{
+			name: "GitRepository with commit",
+			update: builtin.FluxResourceUpdate{
+				Kind: builtin.GitRepository,
+				Ref:  &builtin.FluxSourceRef{Commit: "abc123"},
+			},
+		},
+		{
+			name: "GitRepository with digest",
+			update: builtin.FluxResourceUpdate{
+				Kind: builtin.GitRepository,
+				Ref:  &builtin.FluxSourceRef{Digest: "sha256:abc123"},
+			},
+			errMsg: "not applicable to its kind: digest",
+		},
+		{
+			name: "OCIRepository with digest",
+			update: builtin.FluxResourceUpdate{
+				Kind: builtin.OCIRepository,
+				Ref:  &builtin.FluxSourceRef{Digest: "sha256:abc123"},
+			},
+		},
+		{
+			name: "Kustomization with ref",
+			update: builtin.FluxResourceUpdate{
+				Kind: builtin.Kustomization,
+				Ref:  &builtin.FluxSourceRef{Tag: "v1"},
+			},
+			errMsg: "only applicable to GitRepositories and OCIRepositories",
+		},
+		{
+			name: "GitRepository with chartVersion",
+			update: builtin.FluxResourceUpdate{
+				Kind:         builtin.GitRepository,
+				ChartVersion: "1.2.3",
+			},
+			errMsg: "only applicable to HelmReleases",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateFluxResourceUpdate(tt.update)
+			if tt.errMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.errMsg)
+		})
+	}
+}
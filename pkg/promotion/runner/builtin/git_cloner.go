@@ -174,7 +174,7 @@ func (g *gitCloner) run(
 		switch {
 		case checkout.Branch != "":
 			ref = checkout.Branch
-			if err = ensureRemoteBranch(repo, ref, checkout.Create); err != nil {
+			if err = ensureRemoteBranch(repo, ref, checkout.Create, stepCtx.DryRun); err != nil {
 				return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
 					fmt.Errorf("error ensuring existence of remote branch %s: %w", ref, err)
 			}
@@ -195,6 +195,9 @@ func (g *gitCloner) run(
 			&git.AddWorkTreeOptions{
 				Ref:    ref,
 				Sparse: checkout.Sparse,
+				// Only a dry run reports the changes made to the working tree
+				// without pushing them.
+				RecordBase: stepCtx.DryRun,
 			},
 		)
 		if err != nil {
@@ -232,8 +235,9 @@ func (g *gitCloner) run(
 // branch exists, no action is taken and nil is returned. If the branch does not
 // exist and create == true, an empty orphaned branch is created and pushed to
 // the remote. If the branch does not exist and create == false, an error is
-// returned.
-func ensureRemoteBranch(repo git.BareRepo, branch string, create bool) error {
+// returned. If dryRun == true, a branch that is created is not pushed to the
+// remote, but only exists locally.
+func ensureRemoteBranch(repo git.BareRepo, branch string, create, dryRun bool) error {
 	exists, err := repo.RemoteBranchExists(branch)
 	if err != nil {
 		return fmt.Errorf(
@@ -283,6 +287,9 @@ func ensureRemoteBranch(repo git.BareRepo, branch string, create bool) error {
 			branch, repo.URL(), err,
 		)
 	}
+	if dryRun {
+		return nil
+	}
 	if err = workTree.Push(&git.PushOptions{TargetBranch: branch}); err != nil {
 		return fmt.Errorf(
 			"error pushing initial commit to new branch %q to repo %s: %w",
@@ -31,6 +31,7 @@ func init() {
 				RequiredCapabilities: []promotion.StepRunnerCapability{
 					promotion.StepCapabilityAccessCredentials,
 				},
+				SideEffecting: true,
 			},
 			Value: newGitPRMerger,
 		},
@@ -33,6 +33,7 @@ func init() {
 				RequiredCapabilities: []promotion.StepRunnerCapability{
 					promotion.StepCapabilityAccessCredentials,
 				},
+				SideEffecting: true,
 			},
 			Value: newGitPROpener,
 		},
@@ -30,6 +30,7 @@ func init() {
 				RequiredCapabilities: []promotion.StepRunnerCapability{
 					promotion.StepCapabilityAccessCredentials,
 				},
+				SideEffecting: true,
 			},
 			Value: newGitPRWaiter,
 		},
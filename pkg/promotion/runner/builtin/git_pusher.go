@@ -40,6 +40,7 @@ func init() {
 				RequiredCapabilities: []promotion.StepRunnerCapability{
 					promotion.StepCapabilityAccessCredentials,
 				},
+				SideEffecting: true,
 			},
 			Value: newGitPusher,
 		},
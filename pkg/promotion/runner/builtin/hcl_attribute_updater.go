@@ -0,0 +1,351 @@
+package builtin
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/xeipuuv/gojsonschema"
+	"github.com/zclconf/go-cty/cty"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const stepKindHCLAttributeUpdate = "hcl-attribute-update"
+
+func init() {
+	promotion.DefaultStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name:  stepKindHCLAttributeUpdate,
+			Value: newHCLAttributeUpdater,
+		},
+	)
+}
+
+// hclAttributeUpdater is an implementation of the promotion.StepRunner interface that
+// updates the values of specified attributes in an HCL file.
+type hclAttributeUpdater struct {
+	schemaLoader gojsonschema.JSONLoader
+}
+
+// newHCLAttributeUpdater returns an implementation of the promotion.StepRunner
+// interface that updates the values of specified attributes in an HCL file.
+func newHCLAttributeUpdater(promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &hclAttributeUpdater{schemaLoader: getConfigSchemaLoader(stepKindHCLAttributeUpdate)}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (h *hclAttributeUpdater) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := h.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return h.run(ctx, stepCtx, cfg)
+}
+
+// convert validates hclAttributeUpdater configuration against a JSON schema and
+// converts it into a builtin.HCLAttributeUpdateConfig struct.
+func (h *hclAttributeUpdater) convert(cfg promotion.Config) (builtin.HCLAttributeUpdateConfig, error) {
+	return validateAndConvert[builtin.HCLAttributeUpdateConfig](h.schemaLoader, cfg, stepKindHCLAttributeUpdate)
+}
+
+func (h *hclAttributeUpdater) run(
+	_ context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.HCLAttributeUpdateConfig,
+) (promotion.StepResult, error) {
+	result := promotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded}
+
+	if len(cfg.Updates) > 0 {
+		if err := h.updateFile(stepCtx.WorkDir, cfg.Path, cfg.Updates); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("HCL file update failed: %w", err)
+		}
+
+		if commitMsg := h.generateCommitMessage(cfg.Path, cfg.Updates); commitMsg != "" {
+			result.Output = map[string]any{
+				"commitMessage": commitMsg,
+			}
+		}
+	}
+	return result, nil
+}
+
+func (h *hclAttributeUpdater) updateFile(workDir string, path string, updates []builtin.HCLAttributeUpdate) error {
+	absFilePath, err := securejoin.SecureJoin(workDir, path)
+	if err != nil {
+		return fmt.Errorf("error joining path %q: %w", path, err)
+	}
+
+	fileContent, err := os.ReadFile(absFilePath)
+	if err != nil {
+		return fmt.Errorf("error reading HCL file %q: %w", absFilePath, err)
+	}
+
+	for _, update := range updates {
+		if !isValidScalar(update.Value) {
+			return fmt.Errorf("value for key %q is not a scalar type", update.Key)
+		}
+		if fileContent, err = setHCLValue(fileContent, update.Key, update.Value); err != nil {
+			return fmt.Errorf("error setting key %q in HCL file: %w", update.Key, err)
+		}
+	}
+
+	if err = os.WriteFile(absFilePath, fileContent, 0600); err != nil {
+		return fmt.Errorf("error writing updated HCL file %q: %w", absFilePath, err)
+	}
+
+	return nil
+}
+
+func (h *hclAttributeUpdater) generateCommitMessage(path string, updates []builtin.HCLAttributeUpdate) string {
+	if len(updates) == 0 {
+		return ""
+	}
+
+	var commitMsg strings.Builder
+	_, _ = fmt.Fprintf(&commitMsg, "Updated %s\n", path)
+
+	for _, update := range updates {
+		switch v := update.Value.(type) {
+		case string:
+			_, _ = fmt.Fprintf(&commitMsg, "\n- %s: %q", update.Key, v)
+		default:
+			_, _ = fmt.Fprintf(&commitMsg, "\n- %s: %v", update.Key, v)
+		}
+	}
+
+	return commitMsg.String()
+}
+
+// errHCLKeyNotFound is returned when a key cannot be found in an HCL document.
+var errHCLKeyNotFound = errors.New("key not found")
+
+// hclTarget describes where in an HCL document a value is to be set. Either
+// expr is the expression holding the existing value, or name is the name of a
+// new attribute to be added to the body of the block whose closing brace is at
+// offset closeBrace, or to the root body if closeBrace is negative.
+type hclTarget struct {
+	expr       hclsyntax.Expression
+	name       string
+	closeBrace int
+}
+
+// setHCLValue sets the value of the key at the specified dot-separated path in
+// the provided HCL document and returns the updated document. Path segments
+// identify attributes, keys of object values, or blocks, which are identified
+// by their type followed by their labels. Only the bytes of the existing value
+// are replaced, so comments and formatting are preserved. If the key does not
+// exist, it is added as an attribute to the body that contains it.
+func setHCLValue(doc []byte, key string, value any) ([]byte, error) {
+	file, diags := hclsyntax.ParseConfig(doc, "", hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("error parsing HCL: %w", diags)
+	}
+	body, ok := file.Body.(*hclsyntax.Body)
+	if !ok {
+		return nil, errors.New("error parsing HCL: unexpected body type")
+	}
+
+	target, err := findHCLTarget(body, strings.Split(key, "."), -1)
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := encodeHCLValue(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated []byte
+	if target.expr != nil {
+		rng := target.expr.Range()
+		updated = slices.Concat(doc[:rng.Start.Byte], encoded, doc[rng.End.Byte:])
+	} else {
+		if !hclsyntax.ValidIdentifier(target.name) {
+			return nil, fmt.Errorf("%q is not a valid attribute name", target.name)
+		}
+		if updated, err = insertHCLAttribute(doc, target, encoded); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, diags = hclsyntax.ParseConfig(updated, "", hcl.InitialPos); diags.HasErrors() {
+		return nil, fmt.Errorf("updated HCL is invalid: %w", diags)
+	}
+	return updated, nil
+}
+
+// findHCLTarget finds where the value of the key at the specified path is to
+// be set within the provided body, which is the body of the block whose
+// closing brace is at offset closeBrace, or the root body if closeBrace is
+// negative.
+func findHCLTarget(
+	body *hclsyntax.Body,
+	path []string,
+	closeBrace int,
+) (hclTarget, error) {
+	if attr, ok := body.Attributes[path[0]]; ok {
+		if len(path) == 1 {
+			return hclTarget{expr: attr.Expr}, nil
+		}
+		expr, err := findHCLObjectValue(attr.Expr, path[0], path[1:])
+		if err != nil {
+			return hclTarget{}, err
+		}
+		return hclTarget{expr: expr}, nil
+	}
+
+	// A key may be found in any of the blocks matching the path. An existing
+	// value takes precedence over adding a new attribute to the first match.
+	var candidate *hclTarget
+	for _, block := range body.Blocks {
+		labelCount := len(block.Labels)
+		if block.Type != path[0] || len(path) < labelCount+2 ||
+			!slices.Equal(block.Labels, path[1:labelCount+1]) {
+			continue
+		}
+		target, err := findHCLTarget(
+			block.Body,
+			path[labelCount+1:],
+			block.CloseBraceRange.Start.Byte,
+		)
+		if err != nil {
+			if errors.Is(err, errHCLKeyNotFound) {
+				continue
+			}
+			return hclTarget{}, err
+		}
+		if target.expr != nil {
+			return target, nil
+		}
+		if candidate == nil {
+			candidate = &target
+		}
+	}
+	if candidate != nil {
+		return *candidate, nil
+	}
+
+	if len(path) == 1 {
+		return hclTarget{name: path[0], closeBrace: closeBrace}, nil
+	}
+	return hclTarget{}, fmt.Errorf("%w: %s", errHCLKeyNotFound, strings.Join(path, "."))
+}
+
+// findHCLObjectValue finds the expression holding the value of the key at the
+// specified path within the provided object expression, which is the value of
+// the key named name.
+func findHCLObjectValue(
+	expr hclsyntax.Expression,
+	name string,
+	path []string,
+) (hclsyntax.Expression, error) {
+	obj, ok := expr.(*hclsyntax.ObjectConsExpr)
+	if !ok {
+		return nil, fmt.Errorf("value of %q is not an object", name)
+	}
+	for _, item := range obj.Items {
+		if hclObjectKey(item.KeyExpr) != path[0] {
+			continue
+		}
+		if len(path) == 1 {
+			return item.ValueExpr, nil
+		}
+		return findHCLObjectValue(item.ValueExpr, path[0], path[1:])
+	}
+	return nil, fmt.Errorf("%w: %s", errHCLKeyNotFound, strings.Join(path, "."))
+}
+
+// hclObjectKey returns the name of the provided object key, which may be
+// either a bare identifier or a string literal. An empty string is returned if
+// the key is neither.
+func hclObjectKey(expr hclsyntax.Expression) string {
+	if keyExpr, ok := expr.(*hclsyntax.ObjectConsKeyExpr); ok {
+		if name := hcl.ExprAsKeyword(keyExpr.Wrapped); name != "" {
+			return name
+		}
+		expr = keyExpr.Wrapped
+	}
+	val, diags := expr.Value(nil)
+	if diags.HasErrors() || !val.IsKnown() || val.IsNull() || val.Type() != cty.String {
+		return ""
+	}
+	return val.AsString()
+}
+
+// insertHCLAttribute adds a new attribute to the body described by the
+// provided target. Attributes are added to the end of the file when the body
+// is the root body, or before the closing brace of a block otherwise.
+func insertHCLAttribute(doc []byte, target hclTarget, encoded []byte) ([]byte, error) {
+	attr := fmt.Sprintf("%s = %s\n", target.name, encoded)
+	if target.closeBrace < 0 {
+		if len(doc) > 0 && doc[len(doc)-1] != '\n' {
+			attr = "\n" + attr
+		}
+		return slices.Concat(doc, []byte(attr)), nil
+	}
+
+	lineStart := bytes.LastIndexByte(doc[:target.closeBrace], '\n') + 1
+	indent := doc[lineStart:target.closeBrace]
+	if len(bytes.TrimSpace(indent)) > 0 {
+		return nil, fmt.Errorf(
+			"cannot add attribute %q to a block whose closing brace does not "+
+				"begin a line", target.name,
+		)
+	}
+	attr = string(indent) + "  " + attr
+	return slices.Concat(doc[:lineStart], []byte(attr), doc[lineStart:]), nil
+}
+
+// encodeHCLValue encodes the provided scalar value as an HCL literal.
+func encodeHCLValue(value any) ([]byte, error) {
+	var val cty.Value
+	switch v := value.(type) {
+	case string:
+		val = cty.StringVal(v)
+	case bool:
+		val = cty.BoolVal(v)
+	case int:
+		val = cty.NumberIntVal(int64(v))
+	case int8:
+		val = cty.NumberIntVal(int64(v))
+	case int16:
+		val = cty.NumberIntVal(int64(v))
+	case int32:
+		val = cty.NumberIntVal(int64(v))
+	case int64:
+		val = cty.NumberIntVal(v)
+	case uint:
+		val = cty.NumberUIntVal(uint64(v))
+	case uint8:
+		val = cty.NumberUIntVal(uint64(v))
+	case uint16:
+		val = cty.NumberUIntVal(uint64(v))
+	case uint32:
+		val = cty.NumberUIntVal(uint64(v))
+	case uint64:
+		val = cty.NumberUIntVal(v)
+	case float32:
+		val = cty.NumberFloatVal(float64(v))
+	case float64:
+		val = cty.NumberFloatVal(v)
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", value)
+	}
+	return hclwrite.TokensForValue(val).Bytes(), nil
+}
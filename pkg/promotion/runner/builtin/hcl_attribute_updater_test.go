@@ -0,0 +1,336 @@
+package builtin
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_hclAttributeUpdater_convert(t *testing.T) {
+	tests := []validationTestCase{
+		{
+			name:   "path is not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): path is required",
+			},
+		},
+		{
+			name:   "updates is null",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): updates is required",
+			},
+		},
+		{
+			name: "updates is empty",
+			config: promotion.Config{
+				"updates": []promotion.Config{},
+			},
+			expectedProblems: []string{
+				"updates: Array must have at least 1 items",
+			},
+		},
+		{
+			name: "key not specified",
+			config: promotion.Config{
+				"updates": []promotion.Config{{}},
+			},
+			expectedProblems: []string{
+				"updates.0: key is required",
+			},
+		},
+		{
+			name: "value not specified",
+			config: promotion.Config{
+				"updates": []promotion.Config{{}},
+			},
+			expectedProblems: []string{
+				"updates.0: value is required",
+			},
+		},
+		{
+			name: "valid config",
+			config: promotion.Config{
+				"path": "fake-path",
+				"updates": []promotion.Config{
+					{
+						"key":   "image_uri",
+						"value": "fake-image:v1",
+					},
+				},
+			},
+		},
+	}
+
+	r := newHCLAttributeUpdater(promotion.StepRunnerCapabilities{})
+	runner, ok := r.(*hclAttributeUpdater)
+	require.True(t, ok)
+
+	runValidationTests(t, runner.convert, tests)
+}
+
+func Test_hclAttributeUpdater_run(t *testing.T) {
+	tests := []struct {
+		name       string
+		cfg        builtin.HCLAttributeUpdateConfig
+		files      map[string]string
+		assertions func(*testing.T, string, promotion.StepResult, error)
+	}{
+		{
+			name: "successful run with updates",
+			cfg: builtin.HCLAttributeUpdateConfig{
+				Path: "env.auto.tfvars",
+				Updates: []builtin.HCLAttributeUpdate{
+					{Key: "image_uri", Value: "my-app:v1.0.1"},
+					{Key: "replica_count", Value: float64(3)},
+					{Key: "enable_monitoring", Value: true},
+				},
+			},
+			files: map[string]string{
+				"env.auto.tfvars": `# Managed by Kargo
+image_uri     = "my-app:v1.0.0" # the image
+replica_count = 1
+enable_monitoring = false
+`,
+			},
+			assertions: func(t *testing.T, workDir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, promotion.StepResult{
+					Status: kargoapi.PromotionStepStatusSucceeded,
+					Output: map[string]any{
+						"commitMessage": "Updated env.auto.tfvars\n\n" +
+							"- image_uri: \"my-app:v1.0.1\"\n" +
+							"- replica_count: 3\n" +
+							"- enable_monitoring: true",
+					},
+				}, result)
+				content, err := os.ReadFile(path.Join(workDir, "env.auto.tfvars"))
+				require.NoError(t, err)
+				assert.Equal(t, `# Managed by Kargo
+image_uri     = "my-app:v1.0.1" # the image
+replica_count = 3
+enable_monitoring = true
+`, string(content))
+			},
+		},
+		{
+			name: "value is not a scalar",
+			cfg: builtin.HCLAttributeUpdateConfig{
+				Path: "main.tf",
+				Updates: []builtin.HCLAttributeUpdate{
+					{Key: "key", Value: map[string]any{"a": "b"}},
+				},
+			},
+			files: map[string]string{
+				"main.tf": "key = 1\n",
+			},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "is not a scalar type")
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.Status)
+			},
+		},
+		{
+			name: "file does not exist",
+			cfg: builtin.HCLAttributeUpdateConfig{
+				Path: "main.tf",
+				Updates: []builtin.HCLAttributeUpdate{
+					{Key: "key", Value: "value"},
+				},
+			},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "HCL file update failed")
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.Status)
+			},
+		},
+	}
+
+	runner := &hclAttributeUpdater{}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workDir := t.TempDir()
+			for p, c := range tt.files {
+				require.NoError(t, os.WriteFile(path.Join(workDir, p), []byte(c), 0o600))
+			}
+			result, err := runner.run(
+				context.Background(),
+				&promotion.StepContext{Project: "test-project", WorkDir: workDir},
+				tt.cfg,
+			)
+			tt.assertions(t, workDir, result, err)
+		})
+	}
+}
+
+func Test_setHCLValue(t *testing.T) {
+	const mainTF = `resource "aws_lambda_function" "app" {
+  # The image to run
+  image_uri = "my-app:v1.0.0"
+
+  tags = {
+    version     = "v1.0.0"
+    "team-name" = "platform"
+  }
+}
+
+resource "aws_lambda_function" "worker" {
+  image_uri = "my-worker:v1.0.0"
+}
+`
+
+	tests := []struct {
+		name       string
+		doc        string
+		key        string
+		value      any
+		assertions func(*testing.T, string, error)
+	}{
+		{
+			name:  "updates attribute in labeled block",
+			doc:   mainTF,
+			key:   "resource.aws_lambda_function.worker.image_uri",
+			value: "my-worker:v1.0.1",
+			assertions: func(t *testing.T, doc string, err error) {
+				require.NoError(t, err)
+				assert.Contains(t, doc, `  image_uri = "my-worker:v1.0.1"`)
+				assert.Contains(t, doc, `  image_uri = "my-app:v1.0.0"`)
+			},
+		},
+		{
+			name:  "updates key of object value",
+			doc:   mainTF,
+			key:   "resource.aws_lambda_function.app.tags.version",
+			value: "v1.0.1",
+			assertions: func(t *testing.T, doc string, err error) {
+				require.NoError(t, err)
+				assert.Contains(t, doc, `    version     = "v1.0.1"`)
+			},
+		},
+		{
+			name:  "updates quoted key of object value",
+			doc:   mainTF,
+			key:   "resource.aws_lambda_function.app.tags.team-name",
+			value: "apps",
+			assertions: func(t *testing.T, doc string, err error) {
+				require.NoError(t, err)
+				assert.Contains(t, doc, `    "team-name" = "apps"`)
+			},
+		},
+		{
+			name:  "replaces expression",
+			doc:   "image_uri = var.image\n",
+			key:   "image_uri",
+			value: "my-app:v1.0.1",
+			assertions: func(t *testing.T, doc string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "image_uri = \"my-app:v1.0.1\"\n", doc)
+			},
+		},
+		{
+			name:  "escapes template sequences",
+			doc:   "greeting = \"hello\"\n",
+			key:   "greeting",
+			value: "hello ${name}",
+			assertions: func(t *testing.T, doc string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "greeting = \"hello $${name}\"\n", doc)
+			},
+		},
+		{
+			name:  "adds attribute to root body",
+			doc:   "image_uri = \"my-app:v1.0.0\"",
+			key:   "replica_count",
+			value: 2,
+			assertions: func(t *testing.T, doc string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "image_uri = \"my-app:v1.0.0\"\nreplica_count = 2\n", doc)
+			},
+		},
+		{
+			name:  "adds attribute to block",
+			doc:   mainTF,
+			key:   "resource.aws_lambda_function.worker.timeout",
+			value: float64(30),
+			assertions: func(t *testing.T, doc string, err error) {
+				require.NoError(t, err)
+				assert.Contains(
+					t,
+					doc,
+					"  image_uri = \"my-worker:v1.0.0\"\n  timeout = 30\n}\n",
+				)
+			},
+		},
+		{
+			name:  "block not found",
+			doc:   mainTF,
+			key:   "resource.aws_lambda_function.missing.image_uri",
+			value: "my-app:v1.0.1",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "key not found")
+			},
+		},
+		{
+			name:  "key of object value not found",
+			doc:   mainTF,
+			key:   "resource.aws_lambda_function.app.tags.missing",
+			value: "value",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(
+					t, err, "key not found: resource.aws_lambda_function.app.tags.missing",
+				)
+			},
+		},
+		{
+			name:  "value is not an object",
+			doc:   mainTF,
+			key:   "resource.aws_lambda_function.app.image_uri.tag",
+			value: "v1.0.1",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, `value of "image_uri" is not an object`)
+			},
+		},
+		{
+			name:  "invalid attribute name",
+			doc:   "a = 1\n",
+			key:   "not valid",
+			value: 2,
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "is not a valid attribute name")
+			},
+		},
+		{
+			name:  "single-line block",
+			doc:   "locals { a = 1 }\n",
+			key:   "locals.b",
+			value: 2,
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "closing brace does not begin a line")
+			},
+		},
+		{
+			name:  "invalid HCL",
+			doc:   "this is not HCL",
+			key:   "key",
+			value: "value",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "error parsing HCL")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := setHCLValue([]byte(tt.doc), tt.key, tt.value)
+			tt.assertions(t, string(doc), err)
+		})
+	}
+}
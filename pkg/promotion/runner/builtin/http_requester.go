@@ -42,12 +42,28 @@ const (
 func init() {
 	promotion.DefaultStepRunnerRegistry.MustRegister(
 		promotion.StepRunnerRegistration{
-			Name:  stepKindHTTP,
+			Name: stepKindHTTP,
+			Metadata: promotion.StepRunnerMetadata{
+				SideEffectingFn: isHTTPRequestSideEffecting,
+			},
 			Value: newHTTPRequester,
 		},
 	)
 }
 
+// isHTTPRequestSideEffecting returns whether sending the HTTP request described
+// by the provided configuration may have side effects. Only requests using a
+// safe method, as defined by RFC 9110, are considered free of side effects.
+func isHTTPRequestSideEffecting(cfg promotion.Config) bool {
+	method, _ := cfg["method"].(string)
+	switch strings.ToUpper(method) {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
+
 // httpRequester is an implementation of the promotion.StepRunner interface that
 // sends an HTTP request and processes the response.
 type httpRequester struct {
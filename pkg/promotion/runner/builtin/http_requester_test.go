@@ -15,6 +15,42 @@ import (
 	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
 )
 
+func Test_isHTTPRequestSideEffecting(t *testing.T) {
+	testCases := []struct {
+		name     string
+		cfg      promotion.Config
+		expected bool
+	}{
+		{
+			name: "default method",
+			cfg:  promotion.Config{"url": "https://example.com"},
+		},
+		{
+			name: "GET",
+			cfg:  promotion.Config{"method": "GET"},
+		},
+		{
+			name: "lowercase head",
+			cfg:  promotion.Config{"method": "head"},
+		},
+		{
+			name:     "POST",
+			cfg:      promotion.Config{"method": "POST"},
+			expected: true,
+		},
+		{
+			name:     "DELETE",
+			cfg:      promotion.Config{"method": "DELETE"},
+			expected: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, isHTTPRequestSideEffecting(testCase.cfg))
+		})
+	}
+}
+
 func Test_httpRequester_convert(t *testing.T) {
 	tests := []validationTestCase{
 		{
@@ -0,0 +1,143 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/xeipuuv/gojsonschema"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/credentials"
+	"github.com/akuity/kargo/pkg/image"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const stepKindImageCopy = "image-copy"
+
+func init() {
+	promotion.DefaultStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name: stepKindImageCopy,
+			Metadata: promotion.StepRunnerMetadata{
+				RequiredCapabilities: []promotion.StepRunnerCapability{
+					promotion.StepCapabilityAccessCredentials,
+				},
+				SideEffecting: true,
+			},
+			Value: newImageCopier,
+		},
+	)
+}
+
+// imageCopier is an implementation of the promotion.StepRunner interface that
+// copies a container image from one repository to another.
+type imageCopier struct {
+	schemaLoader gojsonschema.JSONLoader
+	credsDB      credentials.Database
+}
+
+// newImageCopier returns an implementation of the promotion.StepRunner
+// interface that copies a container image from one repository to another. It
+// uses the provided credentials database to authenticate with both
+// repositories.
+func newImageCopier(caps promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &imageCopier{
+		credsDB:      caps.CredsDB,
+		schemaLoader: getConfigSchemaLoader(stepKindImageCopy),
+	}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (c *imageCopier) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := c.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return c.run(ctx, stepCtx, cfg)
+}
+
+// convert validates the imageCopier configuration against a JSON schema and
+// converts it into a builtin.ImageCopyConfig struct.
+func (c *imageCopier) convert(cfg promotion.Config) (builtin.ImageCopyConfig, error) {
+	return validateAndConvert[builtin.ImageCopyConfig](c.schemaLoader, cfg, stepKindImageCopy)
+}
+
+// run executes the imageCopier step with the provided configuration.
+func (c *imageCopier) run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.ImageCopyConfig,
+) (promotion.StepResult, error) {
+	srcRef := fmt.Sprintf("%s:%s", cfg.RepoURL, cfg.Tag)
+	if cfg.Digest != "" {
+		srcRef = fmt.Sprintf("%s@%s", cfg.RepoURL, cfg.Digest)
+	}
+	if _, err := name.ParseReference(srcRef); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed},
+			&promotion.TerminalError{
+				Err: fmt.Errorf("invalid image reference %q: %w", srcRef, err),
+			}
+	}
+	if _, err := name.NewRepository(cfg.TargetRepoURL); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed},
+			&promotion.TerminalError{
+				Err: fmt.Errorf("invalid target image repo URL %q: %w", cfg.TargetRepoURL, err),
+			}
+	}
+
+	srcCreds, err := c.getCredentials(ctx, stepCtx.Project, cfg.RepoURL)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+	targetCreds, err := c.getCredentials(ctx, stepCtx.Project, cfg.TargetRepoURL)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+
+	digest, err := image.Copy(ctx, srcRef, cfg.TargetRepoURL, &image.CopyOptions{
+		SourceCredentials:     srcCreds,
+		TargetCredentials:     targetCreds,
+		TargetTag:             cfg.TargetTag,
+		InsecureSkipTLSVerify: cfg.InsecureSkipTLSVerify,
+	})
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error copying image %q to %q: %w", srcRef, cfg.TargetRepoURL, err)
+	}
+
+	return promotion.StepResult{
+		Status: kargoapi.PromotionStepStatusSucceeded,
+		Output: map[string]any{
+			"tag":      cfg.TargetTag,
+			"digest":   digest,
+			"imageRef": fmt.Sprintf("%s@%s", cfg.TargetRepoURL, digest),
+		},
+	}, nil
+}
+
+// getCredentials obtains credentials for the given image repository, if any
+// exist.
+func (c *imageCopier) getCredentials(
+	ctx context.Context,
+	project string,
+	repoURL string,
+) (*image.Credentials, error) {
+	creds, err := c.credsDB.Get(ctx, project, credentials.TypeImage, repoURL)
+	if err != nil {
+		return nil, fmt.Errorf("error obtaining credentials for image repo %q: %w", repoURL, err)
+	}
+	if creds == nil {
+		return nil, nil
+	}
+	return &image.Credentials{
+		Username: creds.Username,
+		Password: creds.Password,
+	}, nil
+}
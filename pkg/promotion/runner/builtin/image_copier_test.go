@@ -0,0 +1,208 @@
+package builtin
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/credentials"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_imageCopier_convert(t *testing.T) {
+	tests := []validationTestCase{
+		{
+			name:   "repoURL not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): repoURL is required",
+			},
+		},
+		{
+			name:   "targetRepoURL not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): targetRepoURL is required",
+			},
+		},
+		{
+			name: "neither tag nor digest specified",
+			config: promotion.Config{
+				"repoURL":       "example.com/app",
+				"targetRepoURL": "prod.example.com/app",
+			},
+			expectedProblems: []string{
+				"(root): Must validate one and only one schema (oneOf)",
+			},
+		},
+		{
+			name: "both tag and digest specified",
+			config: promotion.Config{
+				"repoURL":       "example.com/app",
+				"tag":           "v1.0.0",
+				"digest":        "sha256:abc",
+				"targetRepoURL": "prod.example.com/app",
+			},
+			expectedProblems: []string{
+				"(root): Must validate one and only one schema (oneOf)",
+			},
+		},
+		{
+			name: "invalid targetTag",
+			config: promotion.Config{
+				"repoURL":       "example.com/app",
+				"tag":           "v1.0.0",
+				"targetRepoURL": "prod.example.com/app",
+				"targetTag":     "-invalid",
+			},
+			expectedProblems: []string{
+				"targetTag: Does not match pattern",
+			},
+		},
+		{
+			name: "valid with tag",
+			config: promotion.Config{
+				"repoURL":       "example.com/app",
+				"tag":           "v1.0.0",
+				"targetRepoURL": "prod.example.com/app",
+			},
+		},
+		{
+			name: "valid kitchen sink",
+			config: promotion.Config{
+				"repoURL":               "example.com/app",
+				"digest":                "sha256:abc",
+				"targetRepoURL":         "prod.example.com/app",
+				"targetTag":             "v1.0.0",
+				"insecureSkipTLSVerify": true,
+			},
+		},
+	}
+
+	r := newImageCopier(promotion.StepRunnerCapabilities{})
+	runner, ok := r.(*imageCopier)
+	require.True(t, ok)
+
+	runValidationTests(t, runner.convert, tests)
+}
+
+func Test_imageCopier_run(t *testing.T) {
+	srv := httptest.NewServer(registry.New())
+	t.Cleanup(srv.Close)
+	srvURL, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	registryHost := srvURL.Host
+
+	idx, err := random.Index(64, 1, 2)
+	require.NoError(t, err)
+	idxDigest, err := idx.Digest()
+	require.NoError(t, err)
+	srcRef, err := name.ParseReference(registryHost + "/dev/app:v1.0.0")
+	require.NoError(t, err)
+	require.NoError(t, remote.WriteIndex(srcRef, idx))
+
+	tests := []struct {
+		name       string
+		credsDB    credentials.Database
+		config     builtin.ImageCopyConfig
+		assertions func(*testing.T, promotion.StepResult, error)
+	}{
+		{
+			name:    "invalid target repo URL",
+			credsDB: &credentials.FakeDB{},
+			config: builtin.ImageCopyConfig{
+				RepoURL:       registryHost + "/dev/app",
+				Tag:           "v1.0.0",
+				TargetRepoURL: "Invalid Repo",
+			},
+			assertions: func(t *testing.T, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "invalid target image repo URL")
+				assert.True(t, promotion.IsTerminal(err))
+				assert.Equal(t, kargoapi.PromotionStepStatusFailed, result.Status)
+			},
+		},
+		{
+			name: "error obtaining credentials",
+			credsDB: &credentials.FakeDB{
+				GetFn: func(
+					context.Context,
+					string,
+					credentials.Type,
+					string,
+				) (*credentials.Credentials, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			config: builtin.ImageCopyConfig{
+				RepoURL:       registryHost + "/dev/app",
+				Tag:           "v1.0.0",
+				TargetRepoURL: registryHost + "/prod/app",
+			},
+			assertions: func(t *testing.T, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "error obtaining credentials")
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.Status)
+			},
+		},
+		{
+			name:    "source image not found",
+			credsDB: &credentials.FakeDB{},
+			config: builtin.ImageCopyConfig{
+				RepoURL:       registryHost + "/dev/app",
+				Tag:           "missing",
+				TargetRepoURL: registryHost + "/prod/app",
+			},
+			assertions: func(t *testing.T, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "error copying image")
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.Status)
+			},
+		},
+		{
+			name:    "copies image by digest and adds tag",
+			credsDB: &credentials.FakeDB{},
+			config: builtin.ImageCopyConfig{
+				RepoURL:       registryHost + "/dev/app",
+				Digest:        idxDigest.String(),
+				TargetRepoURL: registryHost + "/prod/app",
+				TargetTag:     "v1.0.0",
+			},
+			assertions: func(t *testing.T, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.Status)
+				assert.Equal(t, map[string]any{
+					"tag":      "v1.0.0",
+					"digest":   idxDigest.String(),
+					"imageRef": registryHost + "/prod/app@" + idxDigest.String(),
+				}, result.Output)
+
+				ref, err := name.ParseReference(registryHost + "/prod/app:v1.0.0")
+				require.NoError(t, err)
+				desc, err := remote.Head(ref)
+				require.NoError(t, err)
+				assert.Equal(t, idxDigest, desc.Digest)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := &imageCopier{credsDB: tt.credsDB}
+			result, err := runner.run(
+				context.Background(),
+				&promotion.StepContext{Project: "test-project"},
+				tt.config,
+			)
+			tt.assertions(t, result, err)
+		})
+	}
+}
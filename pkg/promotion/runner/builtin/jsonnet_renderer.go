@@ -0,0 +1,182 @@
+package builtin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/google/go-jsonnet"
+	"github.com/xeipuuv/gojsonschema"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/io/fs"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const stepKindJsonnetRender = "jsonnet-render"
+
+func init() {
+	promotion.DefaultStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name:  stepKindJsonnetRender,
+			Value: newJsonnetRenderer,
+		},
+	)
+}
+
+// jsonnetRenderer is an implementation of the promotion.StepRunner interface
+// that renders a Jsonnet file.
+type jsonnetRenderer struct {
+	schemaLoader gojsonschema.JSONLoader
+}
+
+// newJsonnetRenderer returns an implementation of the promotion.StepRunner
+// interface that renders a Jsonnet file.
+func newJsonnetRenderer(promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &jsonnetRenderer{
+		schemaLoader: getConfigSchemaLoader(stepKindJsonnetRender),
+	}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (j *jsonnetRenderer) Run(
+	_ context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := j.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return j.run(stepCtx, cfg)
+}
+
+// convert validates jsonnetRenderer configuration against a JSON schema and
+// converts it into a builtin.JsonnetRenderConfig struct.
+func (j *jsonnetRenderer) convert(cfg promotion.Config) (builtin.JsonnetRenderConfig, error) {
+	return validateAndConvert[builtin.JsonnetRenderConfig](j.schemaLoader, cfg, stepKindJsonnetRender)
+}
+
+func (j *jsonnetRenderer) run(
+	stepCtx *promotion.StepContext,
+	cfg builtin.JsonnetRenderConfig,
+) (promotion.StepResult, error) {
+	vm := jsonnet.MakeVM()
+	vm.Importer(&workDirImporter{
+		workDir: stepCtx.WorkDir,
+		jPaths:  cfg.JPaths,
+	})
+	for _, v := range cfg.ExtVars {
+		if v.Code {
+			vm.ExtCode(v.Name, v.Value)
+		} else {
+			vm.ExtVar(v.Name, v.Value)
+		}
+	}
+	for _, v := range cfg.TLAs {
+		if v.Code {
+			vm.TLACode(v.Name, v.Value)
+		} else {
+			vm.TLAVar(v.Name, v.Value)
+		}
+	}
+
+	// Errors in Jsonnet code will not resolve themselves on retry.
+	out, err := vm.EvaluateFile(cfg.Path)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed},
+			&promotion.TerminalError{
+				Err: fmt.Errorf("error rendering %q: %w", cfg.Path, err),
+			}
+	}
+
+	outPath, err := securejoin.SecureJoin(stepCtx.WorkDir, cfg.OutPath)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("failed to join path %q: %w", cfg.OutPath, err)
+	}
+	if err = writeRenderedJSON(outPath, []byte(out)); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, fmt.Errorf(
+			"failed to write rendered output to %q: %w", cfg.OutPath,
+			fs.SanitizePathError(err, stepCtx.WorkDir),
+		)
+	}
+	return promotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded}, nil
+}
+
+// workDirImporter is an implementation of the jsonnet.Importer interface that
+// only imports files from within a working directory. Paths are resolved, and
+// reported, relative to the working directory. Absolute paths are treated as
+// relative to the root of the working directory.
+type workDirImporter struct {
+	workDir string
+	jPaths  []string
+	cache   map[string]*jsonnet.Contents
+}
+
+// Import implements the jsonnet.Importer interface.
+func (w *workDirImporter) Import(
+	importedFrom string,
+	importedPath string,
+) (jsonnet.Contents, string, error) {
+	candidates := make([]string, 0, len(w.jPaths)+1)
+	if filepath.IsAbs(importedPath) {
+		candidates = append(candidates, importedPath)
+	} else {
+		candidates = append(candidates, filepath.Join(filepath.Dir(importedFrom), importedPath))
+		// As with the jsonnet CLI, the right-most library path wins.
+		for i := len(w.jPaths) - 1; i >= 0; i-- {
+			candidates = append(candidates, filepath.Join(w.jPaths[i], importedPath))
+		}
+	}
+	for _, candidate := range candidates {
+		found, contents, foundAt, err := w.tryPath(candidate)
+		if err != nil {
+			return jsonnet.Contents{}, "", err
+		}
+		if found {
+			return contents, foundAt, nil
+		}
+	}
+	return jsonnet.Contents{}, "", fmt.Errorf(
+		"couldn't open import %q: no match locally or in library paths",
+		importedPath,
+	)
+}
+
+// tryPath attempts to read the file at the provided path, relative to the
+// working directory.
+func (w *workDirImporter) tryPath(path string) (bool, jsonnet.Contents, string, error) {
+	relPath := strings.TrimPrefix(filepath.Clean("/"+path), "/")
+	if w.cache == nil {
+		w.cache = map[string]*jsonnet.Contents{}
+	}
+	if contents, ok := w.cache[relPath]; ok {
+		// A nil entry records that the file does not exist.
+		if contents == nil {
+			return false, jsonnet.Contents{}, "", nil
+		}
+		return true, *contents, relPath, nil
+	}
+	absPath, err := securejoin.SecureJoin(w.workDir, relPath)
+	if err != nil {
+		return false, jsonnet.Contents{}, "", fmt.Errorf("failed to join path %q: %w", relPath, err)
+	}
+	data, err := os.ReadFile(absPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			w.cache[relPath] = nil
+			return false, jsonnet.Contents{}, "", nil
+		}
+		return false, jsonnet.Contents{}, "", fs.SanitizePathError(err, w.workDir)
+	}
+	contents := jsonnet.MakeContentsRaw(data)
+	w.cache[relPath] = &contents
+	return true, contents, relPath, nil
+}
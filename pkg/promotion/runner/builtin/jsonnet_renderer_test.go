@@ -0,0 +1,234 @@
+package builtin
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_jsonnetRenderer_convert(t *testing.T) {
+	tests := []validationTestCase{
+		{
+			name:   "path not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): path is required",
+			},
+		},
+		{
+			name: "path is empty string",
+			config: promotion.Config{
+				"path": "",
+			},
+			expectedProblems: []string{
+				"path: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name:   "outPath not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): outPath is required",
+			},
+		},
+		{
+			name: "jPath is empty string",
+			config: promotion.Config{
+				"path":    "main.jsonnet",
+				"outPath": "out.yaml",
+				"jPaths":  []string{""},
+			},
+			expectedProblems: []string{
+				"jPaths.0: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name: "extVar name not specified",
+			config: promotion.Config{
+				"path":    "main.jsonnet",
+				"outPath": "out.yaml",
+				"extVars": []promotion.Config{{
+					"value": "bar",
+				}},
+			},
+			expectedProblems: []string{
+				"extVars.0: name is required",
+			},
+		},
+		{
+			name: "tla value not specified",
+			config: promotion.Config{
+				"path":    "main.jsonnet",
+				"outPath": "out.yaml",
+				"tlas": []promotion.Config{{
+					"name": "foo",
+				}},
+			},
+			expectedProblems: []string{
+				"tlas.0: value is required",
+			},
+		},
+		{
+			name: "valid kitchen sink",
+			config: promotion.Config{
+				"path":    "main.jsonnet",
+				"outPath": "out.yaml",
+				"jPaths":  []string{"lib", "vendor"},
+				"extVars": []promotion.Config{
+					{"name": "env", "value": "prod"},
+					{"name": "replicas", "value": "3", "code": true},
+				},
+				"tlas": []promotion.Config{
+					{"name": "image", "value": "nginx:1.27"},
+				},
+			},
+		},
+	}
+
+	r := newJsonnetRenderer(promotion.StepRunnerCapabilities{})
+	runner, ok := r.(*jsonnetRenderer)
+	require.True(t, ok)
+
+	runValidationTests(t, runner.convert, tests)
+}
+
+func Test_jsonnetRenderer_run(t *testing.T) {
+	tests := []struct {
+		name       string
+		files      map[string]string
+		config     builtin.JsonnetRenderConfig
+		assertions func(*testing.T, string, promotion.StepResult, error)
+	}{
+		{
+			name: "renders with library paths and variables",
+			files: map[string]string{
+				"lib/app.libsonnet": `{
+  deployment(name, replicas): {
+    apiVersion: 'apps/v1',
+    kind: 'Deployment',
+    metadata: { name: name },
+    spec: { replicas: replicas },
+  },
+}`,
+				// Shadowed by the same file in a later library path
+				"lib/version.libsonnet":    `'v1'`,
+				"vendor/version.libsonnet": `'v2'`,
+				"env/main.jsonnet": `local app = import 'app.libsonnet';
+local version = import 'version.libsonnet';
+function(image) [
+  app.deployment(std.extVar('name'), std.extVar('replicas')) + {
+    metadata+: { labels: { version: version, image: image } },
+  },
+]`,
+			},
+			config: builtin.JsonnetRenderConfig{
+				Path:    "env/main.jsonnet",
+				OutPath: "out/manifests.yaml",
+				JPaths:  []string{"lib", "vendor"},
+				ExtVars: []builtin.JsonnetVar{
+					{Name: "name", Value: "my-app"},
+					{Name: "replicas", Value: "3", Code: true},
+				},
+				TLAs: []builtin.JsonnetVar{
+					{Name: "image", Value: "nginx:1.27"},
+				},
+			},
+			assertions: func(t *testing.T, dir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.Status)
+				b, err := os.ReadFile(filepath.Join(dir, "out", "manifests.yaml"))
+				require.NoError(t, err)
+				assert.Equal(t, `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  labels:
+    image: nginx:1.27
+    version: v2
+  name: my-app
+spec:
+  replicas: 3
+`, string(b))
+			},
+		},
+		{
+			name: "renders JSON",
+			files: map[string]string{
+				"main.jsonnet": `{ foo: 'bar' }`,
+			},
+			config: builtin.JsonnetRenderConfig{
+				Path:    "main.jsonnet",
+				OutPath: "out.json",
+			},
+			assertions: func(t *testing.T, dir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.Status)
+				b, err := os.ReadFile(filepath.Join(dir, "out.json"))
+				require.NoError(t, err)
+				assert.Equal(t, "{\n  \"foo\": \"bar\"\n}\n", string(b))
+			},
+		},
+		{
+			name: "imports cannot escape working directory",
+			files: map[string]string{
+				"main.jsonnet": `import '../../../../../../../etc/passwd'`,
+			},
+			config: builtin.JsonnetRenderConfig{
+				Path:    "main.jsonnet",
+				OutPath: "out.yaml",
+			},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "couldn't open import")
+				assert.True(t, promotion.IsTerminal(err))
+				assert.Equal(t, kargoapi.PromotionStepStatusFailed, result.Status)
+			},
+		},
+		{
+			name: "evaluation error",
+			files: map[string]string{
+				"main.jsonnet": `error 'boom'`,
+			},
+			config: builtin.JsonnetRenderConfig{
+				Path:    "main.jsonnet",
+				OutPath: "out.yaml",
+			},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "boom")
+				assert.True(t, promotion.IsTerminal(err))
+				assert.Equal(t, kargoapi.PromotionStepStatusFailed, result.Status)
+			},
+		},
+		{
+			name: "file does not exist",
+			config: builtin.JsonnetRenderConfig{
+				Path:    "main.jsonnet",
+				OutPath: "out.yaml",
+			},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "error rendering")
+				assert.Equal(t, kargoapi.PromotionStepStatusFailed, result.Status)
+			},
+		},
+	}
+
+	runner := &jsonnetRenderer{}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tt.files {
+				path := filepath.Join(dir, name)
+				require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+				require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+			}
+			result, err := runner.run(&promotion.StepContext{WorkDir: dir}, tt.config)
+			tt.assertions(t, dir, result, err)
+		})
+	}
+}
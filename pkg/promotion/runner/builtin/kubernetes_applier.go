@@ -0,0 +1,536 @@
+package builtin
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/xeipuuv/gojsonschema"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cli-utils/pkg/kstatus/status"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/logging"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const (
+	stepKindKubernetesApply = "kubernetes-apply"
+
+	kubernetesApplyDefaultFieldManager  = "kargo"
+	kubernetesApplyDefaultKubeconfigKey = "kubeconfig"
+)
+
+func init() {
+	promotion.DefaultStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name: stepKindKubernetesApply,
+			Metadata: promotion.StepRunnerMetadata{
+				RequiredCapabilities: []promotion.StepRunnerCapability{
+					promotion.StepCapabilityAccessControlPlane,
+				},
+				SideEffecting: true,
+			},
+			Value: newKubernetesApplier,
+		},
+	)
+}
+
+// kubernetesApplier is an implementation of the promotion.StepRunner interface
+// that server-side applies manifests to a Kubernetes cluster.
+type kubernetesApplier struct {
+	schemaLoader gojsonschema.JSONLoader
+	kargoClient  client.Client
+	newClientFn  func(*rest.Config) (client.Client, error)
+}
+
+// newKubernetesApplier returns an implementation of the promotion.StepRunner
+// interface that server-side applies manifests to a Kubernetes cluster.
+func newKubernetesApplier(caps promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &kubernetesApplier{
+		schemaLoader: getConfigSchemaLoader(stepKindKubernetesApply),
+		kargoClient:  caps.KargoClient,
+		newClientFn: func(cfg *rest.Config) (client.Client, error) {
+			return client.New(cfg, client.Options{})
+		},
+	}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (k *kubernetesApplier) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := k.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return k.run(ctx, stepCtx, cfg)
+}
+
+// convert validates kubernetesApplier configuration against a JSON schema and
+// converts it into a builtin.KubernetesApplyConfig struct.
+func (k *kubernetesApplier) convert(cfg promotion.Config) (builtin.KubernetesApplyConfig, error) {
+	return validateAndConvert[builtin.KubernetesApplyConfig](k.schemaLoader, cfg, stepKindKubernetesApply)
+}
+
+func (k *kubernetesApplier) run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.KubernetesApplyConfig,
+) (promotion.StepResult, error) {
+	if k.kargoClient == nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed},
+			&promotion.TerminalError{
+				Err: fmt.Errorf("no Kubernetes client is available to the %s step", stepKindKubernetesApply),
+			}
+	}
+
+	objs, err := loadKubernetesManifests(stepCtx.WorkDir, cfg)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed},
+			&promotion.TerminalError{Err: err}
+	}
+	if len(objs) == 0 {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed},
+			&promotion.TerminalError{Err: errors.New("no manifests found to apply")}
+	}
+
+	targetClient, defaultNamespace, err := k.getTargetClient(ctx, stepCtx.Project, cfg.KubeconfigSecret)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+	if cfg.Namespace != "" {
+		defaultNamespace = cfg.Namespace
+	}
+
+	if err = k.apply(ctx, targetClient, objs, defaultNamespace, cfg); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+
+	if cfg.Prune != nil {
+		if err = k.prune(ctx, targetClient, objs, cfg.Prune.Labels); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+		}
+	}
+
+	if !cfg.Wait {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded}, nil
+	}
+	return k.checkReadiness(ctx, targetClient, objs)
+}
+
+// getTargetClient returns a client for the cluster described by the kubeconfig
+// held by the referenced Secret in the Project namespace, along with the
+// namespace of the kubeconfig's current context.
+func (k *kubernetesApplier) getTargetClient(
+	ctx context.Context,
+	project string,
+	ref builtin.KubeconfigSecretRef,
+) (client.Client, string, error) {
+	secret := &corev1.Secret{}
+	if err := k.kargoClient.Get(
+		ctx,
+		client.ObjectKey{Namespace: project, Name: ref.Name},
+		secret,
+	); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, "", fmt.Errorf(
+				"unable to find Secret %q in namespace %q", ref.Name, project,
+			)
+		}
+		return nil, "", fmt.Errorf(
+			"error getting Secret %q in namespace %q: %w", ref.Name, project, err,
+		)
+	}
+	key := ref.Key
+	if key == "" {
+		key = kubernetesApplyDefaultKubeconfigKey
+	}
+	data, ok := secret.Data[key]
+	if !ok {
+		return nil, "", fmt.Errorf("no key %q found in Secret %q", key, ref.Name)
+	}
+
+	kubeconfig, err := clientcmd.Load(data)
+	if err != nil {
+		return nil, "", fmt.Errorf("error loading kubeconfig from Secret %q: %w", ref.Name, err)
+	}
+	if err = validateKubeconfig(kubeconfig); err != nil {
+		return nil, "", fmt.Errorf("kubeconfig in Secret %q is not permitted: %w", ref.Name, err)
+	}
+	clientCfg := clientcmd.NewDefaultClientConfig(*kubeconfig, &clientcmd.ConfigOverrides{})
+	restCfg, err := clientCfg.ClientConfig()
+	if err != nil {
+		return nil, "", fmt.Errorf("error building client configuration from Secret %q: %w", ref.Name, err)
+	}
+	namespace, _, err := clientCfg.Namespace()
+	if err != nil {
+		return nil, "", fmt.Errorf("error determining namespace from kubeconfig: %w", err)
+	}
+	c, err := k.newClientFn(restCfg)
+	if err != nil {
+		return nil, "", fmt.Errorf("error creating client for target cluster: %w", err)
+	}
+	return c, namespace, nil
+}
+
+// validateKubeconfig returns an error if the provided kubeconfig references
+// files or executes commands. Either would be resolved against the file system
+// of, or executed by, the controller rather than on behalf of the user.
+func validateKubeconfig(cfg *clientcmdapi.Config) error {
+	for name, cluster := range cfg.Clusters {
+		if cluster.CertificateAuthority != "" {
+			return fmt.Errorf("cluster %q references a certificate authority file", name)
+		}
+	}
+	for name, authInfo := range cfg.AuthInfos {
+		switch {
+		case authInfo.Exec != nil:
+			return fmt.Errorf("user %q uses an exec credential plugin", name)
+		case authInfo.AuthProvider != nil:
+			return fmt.Errorf("user %q uses an auth provider", name)
+		case authInfo.ClientCertificate != "" || authInfo.ClientKey != "":
+			return fmt.Errorf("user %q references a client certificate or key file", name)
+		case authInfo.TokenFile != "":
+			return fmt.Errorf("user %q references a token file", name)
+		}
+	}
+	return nil
+}
+
+// apply server-side applies the provided objects in order. Namespaced objects
+// that do not specify a namespace are applied to the provided namespace.
+func (k *kubernetesApplier) apply(
+	ctx context.Context,
+	c client.Client,
+	objs []*unstructured.Unstructured,
+	namespace string,
+	cfg builtin.KubernetesApplyConfig,
+) error {
+	fieldManager := cfg.FieldManager
+	if fieldManager == "" {
+		fieldManager = kubernetesApplyDefaultFieldManager
+	}
+	opts := []client.ApplyOption{client.FieldOwner(fieldManager)}
+	if cfg.Force {
+		opts = append(opts, client.ForceOwnership)
+	}
+
+	logger := logging.LoggerFromContext(ctx)
+	for _, obj := range objs {
+		if cfg.Prune != nil {
+			labels := obj.GetLabels()
+			if labels == nil {
+				labels = make(map[string]string, len(cfg.Prune.Labels))
+			}
+			maps.Copy(labels, cfg.Prune.Labels)
+			obj.SetLabels(labels)
+		}
+		// Whether a kind is namespaced is determined immediately before applying
+		// an object of that kind, because its definition may have been applied
+		// earlier in the same step.
+		namespaced, err := c.IsObjectNamespaced(obj)
+		if err != nil {
+			return fmt.Errorf(
+				"error determining scope of %s %q: %w", obj.GetKind(), obj.GetName(), err,
+			)
+		}
+		switch {
+		case !namespaced:
+			obj.SetNamespace("")
+		case obj.GetNamespace() == "":
+			obj.SetNamespace(namespace)
+		}
+		if err = c.Apply(ctx, client.ApplyConfigurationFromUnstructured(obj), opts...); err != nil {
+			return fmt.Errorf(
+				"error applying %s %q: %w", obj.GetKind(), kubernetesObjectName(obj), err,
+			)
+		}
+		logger.Debug(
+			"applied resource",
+			"kind", obj.GetKind(),
+			"namespace", obj.GetNamespace(),
+			"name", obj.GetName(),
+		)
+	}
+	return nil
+}
+
+// prune deletes resources carrying all of the provided labels that are not
+// among the provided objects. Only resources of the same kinds, and in the
+// same namespaces, as the provided objects are considered.
+func (k *kubernetesApplier) prune(
+	ctx context.Context,
+	c client.Client,
+	objs []*unstructured.Unstructured,
+	labels map[string]string,
+) error {
+	type scope struct {
+		gvk       schema.GroupVersionKind
+		namespace string
+	}
+	type key struct {
+		scope
+		name string
+	}
+	var scopes []scope
+	applied := make(map[key]struct{}, len(objs))
+	for _, obj := range objs {
+		s := scope{gvk: obj.GroupVersionKind(), namespace: obj.GetNamespace()}
+		if !slices.Contains(scopes, s) {
+			scopes = append(scopes, s)
+		}
+		applied[key{scope: s, name: obj.GetName()}] = struct{}{}
+	}
+
+	logger := logging.LoggerFromContext(ctx)
+	for _, s := range scopes {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(s.gvk.GroupVersion().WithKind(s.gvk.Kind + "List"))
+		opts := []client.ListOption{client.MatchingLabels(labels)}
+		if s.namespace != "" {
+			opts = append(opts, client.InNamespace(s.namespace))
+		}
+		if err := c.List(ctx, list, opts...); err != nil {
+			return fmt.Errorf("error listing %s resources to prune: %w", s.gvk.Kind, err)
+		}
+		for i := range list.Items {
+			obj := &list.Items[i]
+			if _, ok := applied[key{scope: s, name: obj.GetName()}]; ok ||
+				obj.GetDeletionTimestamp() != nil {
+				continue
+			}
+			if err := client.IgnoreNotFound(c.Delete(
+				ctx,
+				obj,
+				client.PropagationPolicy(metav1.DeletePropagationBackground),
+			)); err != nil {
+				return fmt.Errorf(
+					"error pruning %s %q: %w", s.gvk.Kind, kubernetesObjectName(obj), err,
+				)
+			}
+			logger.Debug(
+				"pruned resource",
+				"kind", s.gvk.Kind,
+				"namespace", obj.GetNamespace(),
+				"name", obj.GetName(),
+			)
+		}
+	}
+	return nil
+}
+
+// checkReadiness assesses whether all of the provided objects are ready. The
+// step is considered to be running until they are, and to have failed if any
+// of them have failed.
+func (k *kubernetesApplier) checkReadiness(
+	ctx context.Context,
+	c client.Client,
+	objs []*unstructured.Unstructured,
+) (promotion.StepResult, error) {
+	var pending []string
+	for _, obj := range objs {
+		live := &unstructured.Unstructured{}
+		live.SetGroupVersionKind(obj.GroupVersionKind())
+		if err := c.Get(ctx, client.ObjectKeyFromObject(obj), live); err != nil {
+			if apierrors.IsNotFound(err) {
+				pending = append(pending, kubernetesObjectRef(obj))
+				continue
+			}
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("error getting %s %q: %w", obj.GetKind(), kubernetesObjectName(obj), err)
+		}
+		res, err := status.Compute(live)
+		if err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf(
+					"error computing status of %s %q: %w", obj.GetKind(), kubernetesObjectName(obj), err,
+				)
+		}
+		switch res.Status {
+		case status.CurrentStatus:
+		case status.FailedStatus:
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed},
+				&promotion.TerminalError{
+					Err: fmt.Errorf("%s has failed: %s", kubernetesObjectRef(obj), res.Message),
+				}
+		default:
+			pending = append(pending, kubernetesObjectRef(obj))
+		}
+	}
+
+	if len(pending) > 0 {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusRunning,
+			Message: fmt.Sprintf(
+				"Waiting for resources to become ready: %s", strings.Join(pending, ", "),
+			),
+			RetryAfter: ptr.To(10 * time.Second),
+		}, nil
+	}
+	return promotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded}, nil
+}
+
+// loadKubernetesManifests loads the objects described by the manifests in the
+// provided configuration. Namespaces and CustomResourceDefinitions are ordered
+// ahead of all other objects, so that the objects that depend on them can be
+// applied in the same step.
+func loadKubernetesManifests(
+	workDir string,
+	cfg builtin.KubernetesApplyConfig,
+) ([]*unstructured.Unstructured, error) {
+	var objs []*unstructured.Unstructured
+	if cfg.Path == "" {
+		var err error
+		if objs, err = decodeKubernetesManifests([]byte(cfg.Manifests)); err != nil {
+			return nil, err
+		}
+	} else if err := walkKubernetesManifests(workDir, cfg.Path, func(fileObjs []*unstructured.Unstructured) {
+		objs = append(objs, fileObjs...)
+	}); err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(objs, func(a, b *unstructured.Unstructured) int {
+		return kubernetesApplyPriority(a) - kubernetesApplyPriority(b)
+	})
+	return objs, nil
+}
+
+// walkKubernetesManifests decodes the manifests in the file or directory at the
+// provided path, relative to the provided working directory, and passes the
+// objects decoded from each file to the provided function.
+func walkKubernetesManifests(
+	workDir string,
+	path string,
+	fn func([]*unstructured.Unstructured),
+) error {
+	absPath, err := securejoin.SecureJoin(workDir, path)
+	if err != nil {
+		return fmt.Errorf("error joining path %q: %w", path, err)
+	}
+	return filepath.WalkDir(absPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		// Files within a directory are only considered if they have a manifest
+		// extension, but a file that was explicitly specified is always loaded.
+		if path != absPath {
+			switch strings.ToLower(filepath.Ext(path)) {
+			case ".yaml", ".yml", ".json":
+			default:
+				return nil
+			}
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("error reading manifest file %q: %w", path, err)
+		}
+		fileObjs, err := decodeKubernetesManifests(data)
+		if err != nil {
+			relPath, _ := filepath.Rel(workDir, path)
+			return fmt.Errorf("error loading manifests from %q: %w", relPath, err)
+		}
+		fn(fileObjs)
+		return nil
+	})
+}
+
+// kubernetesApplyPriority returns the relative order in which an object should
+// be applied. Lower values are applied first.
+func kubernetesApplyPriority(obj *unstructured.Unstructured) int {
+	switch obj.GroupVersionKind().GroupKind() {
+	case schema.GroupKind{Kind: "Namespace"},
+		schema.GroupKind{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// decodeKubernetesManifests decodes the objects described by one or more YAML
+// or JSON documents. Lists are flattened into the objects they contain.
+func decodeKubernetesManifests(data []byte) ([]*unstructured.Unstructured, error) {
+	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+	var objs []*unstructured.Unstructured
+	for {
+		ext := runtime.RawExtension{}
+		if err := decoder.Decode(&ext); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("error decoding manifest: %w", err)
+		}
+		ext.Raw = bytes.TrimSpace(ext.Raw)
+		if len(ext.Raw) == 0 || bytes.Equal(ext.Raw, []byte("null")) {
+			continue
+		}
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(ext.Raw); err != nil {
+			return nil, fmt.Errorf("error unmarshaling manifest: %w", err)
+		}
+		if obj.IsList() {
+			if err := obj.EachListItem(func(item runtime.Object) error {
+				u, ok := item.(*unstructured.Unstructured)
+				if !ok {
+					return fmt.Errorf("unexpected list item type %T", item)
+				}
+				objs = append(objs, u)
+				return nil
+			}); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		objs = append(objs, obj)
+	}
+	for _, obj := range objs {
+		if obj.GetName() == "" {
+			return nil, fmt.Errorf("%s manifest does not specify a name", obj.GetKind())
+		}
+	}
+	return objs, nil
+}
+
+// kubernetesObjectName returns the name of the provided object, qualified by
+// its namespace if it has one.
+func kubernetesObjectName(obj client.Object) string {
+	if obj.GetNamespace() == "" {
+		return obj.GetName()
+	}
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
+
+// kubernetesObjectRef returns a human-readable reference to the provided
+// object, including its kind.
+func kubernetesObjectRef(obj *unstructured.Unstructured) string {
+	return obj.GetKind() + " " + kubernetesObjectName(obj)
+}
@@ -0,0 +1,630 @@
+package builtin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_kubernetesApplier_convert(t *testing.T) {
+	tests := []validationTestCase{
+		{
+			name:   "kubeconfigSecret not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): kubeconfigSecret is required",
+			},
+		},
+		{
+			name: "neither path nor manifests specified",
+			config: promotion.Config{
+				"kubeconfigSecret": promotion.Config{"name": "fake-secret"},
+			},
+			expectedProblems: []string{
+				"(root): Must validate one and only one schema (oneOf)",
+			},
+		},
+		{
+			name: "both path and manifests specified",
+			config: promotion.Config{
+				"kubeconfigSecret": promotion.Config{"name": "fake-secret"},
+				"path":             "fake-path",
+				"manifests":        "fake-manifests",
+			},
+			expectedProblems: []string{
+				"(root): Must validate one and only one schema (oneOf)",
+			},
+		},
+		{
+			name: "kubeconfigSecret name not specified",
+			config: promotion.Config{
+				"kubeconfigSecret": promotion.Config{},
+				"path":             "fake-path",
+			},
+			expectedProblems: []string{
+				"kubeconfigSecret: name is required",
+			},
+		},
+		{
+			name: "prune labels not specified",
+			config: promotion.Config{
+				"kubeconfigSecret": promotion.Config{"name": "fake-secret"},
+				"path":             "fake-path",
+				"prune":            promotion.Config{},
+			},
+			expectedProblems: []string{
+				"prune: labels is required",
+			},
+		},
+		{
+			name: "prune labels empty",
+			config: promotion.Config{
+				"kubeconfigSecret": promotion.Config{"name": "fake-secret"},
+				"path":             "fake-path",
+				"prune": promotion.Config{
+					"labels": promotion.Config{},
+				},
+			},
+			expectedProblems: []string{
+				"prune.labels: Must have at least 1 properties",
+			},
+		},
+		{
+			name: "valid kitchen sink",
+			config: promotion.Config{
+				"kubeconfigSecret": promotion.Config{
+					"name": "fake-secret",
+					"key":  "fake-key",
+				},
+				"path":         "fake-path",
+				"namespace":    "fake-namespace",
+				"fieldManager": "fake-manager",
+				"force":        true,
+				"prune": promotion.Config{
+					"labels": promotion.Config{"app": "fake-app"},
+				},
+				"wait": true,
+			},
+		},
+	}
+
+	r := newKubernetesApplier(promotion.StepRunnerCapabilities{})
+	runner, ok := r.(*kubernetesApplier)
+	require.True(t, ok)
+
+	runValidationTests(t, runner.convert, tests)
+}
+
+func Test_kubernetesApplier_run(t *testing.T) {
+	const testProject = "fake-project"
+
+	const testKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: target
+  cluster:
+    server: https://target.example.com
+contexts:
+- name: target
+  context:
+    cluster: target
+    user: target
+    namespace: from-kubeconfig
+current-context: target
+users:
+- name: target
+  user:
+    token: fake-token
+`
+
+	const testManifests = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: app-config
+data:
+  key: value
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: app
+`
+
+	kubeconfigSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testProject,
+			Name:      "target-cluster",
+		},
+		Data: map[string][]byte{
+			"kubeconfig": []byte(testKubeconfig),
+		},
+	}
+
+	tests := []struct {
+		name          string
+		cfg           builtin.KubernetesApplyConfig
+		files         map[string]string
+		kargoObjects  []client.Object
+		targetObjects []client.Object
+		assertions    func(*testing.T, client.Client, promotion.StepResult, error)
+	}{
+		{
+			name: "Secret not found",
+			cfg: builtin.KubernetesApplyConfig{
+				KubeconfigSecret: builtin.KubeconfigSecretRef{Name: "missing"},
+				Manifests:        testManifests,
+			},
+			assertions: func(t *testing.T, _ client.Client, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, `unable to find Secret "missing"`)
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "Secret key not found",
+			cfg: builtin.KubernetesApplyConfig{
+				KubeconfigSecret: builtin.KubeconfigSecretRef{
+					Name: "target-cluster",
+					Key:  "missing",
+				},
+				Manifests: testManifests,
+			},
+			kargoObjects: []client.Object{kubeconfigSecret},
+			assertions: func(t *testing.T, _ client.Client, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, `no key "missing" found in Secret`)
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "invalid manifests",
+			cfg: builtin.KubernetesApplyConfig{
+				KubeconfigSecret: builtin.KubeconfigSecretRef{Name: "target-cluster"},
+				Manifests:        "apiVersion: v1\nkind: ConfigMap\n",
+			},
+			kargoObjects: []client.Object{kubeconfigSecret},
+			assertions: func(t *testing.T, _ client.Client, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "ConfigMap manifest does not specify a name")
+				require.True(t, promotion.IsTerminal(err))
+				assert.Equal(t, kargoapi.PromotionStepStatusFailed, res.Status)
+			},
+		},
+		{
+			name: "no manifests found",
+			cfg: builtin.KubernetesApplyConfig{
+				KubeconfigSecret: builtin.KubeconfigSecretRef{Name: "target-cluster"},
+				Path:             "manifests",
+			},
+			files: map[string]string{
+				"manifests/README.md": "Not a manifest",
+			},
+			kargoObjects: []client.Object{kubeconfigSecret},
+			assertions: func(t *testing.T, _ client.Client, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "no manifests found to apply")
+				require.True(t, promotion.IsTerminal(err))
+				assert.Equal(t, kargoapi.PromotionStepStatusFailed, res.Status)
+			},
+		},
+		{
+			name: "applies inline manifests",
+			cfg: builtin.KubernetesApplyConfig{
+				KubeconfigSecret: builtin.KubeconfigSecretRef{Name: "target-cluster"},
+				Manifests:        testManifests,
+			},
+			kargoObjects: []client.Object{kubeconfigSecret},
+			assertions: func(t *testing.T, c client.Client, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+
+				ns := &corev1.Namespace{}
+				require.NoError(t, c.Get(t.Context(), client.ObjectKey{Name: "app"}, ns))
+
+				// The namespace of the kubeconfig's current context is used
+				// when a manifest does not specify one.
+				cm := &corev1.ConfigMap{}
+				require.NoError(t, c.Get(
+					t.Context(),
+					client.ObjectKey{Namespace: "from-kubeconfig", Name: "app-config"},
+					cm,
+				))
+				assert.Equal(t, map[string]string{"key": "value"}, cm.Data)
+			},
+		},
+		{
+			name: "applies manifests from directory to namespace",
+			cfg: builtin.KubernetesApplyConfig{
+				KubeconfigSecret: builtin.KubeconfigSecretRef{Name: "target-cluster"},
+				Path:             "manifests",
+				Namespace:        "app",
+			},
+			files: map[string]string{
+				"manifests/config.yaml": testManifests,
+				"manifests/nested/deployment.json": `{
+  "apiVersion": "apps/v1",
+  "kind": "Deployment",
+  "metadata": {"name": "app", "namespace": "other"},
+  "spec": {
+    "selector": {"matchLabels": {"app": "app"}},
+    "template": {
+      "metadata": {"labels": {"app": "app"}},
+      "spec": {"containers": [{"name": "app", "image": "app:v1"}]}
+    }
+  }
+}`,
+			},
+			kargoObjects: []client.Object{kubeconfigSecret},
+			assertions: func(t *testing.T, c client.Client, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+
+				cm := &corev1.ConfigMap{}
+				require.NoError(t, c.Get(
+					t.Context(),
+					client.ObjectKey{Namespace: "app", Name: "app-config"},
+					cm,
+				))
+				deploy := &appsv1.Deployment{}
+				require.NoError(t, c.Get(
+					t.Context(),
+					client.ObjectKey{Namespace: "other", Name: "app"},
+					deploy,
+				))
+				assert.Equal(t, "app:v1", deploy.Spec.Template.Spec.Containers[0].Image)
+			},
+		},
+		{
+			name: "updates existing resource",
+			cfg: builtin.KubernetesApplyConfig{
+				KubeconfigSecret: builtin.KubeconfigSecretRef{Name: "target-cluster"},
+				Manifests:        testManifests,
+				Namespace:        "app",
+				FieldManager:     "fake-manager",
+				Force:            true,
+			},
+			kargoObjects: []client.Object{kubeconfigSecret},
+			targetObjects: []client.Object{
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "app-config"},
+					Data:       map[string]string{"key": "old-value"},
+				},
+			},
+			assertions: func(t *testing.T, c client.Client, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+
+				cm := &corev1.ConfigMap{}
+				require.NoError(t, c.Get(
+					t.Context(),
+					client.ObjectKey{Namespace: "app", Name: "app-config"},
+					cm,
+				))
+				assert.Equal(t, "value", cm.Data["key"])
+			},
+		},
+		{
+			name: "prunes resources no longer applied",
+			cfg: builtin.KubernetesApplyConfig{
+				KubeconfigSecret: builtin.KubeconfigSecretRef{Name: "target-cluster"},
+				Manifests:        testManifests,
+				Namespace:        "app",
+				Prune: &builtin.KubernetesPrune{
+					Labels: map[string]string{"managed-by": "kargo"},
+				},
+			},
+			kargoObjects: []client.Object{kubeconfigSecret},
+			targetObjects: []client.Object{
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "app",
+						Name:      "stale-config",
+						Labels:    map[string]string{"managed-by": "kargo"},
+					},
+				},
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "app",
+						Name:      "unmanaged-config",
+					},
+				},
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "elsewhere",
+						Name:      "stale-config",
+						Labels:    map[string]string{"managed-by": "kargo"},
+					},
+				},
+			},
+			assertions: func(t *testing.T, c client.Client, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+
+				cm := &corev1.ConfigMap{}
+				require.NoError(t, c.Get(
+					t.Context(),
+					client.ObjectKey{Namespace: "app", Name: "app-config"},
+					cm,
+				))
+				assert.Equal(t, "kargo", cm.Labels["managed-by"])
+
+				err = c.Get(
+					t.Context(),
+					client.ObjectKey{Namespace: "app", Name: "stale-config"},
+					cm,
+				)
+				require.True(t, apierrors.IsNotFound(err))
+				require.NoError(t, c.Get(
+					t.Context(),
+					client.ObjectKey{Namespace: "app", Name: "unmanaged-config"},
+					cm,
+				))
+				require.NoError(t, c.Get(
+					t.Context(),
+					client.ObjectKey{Namespace: "elsewhere", Name: "stale-config"},
+					cm,
+				))
+			},
+		},
+		{
+			name: "waits for resources to become ready",
+			cfg: builtin.KubernetesApplyConfig{
+				KubeconfigSecret: builtin.KubeconfigSecretRef{Name: "target-cluster"},
+				Manifests: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+spec:
+  selector:
+    matchLabels:
+      app: app
+  template:
+    metadata:
+      labels:
+        app: app
+    spec:
+      containers:
+      - name: app
+        image: app:v1
+`,
+				Namespace: "app",
+				Wait:      true,
+			},
+			kargoObjects: []client.Object{kubeconfigSecret},
+			assertions: func(t *testing.T, _ client.Client, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionStepStatusRunning, res.Status)
+				assert.Contains(t, res.Message, "Deployment app/app")
+				assert.NotNil(t, res.RetryAfter)
+			},
+		},
+		{
+			name: "resources are ready",
+			cfg: builtin.KubernetesApplyConfig{
+				KubeconfigSecret: builtin.KubeconfigSecretRef{Name: "target-cluster"},
+				Manifests:        testManifests,
+				Namespace:        "app",
+				Wait:             true,
+			},
+			kargoObjects: []client.Object{kubeconfigSecret},
+			assertions: func(t *testing.T, _ client.Client, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+			},
+		},
+		{
+			name: "resource has failed",
+			cfg: builtin.KubernetesApplyConfig{
+				KubeconfigSecret: builtin.KubeconfigSecretRef{Name: "target-cluster"},
+				Manifests: `apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+  namespace: app
+`,
+				Wait: true,
+			},
+			kargoObjects: []client.Object{kubeconfigSecret},
+			targetObjects: []client.Object{
+				&batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "migrate"},
+					Status: batchv1.JobStatus{
+						Conditions: []batchv1.JobCondition{{
+							Type:    batchv1.JobFailed,
+							Status:  corev1.ConditionTrue,
+							Message: "BackoffLimitExceeded",
+						}},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ client.Client, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "Job app/migrate has failed")
+				require.True(t, promotion.IsTerminal(err))
+				assert.Equal(t, kargoapi.PromotionStepStatusFailed, res.Status)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workDir := t.TempDir()
+			for p, c := range tt.files {
+				absPath := filepath.Join(workDir, p)
+				require.NoError(t, os.MkdirAll(filepath.Dir(absPath), 0o700))
+				require.NoError(t, os.WriteFile(absPath, []byte(c), 0o600))
+			}
+
+			scheme := runtime.NewScheme()
+			require.NoError(t, clientgoscheme.AddToScheme(scheme))
+			restMapper := meta.NewDefaultRESTMapper(nil)
+			for gvk := range scheme.AllKnownTypes() {
+				scope := meta.RESTScopeNamespace
+				if gvk.Kind == "Namespace" {
+					scope = meta.RESTScopeRoot
+				}
+				restMapper.Add(gvk, scope)
+			}
+			targetClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithRESTMapper(restMapper).
+				WithObjects(tt.targetObjects...).
+				Build()
+
+			runner := &kubernetesApplier{
+				kargoClient: fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(tt.kargoObjects...).
+					Build(),
+				newClientFn: func(cfg *rest.Config) (client.Client, error) {
+					assert.Equal(t, "https://target.example.com", cfg.Host)
+					assert.Equal(t, "fake-token", cfg.BearerToken)
+					return targetClient, nil
+				},
+			}
+
+			res, err := runner.run(
+				context.Background(),
+				&promotion.StepContext{
+					Project: testProject,
+					WorkDir: workDir,
+				},
+				tt.cfg,
+			)
+			tt.assertions(t, targetClient, res, err)
+		})
+	}
+}
+
+func Test_validateKubeconfig(t *testing.T) {
+	tests := []struct {
+		name       string
+		cfg        *clientcmdapi.Config
+		assertions func(*testing.T, error)
+	}{
+		{
+			name: "inline credentials",
+			cfg: &clientcmdapi.Config{
+				Clusters: map[string]*clientcmdapi.Cluster{
+					"target": {
+						Server:                   "https://target.example.com",
+						CertificateAuthorityData: []byte("fake-ca"),
+					},
+				},
+				AuthInfos: map[string]*clientcmdapi.AuthInfo{
+					"target": {
+						ClientCertificateData: []byte("fake-cert"),
+						ClientKeyData:         []byte("fake-key"),
+					},
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "certificate authority file",
+			cfg: &clientcmdapi.Config{
+				Clusters: map[string]*clientcmdapi.Cluster{
+					"target": {CertificateAuthority: "/etc/ca.crt"},
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "references a certificate authority file")
+			},
+		},
+		{
+			name: "exec credential plugin",
+			cfg: &clientcmdapi.Config{
+				AuthInfos: map[string]*clientcmdapi.AuthInfo{
+					"target": {Exec: &clientcmdapi.ExecConfig{Command: "sh"}},
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "uses an exec credential plugin")
+			},
+		},
+		{
+			name: "auth provider",
+			cfg: &clientcmdapi.Config{
+				AuthInfos: map[string]*clientcmdapi.AuthInfo{
+					"target": {AuthProvider: &clientcmdapi.AuthProviderConfig{Name: "oidc"}},
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "uses an auth provider")
+			},
+		},
+		{
+			name: "client key file",
+			cfg: &clientcmdapi.Config{
+				AuthInfos: map[string]*clientcmdapi.AuthInfo{
+					"target": {ClientKey: "/etc/client.key"},
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "references a client certificate or key file")
+			},
+		},
+		{
+			name: "token file",
+			cfg: &clientcmdapi.Config{
+				AuthInfos: map[string]*clientcmdapi.AuthInfo{
+					"target": {TokenFile: "/var/run/secrets/token"},
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "references a token file")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.assertions(t, validateKubeconfig(tt.cfg))
+		})
+	}
+}
+
+func Test_loadKubernetesManifests(t *testing.T) {
+	objs, err := loadKubernetesManifests(t.TempDir(), builtin.KubernetesApplyConfig{
+		Manifests: `apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: first
+- apiVersion: apiextensions.k8s.io/v1
+  kind: CustomResourceDefinition
+  metadata:
+    name: widgets.example.com
+---
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: app
+`,
+	})
+	require.NoError(t, err)
+	require.Len(t, objs, 3)
+	assert.Equal(t, "widgets.example.com", objs[0].GetName())
+	assert.Equal(t, "app", objs[1].GetName())
+	assert.Equal(t, "first", objs[2].GetName())
+}
@@ -0,0 +1,360 @@
+package builtin
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/hashicorp/go-cleanhttp"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/xeipuuv/gojsonschema"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/credentials"
+	kargofs "github.com/akuity/kargo/pkg/io/fs"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const (
+	stepKindOCIPush = "oci-push"
+
+	defaultOCIPushConfigMediaType = "application/vnd.kargo.config.v1+json"
+	defaultOCIPushDirMediaType    = ocispec.MediaTypeImageLayerGzip
+	defaultOCIPushFileMediaType   = "application/octet-stream"
+
+	// ociPushAnnotationFreight is the annotation key used to record the name of
+	// the Freight being promoted on a pushed artifact.
+	ociPushAnnotationFreight = "kargo.akuity.io/freight"
+)
+
+func init() {
+	promotion.DefaultStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name: stepKindOCIPush,
+			Metadata: promotion.StepRunnerMetadata{
+				RequiredCapabilities: []promotion.StepRunnerCapability{
+					promotion.StepCapabilityAccessCredentials,
+				},
+				SideEffecting: true,
+			},
+			Value: newOCIPusher,
+		},
+	)
+}
+
+// ociPusher is an implementation of the promotion.StepRunner interface that
+// packages a file or directory as an OCI artifact and pushes it to a registry.
+type ociPusher struct {
+	schemaLoader gojsonschema.JSONLoader
+	credsDB      credentials.Database
+}
+
+// newOCIPusher returns an implementation of the promotion.StepRunner interface
+// that packages a file or directory as an OCI artifact and pushes it to a
+// registry. It uses the provided credentials database to authenticate with
+// the registry.
+func newOCIPusher(caps promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &ociPusher{
+		credsDB:      caps.CredsDB,
+		schemaLoader: getConfigSchemaLoader(stepKindOCIPush),
+	}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (p *ociPusher) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := p.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return p.run(ctx, stepCtx, cfg)
+}
+
+// convert validates the ociPusher configuration against a JSON schema and
+// converts it into a builtin.OCIPushConfig struct.
+func (p *ociPusher) convert(cfg promotion.Config) (builtin.OCIPushConfig, error) {
+	return validateAndConvert[builtin.OCIPushConfig](p.schemaLoader, cfg, stepKindOCIPush)
+}
+
+// run executes the ociPusher step with the provided configuration.
+func (p *ociPusher) run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.OCIPushConfig,
+) (promotion.StepResult, error) {
+	ref, err := name.NewTag(cfg.ImageRef)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed},
+			&promotion.TerminalError{
+				Err: fmt.Errorf("invalid image reference %q: %w", cfg.ImageRef, err),
+			}
+	}
+
+	img, err := p.buildArtifact(stepCtx, cfg)
+	if err != nil {
+		if promotion.IsTerminal(err) {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed}, err
+		}
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+	digest, err := img.Digest()
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error computing digest of artifact: %w", err)
+	}
+
+	remoteOpts, err := p.buildRemoteOptions(ctx, stepCtx, cfg, ref)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+	if err = remote.Write(ref, img, remoteOpts...); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error pushing artifact to %q: %w", cfg.ImageRef, err)
+	}
+
+	return promotion.StepResult{
+		Status: kargoapi.PromotionStepStatusSucceeded,
+		Output: map[string]any{
+			"tag":      ref.TagStr(),
+			"digest":   digest.String(),
+			"imageRef": ref.Context().Digest(digest.String()).String(),
+		},
+	}, nil
+}
+
+// buildArtifact packages the configured file or directory as a single-layer
+// OCI artifact.
+func (p *ociPusher) buildArtifact(
+	stepCtx *promotion.StepContext,
+	cfg builtin.OCIPushConfig,
+) (v1.Image, error) {
+	absPath, err := securejoin.SecureJoin(stepCtx.WorkDir, cfg.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to join path %q: %w", cfg.Path, err)
+	}
+	fi, err := os.Stat(absPath)
+	if err != nil {
+		return nil, &promotion.TerminalError{
+			Err: fmt.Errorf(
+				"error reading %q: %w", cfg.Path,
+				kargofs.SanitizePathError(err, stepCtx.WorkDir),
+			),
+		}
+	}
+
+	addendum := mutate.Addendum{MediaType: types.MediaType(cfg.MediaType)}
+	var content []byte
+	if fi.IsDir() {
+		if content, err = tarGzipDir(absPath, maxOCIArtifactSize); err != nil {
+			return nil, fmt.Errorf(
+				"error packaging directory %q: %w", cfg.Path,
+				kargofs.SanitizePathError(err, stepCtx.WorkDir),
+			)
+		}
+		if addendum.MediaType == "" {
+			addendum.MediaType = defaultOCIPushDirMediaType
+		}
+	} else {
+		if fi.Size() > maxOCIArtifactSize {
+			return nil, &promotion.TerminalError{
+				Err: fmt.Errorf(
+					"file size %d exceeds maximum allowed size of %d bytes",
+					fi.Size(), maxOCIArtifactSize,
+				),
+			}
+		}
+		if content, err = os.ReadFile(absPath); err != nil {
+			return nil, fmt.Errorf(
+				"error reading file %q: %w", cfg.Path,
+				kargofs.SanitizePathError(err, stepCtx.WorkDir),
+			)
+		}
+		if addendum.MediaType == "" {
+			addendum.MediaType = defaultOCIPushFileMediaType
+		}
+		addendum.Annotations = map[string]string{
+			ocispec.AnnotationTitle: filepath.Base(absPath),
+		}
+	}
+	addendum.Layer = static.NewLayer(content, addendum.MediaType)
+
+	img, err := mutate.Append(empty.Image, addendum)
+	if err != nil {
+		return nil, fmt.Errorf("error building artifact: %w", err)
+	}
+	img = mutate.MediaType(img, types.OCIManifestSchema1)
+	configMediaType := defaultOCIPushConfigMediaType
+	if cfg.ConfigMediaType != "" {
+		configMediaType = cfg.ConfigMediaType
+	}
+	img = mutate.ConfigMediaType(img, types.MediaType(configMediaType))
+
+	annotations := ociPushAnnotations(stepCtx)
+	maps.Copy(annotations, cfg.Annotations)
+	img, ok := mutate.Annotations(img, annotations).(v1.Image)
+	if !ok {
+		return nil, errors.New("error annotating artifact")
+	}
+	return img, nil
+}
+
+// ociPushAnnotations returns the annotations Kargo adds to every pushed
+// artifact by default. These record the Freight being promoted and, if that
+// Freight references exactly one commit, the commit the artifact was built
+// from.
+func ociPushAnnotations(stepCtx *promotion.StepContext) map[string]string {
+	annotations := map[string]string{}
+	if stepCtx.TargetFreightRef.Name != "" {
+		annotations[ociPushAnnotationFreight] = stepCtx.TargetFreightRef.Name
+	}
+	if commits := stepCtx.TargetFreightRef.Commits; len(commits) == 1 {
+		annotations[ocispec.AnnotationSource] = commits[0].RepoURL
+		annotations[ocispec.AnnotationRevision] = commits[0].ID
+	}
+	return annotations
+}
+
+// buildRemoteOptions constructs the remote options for the registry.
+func (p *ociPusher) buildRemoteOptions(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.OCIPushConfig,
+	ref name.Reference,
+) ([]remote.Option, error) {
+	httpTransport := cleanhttp.DefaultTransport()
+	if cfg.InsecureSkipTLSVerify {
+		httpTransport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: true, // nolint: gosec
+		}
+	}
+	remoteOpts := []remote.Option{
+		remote.WithContext(ctx),
+		remote.WithTransport(httpTransport),
+	}
+
+	repoURL := ref.Context().String()
+	creds, err := p.credsDB.Get(ctx, stepCtx.Project, credentials.TypeImage, repoURL)
+	if err != nil {
+		return nil, fmt.Errorf("error obtaining credentials for image repo %q: %w", repoURL, err)
+	}
+	if creds != nil && (creds.Username != "" || creds.Password != "") {
+		remoteOpts = append(remoteOpts, remote.WithAuth(&authn.Basic{
+			Username: creds.Username,
+			Password: creds.Password,
+		}))
+	}
+
+	return remoteOpts, nil
+}
+
+// tarGzipDir returns a gzipped tarball of the contents of the provided
+// directory. The tarball is reproducible: entries are written in lexical
+// order, and timestamps and ownership are omitted. Git metadata and symlinks
+// are skipped. An error is returned if the tarball would exceed maxSize bytes.
+func tarGzipDir(dir string, maxSize int64) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	gzw := gzip.NewWriter(&limitedWriter{w: buf, limit: maxSize, remaining: maxSize})
+	tw := tar.NewWriter(gzw)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == dir {
+			return nil
+		}
+		if d.Name() == ".git" {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.IsDir() && !d.Type().IsRegular() {
+			return nil
+		}
+		fi, err := d.Info()
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		hdr := &tar.Header{
+			Name:   filepath.ToSlash(relPath),
+			Mode:   int64(fi.Mode().Perm()),
+			Format: tar.FormatPAX,
+		}
+		if d.IsDir() {
+			hdr.Typeflag = tar.TypeDir
+			hdr.Name += "/"
+		} else {
+			hdr.Typeflag = tar.TypeReg
+			hdr.Size = fi.Size()
+		}
+		if err = tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = tw.Close(); err != nil {
+		return nil, err
+	}
+	if err = gzw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// limitedWriter is an io.Writer that returns a terminal error once more than
+// a fixed number of bytes have been written to it.
+type limitedWriter struct {
+	w         io.Writer
+	limit     int64
+	remaining int64
+}
+
+// Write implements io.Writer.
+func (l *limitedWriter) Write(p []byte) (int, error) {
+	if int64(len(p)) > l.remaining {
+		return 0, &promotion.TerminalError{
+			Err: fmt.Errorf("artifact exceeds maximum allowed size of %d bytes", l.limit),
+		}
+	}
+	l.remaining -= int64(len(p))
+	return l.w.Write(p)
+}
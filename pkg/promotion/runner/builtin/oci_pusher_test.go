@@ -0,0 +1,332 @@
+package builtin
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/credentials"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_ociPusher_convert(t *testing.T) {
+	tests := []validationTestCase{
+		{
+			name:   "path not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): path is required",
+			},
+		},
+		{
+			name:   "imageRef not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): imageRef is required",
+			},
+		},
+		{
+			name: "imageRef without tag",
+			config: promotion.Config{
+				"path":     "out",
+				"imageRef": "registry.example.com/manifests",
+			},
+			expectedProblems: []string{
+				"imageRef: Does not match pattern",
+			},
+		},
+		{
+			name: "imageRef with digest",
+			config: promotion.Config{
+				"path":     "out",
+				"imageRef": "registry.example.com/manifests@sha256:abc",
+			},
+			expectedProblems: []string{
+				"imageRef: Does not match pattern",
+			},
+		},
+		{
+			name: "annotation value is not a string",
+			config: promotion.Config{
+				"path":     "out",
+				"imageRef": "registry.example.com/manifests:v1",
+				"annotations": map[string]any{
+					"foo": 42,
+				},
+			},
+			expectedProblems: []string{
+				"annotations.foo: Invalid type",
+			},
+		},
+		{
+			name: "valid kitchen sink",
+			config: promotion.Config{
+				"path":            "out",
+				"imageRef":        "localhost:5000/team/manifests:v1",
+				"mediaType":       "application/vnd.cncf.flux.content.v1.tar+gzip",
+				"configMediaType": "application/vnd.cncf.flux.config.v1+json",
+				"annotations": map[string]any{
+					"foo": "bar",
+				},
+				"insecureSkipTLSVerify": true,
+			},
+		},
+	}
+
+	r := newOCIPusher(promotion.StepRunnerCapabilities{})
+	runner, ok := r.(*ociPusher)
+	require.True(t, ok)
+
+	runValidationTests(t, runner.convert, tests)
+}
+
+func Test_ociPusher_run(t *testing.T) {
+	srv := httptest.NewServer(registry.New())
+	t.Cleanup(srv.Close)
+	srvURL, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	registryHost := srvURL.Host
+
+	setupManifests := func(t *testing.T, dir string) {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "out", "base"), 0o700))
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "out", ".git"), 0o700))
+		require.NoError(t, os.WriteFile(
+			filepath.Join(dir, "out", "base", "deploy.yaml"), []byte("kind: Deployment\n"), 0o600,
+		))
+		require.NoError(t, os.WriteFile(
+			filepath.Join(dir, "out", ".git", "HEAD"), []byte("ref: refs/heads/main\n"), 0o600,
+		))
+	}
+
+	tests := []struct {
+		name       string
+		credsDB    credentials.Database
+		setupFiles func(*testing.T, string)
+		stepCtx    *promotion.StepContext
+		config     builtin.OCIPushConfig
+		assertions func(*testing.T, promotion.StepResult, error)
+	}{
+		{
+			name:       "pushes directory",
+			credsDB:    &credentials.FakeDB{},
+			setupFiles: setupManifests,
+			stepCtx: &promotion.StepContext{
+				Project: "test-project",
+				TargetFreightRef: kargoapi.FreightReference{
+					Name: "abc123",
+					Commits: []kargoapi.GitCommit{{
+						RepoURL: "https://github.com/example/repo.git",
+						ID:      "deadbeef",
+					}},
+				},
+			},
+			config: builtin.OCIPushConfig{
+				Path:     "out",
+				ImageRef: registryHost + "/manifests:v1",
+				Annotations: map[string]string{
+					"foo": "bar",
+				},
+			},
+			assertions: func(t *testing.T, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.Status)
+
+				digest, ok := result.Output["digest"].(string)
+				require.True(t, ok)
+				assert.Equal(t, "v1", result.Output["tag"])
+				assert.Equal(t, registryHost+"/manifests@"+digest, result.Output["imageRef"])
+
+				ref, err := name.ParseReference(registryHost + "/manifests:v1")
+				require.NoError(t, err)
+				img, err := remote.Image(ref)
+				require.NoError(t, err)
+				manifest, err := img.Manifest()
+				require.NoError(t, err)
+				assert.Equal(t, types.OCIManifestSchema1, manifest.MediaType)
+				assert.Equal(
+					t,
+					types.MediaType(defaultOCIPushConfigMediaType),
+					manifest.Config.MediaType,
+				)
+				assert.Equal(t, map[string]string{
+					ociPushAnnotationFreight:   "abc123",
+					ocispec.AnnotationSource:   "https://github.com/example/repo.git",
+					ocispec.AnnotationRevision: "deadbeef",
+					"foo":                      "bar",
+				}, manifest.Annotations)
+				require.Len(t, manifest.Layers, 1)
+				assert.Equal(
+					t,
+					types.MediaType(defaultOCIPushDirMediaType),
+					manifest.Layers[0].MediaType,
+				)
+
+				layers, err := img.Layers()
+				require.NoError(t, err)
+				rc, err := layers[0].Compressed()
+				require.NoError(t, err)
+				defer rc.Close()
+				assert.Equal(
+					t,
+					map[string]string{
+						"base/":            "",
+						"base/deploy.yaml": "kind: Deployment\n",
+					},
+					readTarGzip(t, rc),
+				)
+			},
+		},
+		{
+			name:    "pushes file with custom media types",
+			credsDB: &credentials.FakeDB{},
+			setupFiles: func(t *testing.T, dir string) {
+				require.NoError(t, os.WriteFile(
+					filepath.Join(dir, "manifests.yaml"), []byte("kind: Service\n"), 0o600,
+				))
+			},
+			stepCtx: &promotion.StepContext{Project: "test-project"},
+			config: builtin.OCIPushConfig{
+				Path:            "manifests.yaml",
+				ImageRef:        registryHost + "/file:v1",
+				MediaType:       "application/yaml",
+				ConfigMediaType: "application/vnd.example.config.v1+json",
+			},
+			assertions: func(t *testing.T, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.Status)
+
+				ref, err := name.ParseReference(registryHost + "/file:v1")
+				require.NoError(t, err)
+				img, err := remote.Image(ref)
+				require.NoError(t, err)
+				manifest, err := img.Manifest()
+				require.NoError(t, err)
+				assert.Equal(
+					t,
+					types.MediaType("application/vnd.example.config.v1+json"),
+					manifest.Config.MediaType,
+				)
+				assert.Empty(t, manifest.Annotations)
+				require.Len(t, manifest.Layers, 1)
+				assert.Equal(t, types.MediaType("application/yaml"), manifest.Layers[0].MediaType)
+				assert.Equal(
+					t,
+					map[string]string{ocispec.AnnotationTitle: "manifests.yaml"},
+					manifest.Layers[0].Annotations,
+				)
+			},
+		},
+		{
+			name:       "path does not exist",
+			credsDB:    &credentials.FakeDB{},
+			setupFiles: func(*testing.T, string) {},
+			stepCtx:    &promotion.StepContext{Project: "test-project"},
+			config: builtin.OCIPushConfig{
+				Path:     "missing",
+				ImageRef: registryHost + "/manifests:v1",
+			},
+			assertions: func(t *testing.T, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "error reading \"missing\"")
+				assert.True(t, promotion.IsTerminal(err))
+				assert.Equal(t, kargoapi.PromotionStepStatusFailed, result.Status)
+			},
+		},
+		{
+			name: "error obtaining credentials",
+			credsDB: &credentials.FakeDB{
+				GetFn: func(
+					context.Context,
+					string,
+					credentials.Type,
+					string,
+				) (*credentials.Credentials, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			setupFiles: setupManifests,
+			stepCtx:    &promotion.StepContext{Project: "test-project"},
+			config: builtin.OCIPushConfig{
+				Path:     "out",
+				ImageRef: registryHost + "/manifests:v1",
+			},
+			assertions: func(t *testing.T, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "error obtaining credentials")
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.Status)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.stepCtx.WorkDir = t.TempDir()
+			tt.setupFiles(t, tt.stepCtx.WorkDir)
+			runner := &ociPusher{credsDB: tt.credsDB}
+			result, err := runner.run(context.Background(), tt.stepCtx, tt.config)
+			tt.assertions(t, result, err)
+		})
+	}
+}
+
+func Test_tarGzipDir(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.yaml"), []byte("a"), 0o600))
+	require.NoError(t, os.Symlink("a.yaml", filepath.Join(dir, "link.yaml")))
+
+	t.Run("reproducible", func(t *testing.T) {
+		first, err := tarGzipDir(dir, maxOCIArtifactSize)
+		require.NoError(t, err)
+		// Change the modification time of the file
+		later := time.Now().Add(time.Hour)
+		require.NoError(t, os.Chtimes(filepath.Join(dir, "a.yaml"), later, later))
+		second, err := tarGzipDir(dir, maxOCIArtifactSize)
+		require.NoError(t, err)
+		assert.Equal(t, first, second)
+		// Symlinks are skipped
+		assert.Equal(t, map[string]string{"a.yaml": "a"}, readTarGzip(t, bytes.NewReader(first)))
+	})
+
+	t.Run("exceeds max size", func(t *testing.T) {
+		_, err := tarGzipDir(dir, 10)
+		require.ErrorContains(t, err, "exceeds maximum allowed size")
+		assert.True(t, promotion.IsTerminal(err))
+	})
+}
+
+// readTarGzip returns the contents of the entries of a gzipped tarball, keyed
+// by name.
+func readTarGzip(t *testing.T, r io.Reader) map[string]string {
+	gzr, err := gzip.NewReader(r)
+	require.NoError(t, err)
+	tr := tar.NewReader(gzr)
+	entries := map[string]string{}
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		b, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		entries[hdr.Name] = string(b)
+	}
+	return entries
+}
@@ -0,0 +1,65 @@
+package builtin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// writeRenderedJSON writes the JSON document produced by a rendering tool to
+// the provided path. If the path has a .json extension, the document is
+// written as indented JSON. Otherwise, it is written as YAML, and if the
+// document is an array, each of its elements is written as a separate
+// document in a YAML stream. This matches the way Kubernetes manifests are
+// usually consumed.
+func writeRenderedJSON(outPath string, data []byte) error {
+	var out []byte
+	if strings.EqualFold(filepath.Ext(outPath), ".json") {
+		buf := &bytes.Buffer{}
+		if err := json.Indent(buf, bytes.TrimSpace(data), "", "  "); err != nil {
+			return fmt.Errorf("error formatting output as JSON: %w", err)
+		}
+		buf.WriteByte('\n')
+		out = buf.Bytes()
+	} else {
+		var err error
+		if out, err = jsonToYAMLStream(data); err != nil {
+			return fmt.Errorf("error formatting output as YAML: %w", err)
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(outPath), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(outPath, out, 0o600)
+}
+
+// jsonToYAMLStream converts the provided JSON document to YAML. If the
+// document is an array, each of its elements is converted to a separate
+// document in a YAML stream.
+func jsonToYAMLStream(data []byte) ([]byte, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || trimmed[0] != '[' {
+		return yaml.JSONToYAML(data)
+	}
+	var docs []json.RawMessage
+	if err := json.Unmarshal(trimmed, &docs); err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	for i, doc := range docs {
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		b, err := yaml.JSONToYAML(doc)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(b)
+	}
+	return buf.Bytes(), nil
+}
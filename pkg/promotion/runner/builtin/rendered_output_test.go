@@ -0,0 +1,54 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_jsonToYAMLStream(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		assertions func(*testing.T, []byte, error)
+	}{
+		{
+			name:  "object",
+			input: `{"foo":"bar"}`,
+			assertions: func(t *testing.T, out []byte, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "foo: bar\n", string(out))
+			},
+		},
+		{
+			name:  "array",
+			input: `[{"foo":"bar"},{"bat":"baz"}]`,
+			assertions: func(t *testing.T, out []byte, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "foo: bar\n---\nbat: baz\n", string(out))
+			},
+		},
+		{
+			name:  "empty array",
+			input: `[]`,
+			assertions: func(t *testing.T, out []byte, err error) {
+				require.NoError(t, err)
+				assert.Empty(t, out)
+			},
+		},
+		{
+			name:  "invalid JSON",
+			input: `[{"foo":`,
+			assertions: func(t *testing.T, _ []byte, err error) {
+				require.Error(t, err)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := jsonToYAMLStream([]byte(tt.input))
+			tt.assertions(t, out, err)
+		})
+	}
+}
@@ -0,0 +1,350 @@
+package builtin
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/pelletier/go-toml/v2"
+	"github.com/pelletier/go-toml/v2/unstable"
+	"github.com/xeipuuv/gojsonschema"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const stepKindTOMLUpdate = "toml-update"
+
+// tomlBareKeyRegex matches TOML keys that do not need to be quoted.
+var tomlBareKeyRegex = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+func init() {
+	promotion.DefaultStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name:  stepKindTOMLUpdate,
+			Value: newTOMLUpdater,
+		},
+	)
+}
+
+// tomlUpdater is an implementation of the promotion.StepRunner interface that
+// updates the values of specified keys in a TOML file.
+type tomlUpdater struct {
+	schemaLoader gojsonschema.JSONLoader
+}
+
+// newTOMLUpdater returns an implementation of the promotion.StepRunner
+// interface that updates the values of specified keys in a TOML file.
+func newTOMLUpdater(promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &tomlUpdater{schemaLoader: getConfigSchemaLoader(stepKindTOMLUpdate)}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (t *tomlUpdater) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := t.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return t.run(ctx, stepCtx, cfg)
+}
+
+// convert validates tomlUpdater configuration against a JSON schema and
+// converts it into a builtin.TOMLUpdateConfig struct.
+func (t *tomlUpdater) convert(cfg promotion.Config) (builtin.TOMLUpdateConfig, error) {
+	return validateAndConvert[builtin.TOMLUpdateConfig](t.schemaLoader, cfg, stepKindTOMLUpdate)
+}
+
+func (t *tomlUpdater) run(
+	_ context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.TOMLUpdateConfig,
+) (promotion.StepResult, error) {
+	result := promotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded}
+
+	if len(cfg.Updates) > 0 {
+		if err := t.updateFile(stepCtx.WorkDir, cfg.Path, cfg.Updates); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("TOML file update failed: %w", err)
+		}
+
+		if commitMsg := t.generateCommitMessage(cfg.Path, cfg.Updates); commitMsg != "" {
+			result.Output = map[string]any{
+				"commitMessage": commitMsg,
+			}
+		}
+	}
+	return result, nil
+}
+
+func (t *tomlUpdater) updateFile(workDir string, path string, updates []builtin.TOMLUpdate) error {
+	absFilePath, err := securejoin.SecureJoin(workDir, path)
+	if err != nil {
+		return fmt.Errorf("error joining path %q: %w", path, err)
+	}
+
+	fileContent, err := os.ReadFile(absFilePath)
+	if err != nil {
+		return fmt.Errorf("error reading TOML file %q: %w", absFilePath, err)
+	}
+
+	for _, update := range updates {
+		if !isValidScalar(update.Value) {
+			return fmt.Errorf("value for key %q is not a scalar type", update.Key)
+		}
+		if fileContent, err = setTOMLValue(fileContent, update.Key, update.Value); err != nil {
+			return fmt.Errorf("error setting key %q in TOML file: %w", update.Key, err)
+		}
+	}
+
+	if err = os.WriteFile(absFilePath, fileContent, 0600); err != nil {
+		return fmt.Errorf("error writing updated TOML file %q: %w", absFilePath, err)
+	}
+
+	return nil
+}
+
+func (t *tomlUpdater) generateCommitMessage(path string, updates []builtin.TOMLUpdate) string {
+	if len(updates) == 0 {
+		return ""
+	}
+
+	var commitMsg strings.Builder
+	_, _ = fmt.Fprintf(&commitMsg, "Updated %s\n", path)
+
+	for _, update := range updates {
+		switch v := update.Value.(type) {
+		case string:
+			_, _ = fmt.Fprintf(&commitMsg, "\n- %s: %q", update.Key, v)
+		default:
+			_, _ = fmt.Fprintf(&commitMsg, "\n- %s: %v", update.Key, v)
+		}
+	}
+
+	return commitMsg.String()
+}
+
+// setTOMLValue sets the value of the key at the specified dot-separated path
+// in the provided TOML document and returns the updated document. Only the
+// bytes of the existing value are replaced, so comments and formatting are
+// preserved. If the key does not exist, it is added to the end of the table
+// that contains it, which must be the root table or a table with its own
+// header.
+func setTOMLValue(doc []byte, key string, value any) ([]byte, error) {
+	path := strings.Split(key, ".")
+	tablePath := path[:len(path)-1]
+
+	var (
+		// valueStart and valueEnd are the offsets of the existing value, if
+		// found.
+		valueStart, valueEnd = -1, -1
+		valueKind            unstable.Kind
+		// sectionStart and sectionEnd are the offsets of the section of the
+		// document holding the table the key belongs to, if found.
+		sectionStart, sectionEnd = -1, -1
+		currentTable             []string
+		inArrayTable             bool
+	)
+	if len(tablePath) == 0 {
+		sectionStart = 0
+	}
+
+	p := unstable.Parser{}
+	p.Reset(doc)
+	for p.NextExpression() {
+		expr := p.Expression()
+		switch expr.Kind {
+		case unstable.Table, unstable.ArrayTable:
+			keys, keyStart := tomlNodeKeys(expr)
+			headerStart := bytes.LastIndexByte(doc[:keyStart], '\n') + 1
+			if sectionStart >= 0 && sectionEnd < 0 {
+				sectionEnd = headerStart
+			}
+			currentTable = keys
+			inArrayTable = expr.Kind == unstable.ArrayTable
+			if !inArrayTable && slices.Equal(keys, tablePath) && len(tablePath) > 0 {
+				sectionStart = headerStart
+			}
+		case unstable.KeyValue:
+			if inArrayTable {
+				continue
+			}
+			keys, _ := tomlNodeKeys(expr)
+			if !slices.Equal(append(slices.Clone(currentTable), keys...), path) {
+				continue
+			}
+			val := expr.Value()
+			switch val.Kind {
+			case unstable.Array, unstable.InlineTable:
+				return nil, fmt.Errorf("value of key %q is not a scalar", key)
+			}
+			raw := val.Raw
+			if raw.Length == 0 {
+				raw = p.Range(val.Data)
+			}
+			valueStart = int(raw.Offset)
+			valueEnd = valueStart + int(raw.Length)
+			valueKind = val.Kind
+		}
+	}
+	if err := p.Error(); err != nil {
+		return nil, fmt.Errorf("error parsing TOML: %w", err)
+	}
+
+	var updated []byte
+	switch {
+	case valueStart >= 0:
+		encoded, err := encodeTOMLValue(value, doc[valueStart:valueEnd], valueKind)
+		if err != nil {
+			return nil, err
+		}
+		updated = slices.Concat(doc[:valueStart], []byte(encoded), doc[valueEnd:])
+	case sectionStart >= 0:
+		if sectionEnd < 0 {
+			sectionEnd = len(doc)
+		}
+		encoded, err := encodeTOMLValue(value, nil, unstable.Invalid)
+		if err != nil {
+			return nil, err
+		}
+		insertAt := tomlInsertionOffset(doc, sectionStart, sectionEnd)
+		line := fmt.Sprintf("%s = %s\n", encodeTOMLKey(path[len(path)-1]), encoded)
+		if insertAt > 0 && doc[insertAt-1] != '\n' {
+			line = "\n" + line
+		}
+		updated = slices.Concat(doc[:insertAt], []byte(line), doc[insertAt:])
+	default:
+		return nil, fmt.Errorf("table %q not found", strings.Join(tablePath, "."))
+	}
+
+	if err := toml.Unmarshal(updated, &map[string]any{}); err != nil {
+		return nil, fmt.Errorf("updated TOML is invalid: %w", err)
+	}
+	return updated, nil
+}
+
+// tomlNodeKeys returns the parts of the (possibly dotted) key of the provided
+// table, array table, or key-value expression, along with the offset at which
+// the key starts.
+func tomlNodeKeys(expr *unstable.Node) ([]string, int) {
+	var keys []string
+	start := -1
+	it := expr.Key()
+	for it.Next() {
+		n := it.Node()
+		if start < 0 {
+			start = int(n.Raw.Offset)
+		}
+		keys = append(keys, string(n.Data))
+	}
+	return keys, start
+}
+
+// tomlInsertionOffset returns the offset at which a new key-value should be
+// inserted into the section of the document between start and end. Blank and
+// comment lines at the end of the section are assumed to belong to whatever
+// follows the section, so the offset precedes them.
+func tomlInsertionOffset(doc []byte, start, end int) int {
+	offset := end
+	for offset > start {
+		lineStart := bytes.LastIndexByte(doc[:max(offset-1, 0)], '\n') + 1
+		if lineStart < start {
+			break
+		}
+		line := bytes.TrimSpace(doc[lineStart:offset])
+		if len(line) > 0 && line[0] != '#' {
+			break
+		}
+		offset = lineStart
+	}
+	return offset
+}
+
+// encodeTOMLKey encodes the provided key, quoting it if necessary.
+func encodeTOMLKey(key string) string {
+	if tomlBareKeyRegex.MatchString(key) {
+		return key
+	}
+	return encodeTOMLBasicString(key)
+}
+
+// encodeTOMLValue encodes the provided scalar value as TOML. The raw bytes and
+// kind of the value being replaced, if any, are used to preserve its style
+// where possible.
+func encodeTOMLValue(value any, existing []byte, existingKind unstable.Kind) (string, error) {
+	switch v := value.(type) {
+	case string:
+		if len(existing) > 1 && existing[0] == '\'' && existing[1] != '\'' &&
+			!strings.ContainsAny(v, "'\n\r") {
+			return "'" + v + "'", nil
+		}
+		return encodeTOMLBasicString(v), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", v), nil
+	case float32:
+		return encodeTOMLFloat(float64(v), existingKind), nil
+	case float64:
+		return encodeTOMLFloat(v, existingKind), nil
+	default:
+		return "", fmt.Errorf("unsupported value type %T", value)
+	}
+}
+
+// encodeTOMLFloat encodes the provided number as TOML. Whole numbers are
+// encoded as integers unless the value being replaced is a float.
+func encodeTOMLFloat(f float64, existingKind unstable.Kind) string {
+	if f == math.Trunc(f) && math.Abs(f) < 1<<53 && existingKind != unstable.Float {
+		return strconv.FormatInt(int64(f), 10)
+	}
+	s := strconv.FormatFloat(f, 'f', -1, 64)
+	if !strings.ContainsAny(s, ".eE") {
+		s += ".0"
+	}
+	return s
+}
+
+// encodeTOMLBasicString encodes the provided string as a TOML basic string.
+func encodeTOMLBasicString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\b':
+			b.WriteString(`\b`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\f':
+			b.WriteString(`\f`)
+		case '\r':
+			b.WriteString(`\r`)
+		default:
+			if r < 0x20 || r == 0x7f {
+				_, _ = fmt.Fprintf(&b, `\u%04X`, r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
@@ -0,0 +1,337 @@
+package builtin
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_tomlUpdater_convert(t *testing.T) {
+	tests := []validationTestCase{
+		{
+			name:   "path is not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): path is required",
+			},
+		},
+		{
+			name:   "updates is null",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): updates is required",
+			},
+		},
+		{
+			name: "updates is empty",
+			config: promotion.Config{
+				"updates": []promotion.Config{},
+			},
+			expectedProblems: []string{
+				"updates: Array must have at least 1 items",
+			},
+		},
+		{
+			name: "key not specified",
+			config: promotion.Config{
+				"updates": []promotion.Config{{}},
+			},
+			expectedProblems: []string{
+				"updates.0: key is required",
+			},
+		},
+		{
+			name: "value not specified",
+			config: promotion.Config{
+				"updates": []promotion.Config{{}},
+			},
+			expectedProblems: []string{
+				"updates.0: value is required",
+			},
+		},
+		{
+			name: "valid config",
+			config: promotion.Config{
+				"path": "fake-path",
+				"updates": []promotion.Config{
+					{
+						"key":   "package.version",
+						"value": "1.0.0",
+					},
+				},
+			},
+		},
+	}
+
+	r := newTOMLUpdater(promotion.StepRunnerCapabilities{})
+	runner, ok := r.(*tomlUpdater)
+	require.True(t, ok)
+
+	runValidationTests(t, runner.convert, tests)
+}
+
+func Test_tomlUpdater_run(t *testing.T) {
+	tests := []struct {
+		name       string
+		cfg        builtin.TOMLUpdateConfig
+		files      map[string]string
+		assertions func(*testing.T, string, promotion.StepResult, error)
+	}{
+		{
+			name: "successful run with updates",
+			cfg: builtin.TOMLUpdateConfig{
+				Path: "Cargo.toml",
+				Updates: []builtin.TOMLUpdate{
+					{Key: "package.version", Value: "1.0.1"},
+					{Key: "dependencies.serde", Value: "1.0.200"},
+				},
+			},
+			files: map[string]string{
+				"Cargo.toml": `# The package
+[package]
+name = "app"
+version = "1.0.0" # bumped by Kargo
+
+[dependencies]
+serde = "1.0.0"
+`,
+			},
+			assertions: func(t *testing.T, workDir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, promotion.StepResult{
+					Status: kargoapi.PromotionStepStatusSucceeded,
+					Output: map[string]any{
+						"commitMessage": "Updated Cargo.toml\n\n" +
+							"- package.version: \"1.0.1\"\n" +
+							"- dependencies.serde: \"1.0.200\"",
+					},
+				}, result)
+				content, err := os.ReadFile(path.Join(workDir, "Cargo.toml"))
+				require.NoError(t, err)
+				assert.Equal(t, `# The package
+[package]
+name = "app"
+version = "1.0.1" # bumped by Kargo
+
+[dependencies]
+serde = "1.0.200"
+`, string(content))
+			},
+		},
+		{
+			name: "value is not a scalar",
+			cfg: builtin.TOMLUpdateConfig{
+				Path: "config.toml",
+				Updates: []builtin.TOMLUpdate{
+					{Key: "key", Value: []any{"a", "b"}},
+				},
+			},
+			files: map[string]string{
+				"config.toml": "key = 1\n",
+			},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "is not a scalar type")
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.Status)
+			},
+		},
+		{
+			name: "file does not exist",
+			cfg: builtin.TOMLUpdateConfig{
+				Path: "config.toml",
+				Updates: []builtin.TOMLUpdate{
+					{Key: "key", Value: "value"},
+				},
+			},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "TOML file update failed")
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.Status)
+			},
+		},
+	}
+
+	runner := &tomlUpdater{}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workDir := t.TempDir()
+			for p, c := range tt.files {
+				require.NoError(t, os.WriteFile(path.Join(workDir, p), []byte(c), 0o600))
+			}
+			result, err := runner.run(
+				context.Background(),
+				&promotion.StepContext{Project: "test-project", WorkDir: workDir},
+				tt.cfg,
+			)
+			tt.assertions(t, workDir, result, err)
+		})
+	}
+}
+
+func Test_setTOMLValue(t *testing.T) {
+	tests := []struct {
+		name       string
+		doc        string
+		key        string
+		value      any
+		assertions func(*testing.T, string, error)
+	}{
+		{
+			name:  "updates root key",
+			doc:   "# comment\nimage = \"app:v1\"  # trailing\n",
+			key:   "image",
+			value: "app:v2",
+			assertions: func(t *testing.T, doc string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "# comment\nimage = \"app:v2\"  # trailing\n", doc)
+			},
+		},
+		{
+			name:  "preserves literal string style",
+			doc:   "path = 'C:\\tools'\n",
+			key:   "path",
+			value: "C:\\bin",
+			assertions: func(t *testing.T, doc string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "path = 'C:\\bin'\n", doc)
+			},
+		},
+		{
+			name:  "escapes basic string",
+			doc:   "msg = 'hello'\n",
+			key:   "msg",
+			value: "it's \"quoted\"",
+			assertions: func(t *testing.T, doc string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "msg = \"it's \\\"quoted\\\"\"\n", doc)
+			},
+		},
+		{
+			name:  "updates dotted key in table",
+			doc:   "[server]\nhttp.port = 8080\n",
+			key:   "server.http.port",
+			value: float64(9090),
+			assertions: func(t *testing.T, doc string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "[server]\nhttp.port = 9090\n", doc)
+			},
+		},
+		{
+			name:  "preserves float type",
+			doc:   "[limits]\nratio = 0.5\n",
+			key:   "limits.ratio",
+			value: float64(1),
+			assertions: func(t *testing.T, doc string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "[limits]\nratio = 1.0\n", doc)
+			},
+		},
+		{
+			name:  "updates boolean",
+			doc:   "enabled = false\n",
+			key:   "enabled",
+			value: true,
+			assertions: func(t *testing.T, doc string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "enabled = true\n", doc)
+			},
+		},
+		{
+			name:  "ignores keys in array tables",
+			doc:   "[[bin]]\nname = \"a\"\n",
+			key:   "bin.name",
+			value: "b",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, `table "bin" not found`)
+			},
+		},
+		{
+			name:  "adds key to root table",
+			doc:   "name = \"app\"\n\n# Server settings\n[server]\nport = 8080\n",
+			key:   "version",
+			value: "1.0.0",
+			assertions: func(t *testing.T, doc string, err error) {
+				require.NoError(t, err)
+				assert.Equal(
+					t,
+					"name = \"app\"\nversion = \"1.0.0\"\n\n# Server settings\n[server]\nport = 8080\n",
+					doc,
+				)
+			},
+		},
+		{
+			name:  "adds key to table",
+			doc:   "[server]\nport = 8080\n\n[client]\nretries = 3",
+			key:   "server.host",
+			value: "localhost",
+			assertions: func(t *testing.T, doc string, err error) {
+				require.NoError(t, err)
+				assert.Equal(
+					t,
+					"[server]\nport = 8080\nhost = \"localhost\"\n\n[client]\nretries = 3",
+					doc,
+				)
+			},
+		},
+		{
+			name:  "adds quoted key to last table",
+			doc:   "[server]\nport = 8080",
+			key:   "server.log level",
+			value: "debug",
+			assertions: func(t *testing.T, doc string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "[server]\nport = 8080\n\"log level\" = \"debug\"\n", doc)
+			},
+		},
+		{
+			name:  "table not found",
+			doc:   "[server]\nport = 8080\n",
+			key:   "client.retries",
+			value: 3,
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, `table "client" not found`)
+			},
+		},
+		{
+			name:  "existing value is not a scalar",
+			doc:   "features = [\"a\", \"b\"]\n",
+			key:   "features",
+			value: "c",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "is not a scalar")
+			},
+		},
+		{
+			name:  "update results in invalid TOML",
+			doc:   "a.b = 1\n",
+			key:   "a",
+			value: "c",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "updated TOML is invalid")
+			},
+		},
+		{
+			name:  "invalid TOML",
+			doc:   "this is not TOML",
+			key:   "key",
+			value: "value",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "error parsing TOML")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := setTOMLValue([]byte(tt.doc), tt.key, tt.value)
+			tt.assertions(t, string(doc), err)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/gin-gonic/gin"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -17,7 +18,6 @@ import (
 	"github.com/akuity/kargo/pkg/api"
 	"github.com/akuity/kargo/pkg/event"
 	libhttp "github.com/akuity/kargo/pkg/http"
-	"github.com/akuity/kargo/pkg/kubeclient"
 	"github.com/akuity/kargo/pkg/logging"
 	"github.com/akuity/kargo/pkg/server/user"
 )
@@ -105,14 +105,36 @@ func (s *server) ApproveFreight(
 		return &connect.Response[svcv1alpha1.ApproveFreightResponse]{}, nil
 	}
 
-	newStatus := *freight.Status.DeepCopy()
-	if newStatus.ApprovedFor == nil {
-		newStatus.ApprovedFor = make(map[string]kargoapi.ApprovedStage)
+	approval, err := newFreightApproval(ctx, stage, req.Msg.GetComment())
+	if err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+	if freight.Status.HasApprovalFrom(stageName, approval.Approver) {
+		return nil, connect.NewError(
+			connect.CodeAlreadyExists,
+			fmt.Errorf(
+				"freight %q has already been approved for Stage %q by %q",
+				freight.Name, stageName, approval.Approver,
+			),
+		)
 	}
-	newStatus.AddApprovedStage(stageName, time.Now())
 
-	if err := s.patchFreightStatusFn(ctx, freight, newStatus); err != nil {
-		return nil, fmt.Errorf("patch status: %w", err)
+	approved, err := s.approveFreightFn(ctx, s.client, freight, stage, approval)
+	if errors.Is(err, api.ErrDuplicateApproval) {
+		return nil, connect.NewError(
+			connect.CodeAlreadyExists,
+			fmt.Errorf(
+				"freight %q has already been approved for Stage %q by %q",
+				freight.Name, stageName, approval.Approver,
+			),
+		)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("approve freight: %w", err)
+	}
+	if !approved {
+		// More approvals are required before the Freight is approved.
+		return &connect.Response[svcv1alpha1.ApproveFreightResponse]{}, nil
 	}
 
 	var actor string
@@ -130,27 +152,34 @@ func (s *server) ApproveFreight(
 	return &connect.Response[svcv1alpha1.ApproveFreightResponse]{}, nil
 }
 
-func (s *server) patchFreightStatus(
+// newFreightApproval returns a record of the approval of Freight for the
+// provided Stage by the user associated with the provided context. An error is
+// returned if the Stage's ApprovalPolicy does not permit the user to approve
+// Freight for the Stage.
+func newFreightApproval(
 	ctx context.Context,
-	freight *kargoapi.Freight,
-	newStatus kargoapi.FreightStatus,
-) error {
-	if err := kubeclient.PatchStatus(
-		ctx,
-		s.client,
-		freight,
-		func(status *kargoapi.FreightStatus) {
-			*status = newStatus
-		},
-	); err != nil {
-		return fmt.Errorf(
-			"error patching Freight %q status in namespace %q: %w",
-			freight.Name,
-			freight.Namespace,
-			err,
+	stage *kargoapi.Stage,
+	comment string,
+) (kargoapi.FreightApproval, error) {
+	approver := kargoapi.EventActorUnknown
+	var groups []string
+	u, ok := user.InfoFromContext(ctx)
+	if ok {
+		approver = api.FormatEventUserActor(u)
+		groups = api.UserGroups(u)
+	}
+	// The admin user is always permitted to approve Freight.
+	if !u.IsAdmin && !api.IsPermittedApprover(stage.Spec.ApprovalPolicy, groups) {
+		return kargoapi.FreightApproval{}, fmt.Errorf(
+			"approver %q is not a member of any group permitted to approve Freight for Stage %q",
+			approver, stage.Name,
 		)
 	}
-	return nil
+	return kargoapi.FreightApproval{
+		Approver:   approver,
+		ApprovedAt: &metav1.Time{Time: time.Now()},
+		Comment:    comment,
+	}, nil
 }
 
 // @id ApproveFreight
@@ -162,6 +191,7 @@ func (s *server) patchFreightStatus(
 // @Param project path string true "Project name"
 // @Param freight-name-or-alias path string true "Freight name or alias"
 // @Param stage query string true "Stage name"
+// @Param comment query string false "Optional comment recorded with the approval"
 // @Success 200 "Success"
 // @Router /v1beta1/projects/{project}/freight/{freight-name-or-alias}/approve [post]
 func (s *server) approveFreight(c *gin.Context) {
@@ -213,21 +243,40 @@ func (s *server) approveFreight(c *gin.Context) {
 		return
 	}
 
-	newStatus := *freight.Status.DeepCopy()
-	if newStatus.ApprovedFor == nil {
-		newStatus.ApprovedFor = make(map[string]kargoapi.ApprovedStage)
+	approval, err := newFreightApproval(ctx, stage, c.Query("comment"))
+	if err != nil {
+		_ = c.Error(libhttp.Error(err, http.StatusForbidden))
+		return
+	}
+	if freight.Status.HasApprovalFrom(stageName, approval.Approver) {
+		_ = c.Error(libhttp.Error(
+			fmt.Errorf(
+				"freight %q has already been approved for Stage %q by %q",
+				freight.Name, stageName, approval.Approver,
+			),
+			http.StatusConflict,
+		))
+		return
 	}
-	newStatus.AddApprovedStage(stageName, time.Now())
 
-	if err := kubeclient.PatchStatus(
-		ctx,
-		s.client,
-		freight,
-		func(status *kargoapi.FreightStatus) {
-			*status = newStatus
-		},
-	); err != nil {
-		_ = c.Error(fmt.Errorf("patch freight status: %w", err))
+	approved, err := api.ApproveFreight(ctx, s.client, freight, stage, approval)
+	if errors.Is(err, api.ErrDuplicateApproval) {
+		_ = c.Error(libhttp.Error(
+			fmt.Errorf(
+				"freight %q has already been approved for Stage %q by %q",
+				freight.Name, stageName, approval.Approver,
+			),
+			http.StatusConflict,
+		))
+		return
+	}
+	if err != nil {
+		_ = c.Error(fmt.Errorf("approve freight: %w", err))
+		return
+	}
+	if !approved {
+		// More approvals are required before the Freight is approved.
+		c.Status(http.StatusOK)
 		return
 	}
 
@@ -303,12 +303,14 @@ func TestApproveFreight(t *testing.T) {
 				) error {
 					return nil
 				},
-				patchFreightStatusFn: func(
+				approveFreightFn: func(
 					context.Context,
+					client.Client,
 					*kargoapi.Freight,
-					kargoapi.FreightStatus,
-				) error {
-					return errors.New("something went wrong")
+					*kargoapi.Stage,
+					kargoapi.FreightApproval,
+				) (bool, error) {
+					return false, errors.New("something went wrong")
 				},
 			},
 			assertions: func(
@@ -318,11 +320,11 @@ func TestApproveFreight(t *testing.T) {
 				err error,
 			) {
 				require.Error(t, err)
-				require.Equal(t, "patch status: something went wrong", err.Error())
+				require.Equal(t, "approve freight: something went wrong", err.Error())
 			},
 		},
 		{
-			name: "success",
+			name: "approver not permitted by approval policy",
 			req: &svcv1alpha1.ApproveFreightRequest{
 				Project: "fake-project",
 				Name:    "fake-freight",
@@ -346,7 +348,14 @@ func TestApproveFreight(t *testing.T) {
 					client.Client,
 					types.NamespacedName,
 				) (*kargoapi.Stage, error) {
-					return &kargoapi.Stage{}, nil
+					return &kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{Name: "fake-stage"},
+						Spec: kargoapi.StageSpec{
+							ApprovalPolicy: &kargoapi.ApprovalPolicy{
+								ApproverGroups: []string{"release-managers"},
+							},
+						},
+					}, nil
 				},
 				authorizeFn: func(
 					context.Context,
@@ -357,13 +366,197 @@ func TestApproveFreight(t *testing.T) {
 				) error {
 					return nil
 				},
-				patchFreightStatusFn: func(
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.ApproveFreightResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodePermissionDenied, connErr.Code())
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name: "already approved by the same approver",
+			req: &svcv1alpha1.ApproveFreightRequest{
+				Project: "fake-project",
+				Name:    "fake-freight",
+				Stage:   "fake-stage",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getFreightByNameOrAliasFn: func(
 					context.Context,
+					client.Client,
+					string,
+					string,
+					string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{Name: "fake-freight"},
+						Status: kargoapi.FreightStatus{
+							PendingApprovals: map[string]kargoapi.PendingApproval{
+								"fake-stage": {
+									Approvals: []kargoapi.FreightApproval{{
+										Approver: kargoapi.EventActorUnknown,
+									}},
+								},
+							},
+						},
+					}, nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{Name: "fake-stage"},
+						Spec: kargoapi.StageSpec{
+							ApprovalPolicy: &kargoapi.ApprovalPolicy{RequiredApprovals: 2},
+						},
+					}, nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.ApproveFreightResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodeAlreadyExists, connErr.Code())
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name: "additional approvals required",
+			req: &svcv1alpha1.ApproveFreightRequest{
+				Project: "fake-project",
+				Name:    "fake-freight",
+				Stage:   "fake-stage",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string,
+					string,
+					string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{Name: "fake-stage"},
+						Spec: kargoapi.StageSpec{
+							ApprovalPolicy: &kargoapi.ApprovalPolicy{RequiredApprovals: 2},
+						},
+					}, nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				approveFreightFn: func(
+					context.Context,
+					client.Client,
 					*kargoapi.Freight,
-					kargoapi.FreightStatus,
+					*kargoapi.Stage,
+					kargoapi.FreightApproval,
+				) (bool, error) {
+					return false, nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.ApproveFreightResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				// No event is recorded until the Freight is approved
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name: "success",
+			req: &svcv1alpha1.ApproveFreightRequest{
+				Project: "fake-project",
+				Name:    "fake-freight",
+				Stage:   "fake-stage",
+				Comment: "fake-comment",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string,
+					string,
+					string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{}, nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
 				) error {
 					return nil
 				},
+				approveFreightFn: func(
+					_ context.Context,
+					_ client.Client,
+					_ *kargoapi.Freight,
+					_ *kargoapi.Stage,
+					approval kargoapi.FreightApproval,
+				) (bool, error) {
+					if approval.Comment != "fake-comment" {
+						return false, errors.New("unexpected comment")
+					}
+					return true, nil
+				},
 			},
 			assertions: func(
 				t *testing.T,
@@ -495,6 +688,46 @@ func Test_server_approveFreight(t *testing.T) {
 					require.NoError(t, err)
 					require.True(t, freight.IsApprovedFor(testStage.Name))
 					require.Contains(t, freight.Status.ApprovedFor, testStage.Name)
+					require.Len(t, freight.Status.ApprovedFor[testStage.Name].Approvals, 1)
+				},
+			},
+			{
+				name: "records approval pending additional approvals",
+				clientBuilder: fake.NewClientBuilder().
+					WithObjects(
+						testProject,
+						testFreight,
+						func() *kargoapi.Stage {
+							s := testStage.DeepCopy()
+							s.Spec.ApprovalPolicy = &kargoapi.ApprovalPolicy{RequiredApprovals: 2}
+							return s
+						}(),
+					).
+					WithStatusSubresource(testFreight),
+				serverSetup: func(_ *testing.T, s *server) {
+					s.authorizeFn = func(
+						context.Context,
+						string,
+						schema.GroupVersionResource,
+						string,
+						client.ObjectKey,
+					) error {
+						return nil
+					}
+				},
+				assertions: func(t *testing.T, w *httptest.ResponseRecorder, c client.Client) {
+					require.Equal(t, http.StatusOK, w.Code)
+
+					// Verify the approval was recorded without approving the Freight
+					freight := &kargoapi.Freight{}
+					err := c.Get(
+						t.Context(),
+						client.ObjectKeyFromObject(testFreight),
+						freight,
+					)
+					require.NoError(t, err)
+					require.False(t, freight.IsApprovedFor(testStage.Name))
+					require.Len(t, freight.Status.PendingApprovals[testStage.Name].Approvals, 1)
 				},
 			},
 		},
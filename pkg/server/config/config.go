@@ -34,6 +34,7 @@ type ServerConfig struct {
 	AnalysisRunLogURLTemplate   string
 	AnalysisRunLogToken         string
 	AnalysisRunLogHTTPHeaders   map[string]string
+	ContainerStepsNamespace     string
 	SharedResourcesNamespace    string
 	SystemResourcesNamespace    string
 	KargoNamespace              string
@@ -100,6 +101,7 @@ func ServerConfigFromEnv() ServerConfig {
 		"kargo-shared-resources",
 	)
 	cfg.KargoNamespace = os.GetEnv("KARGO_NAMESPACE", "kargo")
+	cfg.ContainerStepsNamespace = os.GetEnv("CONTAINER_STEPS_NAMESPACE", "")
 	return cfg
 }
 
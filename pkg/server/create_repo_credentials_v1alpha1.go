@@ -79,11 +79,12 @@ func (s *server) validateCreateRepoCredentialsRequest(
 	switch req.GetType() {
 	case kargoapi.LabelValueCredentialTypeGit,
 		kargoapi.LabelValueCredentialTypeHelm,
-		kargoapi.LabelValueCredentialTypeImage:
+		kargoapi.LabelValueCredentialTypeImage,
+		kargoapi.LabelValueCredentialTypeS3:
 	default:
 		return connect.NewError(
 			connect.CodeInvalidArgument,
-			errors.New("type should be one of git, helm, or image"),
+			errors.New("type should be one of git, helm, image, or s3"),
 		)
 	}
 	if req.GetRepoUrl() == "" {
@@ -221,9 +222,10 @@ func (s *server) validateRESTCreateRepoCredentialsRequest(
 	switch req.Type {
 	case kargoapi.LabelValueCredentialTypeGit,
 		kargoapi.LabelValueCredentialTypeHelm,
-		kargoapi.LabelValueCredentialTypeImage:
+		kargoapi.LabelValueCredentialTypeImage,
+		kargoapi.LabelValueCredentialTypeS3:
 	default:
-		return errors.New("type should be one of git, helm, or image")
+		return errors.New("type should be one of git, helm, image, or s3")
 	}
 	if req.RepoURL == "" {
 		return errors.New("repoURL should not be empty")
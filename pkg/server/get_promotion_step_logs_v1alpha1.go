@@ -0,0 +1,155 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/text/encoding"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libhttp "github.com/akuity/kargo/pkg/http"
+	"github.com/akuity/kargo/pkg/logging"
+	"github.com/akuity/kargo/pkg/promotion/container"
+)
+
+// @id GetPromotionStepLogs
+// @Summary Stream Promotion step logs
+// @Description Stream logs from a container-run Promotion step as Server-Sent Events (SSE).
+// @Tags Core, Project-Level
+// @Security BearerAuth
+// @Param project path string true "Project name"
+// @Param promotion path string true "Promotion name"
+// @Param step path string true "Step alias"
+// @Produce text/event-stream
+// @Success 200 {string} string "Log stream (SSE)"
+// @Router /v1beta1/projects/{project}/promotions/{promotion}/steps/{step}/logs [get]
+func (s *server) getPromotionStepLogs(c *gin.Context) {
+	if s.cfg.ContainerStepsNamespace == "" {
+		_ = c.Error(libhttp.ErrorStr(
+			"Promotion step log streaming is not configured",
+			http.StatusNotImplemented,
+		))
+		return
+	}
+
+	ctx := c.Request.Context()
+	logger := logging.LoggerFromContext(ctx)
+
+	project := c.Param("project")
+	name := c.Param("promotion")
+	stepAlias := c.Param("step")
+
+	// Retrieving the Promotion first ensures the user is authorized to view it
+	// and, by extension, the logs of its steps.
+	promo := &kargoapi.Promotion{}
+	if err := s.client.Get(
+		ctx,
+		client.ObjectKey{Namespace: project, Name: name},
+		promo,
+	); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	jobName := container.JobName(project, name, stepAlias)
+	logs, err := s.getContainerStepLogsFn(
+		ctx,
+		s.cfg.ContainerStepsNamespace,
+		jobName,
+		&corev1.PodLogOptions{
+			Container: container.ContainerName,
+			Follow:    true,
+		},
+	)
+	if err != nil {
+		if errors.Is(err, container.ErrNoPod) {
+			_ = c.Error(libhttp.ErrorStr(
+				fmt.Sprintf(
+					"no logs found for step %q of Promotion %q in namespace %q",
+					stepAlias, name, project,
+				),
+				http.StatusNotFound,
+			))
+			return
+		}
+		_ = c.Error(err)
+		return
+	}
+	defer logs.Close()
+
+	const bufferSize = 4096 // 4 KB
+
+	// Container logs retrieved from the Kubernetes API are always UTF-8.
+	logCh, err := streamLogs(ctx, bufio.NewReader(logs), encoding.Nop.NewDecoder(), bufferSize)
+	if err != nil {
+		_ = c.Error(fmt.Errorf("error streaming logs: %w", err))
+		return
+	}
+
+	setSSEHeaders(c)
+
+	for {
+		select {
+		case chunk, ok := <-logCh:
+			if !ok {
+				// Channel closed
+				return
+			}
+
+			if chunk.Error != nil {
+				// Error reading log data
+				logger.Error(chunk.Error, "error streaming logs")
+				return
+			}
+
+			// Write log chunk as SSE event
+			// Split on newlines and prefix each line with "data: " per SSE spec
+			lines := strings.Split(chunk.Data, "\n")
+			for _, line := range lines {
+				if _, err := fmt.Fprintf(c.Writer, "data: %s\n", line); err != nil {
+					logger.Debug("failed to write log line", "error", err)
+					return
+				}
+			}
+			// Empty line terminates the SSE event
+			if _, err := fmt.Fprint(c.Writer, "\n"); err != nil {
+				logger.Debug("failed to write event terminator", "error", err)
+				return
+			}
+
+			// Flush to ensure the data is sent immediately
+			c.Writer.Flush()
+
+		case <-ctx.Done():
+			logger.Debug("context done", "error", ctx.Err())
+			return
+		}
+	}
+}
+
+// getContainerStepLogs returns a stream of the logs of the specified Job
+// executing a container step.
+func (s *server) getContainerStepLogs(
+	ctx context.Context,
+	namespace string,
+	jobName string,
+	opts *corev1.PodLogOptions,
+) (io.ReadCloser, error) {
+	if s.cfg.RestConfig == nil { // This shouldn't happen, but just in case...
+		return nil, errors.New("no REST config available for streaming logs")
+	}
+	clientset, err := kubernetes.NewForConfig(s.cfg.RestConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Kubernetes clientset: %w", err)
+	}
+	return container.GetLogs(ctx, clientset, namespace, jobName, opts)
+}
@@ -0,0 +1,105 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion/container"
+	"github.com/akuity/kargo/pkg/server/config"
+)
+
+func Test_server_getPromotionStepLogs(t *testing.T) {
+	testProject := &kargoapi.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "fake-project"},
+	}
+	testPromo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testProject.Name,
+			Name:      "fake-promotion",
+		},
+	}
+	testCfg := &config.ServerConfig{ContainerStepsNamespace: testKargoNamespace}
+	testRESTEndpoint(
+		t, testCfg,
+		http.MethodGet,
+		"/v1beta1/projects/"+testProject.Name+"/promotions/"+testPromo.Name+"/steps/step-1/logs",
+		[]restTestCase{
+			{
+				name:          "log streaming not configured",
+				clientBuilder: fake.NewClientBuilder().WithObjects(testProject, testPromo),
+				serverConfig:  &config.ServerConfig{},
+				assertions: func(t *testing.T, w *httptest.ResponseRecorder, _ client.Client) {
+					require.Equal(t, http.StatusNotImplemented, w.Code)
+				},
+			},
+			{
+				name: "Project does not exist",
+				assertions: func(t *testing.T, w *httptest.ResponseRecorder, _ client.Client) {
+					require.Equal(t, http.StatusNotFound, w.Code)
+				},
+			},
+			{
+				name:          "Promotion does not exist",
+				clientBuilder: fake.NewClientBuilder().WithObjects(testProject),
+				assertions: func(t *testing.T, w *httptest.ResponseRecorder, _ client.Client) {
+					require.Equal(t, http.StatusNotFound, w.Code)
+				},
+			},
+			{
+				name:          "Job has no Pod",
+				clientBuilder: fake.NewClientBuilder().WithObjects(testProject, testPromo),
+				serverSetup: func(_ *testing.T, s *server) {
+					s.getContainerStepLogsFn = func(
+						context.Context,
+						string,
+						string,
+						*corev1.PodLogOptions,
+					) (io.ReadCloser, error) {
+						return nil, container.ErrNoPod
+					}
+				},
+				assertions: func(t *testing.T, w *httptest.ResponseRecorder, _ client.Client) {
+					require.Equal(t, http.StatusNotFound, w.Code)
+				},
+			},
+			{
+				name:          "streams logs",
+				clientBuilder: fake.NewClientBuilder().WithObjects(testProject, testPromo),
+				serverSetup: func(t *testing.T, s *server) {
+					s.getContainerStepLogsFn = func(
+						_ context.Context,
+						namespace string,
+						jobName string,
+						opts *corev1.PodLogOptions,
+					) (io.ReadCloser, error) {
+						require.Equal(t, testKargoNamespace, namespace)
+						require.Equal(
+							t,
+							container.JobName(testProject.Name, testPromo.Name, "step-1"),
+							jobName,
+						)
+						require.Equal(t, container.ContainerName, opts.Container)
+						return io.NopCloser(strings.NewReader("line 1\nline 2")), nil
+					}
+				},
+				assertions: func(t *testing.T, w *httptest.ResponseRecorder, _ client.Client) {
+					require.Equal(t, http.StatusOK, w.Code)
+					require.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+					require.Contains(t, w.Body.String(), "data: line 1\n")
+					require.Contains(t, w.Body.String(), "data: line 2\n")
+				},
+			},
+		},
+	)
+}
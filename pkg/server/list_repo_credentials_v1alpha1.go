@@ -53,6 +53,7 @@ func (s *server) ListRepoCredentials(
 			kargoapi.LabelValueCredentialTypeGit,
 			kargoapi.LabelValueCredentialTypeHelm,
 			kargoapi.LabelValueCredentialTypeImage,
+			kargoapi.LabelValueCredentialTypeS3,
 		})
 
 	if err != nil {
@@ -111,6 +112,7 @@ func (s *server) listProjectRepoCredentials(c *gin.Context) {
 			kargoapi.LabelValueCredentialTypeGit,
 			kargoapi.LabelValueCredentialTypeHelm,
 			kargoapi.LabelValueCredentialTypeImage,
+			kargoapi.LabelValueCredentialTypeS3,
 		})
 	if err != nil {
 		_ = c.Error(err)
@@ -162,6 +164,7 @@ func (s *server) listSharedRepoCredentials(c *gin.Context) {
 			kargoapi.LabelValueCredentialTypeGit,
 			kargoapi.LabelValueCredentialTypeHelm,
 			kargoapi.LabelValueCredentialTypeImage,
+			kargoapi.LabelValueCredentialTypeS3,
 		})
 	if err != nil {
 		_ = c.Error(err)
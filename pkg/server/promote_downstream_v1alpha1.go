@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/gin-gonic/gin"
@@ -14,6 +15,7 @@ import (
 
 	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/api"
 	libhttp "github.com/akuity/kargo/pkg/http"
 	"github.com/akuity/kargo/pkg/kargo"
 )
@@ -133,6 +135,9 @@ func (s *server) PromoteDownstream(
 				),
 			)
 		}
+		if err = s.checkPromotionPermitted(ctx, &downstream, freight); err != nil {
+			return nil, err
+		}
 	}
 
 	promoteErrs := make([]error, 0, len(downstreams))
@@ -316,7 +321,8 @@ func (s *server) promoteDownstream(c *gin.Context) {
 		}
 	}
 
-	// Validate that freight is available to all downstream stages
+	// Validate that freight is available to all downstream stages and that
+	// their ApprovalPolicies and PromotionPolicies currently permit promotion
 	for _, downstream := range downstreams {
 		if !downstream.IsFreightAvailable(freight) {
 			_ = c.Error(libhttp.ErrorStr(
@@ -325,6 +331,22 @@ func (s *server) promoteDownstream(c *gin.Context) {
 			))
 			return
 		}
+		if err := checkSelfApproval(ctx, &downstream, freight); err != nil {
+			_ = c.Error(libhttp.Error(err, http.StatusConflict))
+			return
+		}
+		block, err := api.GetPromotionBlock(ctx, s.client, downstream.ObjectMeta, time.Now())
+		if err != nil {
+			_ = c.Error(fmt.Errorf("evaluate promotion policy: %w", err))
+			return
+		}
+		if block != nil {
+			_ = c.Error(libhttp.ErrorStr(
+				fmt.Sprintf("Promotion to downstream Stage %q is not permitted: %s", downstream.Name, block.Message),
+				http.StatusConflict,
+			))
+			return
+		}
 	}
 
 	// Create promotions for all downstream stages
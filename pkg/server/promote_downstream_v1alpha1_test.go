@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/stretchr/testify/require"
@@ -462,6 +463,115 @@ func TestPromoteDownstream(t *testing.T) {
 				require.Contains(t, connErr.Message(), "is not available to downstream Stage")
 			},
 		},
+		{
+			name: "promotion not permitted",
+			req: &svcv1alpha1.PromoteDownstreamRequest{
+				Project: "fake-project",
+				Stage:   "fake-stage",
+				Freight: "fake-freight",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-project",
+							Name:      "fake-stage",
+						},
+						Spec: testStageSpec,
+					}, nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string, string, string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-project",
+							Name:      "fake-freight",
+						},
+						Status: kargoapi.FreightStatus{
+							VerifiedIn: map[string]kargoapi.VerifiedStage{
+								"fake-stage": {},
+							},
+						},
+					}, nil
+				},
+				findDownstreamStagesFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					kargoapi.FreightOrigin,
+				) ([]kargoapi.Stage, error) {
+					return []kargoapi.Stage{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "fake-project",
+								Name:      "fake-downstream-stage",
+							},
+							Spec: kargoapi.StageSpec{
+								RequestedFreight: []kargoapi.FreightRequest{{
+									Sources: kargoapi.FreightSources{
+										Stages: []string{"fake-stage"},
+									},
+								}},
+								PromotionTemplate: &kargoapi.PromotionTemplate{
+									Spec: kargoapi.PromotionTemplateSpec{
+										Steps: []kargoapi.PromotionStep{{}},
+									},
+								},
+							},
+						},
+					}, nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				getPromotionBlockFn: func(
+					context.Context,
+					client.Client,
+					metav1.ObjectMeta,
+					time.Time,
+				) (*kargoapi.PromotionBlock, error) {
+					return &kargoapi.PromotionBlock{
+						Reason:  kargoapi.PromotionBlockReasonBlackout,
+						Name:    "holidays",
+						Message: "promotion is blocked by blackout \"holidays\"",
+					}, nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.PromoteDownstreamResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodeFailedPrecondition, connErr.Code())
+				require.Contains(t, connErr.Message(), "blocked by blackout")
+			},
+		},
 		{
 			name: "error creating Promotion",
 			req: &svcv1alpha1.PromoteDownstreamRequest{
@@ -538,6 +648,14 @@ func TestPromoteDownstream(t *testing.T) {
 				) error {
 					return nil
 				},
+				getPromotionBlockFn: func(
+					context.Context,
+					client.Client,
+					metav1.ObjectMeta,
+					time.Time,
+				) (*kargoapi.PromotionBlock, error) {
+					return nil, nil
+				},
 				createPromotionFn: func(
 					context.Context,
 					client.Object,
@@ -635,6 +753,14 @@ func TestPromoteDownstream(t *testing.T) {
 				) error {
 					return nil
 				},
+				getPromotionBlockFn: func(
+					context.Context,
+					client.Client,
+					metav1.ObjectMeta,
+					time.Time,
+				) (*kargoapi.PromotionBlock, error) {
+					return nil, nil
+				},
 				createPromotionFn: func(
 					context.Context,
 					client.Object,
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/gin-gonic/gin"
@@ -118,6 +119,10 @@ func (s *server) PromoteToStage(
 		)
 	}
 
+	if err = s.checkPromotionPermitted(ctx, stage, freight); err != nil {
+		return nil, err
+	}
+
 	promotion, err := kargo.NewPromotionBuilder(s.client).Build(ctx, *stage, freight.Name)
 	if err != nil {
 		return nil, fmt.Errorf("build promotion: %w", err)
@@ -138,6 +143,54 @@ func (s *server) isFreightAvailable(
 	return stage.IsFreightAvailable(freight)
 }
 
+// checkPromotionPermitted returns a FailedPrecondition error if the
+// PromotionPolicy applicable to the provided Stage does not currently permit
+// Freight to be promoted into it, or if the Stage's ApprovalPolicy does not
+// permit the user associated with the provided context to promote the
+// provided Freight into it.
+func (s *server) checkPromotionPermitted(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	freight *kargoapi.Freight,
+) error {
+	if err := checkSelfApproval(ctx, stage, freight); err != nil {
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	block, err := s.getPromotionBlockFn(ctx, s.client, stage.ObjectMeta, time.Now())
+	if err != nil {
+		return fmt.Errorf("evaluate promotion policy: %w", err)
+	}
+	if block != nil {
+		return connect.NewError(
+			connect.CodeFailedPrecondition,
+			fmt.Errorf("promotion to Stage %q is not permitted: %s", stage.Name, block.Message),
+		)
+	}
+	return nil
+}
+
+// checkSelfApproval returns an error if the provided Stage's ApprovalPolicy
+// prohibits the user associated with the provided context from promoting the
+// provided Freight into the Stage because they approved it themselves.
+func checkSelfApproval(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	freight *kargoapi.Freight,
+) error {
+	promoter := kargoapi.EventActorUnknown
+	if u, ok := user.InfoFromContext(ctx); ok {
+		promoter = api.FormatEventUserActor(u)
+	}
+	if api.IsSelfApprovedPromotion(stage, freight, promoter) {
+		// nolint:staticcheck
+		return fmt.Errorf(
+			"Freight %q was approved for Stage %q by %q, who may not also promote it",
+			freight.Name, stage.Name, promoter,
+		)
+	}
+	return nil
+}
+
 func (s *server) recordPromotionCreatedEvent(
 	ctx context.Context,
 	p *kargoapi.Promotion,
@@ -160,12 +213,17 @@ func (s *server) recordPromotionCreatedEvent(
 type promoteToStageRequest struct {
 	Freight      string `json:"freight,omitempty"`
 	FreightAlias string `json:"freightAlias,omitempty"`
+	// DryRun indicates that the Promotion should only plan, and not execute,
+	// any steps with side effects beyond its working directory.
+	DryRun bool `json:"dryRun,omitempty"`
 } // @name PromoteToStageRequest
 
 // @id PromoteToStage
 // @Summary Promote to Stage
 // @Description Create a Promotion resource to transition a specified Stage into
-// @Description the state represented by the specified Freight.
+// @Description the state represented by the specified Freight. If dryRun is
+// @Description set, steps with side effects are planned rather than executed
+// @Description and the Stage is left unchanged.
 // @Tags Core, Project-Level
 // @Security BearerAuth
 // @Accept json
@@ -268,12 +326,37 @@ func (s *server) promoteToStage(c *gin.Context) {
 		return
 	}
 
+	// A dry run does not change the Stage, so the policies governing who may
+	// promote, and when, do not apply to it.
+	if !req.DryRun {
+		// Validate that the Stage's ApprovalPolicy permits the user to promote
+		if err := checkSelfApproval(ctx, stage, freight); err != nil {
+			_ = c.Error(libhttp.Error(err, http.StatusConflict))
+			return
+		}
+
+		// Validate that the Stage's PromotionPolicy currently permits promotion
+		block, err := api.GetPromotionBlock(ctx, s.client, stage.ObjectMeta, time.Now())
+		if err != nil {
+			_ = c.Error(fmt.Errorf("evaluate promotion policy: %w", err))
+			return
+		}
+		if block != nil {
+			_ = c.Error(libhttp.ErrorStr(
+				fmt.Sprintf("Promotion to Stage %q is not permitted: %s", stageName, block.Message),
+				http.StatusConflict,
+			))
+			return
+		}
+	}
+
 	// Build and create the Promotion
 	promotion, err := kargo.NewPromotionBuilder(s.client).Build(ctx, *stage, freight.Name)
 	if err != nil {
 		_ = c.Error(fmt.Errorf("build promotion: %w", err))
 		return
 	}
+	promotion.Spec.DryRun = req.DryRun
 
 	if err := s.client.Create(ctx, promotion); err != nil {
 		_ = c.Error(fmt.Errorf("create promotion: %w", err))
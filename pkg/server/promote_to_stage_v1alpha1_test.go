@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/stretchr/testify/require"
@@ -334,6 +335,152 @@ func TestPromoteToStage(t *testing.T) {
 				require.Contains(t, connErr.Message(), "is not available to Stage")
 			},
 		},
+		{
+			name: "self-approved promotion",
+			req: &svcv1alpha1.PromoteToStageRequest{
+				Project: "fake-project",
+				Stage:   "fake-stage",
+				Freight: "fake-freight",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-project",
+							Name:      "fake-stage",
+						},
+						Spec: func() kargoapi.StageSpec {
+							spec := *testStageSpec.DeepCopy()
+							spec.ApprovalPolicy = &kargoapi.ApprovalPolicy{
+								ProhibitSelfApproval: true,
+							}
+							return spec
+						}(),
+					}, nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string, string, string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-project",
+							Name:      "fake-freight",
+						},
+						Status: kargoapi.FreightStatus{
+							ApprovedFor: map[string]kargoapi.ApprovedStage{
+								"fake-stage": {
+									Approvals: []kargoapi.FreightApproval{{
+										Approver: kargoapi.EventActorUnknown,
+									}},
+								},
+							},
+						},
+					}, nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				isFreightAvailableFn: func(*kargoapi.Stage, *kargoapi.Freight) bool {
+					return true
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.PromoteToStageResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodeFailedPrecondition, connErr.Code())
+				require.Contains(t, connErr.Message(), "who may not also promote it")
+			},
+		},
+		{
+			name: "promotion not permitted",
+			req: &svcv1alpha1.PromoteToStageRequest{
+				Project: "fake-project",
+				Stage:   "fake-stage",
+				Freight: "fake-freight",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-project",
+							Name:      "fake-stage",
+						},
+						Spec: testStageSpec,
+					}, nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string, string, string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				isFreightAvailableFn: func(*kargoapi.Stage, *kargoapi.Freight) bool {
+					return true
+				},
+				getPromotionBlockFn: func(
+					context.Context,
+					client.Client,
+					metav1.ObjectMeta,
+					time.Time,
+				) (*kargoapi.PromotionBlock, error) {
+					return &kargoapi.PromotionBlock{
+						Reason:  kargoapi.PromotionBlockReasonBlackout,
+						Name:    "holidays",
+						Message: "promotion is blocked by blackout \"holidays\"",
+					}, nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.PromoteToStageResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodeFailedPrecondition, connErr.Code())
+				require.Contains(t, connErr.Message(), "blocked by blackout")
+			},
+		},
 		{
 			name: "error building Promotion",
 			req: &svcv1alpha1.PromoteToStageRequest{
@@ -377,6 +524,14 @@ func TestPromoteToStage(t *testing.T) {
 				isFreightAvailableFn: func(*kargoapi.Stage, *kargoapi.Freight) bool {
 					return true
 				},
+				getPromotionBlockFn: func(
+					context.Context,
+					client.Client,
+					metav1.ObjectMeta,
+					time.Time,
+				) (*kargoapi.PromotionBlock, error) {
+					return nil, nil
+				},
 			},
 			assertions: func(
 				t *testing.T,
@@ -435,6 +590,14 @@ func TestPromoteToStage(t *testing.T) {
 				isFreightAvailableFn: func(*kargoapi.Stage, *kargoapi.Freight) bool {
 					return true
 				},
+				getPromotionBlockFn: func(
+					context.Context,
+					client.Client,
+					metav1.ObjectMeta,
+					time.Time,
+				) (*kargoapi.PromotionBlock, error) {
+					return nil, nil
+				},
 				createPromotionFn: func(
 					context.Context,
 					client.Object,
@@ -500,6 +663,14 @@ func TestPromoteToStage(t *testing.T) {
 				isFreightAvailableFn: func(*kargoapi.Stage, *kargoapi.Freight) bool {
 					return true
 				},
+				getPromotionBlockFn: func(
+					context.Context,
+					client.Client,
+					metav1.ObjectMeta,
+					time.Time,
+				) (*kargoapi.PromotionBlock, error) {
+					return nil, nil
+				},
 				createPromotionFn: func(
 					context.Context,
 					client.Object,
@@ -706,6 +877,107 @@ func Test_server_promoteToStage(t *testing.T) {
 					require.Equal(t, http.StatusBadRequest, w.Code)
 				},
 			},
+			{
+				name: "promotion not permitted",
+				clientBuilder: fake.NewClientBuilder().WithObjects(
+					testProject,
+					testStage,
+					testFreight,
+					&kargoapi.ProjectConfig{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      testProject.Name,
+							Namespace: testProject.Name,
+						},
+						Spec: kargoapi.ProjectConfigSpec{
+							PromotionPolicies: []kargoapi.PromotionPolicy{{
+								StageSelector: &kargoapi.PromotionPolicySelector{
+									Name: testStage.Name,
+								},
+								Blackouts: []kargoapi.PromotionBlackout{{
+									Name:  "holidays",
+									Start: metav1.NewTime(time.Now().Add(-time.Hour)),
+									End:   metav1.NewTime(time.Now().Add(time.Hour)),
+								}},
+							}},
+						},
+					},
+				),
+				serverSetup: func(_ *testing.T, s *server) {
+					s.authorizeFn = func(
+						context.Context,
+						string,
+						schema.GroupVersionResource,
+						string,
+						client.ObjectKey,
+					) error {
+						return nil
+					}
+				},
+				body: mustJSONBody(promoteToStageRequest{
+					Freight: testFreight.Name,
+				}),
+				assertions: func(t *testing.T, w *httptest.ResponseRecorder, c client.Client) {
+					require.Equal(t, http.StatusConflict, w.Code)
+					require.Contains(t, w.Body.String(), "blocked by blackout")
+
+					// Verify no Promotion was created
+					promos := &kargoapi.PromotionList{}
+					err := c.List(t.Context(), promos, client.InNamespace(testProject.Name))
+					require.NoError(t, err)
+					require.Empty(t, promos.Items)
+				},
+			},
+			{
+				name: "dry run permitted despite blackout",
+				clientBuilder: fake.NewClientBuilder().WithObjects(
+					testProject,
+					testStage,
+					testFreight,
+					&kargoapi.ProjectConfig{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      testProject.Name,
+							Namespace: testProject.Name,
+						},
+						Spec: kargoapi.ProjectConfigSpec{
+							PromotionPolicies: []kargoapi.PromotionPolicy{{
+								StageSelector: &kargoapi.PromotionPolicySelector{
+									Name: testStage.Name,
+								},
+								Blackouts: []kargoapi.PromotionBlackout{{
+									Name:  "holidays",
+									Start: metav1.NewTime(time.Now().Add(-time.Hour)),
+									End:   metav1.NewTime(time.Now().Add(time.Hour)),
+								}},
+							}},
+						},
+					},
+				),
+				serverSetup: func(_ *testing.T, s *server) {
+					s.authorizeFn = func(
+						context.Context,
+						string,
+						schema.GroupVersionResource,
+						string,
+						client.ObjectKey,
+					) error {
+						return nil
+					}
+				},
+				body: mustJSONBody(promoteToStageRequest{
+					Freight: testFreight.Name,
+					DryRun:  true,
+				}),
+				assertions: func(t *testing.T, w *httptest.ResponseRecorder, c client.Client) {
+					require.Equal(t, http.StatusCreated, w.Code)
+
+					// Verify a dry run Promotion was created
+					promos := &kargoapi.PromotionList{}
+					err := c.List(t.Context(), promos, client.InNamespace(testProject.Name))
+					require.NoError(t, err)
+					require.Len(t, promos.Items, 1)
+					require.True(t, promos.Items[0].Spec.DryRun)
+				},
+			},
 			{
 				name:          "Successfully promote by freight name",
 				clientBuilder: fake.NewClientBuilder().WithObjects(testProject, testStage, testFreight),
@@ -185,6 +185,7 @@ func (s *server) setupRESTRouter(ctx context.Context) *gin.Engine {
 			project.GET("/promotions/:promotion", s.getPromotion)
 			project.POST("/promotions/:promotion/refresh", s.refreshPromotion)
 			project.POST("/promotions/:promotion/abort", s.abortPromotion)
+			project.GET("/promotions/:promotion/steps/:step/logs", s.getPromotionStepLogs)
 
 			// Promotion Tasks
 			project.GET("/promotion-tasks", s.listPromotionTasks)
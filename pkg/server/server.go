@@ -5,6 +5,7 @@ import (
 	"embed"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"net"
 	"net/http"
@@ -18,6 +19,8 @@ import (
 	"github.com/rs/cors"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -79,6 +82,12 @@ type server struct {
 		alias string,
 	) (*kargoapi.Freight, error)
 	isFreightAvailableFn func(*kargoapi.Stage, *kargoapi.Freight) bool
+	getPromotionBlockFn  func(
+		context.Context,
+		client.Client,
+		metav1.ObjectMeta,
+		time.Time,
+	) (*kargoapi.PromotionBlock, error)
 
 	// Common Promotions:
 	createPromotionFn func(
@@ -123,11 +132,13 @@ type server struct {
 	) error
 
 	// Freight approval:
-	patchFreightStatusFn func(
-		ctx context.Context,
-		freight *kargoapi.Freight,
-		newStatus kargoapi.FreightStatus,
-	) error
+	approveFreightFn func(
+		context.Context,
+		client.Client,
+		*kargoapi.Freight,
+		*kargoapi.Stage,
+		kargoapi.FreightApproval,
+	) (bool, error)
 
 	// Rollouts integration:
 	getAnalysisTemplateFn func(
@@ -148,6 +159,13 @@ type server struct {
 		types.NamespacedName,
 	) (*rolloutsapi.AnalysisRun, error)
 
+	getContainerStepLogsFn func(
+		ctx context.Context,
+		namespace string,
+		jobName string,
+		opts *corev1.PodLogOptions,
+	) (io.ReadCloser, error)
+
 	// Special authorizations:
 	authorizeFn func(
 		ctx context.Context,
@@ -180,6 +198,7 @@ func NewServer(
 	s.getStageFn = api.GetStage
 	s.getFreightByNameOrAliasFn = api.GetFreightByNameOrAlias
 	s.isFreightAvailableFn = s.isFreightAvailable
+	s.getPromotionBlockFn = api.GetPromotionBlock
 	s.createPromotionFn = kubeClient.Create
 	s.findDownstreamStagesFn = s.findDownstreamStages
 	s.listFreightFn = kubeClient.List
@@ -187,11 +206,12 @@ func NewServer(
 	s.getFreightFromWarehousesFn = s.getFreightFromWarehouses
 	s.getVerifiedFreightFn = s.getVerifiedFreight
 	s.patchFreightAliasFn = s.patchFreightAlias
-	s.patchFreightStatusFn = s.patchFreightStatus
+	s.approveFreightFn = api.ApproveFreight
 	s.authorizeFn = kubeClient.Authorize
 	s.getAnalysisTemplateFn = rollouts.GetAnalysisTemplate
 	s.getClusterAnalysisTemplateFn = rollouts.GetClusterAnalysisTemplate
 	s.getAnalysisRunFn = rollouts.GetAnalysisRun
+	s.getContainerStepLogsFn = s.getContainerStepLogs
 
 	return s
 }
@@ -63,7 +63,7 @@ func TestNewServer(t *testing.T) {
 	require.NotNil(t, s.getFreightFromWarehousesFn)
 	require.NotNil(t, s.getVerifiedFreightFn)
 	require.NotNil(t, s.patchFreightAliasFn)
-	require.NotNil(t, s.patchFreightStatusFn)
+	require.NotNil(t, s.approveFreightFn)
 	require.NotNil(t, s.authorizeFn)
 	require.NotNil(t, s.getAnalysisRunFn)
 }
@@ -216,9 +216,10 @@ func validateUpdateRepoCredentialsRequest(req updateRepoCredentialsRequest) erro
 	switch req.Type {
 	case kargoapi.LabelValueCredentialTypeGit,
 		kargoapi.LabelValueCredentialTypeHelm,
-		kargoapi.LabelValueCredentialTypeImage:
+		kargoapi.LabelValueCredentialTypeImage,
+		kargoapi.LabelValueCredentialTypeS3:
 	default:
-		return errors.New("type should be one of git, helm, or image")
+		return errors.New("type should be one of git, helm, image, or s3")
 	}
 	if req.RepoURL == "" {
 		return errors.New("repoUrl should not be empty")
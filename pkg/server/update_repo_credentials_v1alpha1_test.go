@@ -200,7 +200,7 @@ func TestValidateUpdateRepoCredentialsRequest(t *testing.T) {
 				Username: "user",
 				Password: "pass",
 			},
-			wantErr: "type should be one of git, helm, or image",
+			wantErr: "type should be one of git, helm, image, or s3",
 		},
 		{
 			name: "missing repoUrl",
@@ -110,7 +110,8 @@ func validateRepoCredentialSecret(secret *corev1.Secret) error {
 	}
 	if credType != kargoapi.LabelValueCredentialTypeGit &&
 		credType != kargoapi.LabelValueCredentialTypeHelm &&
-		credType != kargoapi.LabelValueCredentialTypeImage {
+		credType != kargoapi.LabelValueCredentialTypeImage &&
+		credType != kargoapi.LabelValueCredentialTypeS3 {
 		return libhttp.ErrorStr(
 			fmt.Sprintf(
 				"Kubernetes Secret %s/%s exists, but is labeled as unrecognized credential type %q",
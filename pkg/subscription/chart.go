@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/credentials"
@@ -38,6 +39,7 @@ type chartSubscriber struct {
 // discovers Helm chart versions from a Helm chart repository.
 func newChartSubscriber(
 	_ context.Context,
+	_ client.Client,
 	credentialsDB credentials.Database,
 ) (Subscriber, error) {
 	return &chartSubscriber{credentialsDB: credentialsDB}, nil
@@ -184,12 +186,28 @@ func (c *chartSubscriber) DiscoverArtifacts(
 	} else {
 		logger.Debug("discovered chart versions", "count", len(versions))
 	}
+	versions = trimSlice(versions, int(chartSub.DiscoveryLimit))
+
+	// appVersions are used only for correlating artifacts, so they are only
+	// determined when correlation is requested and failure to determine them is
+	// not fatal. Determining them may require fetching each version's chart
+	// metadata, so this is worth avoiding when possible.
+	var appVersions map[string]string
+	if CorrelationFromContext(ctx) {
+		if appVersions, err = selector.AppVersions(ctx, versions); err != nil {
+			logger.Error(err, "error determining appVersions of discovered chart versions")
+		}
+		if len(appVersions) == 0 {
+			appVersions = nil
+		}
+	}
 
 	return kargoapi.ChartDiscoveryResult{
 		RepoURL:          chartSub.RepoURL,
 		Name:             chartSub.Name,
 		SemverConstraint: chartSub.SemverConstraint,
-		Versions:         trimSlice(versions, int(chartSub.DiscoveryLimit)),
+		Versions:         versions,
+		AppVersions:      appVersions,
 	}, nil
 }
 
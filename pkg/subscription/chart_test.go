@@ -1,12 +1,15 @@
 package subscription
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/credentials"
 )
 
 func Test_chartSubscriber_ApplySubscriptionDefaults(t *testing.T) {
@@ -205,3 +208,69 @@ func Test_chartSubscriber_ValidateSubscription(t *testing.T) {
 		})
 	}
 }
+
+func Test_chartSubscriber_DiscoverArtifacts(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/index.yaml" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(`entries:
+  fake-chart:
+    - version: 1.0.0
+      appVersion: abc
+    - version: 1.1.0
+      appVersion: def
+`))
+		},
+	))
+	t.Cleanup(srv.Close)
+
+	sub := kargoapi.RepoSubscription{
+		Chart: &kargoapi.ChartSubscription{
+			RepoURL:        srv.URL,
+			Name:           "fake-chart",
+			DiscoveryLimit: 20,
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		correlated bool
+		assertions func(*testing.T, kargoapi.ChartDiscoveryResult)
+	}{
+		{
+			name: "appVersions are not determined without correlation",
+			assertions: func(t *testing.T, res kargoapi.ChartDiscoveryResult) {
+				require.Equal(t, []string{"1.1.0", "1.0.0"}, res.Versions)
+				require.Nil(t, res.AppVersions)
+			},
+		},
+		{
+			name:       "appVersions are determined with correlation",
+			correlated: true,
+			assertions: func(t *testing.T, res kargoapi.ChartDiscoveryResult) {
+				require.Equal(t, []string{"1.1.0", "1.0.0"}, res.Versions)
+				require.Equal(
+					t,
+					map[string]string{"1.0.0": "abc", "1.1.0": "def"},
+					res.AppVersions,
+				)
+			},
+		},
+	}
+	s := &chartSubscriber{credentialsDB: &credentials.FakeDB{}}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := t.Context()
+			if testCase.correlated {
+				ctx = ContextWithCorrelation(ctx)
+			}
+			res, err := s.DiscoverArtifacts(ctx, "fake-project", sub)
+			require.NoError(t, err)
+			require.IsType(t, kargoapi.ChartDiscoveryResult{}, res)
+			testCase.assertions(t, res.(kargoapi.ChartDiscoveryResult)) // nolint: forcetypeassert
+		})
+	}
+}
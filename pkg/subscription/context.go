@@ -0,0 +1,21 @@
+package subscription
+
+import "context"
+
+type correlationContextKey struct{}
+
+// ContextWithCorrelation returns a context.Context that has been augmented to
+// indicate that discovered artifacts will be correlated with one another.
+// Subscribers may use this to avoid gathering information that is only useful
+// for correlation.
+func ContextWithCorrelation(ctx context.Context) context.Context {
+	return context.WithValue(ctx, correlationContextKey{}, true)
+}
+
+// CorrelationFromContext returns true if the provided context.Context
+// indicates that discovered artifacts will be correlated with one another and
+// false otherwise.
+func CorrelationFromContext(ctx context.Context) bool {
+	correlated, _ := ctx.Value(correlationContextKey{}).(bool)
+	return correlated
+}
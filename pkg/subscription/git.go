@@ -2,12 +2,16 @@ package subscription
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
 	"slices"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/controller/git"
@@ -32,6 +36,7 @@ func init() {
 // gitSubscriber is an implementation of the Subscriber interface that discovers
 // commits from a Git repository.
 type gitSubscriber struct {
+	kubeClient    client.Client
 	credentialsDB credentials.Database
 }
 
@@ -39,9 +44,13 @@ type gitSubscriber struct {
 // discovers commits from a Git repository.
 func newGitSubscriber(
 	_ context.Context,
+	kubeClient client.Client,
 	credentialsDB credentials.Database,
 ) (Subscriber, error) {
-	return &gitSubscriber{credentialsDB: credentialsDB}, nil
+	return &gitSubscriber{
+		kubeClient:    kubeClient,
+		credentialsDB: credentialsDB,
+	}, nil
 }
 
 var (
@@ -151,6 +160,17 @@ func (g *gitSubscriber) ValidateSubscription(
 		))
 	}
 
+	// Validate Verification
+	if sub.Verification != nil {
+		if err := validation.MinLength(
+			f.Child("verification", "keysSecret"),
+			sub.Verification.KeysSecret,
+			1,
+		); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	return errs
 }
 
@@ -208,7 +228,25 @@ func (g *gitSubscriber) DiscoverArtifacts(
 		logger.Debug("found no credentials for git repo")
 	}
 
-	selector, err := commit.NewSelector(ctx, *gitSub, repoCreds)
+	var trustedSigningKeys map[string]string
+	if gitSub.Verification != nil {
+		if trustedSigningKeys, err = g.getTrustedSigningKeys(
+			ctx,
+			project,
+			*gitSub.Verification,
+		); err != nil {
+			return nil, fmt.Errorf(
+				"error obtaining trusted signing keys for git repo %q: %w",
+				gitSub.RepoURL, err,
+			)
+		}
+		logger.Debug(
+			"obtained trusted signing keys for git repo",
+			"count", len(trustedSigningKeys),
+		)
+	}
+
+	selector, err := commit.NewSelector(ctx, *gitSub, repoCreds, trustedSigningKeys)
 	if err != nil {
 		return nil, fmt.Errorf(
 			"error obtaining selector for commits from git repo %q: %w",
@@ -233,3 +271,37 @@ func (g *gitSubscriber) DiscoverArtifacts(
 		Commits: commits,
 	}, nil
 }
+
+// getTrustedSigningKeys returns the public keys of trusted signers, indexed by
+// the identities of those signers, from the Secret referenced by the provided
+// policy. The Secret is expected to reside in the Project's namespace.
+func (g *gitSubscriber) getTrustedSigningKeys(
+	ctx context.Context,
+	project string,
+	policy kargoapi.GitVerification,
+) (map[string]string, error) {
+	if g.kubeClient == nil {
+		return nil, errors.New(
+			"no Kubernetes client is available to retrieve trusted signing keys",
+		)
+	}
+	secret := &corev1.Secret{}
+	if err := g.kubeClient.Get(
+		ctx,
+		types.NamespacedName{
+			Namespace: project,
+			Name:      policy.KeysSecret,
+		},
+		secret,
+	); err != nil {
+		return nil, fmt.Errorf(
+			"error getting secret %q in namespace %q: %w",
+			policy.KeysSecret, project, err,
+		)
+	}
+	keys := make(map[string]string, len(secret.Data))
+	for identity, key := range secret.Data {
+		keys[identity] = string(key)
+	}
+	return keys, nil
+}
@@ -252,6 +252,19 @@ func Test_gitSubscriber_ValidateSubscription(t *testing.T) {
 				require.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
 			},
 		},
+		{
+			name: "Verification keysSecret empty",
+			sub: kargoapi.GitSubscription{
+				RepoURL:        "https://github.com/akuity/kargo.git",
+				DiscoveryLimit: 20,
+				Verification:   &kargoapi.GitVerification{},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "git.verification.keysSecret", errs[0].Field)
+				require.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
+			},
+		},
 		{
 			name: "valid",
 			sub: kargoapi.GitSubscription{
@@ -260,6 +273,10 @@ func Test_gitSubscriber_ValidateSubscription(t *testing.T) {
 				CommitSelectionStrategy: kargoapi.CommitSelectionStrategyNewestFromBranch,
 				SemverConstraint:        "^1.0.0",
 				DiscoveryLimit:          20,
+				Verification: &kargoapi.GitVerification{
+					KeysSecret: "signing-keys",
+					VerifyTags: true,
+				},
 			},
 			assertions: func(t *testing.T, errs field.ErrorList) {
 				require.Nil(t, errs)
@@ -2,12 +2,17 @@ package subscription
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
 	"slices"
 
+	"github.com/expr-lang/expr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/credentials"
@@ -42,6 +47,10 @@ const (
 	CacheByTagPolicyForce CacheByTagPolicy = "Force"
 )
 
+// defaultPublicKeySecretKey is the key within a Secret that is assumed to hold
+// the public key used to verify images when none is specified.
+const defaultPublicKeySecretKey = "cosign.pub"
+
 func init() {
 	DefaultSubscriberRegistry.MustRegister(SubscriberRegistration{
 		Predicate: func(
@@ -57,6 +66,7 @@ func init() {
 // imageSubscriber is an implementation of the Subscriber interface that
 // discovers container images from a container image repository.
 type imageSubscriber struct {
+	kubeClient       client.Client
 	credentialsDB    credentials.Database
 	cacheByTagPolicy CacheByTagPolicy
 }
@@ -65,9 +75,11 @@ type imageSubscriber struct {
 // discovers container images from a container image repository.
 func newImageSubscriber(
 	_ context.Context,
+	kubeClient client.Client,
 	credentialsDB credentials.Database,
 ) (Subscriber, error) {
 	return &imageSubscriber{
+		kubeClient:    kubeClient,
 		credentialsDB: credentialsDB,
 		cacheByTagPolicy: CacheByTagPolicy(
 			os.GetEnv(
@@ -95,6 +107,10 @@ func (i *imageSubscriber) ApplySubscriptionDefaults(
 	if sub.Image.DiscoveryLimit == 0 {
 		sub.Image.DiscoveryLimit = 20
 	}
+	if sub.Image.Verification != nil &&
+		sub.Image.Verification.PublicKeySecretKey == "" {
+		sub.Image.Verification.PublicKeySecretKey = defaultPublicKeySecretKey
+	}
 	return nil
 }
 
@@ -206,6 +222,48 @@ func (i *imageSubscriber) ValidateSubscription(
 		))
 	}
 
+	// Validate Verification
+	if sub.Verification != nil {
+		errs = append(
+			errs,
+			validateImageVerification(f.Child("verification"), *sub.Verification)...,
+		)
+	}
+
+	return errs
+}
+
+func validateImageVerification(
+	f *field.Path,
+	v kargoapi.ImageVerification,
+) field.ErrorList {
+	var errs field.ErrorList
+	if err := validation.MinLength(
+		f.Child("publicKeySecret"),
+		v.PublicKeySecret,
+		1,
+	); err != nil {
+		errs = append(errs, err)
+	}
+	for i, att := range v.Attestations {
+		attPath := f.Child("attestations").Index(i)
+		if err := validation.MinLength(
+			attPath.Child("predicateType"),
+			att.PredicateType,
+			1,
+		); err != nil {
+			errs = append(errs, err)
+		}
+		for j, cond := range att.Conditions {
+			if _, err := expr.Compile(cond, expr.AsBool()); err != nil {
+				errs = append(errs, field.Invalid(
+					attPath.Child("conditions").Index(j),
+					cond,
+					fmt.Sprintf("must be a valid boolean expression: %v", err),
+				))
+			}
+		}
+	}
 	return errs
 }
 
@@ -280,7 +338,17 @@ func (i *imageSubscriber) DiscoverArtifacts(
 		imgSub.CacheByTag = true
 	}
 
-	selector, err := image.NewSelector(ctx, *imgSub, regCreds)
+	var verifier *image.Verifier
+	if imgSub.Verification != nil {
+		if verifier, err = i.getVerifier(ctx, project, *imgSub.Verification); err != nil {
+			return nil, fmt.Errorf(
+				"error building verifier for image %q: %w",
+				imgSub.RepoURL, err,
+			)
+		}
+	}
+
+	selector, err := image.NewSelector(ctx, *imgSub, regCreds, verifier)
 	if err != nil {
 		return nil, fmt.Errorf(
 			"error obtaining selector for image %q: %w",
@@ -300,9 +368,60 @@ func (i *imageSubscriber) DiscoverArtifacts(
 		logger.Debug("discovered images", "count", len(images))
 	}
 
-	return kargoapi.ImageDiscoveryResult{
+	result := kargoapi.ImageDiscoveryResult{
 		RepoURL:    imgSub.RepoURL,
 		Platform:   imgSub.Platform,
 		References: images,
-	}, nil
+	}
+	if verifier != nil {
+		result.Rejected = verifier.Rejected()
+		if len(result.Rejected) > 0 {
+			logger.Debug(
+				"rejected images that failed verification",
+				"count", len(result.Rejected),
+			)
+		}
+	}
+	return result, nil
+}
+
+// getVerifier returns an image.Verifier that enforces the provided policy
+// using the public key found in the Secret referenced by the policy. The
+// Secret is expected to reside in the Project's namespace.
+func (i *imageSubscriber) getVerifier(
+	ctx context.Context,
+	project string,
+	policy kargoapi.ImageVerification,
+) (*image.Verifier, error) {
+	if i.kubeClient == nil {
+		return nil, errors.New(
+			"no Kubernetes client is available to retrieve the public key",
+		)
+	}
+	secret := &corev1.Secret{}
+	if err := i.kubeClient.Get(
+		ctx,
+		types.NamespacedName{
+			Namespace: project,
+			Name:      policy.PublicKeySecret,
+		},
+		secret,
+	); err != nil {
+		return nil, fmt.Errorf(
+			"error getting secret %q in namespace %q: %w",
+			policy.PublicKeySecret, project, err,
+		)
+	}
+	key := policy.PublicKeySecretKey
+	if key == "" {
+		key = defaultPublicKeySecretKey
+	}
+	publicKey, ok := secret.Data[key]
+	if !ok {
+		return nil, fmt.Errorf(
+			"secret %q in namespace %q has no key %q",
+			policy.PublicKeySecret, project, key,
+		)
+	}
+	return image.NewVerifier(policy, publicKey)
 }
@@ -37,6 +37,15 @@ func Test_imageSubscriber_ApplySubscriptionDefaults(t *testing.T) {
 		require.Equal(t, int64(9), sub.Image.DiscoveryLimit)
 	})
 
+	t.Run("defaults public key secret key", func(t *testing.T) {
+		sub := &kargoapi.RepoSubscription{Image: &kargoapi.ImageSubscription{
+			Verification: &kargoapi.ImageVerification{PublicKeySecret: "cosign"},
+		}}
+		err := s.ApplySubscriptionDefaults(t.Context(), sub)
+		require.NoError(t, err)
+		require.Equal(t, "cosign.pub", sub.Image.Verification.PublicKeySecretKey)
+	})
+
 	t.Run("no-op on nil image", func(t *testing.T) {
 		sub := &kargoapi.RepoSubscription{}
 		err := s.ApplySubscriptionDefaults(t.Context(), sub)
@@ -195,6 +204,46 @@ func Test_imageSubscriber_ValidateSubscription(t *testing.T) {
 				require.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
 			},
 		},
+		{
+			name: "Verification missing publicKeySecret",
+			sub: kargoapi.ImageSubscription{
+				RepoURL:        "ghcr.io/akuity/kargo",
+				CacheByTag:     true,
+				DiscoveryLimit: 20,
+				Verification:   &kargoapi.ImageVerification{},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "image.verification.publicKeySecret", errs[0].Field)
+			},
+		},
+		{
+			name: "Verification with invalid attestation policy",
+			sub: kargoapi.ImageSubscription{
+				RepoURL:        "ghcr.io/akuity/kargo",
+				CacheByTag:     true,
+				DiscoveryLimit: 20,
+				Verification: &kargoapi.ImageVerification{
+					PublicKeySecret: "cosign",
+					Attestations: []kargoapi.ImageAttestationPolicy{{
+						Conditions: []string{"predicate.("},
+					}},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 2)
+				require.Equal(
+					t,
+					"image.verification.attestations[0].predicateType",
+					errs[0].Field,
+				)
+				require.Equal(
+					t,
+					"image.verification.attestations[0].conditions[0]",
+					errs[1].Field,
+				)
+			},
+		},
 		{
 			name: "valid",
 			sub: kargoapi.ImageSubscription{
@@ -0,0 +1,490 @@
+package subscription
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/hashicorp/go-cleanhttp"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libSemver "github.com/akuity/kargo/pkg/controller/semver"
+	"github.com/akuity/kargo/pkg/credentials"
+	"github.com/akuity/kargo/pkg/logging"
+	"github.com/akuity/kargo/pkg/validation"
+)
+
+// SubscriptionTypeOCI is the SubscriptionType of generic subscriptions handled
+// by the OCI artifact subscriber.
+const SubscriptionTypeOCI = "oci"
+
+// maxOCIManifestFetches is the maximum number of manifests fetched from the
+// registry in a single discovery. Each tag matching a subscription's tag
+// criteria requires its manifest to be fetched before the artifact type and
+// annotation criteria can be applied, so without this cap, a repository with
+// many matching tags and selective criteria could result in an unbounded
+// number of requests to the registry. It is independent of the discovery
+// limit, which only bounds the number of artifacts discovered.
+const maxOCIManifestFetches = 100
+
+func init() {
+	DefaultSubscriberRegistry.MustRegister(SubscriberRegistration{
+		Predicate: func(
+			_ context.Context,
+			sub kargoapi.RepoSubscription,
+		) (bool, error) {
+			return sub.Subscription != nil &&
+				sub.Subscription.SubscriptionType == SubscriptionTypeOCI, nil
+		},
+		Value: newOCISubscriber,
+	})
+}
+
+// ociSubscriptionConfig is the configuration understood by the OCI artifact
+// subscriber. It is unpacked from the opaque Config field of a generic
+// kargoapi.Subscription.
+type ociSubscriptionConfig struct {
+	// RepoURL is the URL of a repository within an OCI registry. e.g.
+	// ghcr.io/example/terraform-module. It must not include a tag or digest.
+	RepoURL string `json:"repoURL"`
+	// ArtifactTypes is an optional list of artifact types that artifacts must
+	// match to be discovered. An artifact's type is the artifactType field of
+	// its manifest or, when that is not set, the media type of its config. When
+	// left unspecified, artifacts of any type are discovered.
+	ArtifactTypes []string `json:"artifactTypes,omitempty"`
+	// Annotations is an optional map of manifest annotations that artifacts must
+	// carry to be discovered. An empty value only requires the annotation to be
+	// present.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// SemverConstraint specifies constraints on what new versions are
+	// permissible. Tags that are not parseable as semantic versions are never
+	// discovered.
+	SemverConstraint string `json:"semverConstraint,omitempty"`
+	// StrictSemvers specifies whether only "strict" semver tags should be
+	// considered. When left unspecified, it is implicitly true.
+	StrictSemvers *bool `json:"strictSemvers,omitempty"`
+	// AllowTagsRegexes is an optional list of regular expressions that tags must
+	// match to be considered.
+	AllowTagsRegexes []string `json:"allowTagsRegexes,omitempty"`
+	// IgnoreTagsRegexes is an optional list of regular expressions that tags
+	// must NOT match to be considered.
+	IgnoreTagsRegexes []string `json:"ignoreTagsRegexes,omitempty"`
+	// InsecureSkipTLSVerify specifies whether certificate verification errors
+	// should be ignored when connecting to the registry.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+}
+
+// ociArtifactMetadata is the metadata recorded in each ArtifactReference
+// discovered by the OCI artifact subscriber.
+type ociArtifactMetadata struct {
+	RepoURL     string            `json:"repoURL"`
+	Tag         string            `json:"tag"`
+	Digest      string            `json:"digest"`
+	MediaType   string            `json:"mediaType,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// ociManifest is a minimal representation of an OCI image manifest or image
+// index, holding only the fields relevant to artifact discovery.
+type ociManifest struct {
+	MediaType    string `json:"mediaType,omitempty"`
+	ArtifactType string `json:"artifactType,omitempty"`
+	Config       *struct {
+		MediaType string `json:"mediaType,omitempty"`
+	} `json:"config,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// artifactType returns the type of the artifact described by the manifest. This
+// is the manifest's artifactType, if set, and the config's media type
+// otherwise. Image indices without an artifactType fall back to the index's
+// own media type.
+func (m ociManifest) artifactType() string {
+	if m.ArtifactType != "" {
+		return m.ArtifactType
+	}
+	if m.Config != nil && m.Config.MediaType != "" {
+		return m.Config.MediaType
+	}
+	return m.MediaType
+}
+
+// ociSubscriber is an implementation of the Subscriber interface that
+// discovers arbitrary (non-image, non-chart) artifacts from a repository in an
+// OCI registry.
+type ociSubscriber struct {
+	credentialsDB credentials.Database
+
+	remoteListFn func(name.Repository, ...remote.Option) ([]string, error)
+	remoteGetFn  func(name.Reference, ...remote.Option) (*remote.Descriptor, error)
+}
+
+// newOCISubscriber returns an implementation of the Subscriber interface that
+// discovers arbitrary artifacts from a repository in an OCI registry.
+func newOCISubscriber(
+	_ context.Context,
+	_ client.Client,
+	credentialsDB credentials.Database,
+) (Subscriber, error) {
+	return &ociSubscriber{
+		credentialsDB: credentialsDB,
+		remoteListFn:  remote.List,
+		remoteGetFn:   remote.Get,
+	}, nil
+}
+
+// ApplySubscriptionDefaults implements Subscriber.
+func (o *ociSubscriber) ApplySubscriptionDefaults(
+	context.Context,
+	*kargoapi.RepoSubscription,
+) error {
+	return nil
+}
+
+// ValidateSubscription implements Subscriber.
+func (o *ociSubscriber) ValidateSubscription(
+	_ context.Context,
+	f *field.Path,
+	s kargoapi.RepoSubscription,
+) field.ErrorList {
+	if s.Subscription == nil {
+		return nil
+	}
+	f = f.Child("config")
+	cfg, err := parseOCISubscriptionConfig(s.Subscription.Config)
+	if err != nil {
+		return field.ErrorList{field.Invalid(f, "", err.Error())}
+	}
+
+	var errs field.ErrorList
+
+	// Validate RepoURL: MinLength=1, Pattern (Image repo URL regex)
+	if err := validation.MinLength(f.Child("repoURL"), cfg.RepoURL, 1); err != nil {
+		errs = append(errs, err)
+	}
+	if !imageRepoURLRegex.MatchString(cfg.RepoURL) {
+		errs = append(errs, field.Invalid(
+			f.Child("repoURL"),
+			cfg.RepoURL,
+			"must be a valid OCI repository URL",
+		))
+	}
+
+	// Validate SemverConstraint
+	if err := validation.SemverConstraint(
+		f.Child("semverConstraint"),
+		cfg.SemverConstraint,
+	); err != nil {
+		errs = append(errs, err)
+	}
+
+	// Validate tag regexes
+	for i, r := range cfg.AllowTagsRegexes {
+		if _, err := regexp.Compile(r); err != nil {
+			errs = append(errs, field.Invalid(
+				f.Child("allowTagsRegexes").Index(i),
+				r,
+				fmt.Sprintf("must be a valid regular expression: %v", err),
+			))
+		}
+	}
+	for i, r := range cfg.IgnoreTagsRegexes {
+		if _, err := regexp.Compile(r); err != nil {
+			errs = append(errs, field.Invalid(
+				f.Child("ignoreTagsRegexes").Index(i),
+				r,
+				fmt.Sprintf("must be a valid regular expression: %v", err),
+			))
+		}
+	}
+
+	// Validate ArtifactTypes: each MinLength=1
+	for i, t := range cfg.ArtifactTypes {
+		if err := validation.MinLength(
+			f.Child("artifactTypes").Index(i),
+			t,
+			1,
+		); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+// DiscoverArtifacts implements Subscriber.
+func (o *ociSubscriber) DiscoverArtifacts(
+	ctx context.Context,
+	project string,
+	sub kargoapi.RepoSubscription,
+) (any, error) {
+	genericSub := sub.Subscription
+	if genericSub == nil {
+		return nil, nil
+	}
+
+	cfg, err := parseOCISubscriptionConfig(genericSub.Config)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error parsing configuration of subscription %q: %w",
+			genericSub.Name, err,
+		)
+	}
+
+	logger := logging.LoggerFromContext(ctx).WithValues(
+		"subscription", genericSub.Name,
+		"repo", cfg.RepoURL,
+	)
+
+	repo, err := name.NewRepository(cfg.RepoURL)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing OCI repo URL %q: %w", cfg.RepoURL, err)
+	}
+
+	remoteOpts, err := o.buildRemoteOptions(ctx, project, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := o.remoteListFn(repo, remoteOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing tags for OCI repo %q: %w", cfg.RepoURL, err)
+	}
+	if tags, err = filterAndSortOCITags(tags, cfg); err != nil {
+		return nil, err
+	}
+	logger.Trace("tags matched criteria", "count", len(tags))
+
+	refs := make([]kargoapi.ArtifactReference, 0, len(tags))
+	for i, tag := range tags {
+		if genericSub.DiscoveryLimit > 0 &&
+			len(refs) >= int(genericSub.DiscoveryLimit) {
+			break
+		}
+		if i >= maxOCIManifestFetches {
+			logger.Info(
+				"reached maximum number of manifests fetched in a single discovery; "+
+					"remaining tags were not considered",
+				"maxManifestFetches", maxOCIManifestFetches,
+				"remainingTags", len(tags)-i,
+			)
+			break
+		}
+		ref, err := o.getArtifactReference(repo.Tag(tag), genericSub.Name, cfg, remoteOpts)
+		if err != nil {
+			return nil, err
+		}
+		if ref != nil {
+			refs = append(refs, *ref)
+		}
+	}
+
+	if len(refs) == 0 {
+		logger.Debug("discovered no artifacts")
+	} else {
+		logger.Debug("discovered artifacts", "count", len(refs))
+	}
+
+	return kargoapi.DiscoveryResult{
+		SubscriptionName:   genericSub.Name,
+		ArtifactReferences: refs,
+	}, nil
+}
+
+// buildRemoteOptions returns options for interacting with the registry,
+// including credentials for the repository, if any are found.
+func (o *ociSubscriber) buildRemoteOptions(
+	ctx context.Context,
+	project string,
+	cfg ociSubscriptionConfig,
+) ([]remote.Option, error) {
+	httpTransport := cleanhttp.DefaultTransport()
+	if cfg.InsecureSkipTLSVerify {
+		httpTransport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: true, // nolint: gosec
+		}
+	}
+	opts := []remote.Option{
+		remote.WithContext(ctx),
+		remote.WithTransport(httpTransport),
+	}
+
+	// OCI artifacts are stored in the same registries as container images, so
+	// image credentials are used to access them.
+	creds, err := o.credentialsDB.Get(
+		ctx,
+		project,
+		credentials.TypeImage,
+		cfg.RepoURL,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error obtaining credentials for OCI repo %q: %w",
+			cfg.RepoURL, err,
+		)
+	}
+	logger := logging.LoggerFromContext(ctx)
+	if creds != nil {
+		opts = append(opts, remote.WithAuth(&authn.Basic{
+			Username: creds.Username,
+			Password: creds.Password,
+		}))
+		logger.Debug("obtained credentials for OCI repo")
+	} else {
+		logger.Debug("found no credentials for OCI repo")
+	}
+	return opts, nil
+}
+
+// getArtifactReference retrieves the manifest referenced by the provided tag
+// and, if it satisfies the subscription's artifact type and annotation
+// criteria, returns a corresponding ArtifactReference. If the criteria are not
+// satisfied, nil is returned.
+func (o *ociSubscriber) getArtifactReference(
+	tagRef name.Tag,
+	subName string,
+	cfg ociSubscriptionConfig,
+	remoteOpts []remote.Option,
+) (*kargoapi.ArtifactReference, error) {
+	desc, err := o.remoteGetFn(tagRef, remoteOpts...)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error getting manifest for tag %q from OCI repo %q: %w",
+			tagRef.TagStr(), cfg.RepoURL, err,
+		)
+	}
+	var manifest ociManifest
+	if err = json.Unmarshal(desc.Manifest, &manifest); err != nil {
+		return nil, fmt.Errorf(
+			"error parsing manifest for tag %q from OCI repo %q: %w",
+			tagRef.TagStr(), cfg.RepoURL, err,
+		)
+	}
+
+	artifactType := manifest.artifactType()
+	if len(cfg.ArtifactTypes) > 0 &&
+		!slices.Contains(cfg.ArtifactTypes, artifactType) {
+		return nil, nil
+	}
+	for k, v := range cfg.Annotations {
+		if actual, ok := manifest.Annotations[k]; !ok || (v != "" && actual != v) {
+			return nil, nil
+		}
+	}
+
+	digest := desc.Digest.String()
+	metadata, err := json.Marshal(ociArtifactMetadata{
+		RepoURL:     cfg.RepoURL,
+		Tag:         tagRef.TagStr(),
+		Digest:      digest,
+		MediaType:   string(desc.MediaType),
+		Annotations: manifest.Annotations,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling artifact metadata: %w", err)
+	}
+
+	return &kargoapi.ArtifactReference{
+		ArtifactType:     artifactType,
+		SubscriptionName: subName,
+		// Both tag and digest are incorporated into the version so that a
+		// re-pushed ("mutable") tag and a re-tagged digest are both recognized
+		// as new revisions.
+		Version:  fmt.Sprintf("%s@%s", tagRef.TagStr(), digest),
+		Metadata: &apiextensionsv1.JSON{Raw: metadata},
+	}, nil
+}
+
+// parseOCISubscriptionConfig unpacks the opaque configuration of a generic
+// subscription into an ociSubscriptionConfig.
+func parseOCISubscriptionConfig(
+	cfgJSON *apiextensionsv1.JSON,
+) (ociSubscriptionConfig, error) {
+	var cfg ociSubscriptionConfig
+	if cfgJSON == nil || len(cfgJSON.Raw) == 0 {
+		return cfg, fmt.Errorf("config is required")
+	}
+	if err := json.Unmarshal(cfgJSON.Raw, &cfg); err != nil {
+		return cfg, fmt.Errorf("config is not a valid OCI subscription config: %w", err)
+	}
+	return cfg, nil
+}
+
+// filterAndSortOCITags returns only those tags that satisfy the subscription's
+// tag criteria, sorted from highest to lowest semantic version.
+func filterAndSortOCITags(
+	tags []string,
+	cfg ociSubscriptionConfig,
+) ([]string, error) {
+	var constraint *semver.Constraints
+	if cfg.SemverConstraint != "" {
+		var err error
+		if constraint, err = semver.NewConstraint(cfg.SemverConstraint); err != nil {
+			return nil, fmt.Errorf(
+				"error parsing semver constraint %q: %w",
+				cfg.SemverConstraint, err,
+			)
+		}
+	}
+	allow, err := compileRegexes(cfg.AllowTagsRegexes)
+	if err != nil {
+		return nil, err
+	}
+	ignore, err := compileRegexes(cfg.IgnoreTagsRegexes)
+	if err != nil {
+		return nil, err
+	}
+	strict := cfg.StrictSemvers == nil || *cfg.StrictSemvers
+
+	versions := make([]*semver.Version, 0, len(tags))
+	for _, tag := range tags {
+		if len(allow) > 0 && !slices.ContainsFunc(
+			allow,
+			func(r *regexp.Regexp) bool { return r.MatchString(tag) },
+		) {
+			continue
+		}
+		if slices.ContainsFunc(
+			ignore,
+			func(r *regexp.Regexp) bool { return r.MatchString(tag) },
+		) {
+			continue
+		}
+		sv := libSemver.Parse(tag, strict)
+		if sv == nil || (constraint != nil && !constraint.Check(sv)) {
+			continue
+		}
+		versions = append(versions, sv)
+	}
+	slices.SortStableFunc(versions, func(a, b *semver.Version) int {
+		return b.Compare(a)
+	})
+
+	sorted := make([]string, len(versions))
+	for i, sv := range versions {
+		sorted[i] = sv.Original()
+	}
+	return sorted, nil
+}
+
+// compileRegexes compiles all of the provided regular expressions.
+func compileRegexes(exprs []string) ([]*regexp.Regexp, error) {
+	regexes := make([]*regexp.Regexp, len(exprs))
+	for i, expr := range exprs {
+		var err error
+		if regexes[i], err = regexp.Compile(expr); err != nil {
+			return nil, fmt.Errorf("error compiling regular expression %q: %w", expr, err)
+		}
+	}
+	return regexes, nil
+}
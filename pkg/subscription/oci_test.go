@@ -0,0 +1,339 @@
+package subscription
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/credentials"
+)
+
+func Test_ociSubscriber_ValidateSubscription(t *testing.T) {
+	testCases := []struct {
+		name       string
+		config     string
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name:   "config missing",
+			config: "",
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "oci.config", errs[0].Field)
+				require.Contains(t, errs[0].Detail, "config is required")
+			},
+		},
+		{
+			name:   "config not an object",
+			config: `[]`,
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "oci.config", errs[0].Field)
+			},
+		},
+		{
+			name:   "repoURL empty",
+			config: `{}`,
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.NotEmpty(t, errs)
+				require.Equal(t, "oci.config.repoURL", errs[0].Field)
+			},
+		},
+		{
+			name:   "invalid semverConstraint",
+			config: `{"repoURL":"ghcr.io/example/module","semverConstraint":"bogus"}`,
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "oci.config.semverConstraint", errs[0].Field)
+			},
+		},
+		{
+			name:   "invalid tag regexes",
+			config: `{"repoURL":"ghcr.io/example/module","allowTagsRegexes":["("],"ignoreTagsRegexes":["["]}`,
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 2)
+				require.Equal(t, "oci.config.allowTagsRegexes[0]", errs[0].Field)
+				require.Equal(t, "oci.config.ignoreTagsRegexes[0]", errs[1].Field)
+			},
+		},
+		{
+			name:   "empty artifact type",
+			config: `{"repoURL":"ghcr.io/example/module","artifactTypes":[""]}`,
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "oci.config.artifactTypes[0]", errs[0].Field)
+			},
+		},
+		{
+			name: "valid",
+			config: `{
+				"repoURL": "ghcr.io/example/module",
+				"artifactTypes": ["application/vnd.example.module.v1"],
+				"annotations": {"org.opencontainers.image.vendor": "example"},
+				"semverConstraint": "^1.0.0",
+				"allowTagsRegexes": ["^v"]
+			}`,
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+	}
+	s := &ociSubscriber{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			sub := &kargoapi.Subscription{
+				SubscriptionType: SubscriptionTypeOCI,
+				Name:             "module",
+			}
+			if testCase.config != "" {
+				sub.Config = &apiextensionsv1.JSON{Raw: []byte(testCase.config)}
+			}
+			testCase.assertions(
+				t,
+				s.ValidateSubscription(
+					t.Context(),
+					field.NewPath("oci"),
+					kargoapi.RepoSubscription{Subscription: sub},
+				),
+			)
+		})
+	}
+}
+
+func Test_ociSubscriber_DiscoverArtifacts(t *testing.T) {
+	const (
+		moduleType = "application/vnd.example.module.v1"
+		pluginType = "application/vnd.example.plugin.v1"
+	)
+
+	srv := httptest.NewServer(registry.New())
+	t.Cleanup(srv.Close)
+	srvURL, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	repoURL := fmt.Sprintf("%s/example/artifacts", srvURL.Host)
+
+	push := func(tag string, manifest map[string]any) {
+		t.Helper()
+		raw, err := json.Marshal(manifest)
+		require.NoError(t, err)
+		ref, err := name.ParseReference(fmt.Sprintf("%s:%s", repoURL, tag))
+		require.NoError(t, err)
+		require.NoError(t, remote.Put(ref, rawManifest(raw)))
+	}
+	artifactManifest := func(
+		artifactType string,
+		annotations map[string]string,
+	) map[string]any {
+		return map[string]any{
+			"schemaVersion": 2,
+			"mediaType":     string(types.OCIManifestSchema1),
+			"artifactType":  artifactType,
+			"config": map[string]any{
+				"mediaType": "application/vnd.oci.empty.v1+json",
+				"digest":    "sha256:44136fa355b3678a1146ad16f7e8649e94fb4fc21fe77e8310c060f61caaff8a",
+				"size":      2,
+			},
+			"layers":      []any{},
+			"annotations": annotations,
+		}
+	}
+	push("v1.0.0", artifactManifest(moduleType, map[string]string{"tier": "backend"}))
+	push("v1.1.0", artifactManifest(moduleType, map[string]string{"tier": "frontend"}))
+	push("v1.2.0", artifactManifest(pluginType, map[string]string{"tier": "backend"}))
+	push("v2.0.0", artifactManifest(moduleType, map[string]string{"tier": "backend"}))
+	push("latest", artifactManifest(moduleType, nil))
+	// This one has no artifactType, so its config media type is its type.
+	push("v1.3.0", map[string]any{
+		"schemaVersion": 2,
+		"mediaType":     string(types.OCIManifestSchema1),
+		"config": map[string]any{
+			"mediaType": moduleType,
+			"digest":    "sha256:44136fa355b3678a1146ad16f7e8649e94fb4fc21fe77e8310c060f61caaff8a",
+			"size":      2,
+		},
+		"layers": []any{},
+	})
+
+	testCases := []struct {
+		name           string
+		config         string
+		discoveryLimit int32
+		credsDB        credentials.Database
+		assertions     func(*testing.T, any, error)
+	}{
+		{
+			name:    "error getting credentials",
+			config:  fmt.Sprintf(`{"repoURL":%q}`, repoURL),
+			credsDB: &credentials.FakeDB{GetFn: failingCredsGet},
+			assertions: func(t *testing.T, _ any, err error) {
+				require.ErrorContains(t, err, "error obtaining credentials")
+			},
+		},
+		{
+			name:   "filters by artifact type",
+			config: fmt.Sprintf(`{"repoURL":%q,"artifactTypes":[%q]}`, repoURL, moduleType),
+			assertions: func(t *testing.T, res any, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{"v2.0.0", "v1.3.0", "v1.1.0", "v1.0.0"},
+					discoveredTags(t, res),
+				)
+				result := res.(kargoapi.DiscoveryResult) // nolint: forcetypeassert
+				require.Equal(t, "artifacts", result.SubscriptionName)
+				for _, ref := range result.ArtifactReferences {
+					require.Equal(t, moduleType, ref.ArtifactType)
+					require.Equal(t, "artifacts", ref.SubscriptionName)
+				}
+			},
+		},
+		{
+			name: "filters by annotations and semver",
+			config: fmt.Sprintf(
+				`{"repoURL":%q,"annotations":{"tier":"backend"},"semverConstraint":"^1.0.0"}`,
+				repoURL,
+			),
+			assertions: func(t *testing.T, res any, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"v1.2.0", "v1.0.0"}, discoveredTags(t, res))
+			},
+		},
+		{
+			name:           "applies discovery limit",
+			config:         fmt.Sprintf(`{"repoURL":%q}`, repoURL),
+			discoveryLimit: 2,
+			assertions: func(t *testing.T, res any, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"v2.0.0", "v1.3.0"}, discoveredTags(t, res))
+			},
+		},
+		{
+			name: "records tag and digest",
+			config: fmt.Sprintf(
+				`{"repoURL":%q,"allowTagsRegexes":["^v2"]}`,
+				repoURL,
+			),
+			assertions: func(t *testing.T, res any, err error) {
+				require.NoError(t, err)
+				result := res.(kargoapi.DiscoveryResult) // nolint: forcetypeassert
+				require.Len(t, result.ArtifactReferences, 1)
+				ref := result.ArtifactReferences[0]
+				var md ociArtifactMetadata
+				require.NoError(t, json.Unmarshal(ref.Metadata.Raw, &md))
+				require.Equal(t, repoURL, md.RepoURL)
+				require.Equal(t, "v2.0.0", md.Tag)
+				require.NotEmpty(t, md.Digest)
+				require.Equal(t, map[string]string{"tier": "backend"}, md.Annotations)
+				require.Equal(t, fmt.Sprintf("v2.0.0@%s", md.Digest), ref.Version)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			credsDB := testCase.credsDB
+			if credsDB == nil {
+				credsDB = &credentials.FakeDB{}
+			}
+			s, err := newOCISubscriber(t.Context(), nil, credsDB)
+			require.NoError(t, err)
+			res, err := s.DiscoverArtifacts(
+				t.Context(),
+				"fake-project",
+				kargoapi.RepoSubscription{
+					Subscription: &kargoapi.Subscription{
+						SubscriptionType: SubscriptionTypeOCI,
+						Name:             "artifacts",
+						Config:           &apiextensionsv1.JSON{Raw: []byte(testCase.config)},
+						DiscoveryLimit:   testCase.discoveryLimit,
+					},
+				},
+			)
+			testCase.assertions(t, res, err)
+		})
+	}
+}
+
+func Test_ociSubscriber_DiscoverArtifacts_maxManifestFetches(t *testing.T) {
+	tags := make([]string, maxOCIManifestFetches+50)
+	for i := range tags {
+		tags[i] = fmt.Sprintf("v1.%d.0", i)
+	}
+	var fetches int
+	s := &ociSubscriber{
+		credentialsDB: &credentials.FakeDB{},
+		remoteListFn: func(name.Repository, ...remote.Option) ([]string, error) {
+			return tags, nil
+		},
+		remoteGetFn: func(name.Reference, ...remote.Option) (*remote.Descriptor, error) {
+			fetches++
+			// None of the artifacts are of the type the subscription is
+			// interested in.
+			return &remote.Descriptor{
+				Manifest: []byte(`{"artifactType":"application/vnd.example.other"}`),
+			}, nil
+		},
+	}
+	res, err := s.DiscoverArtifacts(
+		t.Context(),
+		"fake-project",
+		kargoapi.RepoSubscription{
+			Subscription: &kargoapi.Subscription{
+				SubscriptionType: SubscriptionTypeOCI,
+				Name:             "artifacts",
+				Config: &apiextensionsv1.JSON{
+					Raw: []byte(`{"repoURL":"example.com/artifacts","artifactTypes":["application/vnd.example.module"]}`),
+				},
+				DiscoveryLimit: 20,
+			},
+		},
+	)
+	require.NoError(t, err)
+	require.Empty(t, discoveredTags(t, res))
+	require.Equal(t, maxOCIManifestFetches, fetches)
+}
+
+// rawManifest is a remote.Taggable wrapping the raw bytes of an OCI image
+// manifest.
+type rawManifest []byte
+
+func (r rawManifest) RawManifest() ([]byte, error) {
+	return r, nil
+}
+
+func (r rawManifest) MediaType() (types.MediaType, error) {
+	return types.OCIManifestSchema1, nil
+}
+
+func failingCredsGet(
+	context.Context,
+	string,
+	credentials.Type,
+	string,
+) (*credentials.Credentials, error) {
+	return nil, fmt.Errorf("something went wrong")
+}
+
+func discoveredTags(t *testing.T, res any) []string {
+	t.Helper()
+	result, ok := res.(kargoapi.DiscoveryResult)
+	require.True(t, ok)
+	tags := make([]string, len(result.ArtifactReferences))
+	for i, ref := range result.ArtifactReferences {
+		var md ociArtifactMetadata
+		require.NoError(t, json.Unmarshal(ref.Metadata.Raw, &md))
+		tags[i] = md.Tag
+	}
+	return tags
+}
@@ -3,6 +3,8 @@ package subscription
 import (
 	"context"
 
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/component"
 	"github.com/akuity/kargo/pkg/credentials"
@@ -16,6 +18,7 @@ type (
 
 	SubscriberFactory = func(
 		context.Context,
+		client.Client,
 		credentials.Database,
 	) (Subscriber, error)
 
@@ -0,0 +1,361 @@
+package subscription
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libSemver "github.com/akuity/kargo/pkg/controller/semver"
+	"github.com/akuity/kargo/pkg/credentials"
+	"github.com/akuity/kargo/pkg/gitprovider"
+	"github.com/akuity/kargo/pkg/logging"
+	"github.com/akuity/kargo/pkg/validation"
+
+	_ "github.com/akuity/kargo/pkg/gitprovider/gitea"  // Gitea provider registration
+	_ "github.com/akuity/kargo/pkg/gitprovider/github" // GitHub provider registration
+	_ "github.com/akuity/kargo/pkg/gitprovider/gitlab" // GitLab provider registration
+)
+
+const (
+	// SubscriptionTypeRelease is the SubscriptionType of generic subscriptions
+	// handled by the Git hosting provider release subscriber.
+	SubscriptionTypeRelease = "release"
+
+	// releaseArtifactType is the ArtifactType of ArtifactReferences discovered
+	// by the release subscriber.
+	releaseArtifactType = "git-release"
+)
+
+// releaseProviders are the names of the Git hosting providers that have a
+// concept of releases.
+var releaseProviders = []string{"gitea", "github", "gitlab"}
+
+func init() {
+	DefaultSubscriberRegistry.MustRegister(SubscriberRegistration{
+		Predicate: func(
+			_ context.Context,
+			sub kargoapi.RepoSubscription,
+		) (bool, error) {
+			return sub.Subscription != nil &&
+				sub.Subscription.SubscriptionType == SubscriptionTypeRelease, nil
+		},
+		Value: newReleaseSubscriber,
+	})
+}
+
+// releaseSubscriptionConfig is the configuration understood by the release
+// subscriber. It is unpacked from the opaque Config field of a generic
+// kargoapi.Subscription.
+type releaseSubscriptionConfig struct {
+	// RepoURL is the URL of a repository hosted by GitHub, GitLab or Gitea.
+	RepoURL string `json:"repoURL"`
+	// Provider is the name of the Git hosting provider. When left unspecified,
+	// the provider is inferred from the repository URL.
+	Provider string `json:"provider,omitempty"`
+	// IncludePrereleases specifies whether releases marked as pre-releases
+	// should be discovered.
+	IncludePrereleases bool `json:"includePrereleases,omitempty"`
+	// IncludeDrafts specifies whether draft releases should be discovered.
+	IncludeDrafts bool `json:"includeDrafts,omitempty"`
+	// SemverConstraint specifies constraints on what new versions are
+	// permissible. Releases whose tags are not parseable as semantic versions
+	// are never discovered.
+	SemverConstraint string `json:"semverConstraint,omitempty"`
+	// StrictSemvers specifies whether only "strict" semver tags should be
+	// considered. When left unspecified, it is implicitly true.
+	StrictSemvers *bool `json:"strictSemvers,omitempty"`
+	// AssetPatterns is an optional list of regular expressions. When specified,
+	// only assets whose names match at least one of them are recorded and
+	// releases without any such asset are not discovered.
+	AssetPatterns []string `json:"assetPatterns,omitempty"`
+	// InsecureSkipTLSVerify specifies whether certificate verification errors
+	// should be ignored when connecting to the provider's API.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+}
+
+// releaseArtifactMetadata is the metadata recorded in each ArtifactReference
+// discovered by the release subscriber.
+type releaseArtifactMetadata struct {
+	RepoURL     string                     `json:"repoURL"`
+	Tag         string                     `json:"tag"`
+	Name        string                     `json:"name,omitempty"`
+	URL         string                     `json:"url,omitempty"`
+	Prerelease  bool                       `json:"prerelease,omitempty"`
+	PublishedAt *time.Time                 `json:"publishedAt,omitempty"`
+	Assets      []gitprovider.ReleaseAsset `json:"assets,omitempty"`
+}
+
+// releaseSubscriber is an implementation of the Subscriber interface that
+// discovers releases, and the assets attached to them, from a repository
+// hosted by a Git hosting provider.
+type releaseSubscriber struct {
+	credentialsDB credentials.Database
+
+	newProviderFn func(string, *gitprovider.Options) (gitprovider.Interface, error)
+}
+
+// newReleaseSubscriber returns an implementation of the Subscriber interface
+// that discovers releases from a repository hosted by a Git hosting provider.
+func newReleaseSubscriber(
+	_ context.Context,
+	_ client.Client,
+	credentialsDB credentials.Database,
+) (Subscriber, error) {
+	return &releaseSubscriber{
+		credentialsDB: credentialsDB,
+		newProviderFn: gitprovider.New,
+	}, nil
+}
+
+// ApplySubscriptionDefaults implements Subscriber.
+func (r *releaseSubscriber) ApplySubscriptionDefaults(
+	context.Context,
+	*kargoapi.RepoSubscription,
+) error {
+	return nil
+}
+
+// ValidateSubscription implements Subscriber.
+func (r *releaseSubscriber) ValidateSubscription(
+	_ context.Context,
+	f *field.Path,
+	s kargoapi.RepoSubscription,
+) field.ErrorList {
+	if s.Subscription == nil {
+		return nil
+	}
+	f = f.Child("config")
+	cfg, err := parseReleaseSubscriptionConfig(s.Subscription.Config)
+	if err != nil {
+		return field.ErrorList{field.Invalid(f, "", err.Error())}
+	}
+
+	var errs field.ErrorList
+
+	// Validate RepoURL: MinLength=1, Pattern (Git repo URL regex)
+	if err := validation.MinLength(f.Child("repoURL"), cfg.RepoURL, 1); err != nil {
+		errs = append(errs, err)
+	}
+	if !gitURLRegex.MatchString(cfg.RepoURL) {
+		errs = append(errs, field.Invalid(
+			f.Child("repoURL"),
+			cfg.RepoURL,
+			"must be a valid Git repository URL",
+		))
+	}
+
+	// Validate Provider
+	if cfg.Provider != "" && !slices.Contains(releaseProviders, cfg.Provider) {
+		errs = append(errs, field.NotSupported(
+			f.Child("provider"),
+			cfg.Provider,
+			releaseProviders,
+		))
+	}
+
+	// Validate SemverConstraint
+	if err := validation.SemverConstraint(
+		f.Child("semverConstraint"),
+		cfg.SemverConstraint,
+	); err != nil {
+		errs = append(errs, err)
+	}
+
+	// Validate asset patterns
+	for i, p := range cfg.AssetPatterns {
+		if _, err := regexp.Compile(p); err != nil {
+			errs = append(errs, field.Invalid(
+				f.Child("assetPatterns").Index(i),
+				p,
+				fmt.Sprintf("must be a valid regular expression: %v", err),
+			))
+		}
+	}
+
+	return errs
+}
+
+// DiscoverArtifacts implements Subscriber.
+func (r *releaseSubscriber) DiscoverArtifacts(
+	ctx context.Context,
+	project string,
+	sub kargoapi.RepoSubscription,
+) (any, error) {
+	genericSub := sub.Subscription
+	if genericSub == nil {
+		return nil, nil
+	}
+
+	cfg, err := parseReleaseSubscriptionConfig(genericSub.Config)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error parsing configuration of subscription %q: %w",
+			genericSub.Name, err,
+		)
+	}
+
+	logger := logging.LoggerFromContext(ctx).WithValues(
+		"subscription", genericSub.Name,
+		"repo", cfg.RepoURL,
+	)
+
+	// Releases are a feature of the Git hosting provider, so Git credentials
+	// are used to access them. The password is expected to be a token.
+	creds, err := r.credentialsDB.Get(ctx, project, credentials.TypeGit, cfg.RepoURL)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error obtaining credentials for git repo %q: %w",
+			cfg.RepoURL, err,
+		)
+	}
+	gpOpts := &gitprovider.Options{
+		Name:                  cfg.Provider,
+		InsecureSkipTLSVerify: cfg.InsecureSkipTLSVerify,
+	}
+	if creds != nil {
+		gpOpts.Token = creds.Password
+		logger.Debug("obtained credentials for git repo")
+	} else {
+		logger.Debug("found no credentials for git repo")
+	}
+	gitProvider, err := r.newProviderFn(cfg.RepoURL, gpOpts)
+	if err != nil {
+		return nil, fmt.Errorf("error creating git provider service: %w", err)
+	}
+
+	releases, err := gitProvider.ListReleases(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing releases for git repo %q: %w", cfg.RepoURL, err)
+	}
+	if releases, err = filterAndSortReleases(releases, cfg); err != nil {
+		return nil, err
+	}
+	logger.Trace("releases matched criteria", "count", len(releases))
+
+	refs := make([]kargoapi.ArtifactReference, 0, len(releases))
+	for _, release := range releases {
+		if genericSub.DiscoveryLimit > 0 &&
+			len(refs) >= int(genericSub.DiscoveryLimit) {
+			break
+		}
+		metadata, err := json.Marshal(releaseArtifactMetadata{
+			RepoURL:     cfg.RepoURL,
+			Tag:         release.TagName,
+			Name:        release.Name,
+			URL:         release.URL,
+			Prerelease:  release.Prerelease,
+			PublishedAt: release.PublishedAt,
+			Assets:      release.Assets,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling artifact metadata: %w", err)
+		}
+		refs = append(refs, kargoapi.ArtifactReference{
+			ArtifactType:     releaseArtifactType,
+			SubscriptionName: genericSub.Name,
+			Version:          release.TagName,
+			Metadata:         &apiextensionsv1.JSON{Raw: metadata},
+		})
+	}
+
+	if len(refs) == 0 {
+		logger.Debug("discovered no releases")
+	} else {
+		logger.Debug("discovered releases", "count", len(refs))
+	}
+
+	return kargoapi.DiscoveryResult{
+		SubscriptionName:   genericSub.Name,
+		ArtifactReferences: refs,
+	}, nil
+}
+
+// parseReleaseSubscriptionConfig unpacks the opaque configuration of a generic
+// subscription into a releaseSubscriptionConfig.
+func parseReleaseSubscriptionConfig(
+	cfgJSON *apiextensionsv1.JSON,
+) (releaseSubscriptionConfig, error) {
+	var cfg releaseSubscriptionConfig
+	if cfgJSON == nil || len(cfgJSON.Raw) == 0 {
+		return cfg, fmt.Errorf("config is required")
+	}
+	if err := json.Unmarshal(cfgJSON.Raw, &cfg); err != nil {
+		return cfg, fmt.Errorf("config is not a valid release subscription config: %w", err)
+	}
+	return cfg, nil
+}
+
+// filterAndSortReleases returns only those releases that satisfy the
+// subscription's criteria, sorted from highest to lowest semantic version.
+// The assets of each returned release are narrowed down to those matching the
+// subscription's asset patterns, if any.
+func filterAndSortReleases(
+	releases []gitprovider.Release,
+	cfg releaseSubscriptionConfig,
+) ([]gitprovider.Release, error) {
+	var constraint *semver.Constraints
+	if cfg.SemverConstraint != "" {
+		var err error
+		if constraint, err = semver.NewConstraint(cfg.SemverConstraint); err != nil {
+			return nil, fmt.Errorf(
+				"error parsing semver constraint %q: %w",
+				cfg.SemverConstraint, err,
+			)
+		}
+	}
+	patterns, err := compileRegexes(cfg.AssetPatterns)
+	if err != nil {
+		return nil, err
+	}
+	strict := cfg.StrictSemvers == nil || *cfg.StrictSemvers
+
+	type semverRelease struct {
+		release gitprovider.Release
+		version *semver.Version
+	}
+	matched := make([]semverRelease, 0, len(releases))
+	for _, release := range releases {
+		if (release.Draft && !cfg.IncludeDrafts) ||
+			(release.Prerelease && !cfg.IncludePrereleases) {
+			continue
+		}
+		sv := libSemver.Parse(release.TagName, strict)
+		if sv == nil || (constraint != nil && !constraint.Check(sv)) {
+			continue
+		}
+		if len(patterns) > 0 {
+			var assets []gitprovider.ReleaseAsset
+			for _, asset := range release.Assets {
+				if slices.ContainsFunc(
+					patterns,
+					func(r *regexp.Regexp) bool { return r.MatchString(asset.Name) },
+				) {
+					assets = append(assets, asset)
+				}
+			}
+			if len(assets) == 0 {
+				continue
+			}
+			release.Assets = assets
+		}
+		matched = append(matched, semverRelease{release: release, version: sv})
+	}
+	slices.SortStableFunc(matched, func(a, b semverRelease) int {
+		return b.version.Compare(a.version)
+	})
+
+	sorted := make([]gitprovider.Release, len(matched))
+	for i, m := range matched {
+		sorted[i] = m.release
+	}
+	return sorted, nil
+}
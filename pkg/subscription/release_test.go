@@ -0,0 +1,288 @@
+package subscription
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/credentials"
+	"github.com/akuity/kargo/pkg/gitprovider"
+)
+
+func Test_releaseSubscriber_ValidateSubscription(t *testing.T) {
+	testCases := []struct {
+		name       string
+		config     string
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name:   "config missing",
+			config: "",
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "release.config", errs[0].Field)
+				require.Contains(t, errs[0].Detail, "config is required")
+			},
+		},
+		{
+			name:   "repoURL empty",
+			config: `{}`,
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.NotEmpty(t, errs)
+				require.Equal(t, "release.config.repoURL", errs[0].Field)
+			},
+		},
+		{
+			name:   "unsupported provider",
+			config: `{"repoURL":"https://github.com/example/repo","provider":"azure"}`,
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "release.config.provider", errs[0].Field)
+			},
+		},
+		{
+			name:   "invalid semverConstraint",
+			config: `{"repoURL":"https://github.com/example/repo","semverConstraint":"bogus"}`,
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "release.config.semverConstraint", errs[0].Field)
+			},
+		},
+		{
+			name:   "invalid asset pattern",
+			config: `{"repoURL":"https://github.com/example/repo","assetPatterns":["("]}`,
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "release.config.assetPatterns[0]", errs[0].Field)
+			},
+		},
+		{
+			name: "valid",
+			config: `{
+				"repoURL": "https://github.com/example/repo",
+				"provider": "github",
+				"includePrereleases": true,
+				"semverConstraint": "^1.0.0",
+				"assetPatterns": ["linux-amd64"]
+			}`,
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+	}
+	s := &releaseSubscriber{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			sub := &kargoapi.Subscription{
+				SubscriptionType: SubscriptionTypeRelease,
+				Name:             "vendor",
+			}
+			if testCase.config != "" {
+				sub.Config = &apiextensionsv1.JSON{Raw: []byte(testCase.config)}
+			}
+			testCase.assertions(
+				t,
+				s.ValidateSubscription(
+					t.Context(),
+					field.NewPath("release"),
+					kargoapi.RepoSubscription{Subscription: sub},
+				),
+			)
+		})
+	}
+}
+
+func Test_releaseSubscriber_DiscoverArtifacts(t *testing.T) {
+	const repoURL = "https://github.com/example/vendor"
+
+	releases := []gitprovider.Release{
+		{
+			TagName: "v1.0.0",
+			Assets: []gitprovider.ReleaseAsset{
+				{Name: "vendor-linux-amd64", DownloadURL: "https://example.com/1.0.0/linux"},
+			},
+		},
+		{
+			TagName: "v2.0.0",
+			Name:    "Version 2",
+			URL:     "https://github.com/example/vendor/releases/tag/v2.0.0",
+			Assets: []gitprovider.ReleaseAsset{
+				{
+					Name:        "vendor-linux-amd64",
+					DownloadURL: "https://example.com/2.0.0/linux",
+					Digest:      "sha256:abc123",
+				},
+				{Name: "vendor-darwin-arm64", DownloadURL: "https://example.com/2.0.0/darwin"},
+			},
+		},
+		{
+			TagName:    "v2.1.0-rc.1",
+			Prerelease: true,
+			Assets: []gitprovider.ReleaseAsset{
+				{Name: "vendor-linux-amd64", DownloadURL: "https://example.com/2.1.0-rc.1/linux"},
+			},
+		},
+		{TagName: "v3.0.0", Draft: true},
+		{TagName: "v1.1.0"},
+		{TagName: "nightly"},
+	}
+
+	testCases := []struct {
+		name           string
+		config         string
+		discoveryLimit int32
+		credsDB        credentials.Database
+		listErr        error
+		assertions     func(*testing.T, *gitprovider.Options, any, error)
+	}{
+		{
+			name:    "error getting credentials",
+			config:  `{"repoURL":"` + repoURL + `"}`,
+			credsDB: &credentials.FakeDB{GetFn: failingCredsGet},
+			assertions: func(t *testing.T, _ *gitprovider.Options, _ any, err error) {
+				require.ErrorContains(t, err, "error obtaining credentials")
+			},
+		},
+		{
+			name:    "error listing releases",
+			config:  `{"repoURL":"` + repoURL + `"}`,
+			listErr: errors.New("something went wrong"),
+			assertions: func(t *testing.T, _ *gitprovider.Options, _ any, err error) {
+				require.ErrorContains(t, err, "error listing releases")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name:   "excludes drafts, pre-releases and non-semver tags by default",
+			config: `{"repoURL":"` + repoURL + `","provider":"github"}`,
+			credsDB: &credentials.FakeDB{
+				GetFn: func(
+					context.Context,
+					string,
+					credentials.Type,
+					string,
+				) (*credentials.Credentials, error) {
+					return &credentials.Credentials{Password: "token"}, nil
+				},
+			},
+			assertions: func(t *testing.T, opts *gitprovider.Options, res any, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "token", opts.Token)
+				require.Equal(t, "github", opts.Name)
+				require.Equal(t, []string{"v2.0.0", "v1.1.0", "v1.0.0"}, discoveredReleaseTags(t, res))
+			},
+		},
+		{
+			name:   "includes drafts and pre-releases when requested",
+			config: `{"repoURL":"` + repoURL + `","includePrereleases":true,"includeDrafts":true}`,
+			assertions: func(t *testing.T, _ *gitprovider.Options, res any, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{"v3.0.0", "v2.1.0-rc.1", "v2.0.0", "v1.1.0", "v1.0.0"},
+					discoveredReleaseTags(t, res),
+				)
+			},
+		},
+		{
+			name:   "filters by semver constraint",
+			config: `{"repoURL":"` + repoURL + `","semverConstraint":"^1.0.0"}`,
+			assertions: func(t *testing.T, _ *gitprovider.Options, res any, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"v1.1.0", "v1.0.0"}, discoveredReleaseTags(t, res))
+			},
+		},
+		{
+			name:           "applies discovery limit",
+			config:         `{"repoURL":"` + repoURL + `"}`,
+			discoveryLimit: 1,
+			assertions: func(t *testing.T, _ *gitprovider.Options, res any, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"v2.0.0"}, discoveredReleaseTags(t, res))
+			},
+		},
+		{
+			name:   "filters releases and assets by asset pattern",
+			config: `{"repoURL":"` + repoURL + `","assetPatterns":["linux-amd64$"]}`,
+			assertions: func(t *testing.T, _ *gitprovider.Options, res any, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"v2.0.0", "v1.0.0"}, discoveredReleaseTags(t, res))
+				result := res.(kargoapi.DiscoveryResult) // nolint: forcetypeassert
+				ref := result.ArtifactReferences[0]
+				require.Equal(t, releaseArtifactType, ref.ArtifactType)
+				require.Equal(t, "vendor", ref.SubscriptionName)
+				require.Equal(t, "v2.0.0", ref.Version)
+				var md releaseArtifactMetadata
+				require.NoError(t, json.Unmarshal(ref.Metadata.Raw, &md))
+				require.Equal(
+					t,
+					releaseArtifactMetadata{
+						RepoURL: repoURL,
+						Tag:     "v2.0.0",
+						Name:    "Version 2",
+						URL:     "https://github.com/example/vendor/releases/tag/v2.0.0",
+						Assets: []gitprovider.ReleaseAsset{{
+							Name:        "vendor-linux-amd64",
+							DownloadURL: "https://example.com/2.0.0/linux",
+							Digest:      "sha256:abc123",
+						}},
+					},
+					md,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			credsDB := testCase.credsDB
+			if credsDB == nil {
+				credsDB = &credentials.FakeDB{}
+			}
+			var providerOpts *gitprovider.Options
+			s := &releaseSubscriber{
+				credentialsDB: credsDB,
+				newProviderFn: func(
+					_ string,
+					opts *gitprovider.Options,
+				) (gitprovider.Interface, error) {
+					providerOpts = opts
+					return &gitprovider.Fake{
+						ListReleasesFn: func(context.Context) ([]gitprovider.Release, error) {
+							return releases, testCase.listErr
+						},
+					}, nil
+				},
+			}
+			res, err := s.DiscoverArtifacts(
+				t.Context(),
+				"fake-project",
+				kargoapi.RepoSubscription{
+					Subscription: &kargoapi.Subscription{
+						SubscriptionType: SubscriptionTypeRelease,
+						Name:             "vendor",
+						Config:           &apiextensionsv1.JSON{Raw: []byte(testCase.config)},
+						DiscoveryLimit:   testCase.discoveryLimit,
+					},
+				},
+			)
+			testCase.assertions(t, providerOpts, res, err)
+		})
+	}
+}
+
+func discoveredReleaseTags(t *testing.T, res any) []string {
+	t.Helper()
+	result, ok := res.(kargoapi.DiscoveryResult)
+	require.True(t, ok)
+	tags := make([]string, len(result.ArtifactReferences))
+	for i, ref := range result.ArtifactReferences {
+		tags[i] = ref.Version
+	}
+	return tags
+}
@@ -0,0 +1,612 @@
+package subscription
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/hashicorp/go-cleanhttp"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libSemver "github.com/akuity/kargo/pkg/controller/semver"
+	"github.com/akuity/kargo/pkg/credentials"
+	"github.com/akuity/kargo/pkg/logging"
+	kargonet "github.com/akuity/kargo/pkg/net"
+	"github.com/akuity/kargo/pkg/validation"
+)
+
+const (
+	// SubscriptionTypeS3 is the SubscriptionType of generic subscriptions
+	// handled by the S3 object subscriber.
+	SubscriptionTypeS3 = "s3"
+
+	// s3ArtifactType is the ArtifactType of all ArtifactReferences discovered
+	// by the S3 object subscriber.
+	s3ArtifactType = "s3-object"
+
+	defaultS3Region = "us-east-1"
+
+	// maxS3ListPages is the maximum number of pages of objects that will be
+	// listed for a single subscription. With the default page size of 1000
+	// objects, this bounds discovery at 100,000 objects.
+	maxS3ListPages = 100
+)
+
+// S3SelectionStrategy specifies how to select versions from amongst the
+// objects discovered by an S3 object subscription.
+type S3SelectionStrategy string
+
+const (
+	// S3SelectionStrategySemVer selects objects by the semantic version
+	// extracted from their keys.
+	S3SelectionStrategySemVer S3SelectionStrategy = "SemVer"
+	// S3SelectionStrategyLexical selects objects by the lexically greatest
+	// version extracted from their keys.
+	S3SelectionStrategyLexical S3SelectionStrategy = "Lexical"
+	// S3SelectionStrategyNewestUpload selects the most recently modified
+	// objects.
+	S3SelectionStrategyNewestUpload S3SelectionStrategy = "NewestUpload"
+)
+
+var validS3SelectionStrategies = []S3SelectionStrategy{
+	S3SelectionStrategyLexical,
+	S3SelectionStrategyNewestUpload,
+	S3SelectionStrategySemVer,
+}
+
+func init() {
+	DefaultSubscriberRegistry.MustRegister(SubscriberRegistration{
+		Predicate: func(
+			_ context.Context,
+			sub kargoapi.RepoSubscription,
+		) (bool, error) {
+			return sub.Subscription != nil &&
+				sub.Subscription.SubscriptionType == SubscriptionTypeS3, nil
+		},
+		Value: newS3Subscriber,
+	})
+}
+
+// s3SubscriptionConfig is the configuration understood by the S3 object
+// subscriber. It is unpacked from the opaque Config field of a generic
+// kargoapi.Subscription.
+type s3SubscriptionConfig struct {
+	// Endpoint is the base URL of the S3-compatible object store. When left
+	// unspecified, the AWS S3 endpoint for the Region is used.
+	Endpoint string `json:"endpoint,omitempty"`
+	// Region is the region in which the bucket resides. When left unspecified,
+	// "us-east-1" is used.
+	Region string `json:"region,omitempty"`
+	// Bucket is the name of the bucket. This field is required.
+	Bucket string `json:"bucket"`
+	// Prefix restricts discovery to objects with keys having this prefix.
+	Prefix string `json:"prefix,omitempty"`
+	// KeyPattern is an optional regular expression that object keys must match
+	// to be discovered. If it contains a capture group named "version", the
+	// matched text is used as the object's version for the purposes of
+	// selection. Otherwise, the key itself is used.
+	KeyPattern string `json:"keyPattern,omitempty"`
+	// SelectionStrategy specifies how to select versions. When left unspecified,
+	// "NewestUpload" is used.
+	SelectionStrategy S3SelectionStrategy `json:"selectionStrategy,omitempty"`
+	// SemverConstraint specifies constraints on what new versions are
+	// permissible. Only has effect when SelectionStrategy is SemVer.
+	SemverConstraint string `json:"semverConstraint,omitempty"`
+	// StrictSemvers specifies whether only "strict" semantic versions should be
+	// considered. When left unspecified, it is implicitly true. Only has effect
+	// when SelectionStrategy is SemVer.
+	StrictSemvers *bool `json:"strictSemvers,omitempty"`
+	// UsePathStyle specifies whether path-style addressing (endpoint/bucket/key)
+	// should be used instead of virtual-hosted-style addressing
+	// (bucket.endpoint/key). This is commonly required by self-hosted S3
+	// implementations such as MinIO. It is implicitly true when Endpoint is
+	// specified.
+	UsePathStyle *bool `json:"usePathStyle,omitempty"`
+}
+
+// s3ArtifactMetadata is the metadata recorded in each ArtifactReference
+// discovered by the S3 object subscriber.
+type s3ArtifactMetadata struct {
+	URL          string    `json:"url"`
+	Bucket       string    `json:"bucket"`
+	Key          string    `json:"key"`
+	ETag         string    `json:"etag,omitempty"`
+	Size         int64     `json:"size"`
+	LastModified time.Time `json:"lastModified"`
+}
+
+// s3Object represents an object listed from an S3-compatible object store.
+type s3Object struct {
+	Key          string    `xml:"Key"`
+	LastModified time.Time `xml:"LastModified"`
+	ETag         string    `xml:"ETag"`
+	Size         int64     `xml:"Size"`
+}
+
+// s3ListBucketResult is the response body of a ListObjectsV2 request.
+type s3ListBucketResult struct {
+	Contents              []s3Object `xml:"Contents"`
+	IsTruncated           bool       `xml:"IsTruncated"`
+	NextContinuationToken string     `xml:"NextContinuationToken"`
+}
+
+// s3Subscriber is an implementation of the Subscriber interface that discovers
+// objects from a bucket in an S3-compatible object store.
+type s3Subscriber struct {
+	credentialsDB credentials.Database
+	httpClient    *http.Client
+}
+
+// newS3Subscriber returns an implementation of the Subscriber interface that
+// discovers objects from a bucket in an S3-compatible object store.
+func newS3Subscriber(
+	_ context.Context,
+	_ client.Client,
+	credentialsDB credentials.Database,
+) (Subscriber, error) {
+	return &s3Subscriber{
+		credentialsDB: credentialsDB,
+		httpClient: &http.Client{
+			Transport: kargonet.SafeTransport(cleanhttp.DefaultTransport()),
+		},
+	}, nil
+}
+
+// ApplySubscriptionDefaults implements Subscriber.
+func (s *s3Subscriber) ApplySubscriptionDefaults(
+	context.Context,
+	*kargoapi.RepoSubscription,
+) error {
+	return nil
+}
+
+// ValidateSubscription implements Subscriber.
+func (s *s3Subscriber) ValidateSubscription(
+	_ context.Context,
+	f *field.Path,
+	sub kargoapi.RepoSubscription,
+) field.ErrorList {
+	if sub.Subscription == nil {
+		return nil
+	}
+	f = f.Child("config")
+	cfg, err := parseS3SubscriptionConfig(sub.Subscription.Config)
+	if err != nil {
+		return field.ErrorList{field.Invalid(f, "", err.Error())}
+	}
+
+	var errs field.ErrorList
+
+	// Validate Bucket: MinLength=1
+	if err := validation.MinLength(f.Child("bucket"), cfg.Bucket, 1); err != nil {
+		errs = append(errs, err)
+	}
+
+	// Validate Endpoint
+	if cfg.Endpoint != "" {
+		if u, err := url.Parse(cfg.Endpoint); err != nil ||
+			(u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = append(errs, field.Invalid(
+				f.Child("endpoint"),
+				cfg.Endpoint,
+				"must be a valid http or https URL",
+			))
+		}
+	}
+
+	// Validate KeyPattern
+	if cfg.KeyPattern != "" {
+		if _, err := regexp.Compile(cfg.KeyPattern); err != nil {
+			errs = append(errs, field.Invalid(
+				f.Child("keyPattern"),
+				cfg.KeyPattern,
+				fmt.Sprintf("must be a valid regular expression: %v", err),
+			))
+		}
+	}
+
+	// Validate SelectionStrategy
+	if cfg.SelectionStrategy != "" &&
+		!slices.Contains(validS3SelectionStrategies, cfg.SelectionStrategy) {
+		errs = append(errs, field.NotSupported(
+			f.Child("selectionStrategy"),
+			cfg.SelectionStrategy,
+			[]string{
+				string(S3SelectionStrategyLexical),
+				string(S3SelectionStrategyNewestUpload),
+				string(S3SelectionStrategySemVer),
+			},
+		))
+	}
+
+	// Validate SemverConstraint
+	if err := validation.SemverConstraint(
+		f.Child("semverConstraint"),
+		cfg.SemverConstraint,
+	); err != nil {
+		errs = append(errs, err)
+	}
+	if cfg.SemverConstraint != "" &&
+		cfg.SelectionStrategy != S3SelectionStrategySemVer {
+		errs = append(errs, field.Invalid(
+			f.Child("semverConstraint"),
+			cfg.SemverConstraint,
+			"may only be set when selectionStrategy is SemVer",
+		))
+	}
+
+	return errs
+}
+
+// DiscoverArtifacts implements Subscriber.
+func (s *s3Subscriber) DiscoverArtifacts(
+	ctx context.Context,
+	project string,
+	sub kargoapi.RepoSubscription,
+) (any, error) {
+	genericSub := sub.Subscription
+	if genericSub == nil {
+		return nil, nil
+	}
+
+	cfg, err := parseS3SubscriptionConfig(genericSub.Config)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error parsing configuration of subscription %q: %w",
+			genericSub.Name, err,
+		)
+	}
+
+	logger := logging.LoggerFromContext(ctx).WithValues(
+		"subscription", genericSub.Name,
+		"bucket", cfg.Bucket,
+		"prefix", cfg.Prefix,
+	)
+	ctx = logging.ContextWithLogger(ctx, logger)
+
+	// Credentials are looked up using a URL that includes the endpoint that
+	// signed requests will be sent to, so that credentials for a bucket cannot
+	// be obtained by pointing a subscription at some other endpoint. Because
+	// this URL has the endpoint's scheme, the credentials database refuses to
+	// return credentials for plain HTTP endpoints unless configured otherwise.
+	credsURL := cfg.credentialsURL()
+	creds, err := s.credentialsDB.Get(ctx, project, credentials.TypeS3, credsURL)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error obtaining credentials for bucket %q: %w",
+			cfg.Bucket, err,
+		)
+	}
+	if creds != nil {
+		logger.Debug("obtained credentials for bucket")
+	} else {
+		logger.Debug("found no credentials for bucket")
+	}
+
+	objects, err := s.listObjects(ctx, cfg, creds)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error listing objects in bucket %q: %w",
+			cfg.Bucket, err,
+		)
+	}
+
+	selected, err := selectS3Objects(objects, cfg)
+	if err != nil {
+		return nil, err
+	}
+	selected = trimSlice(selected, int(genericSub.DiscoveryLimit))
+
+	refs := make([]kargoapi.ArtifactReference, 0, len(selected))
+	for _, obj := range selected {
+		ref, err := s.toArtifactReference(genericSub.Name, cfg, obj)
+		if err != nil {
+			return nil, err
+		}
+		refs = append(refs, ref)
+	}
+
+	if len(refs) == 0 {
+		logger.Debug("discovered no objects")
+	} else {
+		logger.Debug("discovered objects", "count", len(refs))
+	}
+
+	return kargoapi.DiscoveryResult{
+		SubscriptionName:   genericSub.Name,
+		ArtifactReferences: refs,
+	}, nil
+}
+
+// listObjects lists all objects in the configured bucket having the configured
+// prefix.
+func (s *s3Subscriber) listObjects(
+	ctx context.Context,
+	cfg s3SubscriptionConfig,
+	creds *credentials.Credentials,
+) ([]s3Object, error) {
+	bucketURL, err := cfg.bucketURL()
+	if err != nil {
+		return nil, err
+	}
+	var objects []s3Object
+	var continuationToken string
+	for range maxS3ListPages {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		if cfg.Prefix != "" {
+			query.Set("prefix", cfg.Prefix)
+		}
+		if continuationToken != "" {
+			query.Set("continuation-token", continuationToken)
+		}
+		listURL := *bucketURL
+		listURL.RawQuery = query.Encode()
+
+		page, err := s.listObjectsPage(ctx, cfg, creds, listURL.String())
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, page.Contents...)
+		if !page.IsTruncated || page.NextContinuationToken == "" {
+			return objects, nil
+		}
+		continuationToken = page.NextContinuationToken
+	}
+	logging.LoggerFromContext(ctx).Info(
+		"stopped listing objects after reaching maximum number of pages",
+		"maxPages", maxS3ListPages,
+	)
+	return objects, nil
+}
+
+// listObjectsPage executes a single ListObjectsV2 request and returns the
+// parsed response.
+func (s *s3Subscriber) listObjectsPage(
+	ctx context.Context,
+	cfg s3SubscriptionConfig,
+	creds *credentials.Credentials,
+	listURL string,
+) (*s3ListBucketResult, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, listURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	if creds != nil {
+		// This is the SHA-256 hash of an empty payload.
+		emptyPayloadHash := sha256.Sum256(nil)
+		payloadHash := hex.EncodeToString(emptyPayloadHash[:])
+		req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+		if err = v4.NewSigner().SignHTTP(
+			ctx,
+			aws.Credentials{
+				AccessKeyID:     creds.Username,
+				SecretAccessKey: creds.Password,
+			},
+			req,
+			payloadHash,
+			"s3",
+			cfg.region(),
+			time.Now(),
+		); err != nil {
+			return nil, fmt.Errorf("error signing request: %w", err)
+		}
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error listing objects: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf(
+			"unexpected status code %d listing objects: %s",
+			resp.StatusCode, strings.TrimSpace(string(body)),
+		)
+	}
+	result := &s3ListBucketResult{}
+	if err = xml.Unmarshal(body, result); err != nil {
+		return nil, fmt.Errorf("error parsing response body: %w", err)
+	}
+	return result, nil
+}
+
+// toArtifactReference returns an ArtifactReference for the provided object.
+func (s *s3Subscriber) toArtifactReference(
+	subName string,
+	cfg s3SubscriptionConfig,
+	obj s3Object,
+) (kargoapi.ArtifactReference, error) {
+	bucketURL, err := cfg.bucketURL()
+	if err != nil {
+		return kargoapi.ArtifactReference{}, err
+	}
+	objURL := bucketURL.JoinPath(obj.Key)
+	etag := strings.Trim(obj.ETag, `"`)
+	metadata, err := json.Marshal(s3ArtifactMetadata{
+		URL:          objURL.String(),
+		Bucket:       cfg.Bucket,
+		Key:          obj.Key,
+		ETag:         etag,
+		Size:         obj.Size,
+		LastModified: obj.LastModified,
+	})
+	if err != nil {
+		return kargoapi.ArtifactReference{},
+			fmt.Errorf("error marshaling artifact metadata: %w", err)
+	}
+	version := obj.Key
+	if etag != "" {
+		// The ETag is incorporated into the version so that an object that is
+		// overwritten under an existing key is recognized as a new revision.
+		version = fmt.Sprintf("%s@%s", obj.Key, etag)
+	}
+	return kargoapi.ArtifactReference{
+		ArtifactType:     s3ArtifactType,
+		SubscriptionName: subName,
+		Version:          version,
+		Metadata:         &apiextensionsv1.JSON{Raw: metadata},
+	}, nil
+}
+
+// region returns the configured region or the default region if none is
+// configured.
+func (c s3SubscriptionConfig) region() string {
+	if c.Region != "" {
+		return c.Region
+	}
+	return defaultS3Region
+}
+
+// endpoint returns the configured endpoint or the AWS S3 endpoint for the
+// configured region if none is configured.
+func (c s3SubscriptionConfig) endpoint() string {
+	if c.Endpoint != "" {
+		return c.Endpoint
+	}
+	return fmt.Sprintf("https://s3.%s.amazonaws.com", c.region())
+}
+
+// credentialsURL returns the URL used to look up credentials for the
+// configured bucket and prefix. It has the form <endpoint>/<bucket>/<prefix>,
+// regardless of the configured addressing style.
+func (c s3SubscriptionConfig) credentialsURL() string {
+	return fmt.Sprintf(
+		"%s/%s/%s", strings.TrimSuffix(c.endpoint(), "/"), c.Bucket, c.Prefix,
+	)
+}
+
+// bucketURL returns the base URL of the configured bucket, taking the
+// configured addressing style into account.
+func (c s3SubscriptionConfig) bucketURL() (*url.URL, error) {
+	endpoint := c.endpoint()
+	pathStyle := c.Endpoint != ""
+	if c.UsePathStyle != nil {
+		pathStyle = *c.UsePathStyle
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing endpoint %q: %w", endpoint, err)
+	}
+	if pathStyle {
+		return u.JoinPath(c.Bucket), nil
+	}
+	u.Host = fmt.Sprintf("%s.%s", c.Bucket, u.Host)
+	return u, nil
+}
+
+// parseS3SubscriptionConfig unpacks the opaque configuration of a generic
+// subscription into an s3SubscriptionConfig.
+func parseS3SubscriptionConfig(
+	cfgJSON *apiextensionsv1.JSON,
+) (s3SubscriptionConfig, error) {
+	var cfg s3SubscriptionConfig
+	if cfgJSON == nil || len(cfgJSON.Raw) == 0 {
+		return cfg, fmt.Errorf("config is required")
+	}
+	if err := json.Unmarshal(cfgJSON.Raw, &cfg); err != nil {
+		return cfg, fmt.Errorf("config is not a valid S3 subscription config: %w", err)
+	}
+	return cfg, nil
+}
+
+// selectS3Objects returns those objects matching the subscription's key
+// pattern and constraints, ordered from most to least preferred according to
+// the subscription's selection strategy.
+func selectS3Objects(
+	objects []s3Object,
+	cfg s3SubscriptionConfig,
+) ([]s3Object, error) {
+	var keyRegex *regexp.Regexp
+	if cfg.KeyPattern != "" {
+		var err error
+		if keyRegex, err = regexp.Compile(cfg.KeyPattern); err != nil {
+			return nil, fmt.Errorf(
+				"error compiling key pattern %q: %w",
+				cfg.KeyPattern, err,
+			)
+		}
+	}
+	var constraint *semver.Constraints
+	if cfg.SemverConstraint != "" {
+		var err error
+		if constraint, err = semver.NewConstraint(cfg.SemverConstraint); err != nil {
+			return nil, fmt.Errorf(
+				"error parsing semver constraint %q: %w",
+				cfg.SemverConstraint, err,
+			)
+		}
+	}
+	strict := cfg.StrictSemvers == nil || *cfg.StrictSemvers
+
+	type candidate struct {
+		obj     s3Object
+		version string
+		semver  *semver.Version
+	}
+	candidates := make([]candidate, 0, len(objects))
+	for _, obj := range objects {
+		if strings.HasSuffix(obj.Key, "/") {
+			continue // A "directory" placeholder
+		}
+		c := candidate{obj: obj, version: obj.Key}
+		if keyRegex != nil {
+			matches := keyRegex.FindStringSubmatch(obj.Key)
+			if matches == nil {
+				continue
+			}
+			if i := keyRegex.SubexpIndex("version"); i >= 0 {
+				c.version = matches[i]
+			}
+		}
+		if cfg.SelectionStrategy == S3SelectionStrategySemVer {
+			if c.semver = libSemver.Parse(c.version, strict); c.semver == nil {
+				continue
+			}
+			if constraint != nil && !constraint.Check(c.semver) {
+				continue
+			}
+		}
+		candidates = append(candidates, c)
+	}
+
+	switch cfg.SelectionStrategy {
+	case S3SelectionStrategySemVer:
+		slices.SortStableFunc(candidates, func(a, b candidate) int {
+			return b.semver.Compare(a.semver)
+		})
+	case S3SelectionStrategyLexical:
+		slices.SortStableFunc(candidates, func(a, b candidate) int {
+			return strings.Compare(b.version, a.version)
+		})
+	default: // S3SelectionStrategyNewestUpload
+		slices.SortStableFunc(candidates, func(a, b candidate) int {
+			return b.obj.LastModified.Compare(a.obj.LastModified)
+		})
+	}
+
+	selected := make([]s3Object, len(candidates))
+	for i, c := range candidates {
+		selected[i] = c.obj
+	}
+	return selected, nil
+}
@@ -0,0 +1,431 @@
+package subscription
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/credentials"
+)
+
+func Test_s3Subscriber_ValidateSubscription(t *testing.T) {
+	testCases := []struct {
+		name       string
+		config     string
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name:   "config missing",
+			config: "",
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "s3.config", errs[0].Field)
+			},
+		},
+		{
+			name:   "bucket empty",
+			config: `{}`,
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "s3.config.bucket", errs[0].Field)
+			},
+		},
+		{
+			name:   "invalid endpoint",
+			config: `{"bucket":"builds","endpoint":"ftp://minio.example.com"}`,
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "s3.config.endpoint", errs[0].Field)
+			},
+		},
+		{
+			name:   "invalid key pattern",
+			config: `{"bucket":"builds","keyPattern":"("}`,
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "s3.config.keyPattern", errs[0].Field)
+			},
+		},
+		{
+			name:   "unsupported selection strategy",
+			config: `{"bucket":"builds","selectionStrategy":"Bogus"}`,
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "s3.config.selectionStrategy", errs[0].Field)
+				require.Equal(t, field.ErrorTypeNotSupported, errs[0].Type)
+			},
+		},
+		{
+			name:   "semverConstraint without SemVer strategy",
+			config: `{"bucket":"builds","semverConstraint":"^1.0.0"}`,
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "s3.config.semverConstraint", errs[0].Field)
+			},
+		},
+		{
+			name: "valid",
+			config: `{
+				"endpoint": "http://minio.example.com:9000",
+				"bucket": "builds",
+				"prefix": "site/",
+				"keyPattern": "^site/site-(?P<version>.+)\\.tar\\.gz$",
+				"selectionStrategy": "SemVer",
+				"semverConstraint": "^1.0.0"
+			}`,
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+	}
+	s := &s3Subscriber{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			sub := &kargoapi.Subscription{
+				SubscriptionType: SubscriptionTypeS3,
+				Name:             "site",
+			}
+			if testCase.config != "" {
+				sub.Config = &apiextensionsv1.JSON{Raw: []byte(testCase.config)}
+			}
+			testCase.assertions(
+				t,
+				s.ValidateSubscription(
+					t.Context(),
+					field.NewPath("s3"),
+					kargoapi.RepoSubscription{Subscription: sub},
+				),
+			)
+		})
+	}
+}
+
+func Test_s3Subscriber_DiscoverArtifacts(t *testing.T) {
+	// This fake server imitates a self-hosted, S3-compatible object store
+	// (e.g. MinIO) using path-style addressing. It serves two pages of results
+	// and requires signed requests.
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if !strings.HasPrefix(
+				r.Header.Get("Authorization"),
+				"AWS4-HMAC-SHA256 Credential=fake-access-key/",
+			) {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte("<Error><Code>AccessDenied</Code></Error>"))
+				return
+			}
+			if r.URL.Path != "/builds" || r.URL.Query().Get("list-type") != "2" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", "application/xml")
+			if r.URL.Query().Get("continuation-token") == "" {
+				_, _ = w.Write([]byte(`<ListBucketResult>
+  <IsTruncated>true</IsTruncated>
+  <NextContinuationToken>page-2</NextContinuationToken>
+  <Contents>
+    <Key>site/</Key>
+    <LastModified>2025-01-01T00:00:00.000Z</LastModified>
+    <Size>0</Size>
+  </Contents>
+  <Contents>
+    <Key>site/site-1.0.0.tar.gz</Key>
+    <LastModified>2025-01-01T00:00:00.000Z</LastModified>
+    <ETag>"etag-1"</ETag>
+    <Size>10</Size>
+  </Contents>
+  <Contents>
+    <Key>site/site-1.2.0.tar.gz</Key>
+    <LastModified>2025-01-02T00:00:00.000Z</LastModified>
+    <ETag>"etag-2"</ETag>
+    <Size>20</Size>
+  </Contents>
+</ListBucketResult>`))
+				return
+			}
+			_, _ = w.Write([]byte(`<ListBucketResult>
+  <IsTruncated>false</IsTruncated>
+  <Contents>
+    <Key>site/site-1.1.0.tar.gz</Key>
+    <LastModified>2025-01-03T00:00:00.000Z</LastModified>
+    <ETag>"etag-3"</ETag>
+    <Size>30</Size>
+  </Contents>
+  <Contents>
+    <Key>site/site-2.0.0.tar.gz</Key>
+    <LastModified>2024-12-31T00:00:00.000Z</LastModified>
+    <ETag>"etag-4"</ETag>
+    <Size>40</Size>
+  </Contents>
+  <Contents>
+    <Key>site/README.md</Key>
+    <LastModified>2025-01-04T00:00:00.000Z</LastModified>
+    <ETag>"etag-5"</ETag>
+    <Size>50</Size>
+  </Contents>
+</ListBucketResult>`))
+		},
+	))
+	t.Cleanup(srv.Close)
+
+	fakeCredsDB := &credentials.FakeDB{
+		GetFn: func(
+			_ context.Context,
+			_ string,
+			credType credentials.Type,
+			repoURL string,
+		) (*credentials.Credentials, error) {
+			if credType != credentials.TypeS3 || repoURL != srv.URL+"/builds/site/" {
+				return nil, nil
+			}
+			return &credentials.Credentials{
+				Username: "fake-access-key",
+				Password: "fake-secret-key",
+			}, nil
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		config         string
+		discoveryLimit int32
+		credsDB        credentials.Database
+		assertions     func(*testing.T, any, error)
+	}{
+		{
+			name: "error getting credentials",
+			config: fmt.Sprintf(
+				`{"endpoint":%q,"bucket":"builds","prefix":"site/"}`,
+				srv.URL,
+			),
+			credsDB: &credentials.FakeDB{GetFn: failingCredsGet},
+			assertions: func(t *testing.T, _ any, err error) {
+				require.ErrorContains(t, err, "error obtaining credentials")
+			},
+		},
+		{
+			name: "no credentials",
+			config: fmt.Sprintf(
+				`{"endpoint":%q,"bucket":"builds","prefix":"other/"}`,
+				srv.URL,
+			),
+			assertions: func(t *testing.T, _ any, err error) {
+				require.ErrorContains(t, err, "unexpected status code 403")
+			},
+		},
+		{
+			name: "credentials are not sent to other endpoints",
+			config: fmt.Sprintf(
+				`{"endpoint":%q,"bucket":"builds","prefix":"site/"}`,
+				strings.Replace(srv.URL, "127.0.0.1", "localhost", 1),
+			),
+			assertions: func(t *testing.T, _ any, err error) {
+				require.ErrorContains(t, err, "unexpected status code 403")
+			},
+		},
+		{
+			name:   "link-local endpoint",
+			config: `{"endpoint":"http://169.254.169.254","bucket":"builds"}`,
+			assertions: func(t *testing.T, _ any, err error) {
+				require.ErrorContains(t, err, "link-local")
+			},
+		},
+		{
+			name: "newest upload",
+			config: fmt.Sprintf(
+				`{"endpoint":%q,"bucket":"builds","prefix":"site/"}`,
+				srv.URL,
+			),
+			assertions: func(t *testing.T, res any, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{
+						"site/README.md",
+						"site/site-1.1.0.tar.gz",
+						"site/site-1.2.0.tar.gz",
+						"site/site-1.0.0.tar.gz",
+						"site/site-2.0.0.tar.gz",
+					},
+					discoveredKeys(t, res),
+				)
+			},
+		},
+		{
+			name: "semver with key pattern and constraint",
+			config: fmt.Sprintf(
+				`{
+					"endpoint": %q,
+					"bucket": "builds",
+					"prefix": "site/",
+					"keyPattern": "^site/site-(?P<version>.+)\\.tar\\.gz$",
+					"selectionStrategy": "SemVer",
+					"semverConstraint": "^1.0.0"
+				}`,
+				srv.URL,
+			),
+			assertions: func(t *testing.T, res any, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{
+						"site/site-1.2.0.tar.gz",
+						"site/site-1.1.0.tar.gz",
+						"site/site-1.0.0.tar.gz",
+					},
+					discoveredKeys(t, res),
+				)
+			},
+		},
+		{
+			name: "lexical with discovery limit",
+			config: fmt.Sprintf(
+				`{
+					"endpoint": %q,
+					"bucket": "builds",
+					"prefix": "site/",
+					"keyPattern": "\\.tar\\.gz$",
+					"selectionStrategy": "Lexical"
+				}`,
+				srv.URL,
+			),
+			discoveryLimit: 2,
+			assertions: func(t *testing.T, res any, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{"site/site-2.0.0.tar.gz", "site/site-1.2.0.tar.gz"},
+					discoveredKeys(t, res),
+				)
+				result := res.(kargoapi.DiscoveryResult) // nolint: forcetypeassert
+				require.Equal(t, "site", result.SubscriptionName)
+				ref := result.ArtifactReferences[0]
+				require.Equal(t, s3ArtifactType, ref.ArtifactType)
+				require.Equal(t, "site", ref.SubscriptionName)
+				require.Equal(t, "site/site-2.0.0.tar.gz@etag-4", ref.Version)
+				var md s3ArtifactMetadata
+				require.NoError(t, json.Unmarshal(ref.Metadata.Raw, &md))
+				require.Equal(t, srv.URL+"/builds/site/site-2.0.0.tar.gz", md.URL)
+				require.Equal(t, "builds", md.Bucket)
+				require.Equal(t, "etag-4", md.ETag)
+				require.Equal(t, int64(40), md.Size)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			credsDB := testCase.credsDB
+			if credsDB == nil {
+				credsDB = fakeCredsDB
+			}
+			s, err := newS3Subscriber(t.Context(), nil, credsDB)
+			require.NoError(t, err)
+			res, err := s.DiscoverArtifacts(
+				t.Context(),
+				"fake-project",
+				kargoapi.RepoSubscription{
+					Subscription: &kargoapi.Subscription{
+						SubscriptionType: SubscriptionTypeS3,
+						Name:             "site",
+						Config:           &apiextensionsv1.JSON{Raw: []byte(testCase.config)},
+						DiscoveryLimit:   testCase.discoveryLimit,
+					},
+				},
+			)
+			testCase.assertions(t, res, err)
+		})
+	}
+}
+
+func Test_s3SubscriptionConfig_bucketURL(t *testing.T) {
+	testCases := []struct {
+		name     string
+		cfg      s3SubscriptionConfig
+		expected string
+	}{
+		{
+			name:     "AWS default",
+			cfg:      s3SubscriptionConfig{Bucket: "builds"},
+			expected: "https://builds.s3.us-east-1.amazonaws.com",
+		},
+		{
+			name:     "AWS with region",
+			cfg:      s3SubscriptionConfig{Bucket: "builds", Region: "eu-west-1"},
+			expected: "https://builds.s3.eu-west-1.amazonaws.com",
+		},
+		{
+			name: "custom endpoint",
+			cfg: s3SubscriptionConfig{
+				Bucket:   "builds",
+				Endpoint: "http://minio.example.com:9000",
+			},
+			expected: "http://minio.example.com:9000/builds",
+		},
+		{
+			name: "custom endpoint with virtual-hosted-style addressing",
+			cfg: s3SubscriptionConfig{
+				Bucket:       "builds",
+				Endpoint:     "https://objects.example.com",
+				UsePathStyle: new(bool),
+			},
+			expected: "https://builds.objects.example.com",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			u, err := testCase.cfg.bucketURL()
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, u.String())
+		})
+	}
+}
+
+func Test_s3SubscriptionConfig_credentialsURL(t *testing.T) {
+	testCases := []struct {
+		name     string
+		cfg      s3SubscriptionConfig
+		expected string
+	}{
+		{
+			name:     "AWS default",
+			cfg:      s3SubscriptionConfig{Bucket: "builds", Prefix: "site/"},
+			expected: "https://s3.us-east-1.amazonaws.com/builds/site/",
+		},
+		{
+			name: "custom endpoint with virtual-hosted-style addressing",
+			cfg: s3SubscriptionConfig{
+				Bucket:       "builds",
+				Endpoint:     "http://minio.example.com:9000/",
+				UsePathStyle: new(bool),
+			},
+			expected: "http://minio.example.com:9000/builds/",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, testCase.cfg.credentialsURL())
+		})
+	}
+}
+
+func discoveredKeys(t *testing.T, res any) []string {
+	t.Helper()
+	result, ok := res.(kargoapi.DiscoveryResult)
+	require.True(t, ok)
+	keys := make([]string, len(result.ArtifactReferences))
+	for i, ref := range result.ArtifactReferences {
+		var md s3ArtifactMetadata
+		require.NoError(t, json.Unmarshal(ref.Metadata.Raw, &md))
+		keys[i] = md.Key
+	}
+	return keys
+}
@@ -23,6 +23,7 @@ const (
 	summaryRequestNotMatched      = "Request did not match whenExpression"
 	summaryRequestMatchingError   = "Error evaluating whenExpression"
 	summaryResourceSelectionError = "Error evaluating targetSelectionCriteria"
+	summaryProjectRequired        = "Action is only supported by Project-level webhook receivers"
 )
 
 type actionResult struct {
@@ -75,6 +76,12 @@ func (g *genericWebhookReceiver) handleAction(
 		ar.Summary = summaryRequestNotMatched
 		return ar
 	}
+	if g.project == "" && action.ActionType != kargoapi.GenericWebhookActionTypeRefresh {
+		aLogger.Error(nil, "action is not supported by cluster-level webhook receivers; skipping action")
+		ar.Result = resultError
+		ar.Summary = summaryProjectRequired
+		return ar
+	}
 	objects, err := g.listUniqueObjects(ctx, action, env)
 	if err != nil {
 		aLogger.Error(err, "failed to list unique objects")
@@ -85,6 +92,12 @@ func (g *genericWebhookReceiver) handleAction(
 	switch action.ActionType {
 	case kargoapi.GenericWebhookActionTypeRefresh:
 		ar.SelectedTargets, ar.Result, ar.Summary = refreshObjects(ctx, g.client, objects)
+	case kargoapi.GenericWebhookActionTypePromote:
+		ar.SelectedTargets, ar.Result, ar.Summary = g.promoteStages(ctx, objects, action.Parameters, env)
+	case kargoapi.GenericWebhookActionTypeApprove:
+		ar.SelectedTargets, ar.Result, ar.Summary = g.approveFreightForStages(ctx, objects, action.Parameters, env)
+	case kargoapi.GenericWebhookActionTypeReverify:
+		ar.SelectedTargets, ar.Result, ar.Summary = g.reverifyStages(ctx, objects)
 	}
 	return ar
 }
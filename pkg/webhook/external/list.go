@@ -56,6 +56,12 @@ func (g *genericWebhookReceiver) listTargetObjects(
 			return nil, fmt.Errorf("error listing %s targets: %w", targetSelectionCriteria.Kind, err)
 		}
 		objects = itemsToObjects(warehouses.Items)
+	case kargoapi.GenericWebhookTargetKindStage:
+		stages := new(kargoapi.StageList)
+		if err = g.client.List(ctx, stages, listOpts...); err != nil {
+			return nil, fmt.Errorf("error listing %s targets: %w", targetSelectionCriteria.Kind, err)
+		}
+		objects = itemsToObjects(stages.Items)
 	default:
 		return nil, fmt.Errorf("unsupported target kind: %q", targetSelectionCriteria.Kind)
 	}
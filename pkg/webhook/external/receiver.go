@@ -12,6 +12,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/event"
 )
 
 // WebhookReceiver is an interface for components that handle inbound webhooks.
@@ -27,6 +28,9 @@ type WebhookReceiver interface {
 	// setSecretData sets the Secret data for this receiver. This is used to
 	// later when handling inbound webhooks.
 	setSecretData(map[string][]byte)
+	// setEventSender sets the event.Sender used for recording events pertaining
+	// to actions taken by this receiver.
+	setEventSender(event.Sender)
 	// setDetails sets the details of the WebhookReceiver in the form of
 	// kargoapi.WebhookReceiverDetails.
 	setDetails(kargoapi.WebhookReceiverDetails)
@@ -50,6 +54,7 @@ type baseWebhookReceiver struct {
 	secretName string
 	secretData map[string][]byte
 	details    kargoapi.WebhookReceiverDetails
+	sender     event.Sender
 }
 
 // getSecretName implements WebhookReceiver.
@@ -62,6 +67,11 @@ func (b *baseWebhookReceiver) setSecretData(secretData map[string][]byte) {
 	b.secretData = secretData
 }
 
+// setEventSender implements WebhookReceiver.
+func (b *baseWebhookReceiver) setEventSender(sender event.Sender) {
+	b.sender = sender
+}
+
 // setDetails implements WebhookReceiver.
 func (b *baseWebhookReceiver) setDetails(
 	details kargoapi.WebhookReceiverDetails,
@@ -113,6 +113,7 @@ func (s *server) route(w http.ResponseWriter, r *http.Request) {
 		xhttp.WriteErrorJSON(w, err)
 		return
 	}
+	receiver.setEventSender(s.sender)
 
 	// Early check of Content-Length if available
 	maxBodyBytes := receiver.getMaxRequestBodyBytes()
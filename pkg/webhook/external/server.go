@@ -9,22 +9,25 @@ import (
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/akuity/kargo/pkg/event"
 	"github.com/akuity/kargo/pkg/logging"
 )
 
 type server struct {
 	cfg    ServerConfig
 	client client.Client
+	sender event.Sender
 }
 
 type Server interface {
 	Serve(ctx context.Context, l net.Listener) error
 }
 
-func NewServer(cfg ServerConfig, cl client.Client) Server {
+func NewServer(cfg ServerConfig, cl client.Client, sender event.Sender) Server {
 	return &server{
 		cfg:    cfg,
 		client: cl,
+		sender: sender,
 	}
 }
 
@@ -10,19 +10,24 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	k8sevent "github.com/akuity/kargo/pkg/event/kubernetes"
+	fakeevent "github.com/akuity/kargo/pkg/kubernetes/event/fake"
 )
 
 func TestNewServer(t *testing.T) {
 	testCfg := ServerConfig{}
 	testClient := fake.NewFakeClient()
-	s, ok := NewServer(ServerConfig{}, testClient).(*server)
+	testSender := k8sevent.NewEventSender(&fakeevent.EventRecorder{})
+	s, ok := NewServer(ServerConfig{}, testClient, testSender).(*server)
 	require.True(t, ok)
 	require.Equal(t, testCfg, s.cfg)
 	require.Same(t, testClient, s.client)
+	require.Same(t, testSender, s.sender)
 }
 
 func TestServer_Healthz(t *testing.T) {
-	s, ok := NewServer(ServerConfig{}, nil).(*server)
+	s, ok := NewServer(ServerConfig{}, nil, nil).(*server)
 	require.True(t, ok)
 	l, err := net.Listen("tcp", "127.0.0.1:0")
 	require.NoError(t, err)
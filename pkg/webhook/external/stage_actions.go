@@ -0,0 +1,381 @@
+package external
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/expr-lang/expr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/api"
+	"github.com/akuity/kargo/pkg/event"
+	"github.com/akuity/kargo/pkg/kargo"
+	"github.com/akuity/kargo/pkg/logging"
+)
+
+const (
+	// freightParam is the name of the action parameter that identifies Freight
+	// by name.
+	freightParam = "freight"
+	// freightAliasParam is the name of the action parameter that identifies
+	// Freight by alias.
+	freightAliasParam = "freightAlias"
+	// freightExpressionParam is the name of the action parameter that selects
+	// the newest Freight for which the expression evaluates to true.
+	freightExpressionParam = "freightExpression"
+)
+
+// stageAction is a function that performs an action on a single Stage. It
+// returns a short description of what was done for logging purposes.
+type stageAction func(context.Context, *kargoapi.Stage) (string, error)
+
+// actOnStages applies the provided stageAction to each of the provided objects
+// and returns the selected targets, an overall result, and a summary using the
+// provided verb.
+func actOnStages(
+	ctx context.Context,
+	objList []client.Object,
+	verb string,
+	action stageAction,
+) ([]selectedTarget, string, string) {
+	logger := logging.LoggerFromContext(ctx)
+	selectedTargets := make([]selectedTarget, len(objList))
+	var successCount, failureCount int
+	for i, obj := range objList {
+		objKey := client.ObjectKeyFromObject(obj)
+		objLogger := logger.WithValues(
+			"namespace", objKey.Namespace,
+			"stage", objKey.Name,
+		)
+		selectedTargets[i] = selectedTarget{
+			Namespace: objKey.Namespace,
+			Name:      objKey.Name,
+		}
+		stage, ok := obj.(*kargoapi.Stage)
+		if !ok {
+			objLogger.Error(nil, "selected target is not a Stage")
+			failureCount++
+			continue
+		}
+		msg, err := action(logging.ContextWithLogger(ctx, objLogger), stage)
+		if err != nil {
+			objLogger.Error(err, "error acting on Stage")
+			failureCount++
+			continue
+		}
+		objLogger.Debug(msg)
+		successCount++
+		selectedTargets[i].Success = true
+	}
+	result := getResult(len(objList), successCount, failureCount)
+	summary := fmt.Sprintf("%s %d of %d selected Stages", verb, successCount, len(objList))
+	return selectedTargets, result, summary
+}
+
+// promoteStages creates a Promotion of the Freight identified by the provided
+// parameters into each of the provided Stages.
+func (g *genericWebhookReceiver) promoteStages(
+	ctx context.Context,
+	objList []client.Object,
+	params map[string]string,
+	env map[string]any,
+) ([]selectedTarget, string, string) {
+	actor := g.getActor()
+	return actOnStages(
+		ctx,
+		objList,
+		"Promoted",
+		func(ctx context.Context, stage *kargoapi.Stage) (string, error) {
+			freight, err := g.selectFreight(ctx, stage, params, env, true)
+			if err != nil {
+				return "", err
+			}
+			block, err := api.GetPromotionBlock(ctx, g.client, stage.ObjectMeta, time.Now())
+			if err != nil {
+				return "", fmt.Errorf("error evaluating PromotionPolicy: %w", err)
+			}
+			if block != nil {
+				return "", errors.New(block.Message)
+			}
+			if api.IsSelfApprovedPromotion(stage, freight, actor) {
+				return "", fmt.Errorf(
+					"freight %q was approved for the Stage by %q, which may not also promote it",
+					freight.Name, actor,
+				)
+			}
+			promo, err := kargo.NewPromotionBuilder(g.client).Build(ctx, *stage, freight.Name)
+			if err != nil {
+				return "", fmt.Errorf("error building Promotion: %w", err)
+			}
+			promo.Annotations[kargoapi.AnnotationKeyCreateActor] = actor
+			if err = g.client.Create(ctx, promo); err != nil {
+				return "", fmt.Errorf("error creating Promotion: %w", err)
+			}
+			g.sendEvent(
+				ctx,
+				event.NewPromotionCreated(
+					fmt.Sprintf("Promotion created for Stage %q by %q", stage.Name, actor),
+					actor,
+					promo,
+					freight,
+				),
+			)
+			return fmt.Sprintf("created Promotion %q", promo.Name), nil
+		},
+	)
+}
+
+// approveFreightForStages approves the Freight identified by the provided
+// parameters for promotion into each of the provided Stages.
+func (g *genericWebhookReceiver) approveFreightForStages(
+	ctx context.Context,
+	objList []client.Object,
+	params map[string]string,
+	env map[string]any,
+) ([]selectedTarget, string, string) {
+	actor := g.getActor()
+	return actOnStages(
+		ctx,
+		objList,
+		"Approved Freight for",
+		func(ctx context.Context, stage *kargoapi.Stage) (string, error) {
+			freight, err := g.selectFreight(ctx, stage, params, env, false)
+			if err != nil {
+				return "", err
+			}
+			if freight.IsApprovedFor(stage.Name) {
+				return fmt.Sprintf("Freight %q is already approved", freight.Name), nil
+			}
+			// Webhook receivers carry no group claims, so they may only approve
+			// Freight for Stages that do not restrict approval to specific groups.
+			if !api.IsPermittedApprover(stage.Spec.ApprovalPolicy, nil) {
+				return "", errors.New(
+					"the Stage's ApprovalPolicy restricts approval to members of specific groups",
+				)
+			}
+			if freight.Status.HasApprovalFrom(stage.Name, actor) {
+				return fmt.Sprintf("Freight %q is already approved by %q", freight.Name, actor), nil
+			}
+			approved, err := api.ApproveFreight(
+				ctx,
+				g.client,
+				freight,
+				stage,
+				kargoapi.FreightApproval{
+					Approver:   actor,
+					ApprovedAt: &metav1.Time{Time: time.Now()},
+				},
+			)
+			if errors.Is(err, api.ErrDuplicateApproval) {
+				return fmt.Sprintf("Freight %q is already approved by %q", freight.Name, actor), nil
+			}
+			if err != nil {
+				return "", fmt.Errorf("error approving Freight %q: %w", freight.Name, err)
+			}
+			if !approved && freight.IsApprovedFor(stage.Name) {
+				// The Freight was approved concurrently by another approver.
+				return fmt.Sprintf("Freight %q is already approved", freight.Name), nil
+			}
+			if !approved {
+				return fmt.Sprintf(
+					"recorded approval of Freight %q; additional approvals are required",
+					freight.Name,
+				), nil
+			}
+			g.sendEvent(
+				ctx,
+				event.NewFreightApproved(
+					fmt.Sprintf("Freight approved for Stage %q by %q", stage.Name, actor),
+					actor,
+					stage.Name,
+					freight,
+				),
+			)
+			return fmt.Sprintf("approved Freight %q", freight.Name), nil
+		},
+	)
+}
+
+// reverifyStages re-runs the verification of the Freight currently in use by
+// each of the provided Stages. The resulting verification events are
+// attributed to the receiver.
+func (g *genericWebhookReceiver) reverifyStages(
+	ctx context.Context,
+	objList []client.Object,
+) ([]selectedTarget, string, string) {
+	actor := g.getActor()
+	return actOnStages(
+		ctx,
+		objList,
+		"Reverified",
+		func(ctx context.Context, stage *kargoapi.Stage) (string, error) {
+			if err := api.ReverifyStageFreightAs(
+				ctx,
+				g.client,
+				client.ObjectKeyFromObject(stage),
+				actor,
+			); err != nil {
+				return "", err
+			}
+			return "requested reverification", nil
+		},
+	)
+}
+
+// selectFreight returns the Freight identified by the provided parameters for
+// the provided Stage. Exactly one of the freight, freightAlias, or
+// freightExpression parameters must be specified. If availableOnly is true, the
+// selected Freight must also be available to the Stage.
+func (g *genericWebhookReceiver) selectFreight(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	params map[string]string,
+	env map[string]any,
+	availableOnly bool,
+) (*kargoapi.Freight, error) {
+	name := params[freightParam]
+	alias := params[freightAliasParam]
+	expression := params[freightExpressionParam]
+	var specified int
+	for _, p := range []string{name, alias, expression} {
+		if p != "" {
+			specified++
+		}
+	}
+	if specified != 1 {
+		return nil, fmt.Errorf(
+			"exactly one of the %q, %q, or %q parameters must be specified",
+			freightParam, freightAliasParam, freightExpressionParam,
+		)
+	}
+	if expression != "" {
+		return g.selectFreightByExpression(ctx, stage, expression, env, availableOnly)
+	}
+
+	var err error
+	if name != "" {
+		if name, err = evalAsString(name, env); err != nil {
+			return nil, fmt.Errorf("error evaluating %q parameter: %w", freightParam, err)
+		}
+	} else if alias, err = evalAsString(alias, env); err != nil {
+		return nil, fmt.Errorf("error evaluating %q parameter: %w", freightAliasParam, err)
+	}
+	freight, err := api.GetFreightByNameOrAlias(ctx, g.client, stage.Namespace, name, alias)
+	if err != nil {
+		return nil, err
+	}
+	if freight == nil {
+		if name != "" {
+			return nil, fmt.Errorf("freight %q not found in namespace %q", name, stage.Namespace)
+		}
+		return nil, fmt.Errorf(
+			"freight with alias %q not found in namespace %q",
+			alias, stage.Namespace,
+		)
+	}
+	if availableOnly && !stage.IsFreightAvailable(freight) {
+		// nolint:staticcheck
+		return nil, fmt.Errorf(
+			"Freight %q is not available to Stage %q",
+			freight.Name, stage.Name,
+		)
+	}
+	return freight, nil
+}
+
+// selectFreightByExpression returns the newest Freight requested by the
+// provided Stage for which the provided expression evaluates to true. The
+// Freight is exposed to the expression as `freight`. If availableOnly is true,
+// only Freight that is available to the Stage is considered.
+func (g *genericWebhookReceiver) selectFreightByExpression(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	expression string,
+	env map[string]any,
+	availableOnly bool,
+) (*kargoapi.Freight, error) {
+	program, err := expr.Compile(expression)
+	if err != nil {
+		return nil, fmt.Errorf("error compiling %q parameter: %w", freightExpressionParam, err)
+	}
+	freightList := &kargoapi.FreightList{}
+	if err = g.client.List(ctx, freightList, client.InNamespace(stage.Namespace)); err != nil {
+		return nil, fmt.Errorf("error listing Freight in namespace %q: %w", stage.Namespace, err)
+	}
+	candidates := slices.DeleteFunc(freightList.Items, func(f kargoapi.Freight) bool {
+		if availableOnly {
+			return !stage.IsFreightAvailable(&f)
+		}
+		return !slices.ContainsFunc(stage.Spec.RequestedFreight, func(req kargoapi.FreightRequest) bool {
+			return f.Origin.Equals(&req.Origin)
+		})
+	})
+	// Newest first
+	slices.SortFunc(candidates, func(lhs, rhs kargoapi.Freight) int {
+		return rhs.CreationTimestamp.Compare(lhs.CreationTimestamp.Time)
+	})
+	for i := range candidates {
+		freightEnv, err := freightToEnv(&candidates[i])
+		if err != nil {
+			return nil, err
+		}
+		freightExprEnv := maps.Clone(env)
+		freightExprEnv["freight"] = freightEnv
+		result, err := expr.Run(program, freightExprEnv)
+		if err != nil {
+			return nil, fmt.Errorf("error evaluating %q parameter: %w", freightExpressionParam, err)
+		}
+		matched, ok := result.(bool)
+		if !ok {
+			return nil, fmt.Errorf(
+				"%q parameter evaluated to %T; expected bool",
+				freightExpressionParam, result,
+			)
+		}
+		if matched {
+			return &candidates[i], nil
+		}
+	}
+	return nil, fmt.Errorf(
+		"no Freight requested by Stage %q matched the %q parameter",
+		stage.Name, freightExpressionParam,
+	)
+}
+
+// freightToEnv converts the provided Freight to a map that can be used in
+// expressions, using the same field names as its JSON representation.
+func freightToEnv(freight *kargoapi.Freight) (map[string]any, error) {
+	freightJSON, err := json.Marshal(freight)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling Freight %q: %w", freight.Name, err)
+	}
+	var freightEnv map[string]any
+	if err = json.Unmarshal(freightJSON, &freightEnv); err != nil {
+		return nil, fmt.Errorf("error unmarshaling Freight %q: %w", freight.Name, err)
+	}
+	return freightEnv, nil
+}
+
+// getActor returns the actor to which actions taken by the receiver are
+// attributed.
+func (g *genericWebhookReceiver) getActor() string {
+	return api.FormatEventWebhookReceiverActor(g.details.Name)
+}
+
+// sendEvent sends the provided event if the receiver has an event.Sender.
+// Failures are logged, but do not fail the action.
+func (g *genericWebhookReceiver) sendEvent(ctx context.Context, evt event.Meta) {
+	if g.sender == nil {
+		return
+	}
+	if err := g.sender.Send(ctx, evt); err != nil {
+		logging.LoggerFromContext(ctx).Error(err, "error sending event", "eventType", evt.Type())
+	}
+}
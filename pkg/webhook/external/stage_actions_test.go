@@ -0,0 +1,367 @@
+package external
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	k8sevent "github.com/akuity/kargo/pkg/event/kubernetes"
+	fakeevent "github.com/akuity/kargo/pkg/kubernetes/event/fake"
+)
+
+const testStageActionsProject = "fake-project"
+
+func newStageActionsTestObjects() (*kargoapi.Stage, *kargoapi.Freight, *kargoapi.Freight) {
+	testOrigin := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "fake-warehouse",
+	}
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testStageActionsProject,
+			Name:      "fake-stage",
+		},
+		Spec: kargoapi.StageSpec{
+			RequestedFreight: []kargoapi.FreightRequest{{
+				Origin:  testOrigin,
+				Sources: kargoapi.FreightSources{Direct: true},
+			}},
+			PromotionTemplate: &kargoapi.PromotionTemplate{
+				Spec: kargoapi.PromotionTemplateSpec{
+					Steps: []kargoapi.PromotionStep{{Uses: "fake-step"}},
+				},
+			},
+		},
+	}
+	olderFreight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         testStageActionsProject,
+			Name:              "older-freight",
+			Labels:            map[string]string{kargoapi.LabelKeyAlias: "older-alias"},
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Hour)),
+		},
+		Alias:  "older-alias",
+		Origin: testOrigin,
+		Images: []kargoapi.Image{{RepoURL: "example/app", Tag: "v1.0.0"}},
+	}
+	newerFreight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         testStageActionsProject,
+			Name:              "newer-freight",
+			Labels:            map[string]string{kargoapi.LabelKeyAlias: "newer-alias"},
+			CreationTimestamp: metav1.NewTime(time.Now()),
+		},
+		Alias:  "newer-alias",
+		Origin: testOrigin,
+		Images: []kargoapi.Image{{RepoURL: "example/app", Tag: "v1.1.0"}},
+	}
+	return stage, olderFreight, newerFreight
+}
+
+func newStageActionsTestReceiver(
+	t *testing.T,
+	recorder *fakeevent.EventRecorder,
+	objects ...client.Object,
+) *genericWebhookReceiver {
+	testScheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(testScheme))
+	return &genericWebhookReceiver{
+		baseWebhookReceiver: &baseWebhookReceiver{
+			client: fake.NewClientBuilder().
+				WithScheme(testScheme).
+				WithObjects(objects...).
+				WithStatusSubresource(&kargoapi.Freight{}).
+				Build(),
+			project: testStageActionsProject,
+			details: kargoapi.WebhookReceiverDetails{Name: "fake-receiver"},
+			sender:  k8sevent.NewEventSender(recorder),
+		},
+	}
+}
+
+func Test_genericWebhookReceiver_selectFreight(t *testing.T) {
+	stage, olderFreight, newerFreight := newStageActionsTestObjects()
+	env := map[string]any{
+		"request": map[string]any{
+			"body": map[string]any{
+				"freight": "older-freight",
+				"tag":     "v1.0.0",
+			},
+		},
+	}
+	testCases := []struct {
+		name          string
+		params        map[string]string
+		availableOnly bool
+		assertions    func(*testing.T, *kargoapi.Freight, error)
+	}{
+		{
+			name: "no Freight parameters",
+			assertions: func(t *testing.T, _ *kargoapi.Freight, err error) {
+				require.ErrorContains(t, err, "exactly one of")
+			},
+		},
+		{
+			name: "multiple Freight parameters",
+			params: map[string]string{
+				freightParam:      "older-freight",
+				freightAliasParam: "older-alias",
+			},
+			assertions: func(t *testing.T, _ *kargoapi.Freight, err error) {
+				require.ErrorContains(t, err, "exactly one of")
+			},
+		},
+		{
+			name:   "Freight name from request",
+			params: map[string]string{freightParam: "${{ request.body.freight }}"},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "older-freight", freight.Name)
+			},
+		},
+		{
+			name:   "Freight alias",
+			params: map[string]string{freightAliasParam: "newer-alias"},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "newer-freight", freight.Name)
+			},
+		},
+		{
+			name:   "Freight not found",
+			params: map[string]string{freightParam: "nonexistent"},
+			assertions: func(t *testing.T, _ *kargoapi.Freight, err error) {
+				require.ErrorContains(t, err, `freight "nonexistent" not found`)
+			},
+		},
+		{
+			name: "Freight expression selects newest match",
+			params: map[string]string{
+				freightExpressionParam: `freight.images[0].repoURL == "example/app"`,
+			},
+			availableOnly: true,
+			assertions: func(t *testing.T, freight *kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "newer-freight", freight.Name)
+			},
+		},
+		{
+			name: "Freight expression using request",
+			params: map[string]string{
+				freightExpressionParam: `freight.images[0].tag == request.body.tag`,
+			},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "older-freight", freight.Name)
+			},
+		},
+		{
+			name:   "Freight expression matches nothing",
+			params: map[string]string{freightExpressionParam: `freight.alias == "nope"`},
+			assertions: func(t *testing.T, _ *kargoapi.Freight, err error) {
+				require.ErrorContains(t, err, "no Freight requested by Stage")
+			},
+		},
+		{
+			name:   "Freight expression does not evaluate to a bool",
+			params: map[string]string{freightExpressionParam: `freight.alias`},
+			assertions: func(t *testing.T, _ *kargoapi.Freight, err error) {
+				require.ErrorContains(t, err, "expected bool")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := newStageActionsTestReceiver(t, nil, stage, olderFreight, newerFreight)
+			freight, err := g.selectFreight(
+				context.Background(),
+				stage,
+				testCase.params,
+				env,
+				testCase.availableOnly,
+			)
+			testCase.assertions(t, freight, err)
+		})
+	}
+}
+
+func Test_genericWebhookReceiver_promoteStages(t *testing.T) {
+	stage, olderFreight, newerFreight := newStageActionsTestObjects()
+	recorder := fakeevent.NewEventRecorder(1)
+	g := newStageActionsTestReceiver(t, recorder, stage, olderFreight, newerFreight)
+
+	targets, result, summary := g.promoteStages(
+		context.Background(),
+		[]client.Object{stage},
+		map[string]string{freightAliasParam: "older-alias"},
+		map[string]any{},
+	)
+	require.Equal(t, resultSuccess, result)
+	require.Equal(t, "Promoted 1 of 1 selected Stages", summary)
+	require.Equal(t, []selectedTarget{{
+		Namespace: testStageActionsProject,
+		Name:      "fake-stage",
+		Success:   true,
+	}}, targets)
+
+	promos := &kargoapi.PromotionList{}
+	require.NoError(t, g.client.List(context.Background(), promos))
+	require.Len(t, promos.Items, 1)
+	require.Equal(t, "older-freight", promos.Items[0].Spec.Freight)
+	require.Equal(
+		t,
+		"webhook-receiver:fake-receiver",
+		promos.Items[0].Annotations[kargoapi.AnnotationKeyCreateActor],
+	)
+
+	require.Len(t, recorder.Events, 1)
+	evt := <-recorder.Events
+	require.Equal(t, string(kargoapi.EventTypePromotionCreated), evt.Reason)
+	require.Equal(t, "webhook-receiver:fake-receiver", evt.Annotations[kargoapi.AnnotationKeyEventActor])
+}
+
+func Test_genericWebhookReceiver_approveFreightForStages(t *testing.T) {
+	stage, olderFreight, newerFreight := newStageActionsTestObjects()
+	recorder := fakeevent.NewEventRecorder(1)
+	g := newStageActionsTestReceiver(t, recorder, stage, olderFreight, newerFreight)
+
+	targets, result, summary := g.approveFreightForStages(
+		context.Background(),
+		[]client.Object{stage, &kargoapi.Warehouse{}},
+		map[string]string{freightParam: "newer-freight"},
+		map[string]any{},
+	)
+	require.Equal(t, resultPartialSuccess, result)
+	require.Equal(t, "Approved Freight for 1 of 2 selected Stages", summary)
+	require.True(t, targets[0].Success)
+	require.False(t, targets[1].Success)
+
+	freight := &kargoapi.Freight{}
+	require.NoError(t, g.client.Get(
+		context.Background(),
+		client.ObjectKeyFromObject(newerFreight),
+		freight,
+	))
+	require.True(t, freight.IsApprovedFor("fake-stage"))
+
+	require.Len(t, recorder.Events, 1)
+	evt := <-recorder.Events
+	require.Equal(t, string(kargoapi.EventTypeFreightApproved), evt.Reason)
+	require.Equal(t, "webhook-receiver:fake-receiver", evt.Annotations[kargoapi.AnnotationKeyEventActor])
+}
+
+func Test_genericWebhookReceiver_approveFreightForStages_approvalPolicy(t *testing.T) {
+	stage, olderFreight, newerFreight := newStageActionsTestObjects()
+	stage.Spec.ApprovalPolicy = &kargoapi.ApprovalPolicy{RequiredApprovals: 2}
+	restrictedStage := stage.DeepCopy()
+	restrictedStage.Name = "restricted-stage"
+	restrictedStage.Spec.ApprovalPolicy = &kargoapi.ApprovalPolicy{
+		ApproverGroups: []string{"release-managers"},
+	}
+	recorder := fakeevent.NewEventRecorder(1)
+	g := newStageActionsTestReceiver(t, recorder, stage, restrictedStage, olderFreight, newerFreight)
+
+	targets, result, _ := g.approveFreightForStages(
+		context.Background(),
+		[]client.Object{stage, restrictedStage},
+		map[string]string{freightParam: "newer-freight"},
+		map[string]any{},
+	)
+	require.Equal(t, resultPartialSuccess, result)
+	require.True(t, targets[0].Success)
+	require.False(t, targets[1].Success)
+
+	freight := &kargoapi.Freight{}
+	require.NoError(t, g.client.Get(
+		context.Background(),
+		client.ObjectKeyFromObject(newerFreight),
+		freight,
+	))
+	require.False(t, freight.IsApprovedFor(stage.Name))
+	require.True(t, freight.Status.HasApprovalFrom(stage.Name, "webhook-receiver:fake-receiver"))
+
+	// No event is recorded until the Freight is approved
+	require.Empty(t, recorder.Events)
+}
+
+func Test_genericWebhookReceiver_reverifyStages(t *testing.T) {
+	stage, _, _ := newStageActionsTestObjects()
+	verifiedStage := stage.DeepCopy()
+	verifiedStage.Name = "verified-stage"
+	verifiedStage.Status.FreightHistory = kargoapi.FreightHistory{{
+		Freight: map[string]kargoapi.FreightReference{
+			"fake-warehouse": {},
+		},
+		VerificationHistory: []kargoapi.VerificationInfo{{ID: "fake-id"}},
+	}}
+	g := newStageActionsTestReceiver(t, nil, stage, verifiedStage)
+
+	targets, result, summary := g.reverifyStages(
+		context.Background(),
+		[]client.Object{stage, verifiedStage},
+	)
+	require.Equal(t, resultPartialSuccess, result)
+	require.Equal(t, "Reverified 1 of 2 selected Stages", summary)
+	require.False(t, targets[0].Success)
+	require.True(t, targets[1].Success)
+
+	updated := &kargoapi.Stage{}
+	require.NoError(t, g.client.Get(
+		context.Background(),
+		client.ObjectKeyFromObject(verifiedStage),
+		updated,
+	))
+	require.Equal(
+		t,
+		(&kargoapi.VerificationRequest{
+			ID:    "fake-id",
+			Actor: "webhook-receiver:fake-receiver",
+		}).String(),
+		updated.Annotations[kargoapi.AnnotationKeyReverify],
+	)
+}
+
+func Test_genericWebhookReceiver_promoteStages_blocked(t *testing.T) {
+	stage, olderFreight, newerFreight := newStageActionsTestObjects()
+	projectCfg := &kargoapi.ProjectConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testStageActionsProject,
+			Name:      testStageActionsProject,
+		},
+		Spec: kargoapi.ProjectConfigSpec{
+			PromotionPolicies: []kargoapi.PromotionPolicy{{
+				StageSelector: &kargoapi.PromotionPolicySelector{Name: stage.Name},
+				Blackouts: []kargoapi.PromotionBlackout{{
+					Name:  "holidays",
+					Start: metav1.NewTime(time.Now().Add(-time.Hour)),
+					End:   metav1.NewTime(time.Now().Add(time.Hour)),
+				}},
+			}},
+		},
+	}
+	recorder := fakeevent.NewEventRecorder(1)
+	g := newStageActionsTestReceiver(t, recorder, stage, olderFreight, newerFreight, projectCfg)
+
+	targets, result, summary := g.promoteStages(
+		context.Background(),
+		[]client.Object{stage},
+		map[string]string{freightAliasParam: "older-alias"},
+		map[string]any{},
+	)
+	require.Equal(t, resultFailure, result)
+	require.Equal(t, "Promoted 0 of 1 selected Stages", summary)
+	require.Len(t, targets, 1)
+	require.False(t, targets[0].Success)
+
+	promos := &kargoapi.PromotionList{}
+	require.NoError(t, g.client.List(context.Background(), promos))
+	require.Empty(t, promos.Items)
+	require.Empty(t, recorder.Events)
+}
@@ -97,5 +97,36 @@ func (w *webhook) validateSpec(
 	); errs != nil {
 		fieldErrs = append(fieldErrs, errs...)
 	}
+	fieldErrs = append(
+		fieldErrs,
+		validateGenericActions(f.Child("webhookReceivers"), spec.WebhookReceivers)...,
+	)
 	return fieldErrs
 }
+
+// validateGenericActions ensures that cluster-level generic webhook receivers
+// only define Refresh actions. Actions that act upon Stages are only supported
+// by Project-level receivers.
+func validateGenericActions(
+	f *field.Path,
+	webhookReceivers []kargoapi.WebhookReceiverConfig,
+) field.ErrorList {
+	var errs field.ErrorList
+	for i, r := range webhookReceivers {
+		if r.Generic == nil {
+			continue
+		}
+		for j, action := range r.Generic.Actions {
+			if action.ActionType != kargoapi.GenericWebhookActionTypeRefresh {
+				errs = append(errs, field.Forbidden(
+					f.Index(i).Child("generic", "actions").Index(j).Child("action"),
+					fmt.Sprintf(
+						"%s actions are only supported by Project-level webhook receivers",
+						action.ActionType,
+					),
+				))
+			}
+		}
+	}
+	return errs
+}
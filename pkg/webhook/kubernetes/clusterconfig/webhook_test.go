@@ -84,6 +84,49 @@ func Test_webhook_ValidateCreate(t *testing.T) {
 				require.Empty(t, warnings)
 			},
 		},
+		{
+			name: "generic webhook receiver with Stage action",
+			cfg: &kargoapi.ClusterConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: api.ClusterConfigName,
+				},
+				Spec: kargoapi.ClusterConfigSpec{
+					WebhookReceivers: []kargoapi.WebhookReceiverConfig{{
+						Name: "my-webhook-receiver",
+						Generic: &kargoapi.GenericWebhookReceiverConfig{
+							Actions: []kargoapi.GenericWebhookAction{{
+								ActionType: kargoapi.GenericWebhookActionTypeReverify,
+								TargetSelectionCriteria: []kargoapi.GenericWebhookTargetSelectionCriteria{{
+									Kind: kargoapi.GenericWebhookTargetKindStage,
+									Name: "my-stage",
+								}},
+							}},
+						},
+					}},
+				},
+			},
+			assertions: func(t *testing.T, warnings admission.Warnings, err error) {
+				require.Error(t, err)
+
+				var statusErr *apierrors.StatusError
+				require.True(t, errors.As(err, &statusErr))
+
+				require.Equal(t, metav1.StatusReasonInvalid, statusErr.ErrStatus.Reason)
+				require.Equal(t, 1, len(statusErr.ErrStatus.Details.Causes))
+				require.Equal(
+					t,
+					"spec.webhookReceivers[0].generic.actions[0].action",
+					statusErr.ErrStatus.Details.Causes[0].Field,
+				)
+				require.Contains(
+					t,
+					statusErr.ErrStatus.Details.Causes[0].Message,
+					"Reverify actions are only supported by Project-level webhook receivers",
+				)
+
+				require.Empty(t, warnings)
+			},
+		},
 		{
 			name: "valid cluster config",
 			cfg: &kargoapi.ClusterConfig{
@@ -8,6 +8,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
@@ -19,21 +20,23 @@ var clusterPromotionTaskGroupKind = schema.GroupKind{
 	Kind:  "ClusterPromotionTask",
 }
 
-type webhook struct{}
+type webhook struct {
+	client client.Client
+}
 
 func SetupWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(&kargoapi.ClusterPromotionTask{}).
-		WithValidator(&webhook{}).
+		WithValidator(&webhook{client: mgr.GetClient()}).
 		Complete()
 }
 
 func (w *webhook) ValidateCreate(
-	_ context.Context,
+	ctx context.Context,
 	obj runtime.Object,
 ) (admission.Warnings, error) {
 	task := obj.(*kargoapi.ClusterPromotionTask) // nolint: forcetypeassert
-	if errs := w.validateSpec(field.NewPath("spec"), task.Spec); len(errs) > 0 {
+	if errs := w.validateSpec(ctx, field.NewPath("spec"), task.Spec); len(errs) > 0 {
 		return nil, apierrors.NewInvalid(
 			clusterPromotionTaskGroupKind,
 			task.Name,
@@ -44,12 +47,12 @@ func (w *webhook) ValidateCreate(
 }
 
 func (w *webhook) ValidateUpdate(
-	_ context.Context,
+	ctx context.Context,
 	_ runtime.Object,
 	newObj runtime.Object,
 ) (admission.Warnings, error) {
 	task := newObj.(*kargoapi.ClusterPromotionTask) // nolint: forcetypeassert
-	if errs := w.validateSpec(field.NewPath("spec"), task.Spec); len(errs) > 0 {
+	if errs := w.validateSpec(ctx, field.NewPath("spec"), task.Spec); len(errs) > 0 {
 		return nil, apierrors.NewInvalid(
 			clusterPromotionTaskGroupKind,
 			task.Name,
@@ -68,8 +71,17 @@ func (w *webhook) ValidateDelete(
 }
 
 func (w *webhook) validateSpec(
+	ctx context.Context,
 	f *field.Path,
 	spec kargoapi.PromotionTaskSpec,
 ) field.ErrorList {
-	return libWebhook.ValidatePromotionSteps(f.Child("steps"), spec.Steps)
+	errs := libWebhook.ValidatePromotionTaskSpec(f, spec)
+	errs = append(
+		errs,
+		libWebhook.ValidateStepPluginConfigs(ctx, w.client, f.Child("steps"), spec.Steps)...,
+	)
+	return append(
+		errs,
+		libWebhook.ValidateStepPluginConfigs(ctx, w.client, f.Child("onFailure"), spec.OnFailure)...,
+	)
 }
@@ -68,7 +68,7 @@ func Test_webhook_ValidateSpec(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.assertions(
 				t,
-				w.validateSpec(field.NewPath("spec"), testCase.spec),
+				w.validateSpec(t.Context(), field.NewPath("spec"), testCase.spec),
 			)
 		})
 	}
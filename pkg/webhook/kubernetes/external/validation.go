@@ -106,10 +106,78 @@ func validateGenericConfig(
 	var errs field.ErrorList
 	for i, action := range cfg.Actions {
 		errs = append(errs, validateGenericTargets(cfgIndex, i, action.TargetSelectionCriteria)...)
+		errs = append(errs, validateGenericTargetKinds(cfgIndex, i, action)...)
+		errs = append(errs, validateGenericParameters(cfgIndex, i, action)...)
 	}
 	return errs
 }
 
+// validateGenericTargetKinds ensures that all targets of an action are of a
+// kind that the action can be applied to.
+func validateGenericTargetKinds(
+	cfgIndex, actionIndex int,
+	action kargoapi.GenericWebhookAction,
+) field.ErrorList {
+	var expectedKind kargoapi.GenericWebhookTargetKind
+	switch action.ActionType {
+	case kargoapi.GenericWebhookActionTypeRefresh:
+		expectedKind = kargoapi.GenericWebhookTargetKindWarehouse
+	case kargoapi.GenericWebhookActionTypePromote,
+		kargoapi.GenericWebhookActionTypeApprove,
+		kargoapi.GenericWebhookActionTypeReverify:
+		expectedKind = kargoapi.GenericWebhookTargetKindStage
+	default:
+		// Unknown action types are rejected by the CRD's schema.
+		return nil
+	}
+	var errs field.ErrorList
+	for i, target := range action.TargetSelectionCriteria {
+		if target.Kind != expectedKind {
+			errs = append(errs, field.Invalid(
+				field.NewPath(fmt.Sprintf(
+					"spec.webhookReceivers[%d].generic.actions[%d].targetSelectionCriteria[%d].kind",
+					cfgIndex, actionIndex, i,
+				)),
+				target.Kind,
+				fmt.Sprintf("%s actions can only target %s resources", action.ActionType, expectedKind),
+			))
+		}
+	}
+	return errs
+}
+
+// validateGenericParameters ensures that actions that act upon Freight
+// identify that Freight using exactly one of the supported parameters.
+func validateGenericParameters(
+	cfgIndex, actionIndex int,
+	action kargoapi.GenericWebhookAction,
+) field.ErrorList {
+	if action.ActionType != kargoapi.GenericWebhookActionTypePromote &&
+		action.ActionType != kargoapi.GenericWebhookActionTypeApprove {
+		return nil
+	}
+	var specified int
+	for _, key := range []string{"freight", "freightAlias", "freightExpression"} {
+		if action.Parameters[key] != "" {
+			specified++
+		}
+	}
+	if specified == 1 {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(
+		field.NewPath(fmt.Sprintf(
+			"spec.webhookReceivers[%d].generic.actions[%d].parameters",
+			cfgIndex, actionIndex,
+		)),
+		action.Parameters,
+		fmt.Sprintf(
+			"%s actions require exactly one of the freight, freightAlias, or freightExpression parameters",
+			action.ActionType,
+		),
+	)}
+}
+
 func validateGenericTargets(
 	cfgIndex, actionIndex int,
 	targets []kargoapi.GenericWebhookTargetSelectionCriteria,
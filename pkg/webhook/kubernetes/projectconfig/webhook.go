@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/expr-lang/expr"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -18,6 +20,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/cron"
 	"github.com/akuity/kargo/pkg/pattern"
 	"github.com/akuity/kargo/pkg/webhook/kubernetes/external"
 )
@@ -145,9 +148,36 @@ func (w *webhook) validateSpec(
 	); errs != nil {
 		fieldErrs = append(fieldErrs, errs...)
 	}
+
+	if errs := w.validateNotificationSinks(
+		f.Child("notificationSinks"),
+		spec.NotificationSinks,
+	); errs != nil {
+		fieldErrs = append(fieldErrs, errs...)
+	}
 	return fieldErrs
 }
 
+func (w *webhook) validateNotificationSinks(
+	f *field.Path,
+	sinks []kargoapi.NotificationSinkConfig,
+) field.ErrorList {
+	var errs field.ErrorList
+	for i, sink := range sinks {
+		if sink.Filter == "" {
+			continue
+		}
+		if _, err := expr.Compile(sink.Filter); err != nil {
+			errs = append(errs, field.Invalid(
+				f.Index(i).Child("filter"),
+				sink.Filter,
+				fmt.Sprintf("invalid expression: %s", err),
+			))
+		}
+	}
+	return errs
+}
+
 func (w *webhook) validatePromotionPolicies(
 	f *field.Path,
 	promotionPolicies []kargoapi.PromotionPolicy,
@@ -158,6 +188,8 @@ func (w *webhook) validatePromotionPolicies(
 	stageNames := make(map[string][]int)
 
 	for i, policy := range promotionPolicies {
+		errs = append(errs, w.validatePromotionWindows(f.Index(i), policy)...)
+
 		stage := policy.Stage // nolint:staticcheck
 		if policy.StageSelector != nil {
 			stage = policy.StageSelector.Name
@@ -222,6 +254,48 @@ func (w *webhook) validatePromotionPolicies(
 	return errs
 }
 
+func (w *webhook) validatePromotionWindows(
+	f *field.Path,
+	policy kargoapi.PromotionPolicy,
+) field.ErrorList {
+	var errs field.ErrorList
+	for i, window := range policy.PromotionWindows {
+		if _, err := cron.Parse(window.Schedule); err != nil {
+			errs = append(errs, field.Invalid(
+				f.Child("promotionWindows").Index(i).Child("schedule"),
+				window.Schedule,
+				err.Error(),
+			))
+		}
+		if window.Duration.Duration <= 0 {
+			errs = append(errs, field.Invalid(
+				f.Child("promotionWindows").Index(i).Child("duration"),
+				window.Duration.String(),
+				"duration must be greater than zero",
+			))
+		}
+		if window.TimeZone != "" {
+			if _, err := time.LoadLocation(window.TimeZone); err != nil {
+				errs = append(errs, field.Invalid(
+					f.Child("promotionWindows").Index(i).Child("timeZone"),
+					window.TimeZone,
+					err.Error(),
+				))
+			}
+		}
+	}
+	for i, blackout := range policy.Blackouts {
+		if !blackout.End.After(blackout.Start.Time) {
+			errs = append(errs, field.Invalid(
+				f.Child("blackouts").Index(i).Child("end"),
+				blackout.End.String(),
+				"end must be after start",
+			))
+		}
+	}
+	return errs
+}
+
 func (w *webhook) ensureProjectNamespace(ctx context.Context, meta metav1.ObjectMeta) error {
 	ns := &corev1.Namespace{}
 	if err := w.client.Get(ctx, types.NamespacedName{Name: meta.Namespace}, ns); err != nil {
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -134,6 +135,49 @@ func Test_webhook_ValidateCreate(t *testing.T) {
 				assert.Equal(t, "spec.promotionPolicies[1]", statusErr.ErrStatus.Details.Causes[0].Field)
 			},
 		},
+		{
+			name: "invalid spec: invalid promotion windows and blackouts",
+			projectConfig: &kargoapi.ProjectConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testProjectName,
+					Namespace: testProjectName,
+				},
+				Spec: kargoapi.ProjectConfigSpec{
+					PromotionPolicies: []kargoapi.PromotionPolicy{{
+						Stage: "stage-1",
+						PromotionWindows: []kargoapi.PromotionWindow{{
+							Name:     "business-hours",
+							Schedule: "0 25 * * *",
+							Duration: metav1.Duration{},
+							TimeZone: "Mars/Olympus_Mons",
+						}},
+						Blackouts: []kargoapi.PromotionBlackout{{
+							Name:  "holidays",
+							Start: metav1.NewTime(time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)),
+							End:   metav1.NewTime(time.Date(2025, 12, 24, 0, 0, 0, 0, time.UTC)),
+						}},
+					}},
+				},
+			},
+			objects: []client.Object{testNs},
+			assertions: func(t *testing.T, warnings admission.Warnings, err error) {
+				assert.Empty(t, warnings)
+				require.Error(t, err)
+
+				var statusErr *apierrors.StatusError
+				require.True(t, errors.As(err, &statusErr))
+
+				assert.Equal(t, metav1.StatusReasonInvalid, statusErr.ErrStatus.Reason)
+				causes := statusErr.ErrStatus.Details.Causes
+				require.Len(t, causes, 4)
+				assert.Equal(t, "spec.promotionPolicies[0].promotionWindows[0].schedule", causes[0].Field)
+				assert.Contains(t, causes[0].Message, "error parsing hour field")
+				assert.Equal(t, "spec.promotionPolicies[0].promotionWindows[0].duration", causes[1].Field)
+				assert.Equal(t, "spec.promotionPolicies[0].promotionWindows[0].timeZone", causes[2].Field)
+				assert.Equal(t, "spec.promotionPolicies[0].blackouts[0].end", causes[3].Field)
+				assert.Contains(t, causes[3].Message, "end must be after start")
+			},
+		},
 		{
 			name: "namespace does not exist",
 			projectConfig: &kargoapi.ProjectConfig{
@@ -638,6 +682,109 @@ func Test_webhook_ValidateCreate(t *testing.T) {
 					"at least one of name, labelSelector, or indexSelector must be specified for target")
 			},
 		},
+		{
+			name: "generic webhook receiver stage action misconfiguration",
+			projectConfig: &kargoapi.ProjectConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testProjectName,
+					Namespace: testProjectName,
+				},
+				Spec: kargoapi.ProjectConfigSpec{
+					WebhookReceivers: []kargoapi.WebhookReceiverConfig{
+						{
+							Name: "my-generic-webhook-receiver",
+							Generic: &kargoapi.GenericWebhookReceiverConfig{
+								Actions: []kargoapi.GenericWebhookAction{
+									{
+										ActionType: kargoapi.GenericWebhookActionTypePromote,
+										Parameters: map[string]string{
+											"freight":      "${{ request.body.freight }}",
+											"freightAlias": "${{ request.body.alias }}",
+										},
+										TargetSelectionCriteria: []kargoapi.GenericWebhookTargetSelectionCriteria{
+											{
+												Kind: kargoapi.GenericWebhookTargetKindWarehouse,
+												Name: "my-warehouse",
+											},
+										},
+									},
+									{
+										ActionType: kargoapi.GenericWebhookActionTypeReverify,
+										TargetSelectionCriteria: []kargoapi.GenericWebhookTargetSelectionCriteria{
+											{
+												Kind: kargoapi.GenericWebhookTargetKindStage,
+												Name: "my-stage",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			objects: []client.Object{testNs},
+			assertions: func(t *testing.T, warnings admission.Warnings, err error) {
+				assert.Empty(t, warnings)
+				require.Error(t, err)
+
+				var statusErr *apierrors.StatusError
+				require.True(t, errors.As(err, &statusErr))
+
+				assert.Equal(t, metav1.StatusReasonInvalid, statusErr.ErrStatus.Reason)
+				require.Len(t, statusErr.ErrStatus.Details.Causes, 2)
+
+				// Sort errors for consistent testing
+				sort.Slice(statusErr.ErrStatus.Details.Causes, func(i, j int) bool {
+					return statusErr.ErrStatus.Details.Causes[i].Field < statusErr.ErrStatus.Details.Causes[j].Field
+				})
+
+				assert.Equal(t, "spec.webhookReceivers[0].generic.actions[0].parameters",
+					statusErr.ErrStatus.Details.Causes[0].Field)
+				assert.Contains(t, statusErr.ErrStatus.Details.Causes[0].Message,
+					"Promote actions require exactly one of the freight, freightAlias, or freightExpression parameters")
+				assert.Equal(t, "spec.webhookReceivers[0].generic.actions[0].targetSelectionCriteria[0].kind",
+					statusErr.ErrStatus.Details.Causes[1].Field)
+				assert.Contains(t, statusErr.ErrStatus.Details.Causes[1].Message,
+					"Promote actions can only target Stage resources")
+			},
+		},
+		{
+			name: "invalid spec: notification sink filter is not a valid expression",
+			projectConfig: &kargoapi.ProjectConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testProjectName,
+					Namespace: testProjectName,
+				},
+				Spec: kargoapi.ProjectConfigSpec{
+					NotificationSinks: []kargoapi.NotificationSinkConfig{
+						{
+							Name:   "valid",
+							Filter: `event.type == "PromotionSucceeded"`,
+							Slack:  &kargoapi.SlackNotificationSinkConfig{},
+						},
+						{
+							Name:   "invalid",
+							Filter: `event.type ==`,
+							Slack:  &kargoapi.SlackNotificationSinkConfig{},
+						},
+					},
+				},
+			},
+			objects: []client.Object{testNs},
+			assertions: func(t *testing.T, warnings admission.Warnings, err error) {
+				assert.Empty(t, warnings)
+				require.Error(t, err)
+
+				var statusErr *apierrors.StatusError
+				require.True(t, errors.As(err, &statusErr))
+
+				assert.Equal(t, metav1.StatusReasonInvalid, statusErr.ErrStatus.Reason)
+				require.Len(t, statusErr.ErrStatus.Details.Causes, 1)
+				assert.Equal(t, "spec.notificationSinks[1].filter", statusErr.ErrStatus.Details.Causes[0].Field)
+				assert.Contains(t, statusErr.ErrStatus.Details.Causes[0].Message, "invalid expression")
+			},
+		},
 	}
 
 	for _, tt := range tests {
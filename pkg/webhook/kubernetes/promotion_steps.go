@@ -1,51 +1,258 @@
 package webhook
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strings"
 
+	"github.com/xeipuuv/gojsonschema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/promotion"
 )
 
+// ValidatePromotionSteps validates the provided steps, ensuring that their
+// aliases are unique and not reserved, and that parallel groups are well
+// formed.
 func ValidatePromotionSteps(
 	f *field.Path,
 	steps []kargoapi.PromotionStep,
 ) field.ErrorList {
-	errs := field.ErrorList{}
-	indicesByAlias := make(map[string]int)
+	return newPromotionStepsValidator().validate(f, steps)
+}
+
+// ValidatePromotionStepsWithOnFailure validates the provided steps and
+// OnFailure steps in the same manner as ValidatePromotionSteps. As OnFailure
+// steps may reference the outcome of the steps, aliases must be unique across
+// both.
+func ValidatePromotionStepsWithOnFailure(
+	stepsPath *field.Path,
+	steps []kargoapi.PromotionStep,
+	onFailurePath *field.Path,
+	onFailure []kargoapi.PromotionStep,
+) field.ErrorList {
+	v := newPromotionStepsValidator()
+	return append(v.validate(stepsPath, steps), v.validate(onFailurePath, onFailure)...)
+}
+
+// ValidatePromotionTaskSpec validates the steps and OnFailure steps of a
+// (Cluster)PromotionTask. In addition to the validation performed by
+// ValidatePromotionStepsWithOnFailure, it ensures that none of the steps make
+// use of forEach, as steps are only expanded when referenced directly by a
+// Promotion.
+func ValidatePromotionTaskSpec(
+	f *field.Path,
+	spec kargoapi.PromotionTaskSpec,
+) field.ErrorList {
+	errs := ValidatePromotionStepsWithOnFailure(
+		f.Child("steps"),
+		spec.Steps,
+		f.Child("onFailure"),
+		spec.OnFailure,
+	)
+	for _, steps := range []struct {
+		path  *field.Path
+		steps []kargoapi.PromotionStep
+	}{
+		{path: f.Child("steps"), steps: spec.Steps},
+		{path: f.Child("onFailure"), steps: spec.OnFailure},
+	} {
+		for i, step := range steps.steps {
+			if step.ForEach != "" {
+				errs = append(
+					errs,
+					field.Forbidden(
+						steps.path.Index(i).Child("forEach"),
+						"forEach is not supported within a PromotionTask",
+					),
+				)
+			}
+		}
+	}
+	return errs
+}
+
+// ValidateStepPluginConfigs validates the configuration of any of the
+// provided steps executed by a StepPlugin against the config schema advertised
+// by that StepPlugin. Steps whose configuration contains expressions are not
+// validated, as their configuration is only known once the expressions have
+// been evaluated during the Promotion.
+func ValidateStepPluginConfigs(
+	ctx context.Context,
+	c client.Reader,
+	f *field.Path,
+	steps []kargoapi.PromotionStep,
+) field.ErrorList {
+	type pluginStep struct {
+		path *field.Path
+		step kargoapi.PromotionStep
+	}
+	var candidates []pluginStep
 	for i, step := range steps {
-		stepAlias := strings.TrimSpace(step.As)
-		if stepAlias == "" {
+		if len(step.Parallel) > 0 {
+			for j, parallelStep := range step.GetParallelSteps() {
+				candidates = append(candidates, pluginStep{
+					path: f.Index(i).Child("parallel").Index(j),
+					step: parallelStep,
+				})
+			}
+			continue
+		}
+		candidates = append(candidates, pluginStep{path: f.Index(i), step: step})
+	}
+	// Only steps with a static configuration and a kind that is not built in
+	// are candidates for validation. Filtering these up front avoids listing
+	// StepPlugins in the common case where no plugins are in use.
+	filtered := candidates[:0]
+	for _, candidate := range candidates {
+		if candidate.step.Uses == "" || candidate.step.Config == nil ||
+			strings.Contains(string(candidate.step.Config.Raw), "${{") {
+			continue
+		}
+		if _, err := promotion.DefaultStepRunnerRegistry.Get(candidate.step.Uses); err == nil {
+			continue
+		}
+		filtered = append(filtered, candidate)
+	}
+	if len(filtered) == 0 {
+		return nil
+	}
+
+	plugins := &kargoapi.StepPluginList{}
+	if err := c.List(ctx, plugins); err != nil {
+		return field.ErrorList{
+			field.InternalError(f, fmt.Errorf("error listing StepPlugins: %w", err)),
+		}
+	}
+	schemasByKind := make(map[string][]byte)
+	for _, plugin := range plugins.Items {
+		for _, step := range plugin.Spec.Steps {
+			if step.ConfigSchema != nil && len(step.ConfigSchema.Raw) > 0 {
+				schemasByKind[step.Kind] = step.ConfigSchema.Raw
+			}
+		}
+	}
+
+	var errs field.ErrorList
+	for _, candidate := range filtered {
+		schema, ok := schemasByKind[candidate.step.Uses]
+		if !ok {
 			continue
 		}
-		if existingIndex, exists := indicesByAlias[stepAlias]; exists {
+		if err := validateStepConfig(schema, candidate.step.Config.Raw); err != nil {
 			errs = append(
 				errs,
 				field.Invalid(
-					f.Index(i).Child("as"),
-					stepAlias,
-					fmt.Sprintf(
-						"step alias duplicates that of %s",
-						f.Index(existingIndex),
-					),
+					candidate.path.Child("config"),
+					string(candidate.step.Config.Raw),
+					fmt.Sprintf("invalid %s config: %s", candidate.step.Uses, err),
+				),
+			)
+		}
+	}
+	return errs
+}
+
+// validateStepConfig validates the provided step configuration against the
+// provided JSON schema.
+func validateStepConfig(schema, config []byte) error {
+	result, err := gojsonschema.Validate(
+		gojsonschema.NewBytesLoader(schema),
+		gojsonschema.NewBytesLoader(config),
+	)
+	if err != nil {
+		return err
+	}
+	if result.Valid() {
+		return nil
+	}
+	errs := make([]error, len(result.Errors()))
+	for i, err := range result.Errors() {
+		errs[i] = errors.New(err.String())
+	}
+	return errors.Join(errs...)
+}
+
+// promotionStepsValidator validates promotion steps while keeping track of
+// the aliases it has encountered.
+type promotionStepsValidator struct {
+	pathsByAlias map[string]*field.Path
+}
+
+func newPromotionStepsValidator() *promotionStepsValidator {
+	return &promotionStepsValidator{
+		pathsByAlias: make(map[string]*field.Path),
+	}
+}
+
+func (v *promotionStepsValidator) validate(
+	f *field.Path,
+	steps []kargoapi.PromotionStep,
+) field.ErrorList {
+	errs := field.ErrorList{}
+	for i, step := range steps {
+		errs = append(errs, v.validateAlias(f.Index(i), step.As)...)
+		if len(step.Parallel) == 0 {
+			continue
+		}
+		if step.ForEach != "" {
+			errs = append(
+				errs,
+				field.Forbidden(
+					f.Index(i).Child("forEach"),
+					"forEach cannot be combined with parallel",
 				),
 			)
-		} else {
-			indicesByAlias[stepAlias] = i
 		}
-		if promotion.ReservedStepAliasRegex.MatchString(stepAlias) {
+		if step.Uses != "" || step.Task != nil || step.Config != nil || step.Retry != nil {
 			errs = append(
 				errs,
-				field.Invalid(
-					f.Index(i).Child("as"),
-					stepAlias,
-					"step alias is reserved",
+				field.Forbidden(
+					f.Index(i).Child("parallel"),
+					"a parallel group must not specify uses, task, config, or retry",
 				),
 			)
 		}
+		for j, parallelStep := range step.Parallel {
+			errs = append(
+				errs,
+				v.validateAlias(f.Index(i).Child("parallel").Index(j), parallelStep.As)...,
+			)
+		}
+	}
+	return errs
+}
+
+func (v *promotionStepsValidator) validateAlias(f *field.Path, alias string) field.ErrorList {
+	var errs field.ErrorList
+	stepAlias := strings.TrimSpace(alias)
+	if stepAlias == "" {
+		return nil
+	}
+	if existingPath, exists := v.pathsByAlias[stepAlias]; exists {
+		errs = append(
+			errs,
+			field.Invalid(
+				f.Child("as"),
+				stepAlias,
+				fmt.Sprintf("step alias duplicates that of %s", existingPath),
+			),
+		)
+	} else {
+		v.pathsByAlias[stepAlias] = f
+	}
+	if promotion.ReservedStepAliasRegex.MatchString(stepAlias) {
+		errs = append(
+			errs,
+			field.Invalid(
+				f.Child("as"),
+				stepAlias,
+				"step alias is reserved",
+			),
+		)
 	}
 	return errs
 }
@@ -1,10 +1,18 @@
 package webhook
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 )
@@ -55,6 +63,85 @@ func TestValidatePromotionSteps(t *testing.T) {
 				)
 			},
 		},
+		{
+			name: "parallel steps are valid",
+			steps: []kargoapi.PromotionStep{
+				{
+					As: "group",
+					Parallel: []kargoapi.ParallelPromotionStep{
+						{Uses: "fake-step"},
+						{Uses: "fake-step", As: "fake-step"},
+					},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+		{
+			name: "parallel steps are invalid",
+			steps: []kargoapi.PromotionStep{
+				{As: "commit"},
+				{
+					Uses: "fake-step",
+					Parallel: []kargoapi.ParallelPromotionStep{
+						{Uses: "fake-step", As: "step-1-1"}, // Reserved!
+						{Uses: "fake-step", As: "commit"},   // Duplicate!
+					},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeForbidden,
+							Field:    "steps[1].parallel",
+							BadValue: "",
+							Detail:   "a parallel group must not specify uses, task, config, or retry",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "steps[1].parallel[0].as",
+							BadValue: "step-1-1",
+							Detail:   "step alias is reserved",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "steps[1].parallel[1].as",
+							BadValue: "commit",
+							Detail:   "step alias duplicates that of steps[0]",
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "forEach combined with parallel",
+			steps: []kargoapi.PromotionStep{
+				{
+					ForEach: "${{ [1, 2] }}",
+					Parallel: []kargoapi.ParallelPromotionStep{
+						{Uses: "fake-step"},
+					},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeForbidden,
+							Field:    "steps[0].forEach",
+							BadValue: "",
+							Detail:   "forEach cannot be combined with parallel",
+						},
+					},
+					errs,
+				)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -65,3 +152,220 @@ func TestValidatePromotionSteps(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatePromotionStepsWithOnFailure(t *testing.T) {
+	errs := ValidatePromotionStepsWithOnFailure(
+		field.NewPath("steps"),
+		[]kargoapi.PromotionStep{
+			{As: "commit"},
+		},
+		field.NewPath("onFailure"),
+		[]kargoapi.PromotionStep{
+			{As: "revert"},
+			{As: "commit"}, // Duplicate!
+		},
+	)
+	require.Equal(
+		t,
+		field.ErrorList{
+			{
+				Type:     field.ErrorTypeInvalid,
+				Field:    "onFailure[1].as",
+				BadValue: "commit",
+				Detail:   "step alias duplicates that of steps[0]",
+			},
+		},
+		errs,
+	)
+}
+
+func TestValidatePromotionTaskSpec(t *testing.T) {
+	testCases := []struct {
+		name       string
+		spec       kargoapi.PromotionTaskSpec
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name: "spec is valid",
+			spec: kargoapi.PromotionTaskSpec{
+				Steps: []kargoapi.PromotionStep{
+					{Uses: "fake-step"},
+				},
+				OnFailure: []kargoapi.PromotionStep{
+					{Uses: "fake-step"},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+		{
+			name: "forEach is forbidden",
+			spec: kargoapi.PromotionTaskSpec{
+				Steps: []kargoapi.PromotionStep{
+					{Uses: "fake-step", ForEach: "${{ [1, 2] }}"},
+				},
+				OnFailure: []kargoapi.PromotionStep{
+					{Uses: "fake-step", ForEach: "${{ [1, 2] }}"},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeForbidden,
+							Field:    "spec.steps[0].forEach",
+							BadValue: "",
+							Detail:   "forEach is not supported within a PromotionTask",
+						},
+						{
+							Type:     field.ErrorTypeForbidden,
+							Field:    "spec.onFailure[0].forEach",
+							BadValue: "",
+							Detail:   "forEach is not supported within a PromotionTask",
+						},
+					},
+					errs,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				ValidatePromotionTaskSpec(field.NewPath("spec"), testCase.spec),
+			)
+		})
+	}
+}
+
+func TestValidateStepPluginConfigs(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	testPlugin := &kargoapi.StepPlugin{
+		ObjectMeta: metav1.ObjectMeta{Name: "fake-plugin"},
+		Spec: kargoapi.StepPluginSpec{
+			Address: "localhost:50051",
+			Steps: []kargoapi.StepPluginStep{{
+				Kind: "fake-step",
+				ConfigSchema: &apiextensionsv1.JSON{Raw: []byte(
+					`{"type":"object","required":["path"],"properties":{"path":{"type":"string"}}}`,
+				)},
+			}},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		interceptor interceptor.Funcs
+		steps       []kargoapi.PromotionStep
+		assertions  func(*testing.T, field.ErrorList)
+	}{
+		{
+			name: "no steps with config",
+			interceptor: interceptor.Funcs{
+				List: func(context.Context, client.WithWatch, client.ObjectList, ...client.ListOption) error {
+					return errors.New("StepPlugins should not have been listed")
+				},
+			},
+			steps: []kargoapi.PromotionStep{{Uses: "fake-step"}},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+		{
+			name: "config containing expressions is not validated",
+			interceptor: interceptor.Funcs{
+				List: func(context.Context, client.WithWatch, client.ObjectList, ...client.ListOption) error {
+					return errors.New("StepPlugins should not have been listed")
+				},
+			},
+			steps: []kargoapi.PromotionStep{{
+				Uses:   "fake-step",
+				Config: &apiextensionsv1.JSON{Raw: []byte(`{"path":"${{ vars.path }}"}`)},
+			}},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+		{
+			name: "error listing StepPlugins",
+			interceptor: interceptor.Funcs{
+				List: func(context.Context, client.WithWatch, client.ObjectList, ...client.ListOption) error {
+					return errors.New("something went wrong")
+				},
+			},
+			steps: []kargoapi.PromotionStep{{
+				Uses:   "fake-step",
+				Config: &apiextensionsv1.JSON{Raw: []byte(`{}`)},
+			}},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, field.ErrorTypeInternal, errs[0].Type)
+				require.Contains(t, errs[0].Detail, "something went wrong")
+			},
+		},
+		{
+			name: "step not executed by a StepPlugin",
+			steps: []kargoapi.PromotionStep{{
+				Uses:   "other-step",
+				Config: &apiextensionsv1.JSON{Raw: []byte(`{}`)},
+			}},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+		{
+			name: "invalid config",
+			steps: []kargoapi.PromotionStep{
+				{
+					Uses:   "fake-step",
+					Config: &apiextensionsv1.JSON{Raw: []byte(`{"path":"foo"}`)},
+				},
+				{
+					Parallel: []kargoapi.ParallelPromotionStep{{
+						Uses:   "fake-step",
+						Config: &apiextensionsv1.JSON{Raw: []byte(`{"path":42}`)},
+					}},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
+				require.Equal(t, "steps[1].parallel[0].config", errs[0].Field)
+				require.Contains(t, errs[0].Detail, "invalid fake-step config")
+			},
+		},
+		{
+			name: "valid config",
+			steps: []kargoapi.PromotionStep{{
+				Uses:   "fake-step",
+				Config: &apiextensionsv1.JSON{Raw: []byte(`{"path":"foo"}`)},
+			}},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(testPlugin).
+				WithInterceptorFuncs(testCase.interceptor).
+				Build()
+			testCase.assertions(
+				t,
+				ValidateStepPluginConfigs(
+					t.Context(),
+					c,
+					field.NewPath("steps"),
+					testCase.steps,
+				),
+			)
+		})
+	}
+}
@@ -54,7 +54,7 @@ func (w *webhook) ValidateCreate(
 	}
 	if errs = append(
 		errs,
-		w.validateSpec(field.NewPath("spec"), task.Spec)...,
+		w.validateSpec(ctx, field.NewPath("spec"), task.Spec)...,
 	); len(errs) > 0 {
 		return nil, apierrors.NewInvalid(promotionTaskGroupKind, task.Name, errs)
 	}
@@ -62,12 +62,12 @@ func (w *webhook) ValidateCreate(
 }
 
 func (w *webhook) ValidateUpdate(
-	_ context.Context,
+	ctx context.Context,
 	_ runtime.Object,
 	newObj runtime.Object,
 ) (admission.Warnings, error) {
 	task := newObj.(*kargoapi.PromotionTask) // nolint: forcetypeassert
-	if errs := w.validateSpec(field.NewPath("spec"), task.Spec); len(errs) > 0 {
+	if errs := w.validateSpec(ctx, field.NewPath("spec"), task.Spec); len(errs) > 0 {
 		return nil, apierrors.NewInvalid(promotionTaskGroupKind, task.Name, errs)
 	}
 	return nil, nil
@@ -82,8 +82,17 @@ func (w *webhook) ValidateDelete(
 }
 
 func (w *webhook) validateSpec(
+	ctx context.Context,
 	f *field.Path,
 	spec kargoapi.PromotionTaskSpec,
 ) field.ErrorList {
-	return libWebhook.ValidatePromotionSteps(f.Child("steps"), spec.Steps)
+	errs := libWebhook.ValidatePromotionTaskSpec(f, spec)
+	errs = append(
+		errs,
+		libWebhook.ValidateStepPluginConfigs(ctx, w.client, f.Child("steps"), spec.Steps)...,
+	)
+	return append(
+		errs,
+		libWebhook.ValidateStepPluginConfigs(ctx, w.client, f.Child("onFailure"), spec.OnFailure)...,
+	)
 }
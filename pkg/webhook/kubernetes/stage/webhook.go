@@ -46,6 +46,12 @@ type webhook struct {
 		[]kargoapi.PromotionStep,
 	) field.ErrorList
 
+	validateStepPluginConfigsFn func(
+		context.Context,
+		*field.Path,
+		kargoapi.StageSpec,
+	) field.ErrorList
+
 	isRequestFromKargoControlplaneFn libWebhook.IsRequestFromKargoControlplaneFn
 }
 
@@ -78,6 +84,7 @@ func newWebhook(
 	w.validateProjectFn = libWebhook.ValidateProject
 	w.validateSpecFn = w.validateSpec
 	w.validatePromotionStepTaskRefsFn = w.validatePromotionStepTaskRefs
+	w.validateStepPluginConfigsFn = w.validateStepPluginConfigs
 	w.isRequestFromKargoControlplaneFn =
 		libWebhook.IsRequestFromKargoControlplane(cfg.ControlplaneUserRegex)
 	return w
@@ -169,9 +176,10 @@ func (w *webhook) ValidateCreate(
 		}
 		errs = append(errs, fieldErr)
 	}
+	errs = append(errs, w.validateSpecFn(field.NewPath("spec"), stage.Spec)...)
 	if errs = append(
 		errs,
-		w.validateSpecFn(field.NewPath("spec"), stage.Spec)...,
+		w.validateStepPluginConfigsFn(ctx, field.NewPath("spec"), stage.Spec)...,
 	); len(errs) > 0 {
 		return nil, apierrors.NewInvalid(stageGroupKind, stage.Name, errs)
 	}
@@ -179,12 +187,16 @@ func (w *webhook) ValidateCreate(
 }
 
 func (w *webhook) ValidateUpdate(
-	_ context.Context,
+	ctx context.Context,
 	_ runtime.Object,
 	newObj runtime.Object,
 ) (admission.Warnings, error) {
 	stage := newObj.(*kargoapi.Stage) // nolint: forcetypeassert
-	if errs := w.validateSpecFn(field.NewPath("spec"), stage.Spec); len(errs) > 0 {
+	errs := w.validateSpecFn(field.NewPath("spec"), stage.Spec)
+	if errs = append(
+		errs,
+		w.validateStepPluginConfigsFn(ctx, field.NewPath("spec"), stage.Spec)...,
+	); len(errs) > 0 {
 		return nil, apierrors.NewInvalid(stageGroupKind, stage.Name, errs)
 	}
 	return nil, nil
@@ -210,9 +222,11 @@ func (w *webhook) validateSpec(
 
 	errs = append(
 		errs,
-		libWebhook.ValidatePromotionSteps(
+		libWebhook.ValidatePromotionStepsWithOnFailure(
 			f.Child("promotionTemplate").Child("spec").Child("steps"),
 			spec.PromotionTemplate.Spec.Steps,
+			f.Child("promotionTemplate").Child("spec").Child("onFailure"),
+			spec.PromotionTemplate.Spec.OnFailure,
 		)...,
 	)
 
@@ -224,9 +238,45 @@ func (w *webhook) validateSpec(
 		)...,
 	)
 
+	errs = append(
+		errs,
+		w.validatePromotionStepTaskRefsFn(
+			f.Child("promotionTemplate").Child("spec").Child("onFailure"),
+			spec.PromotionTemplate.Spec.OnFailure,
+		)...,
+	)
+
 	return errs
 }
 
+// validateStepPluginConfigs validates the configuration of PromotionTemplate
+// steps executed by StepPlugins against the config schemas advertised by those
+// StepPlugins.
+func (w *webhook) validateStepPluginConfigs(
+	ctx context.Context,
+	f *field.Path,
+	spec kargoapi.StageSpec,
+) field.ErrorList {
+	if spec.PromotionTemplate == nil {
+		return nil
+	}
+	f = f.Child("promotionTemplate").Child("spec")
+	return append(
+		libWebhook.ValidateStepPluginConfigs(
+			ctx,
+			w.client,
+			f.Child("steps"),
+			spec.PromotionTemplate.Spec.Steps,
+		),
+		libWebhook.ValidateStepPluginConfigs(
+			ctx,
+			w.client,
+			f.Child("onFailure"),
+			spec.PromotionTemplate.Spec.OnFailure,
+		)...,
+	)
+}
+
 func (w *webhook) validateRequestedFreight(
 	f *field.Path,
 	reqs []kargoapi.FreightRequest,
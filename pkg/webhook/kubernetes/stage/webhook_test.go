@@ -34,6 +34,8 @@ func TestNewWebhook(t *testing.T) {
 	require.NotNil(t, w.admissionRequestFromContextFn)
 	require.NotNil(t, w.validateProjectFn)
 	require.NotNil(t, w.validateSpecFn)
+	require.NotNil(t, w.validatePromotionStepTaskRefsFn)
+	require.NotNil(t, w.validateStepPluginConfigsFn)
 	require.NotNil(t, w.isRequestFromKargoControlplaneFn)
 }
 
@@ -878,6 +880,13 @@ func Test_webhook_ValidateCreate(t *testing.T) {
 						field.Invalid(field.NewPath(""), "", "something went wrong"),
 					}
 				},
+				validateStepPluginConfigsFn: func(
+					context.Context,
+					*field.Path,
+					kargoapi.StageSpec,
+				) field.ErrorList {
+					return nil
+				},
 			},
 			assertions: func(t *testing.T, err error) {
 				require.Error(t, err)
@@ -887,6 +896,37 @@ func Test_webhook_ValidateCreate(t *testing.T) {
 				require.Contains(t, statusErr.ErrStatus.Message, "something went wrong")
 			},
 		},
+		{
+			name: "error validating step plugin configs",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					client.Object,
+				) error {
+					return nil
+				},
+				validateSpecFn: func(*field.Path, kargoapi.StageSpec) field.ErrorList {
+					return nil
+				},
+				validateStepPluginConfigsFn: func(
+					context.Context,
+					*field.Path,
+					kargoapi.StageSpec,
+				) field.ErrorList {
+					return field.ErrorList{
+						field.Invalid(field.NewPath(""), "", "invalid plugin config"),
+					}
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.Error(t, err)
+				var statusErr *apierrors.StatusError
+				require.True(t, errors.As(err, &statusErr))
+				require.Equal(t, metav1.StatusReasonInvalid, statusErr.ErrStatus.Reason)
+				require.Contains(t, statusErr.ErrStatus.Message, "invalid plugin config")
+			},
+		},
 		{
 			name: "success",
 			webhook: &webhook{
@@ -900,6 +940,13 @@ func Test_webhook_ValidateCreate(t *testing.T) {
 				validateSpecFn: func(*field.Path, kargoapi.StageSpec) field.ErrorList {
 					return nil
 				},
+				validateStepPluginConfigsFn: func(
+					context.Context,
+					*field.Path,
+					kargoapi.StageSpec,
+				) field.ErrorList {
+					return nil
+				},
 			},
 			assertions: func(t *testing.T, err error) {
 				require.NoError(t, err)
@@ -931,6 +978,13 @@ func Test_webhook_ValidateUpdate(t *testing.T) {
 						field.Invalid(field.NewPath(""), "", "something went wrong"),
 					}
 				},
+				validateStepPluginConfigsFn: func(
+					context.Context,
+					*field.Path,
+					kargoapi.StageSpec,
+				) field.ErrorList {
+					return nil
+				},
 			},
 			assertions: func(t *testing.T, err error) {
 				require.Error(t, err)
@@ -940,12 +994,43 @@ func Test_webhook_ValidateUpdate(t *testing.T) {
 				require.Contains(t, statusErr.ErrStatus.Message, "something went wrong")
 			},
 		},
+		{
+			name: "error validating step plugin configs",
+			webhook: &webhook{
+				validateSpecFn: func(*field.Path, kargoapi.StageSpec) field.ErrorList {
+					return nil
+				},
+				validateStepPluginConfigsFn: func(
+					context.Context,
+					*field.Path,
+					kargoapi.StageSpec,
+				) field.ErrorList {
+					return field.ErrorList{
+						field.Invalid(field.NewPath(""), "", "invalid plugin config"),
+					}
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.Error(t, err)
+				var statusErr *apierrors.StatusError
+				require.True(t, errors.As(err, &statusErr))
+				require.Equal(t, metav1.StatusReasonInvalid, statusErr.ErrStatus.Reason)
+				require.Contains(t, statusErr.ErrStatus.Message, "invalid plugin config")
+			},
+		},
 		{
 			name: "success",
 			webhook: &webhook{
 				validateSpecFn: func(*field.Path, kargoapi.StageSpec) field.ErrorList {
 					return nil
 				},
+				validateStepPluginConfigsFn: func(
+					context.Context,
+					*field.Path,
+					kargoapi.StageSpec,
+				) field.ErrorList {
+					return nil
+				},
 			},
 			assertions: func(t *testing.T, err error) {
 				require.NoError(t, err)
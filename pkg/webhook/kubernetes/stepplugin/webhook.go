@@ -0,0 +1,146 @@
+package stepplugin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/xeipuuv/gojsonschema"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+)
+
+var stepPluginGroupKind = schema.GroupKind{
+	Group: kargoapi.GroupVersion.Group,
+	Kind:  "StepPlugin",
+}
+
+type webhook struct {
+	client client.Client
+	// builtins is the registry of built-in steps, whose kinds StepPlugins must
+	// not claim.
+	builtins promotion.StepRunnerRegistry
+}
+
+func SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&kargoapi.StepPlugin{}).
+		WithValidator(&webhook{
+			client:   mgr.GetClient(),
+			builtins: promotion.DefaultStepRunnerRegistry,
+		}).
+		Complete()
+}
+
+func (w *webhook) ValidateCreate(
+	ctx context.Context,
+	obj runtime.Object,
+) (admission.Warnings, error) {
+	return nil, w.validate(ctx, obj.(*kargoapi.StepPlugin)) // nolint: forcetypeassert
+}
+
+func (w *webhook) ValidateUpdate(
+	ctx context.Context,
+	_ runtime.Object,
+	newObj runtime.Object,
+) (admission.Warnings, error) {
+	return nil, w.validate(ctx, newObj.(*kargoapi.StepPlugin)) // nolint: forcetypeassert
+}
+
+func (w *webhook) ValidateDelete(
+	context.Context,
+	runtime.Object,
+) (admission.Warnings, error) {
+	// No-op
+	return nil, nil
+}
+
+func (w *webhook) validate(ctx context.Context, plugin *kargoapi.StepPlugin) error {
+	plugins := &kargoapi.StepPluginList{}
+	if err := w.client.List(ctx, plugins); err != nil {
+		return apierrors.NewInternalError(
+			fmt.Errorf("error listing StepPlugins: %w", err),
+		)
+	}
+	if errs := w.validateSpec(
+		field.NewPath("spec"),
+		plugin.Name,
+		plugin.Spec,
+		plugins.Items,
+	); len(errs) > 0 {
+		return apierrors.NewInvalid(stepPluginGroupKind, plugin.Name, errs)
+	}
+	return nil
+}
+
+// validateSpec validates that each kind of step executed by the StepPlugin is
+// unique, is not the kind of a built-in step, and is not executed by any of the
+// other provided StepPlugins. It also validates that the config schema of each
+// step, if any, is a valid JSON schema.
+func (w *webhook) validateSpec(
+	f *field.Path,
+	name string,
+	spec kargoapi.StepPluginSpec,
+	others []kargoapi.StepPlugin,
+) field.ErrorList {
+	pluginsByKind := make(map[string]string)
+	for _, other := range others {
+		if other.Name == name {
+			continue
+		}
+		for _, step := range other.Spec.Steps {
+			pluginsByKind[step.Kind] = other.Name
+		}
+	}
+
+	var errs field.ErrorList
+	kinds := make(map[string]struct{}, len(spec.Steps))
+	for i, step := range spec.Steps {
+		stepPath := f.Child("steps").Index(i)
+		if _, exists := kinds[step.Kind]; exists {
+			errs = append(errs, field.Duplicate(stepPath.Child("kind"), step.Kind))
+		}
+		kinds[step.Kind] = struct{}{}
+		if _, err := w.builtins.Get(step.Kind); err == nil {
+			errs = append(
+				errs,
+				field.Forbidden(
+					stepPath.Child("kind"),
+					fmt.Sprintf("%q is the kind of a built-in step", step.Kind),
+				),
+			)
+		}
+		if other, exists := pluginsByKind[step.Kind]; exists {
+			errs = append(
+				errs,
+				field.Invalid(
+					stepPath.Child("kind"),
+					step.Kind,
+					fmt.Sprintf("steps of this kind are already executed by StepPlugin %q", other),
+				),
+			)
+		}
+		if step.ConfigSchema != nil && len(step.ConfigSchema.Raw) > 0 {
+			if _, err := gojsonschema.NewSchema(
+				gojsonschema.NewBytesLoader(step.ConfigSchema.Raw),
+			); err != nil {
+				errs = append(
+					errs,
+					field.Invalid(
+						stepPath.Child("configSchema"),
+						string(step.ConfigSchema.Raw),
+						fmt.Sprintf("invalid JSON schema: %s", err),
+					),
+				)
+			}
+		}
+	}
+	return errs
+}
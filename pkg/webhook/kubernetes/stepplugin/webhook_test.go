@@ -0,0 +1,158 @@
+package stepplugin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+)
+
+func Test_webhook_ValidateCreate(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	testPlugin := &kargoapi.StepPlugin{
+		ObjectMeta: metav1.ObjectMeta{Name: "fake-plugin"},
+		Spec: kargoapi.StepPluginSpec{
+			Address: "localhost:50051",
+			Steps:   []kargoapi.StepPluginStep{{Kind: "fake-step"}},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		interceptor interceptor.Funcs
+		assertions  func(*testing.T, error)
+	}{
+		{
+			name: "error listing StepPlugins",
+			interceptor: interceptor.Funcs{
+				List: func(context.Context, client.WithWatch, client.ObjectList, ...client.ListOption) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.True(t, apierrors.IsInternalError(err))
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "success",
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			w := &webhook{
+				client: fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithInterceptorFuncs(testCase.interceptor).
+					Build(),
+				builtins: promotion.MustNewStepRunnerRegistry(),
+			}
+			_, err := w.ValidateCreate(t.Context(), testPlugin)
+			testCase.assertions(t, err)
+		})
+	}
+}
+
+func Test_webhook_validateSpec(t *testing.T) {
+	w := &webhook{
+		builtins: promotion.MustNewStepRunnerRegistry(
+			promotion.StepRunnerRegistration{
+				Name: "builtin-step",
+				Value: func(promotion.StepRunnerCapabilities) promotion.StepRunner {
+					return &promotion.MockStepRunner{}
+				},
+			},
+		),
+	}
+	others := []kargoapi.StepPlugin{
+		{
+			// The plugin being validated is expected to be excluded
+			ObjectMeta: metav1.ObjectMeta{Name: "fake-plugin"},
+			Spec: kargoapi.StepPluginSpec{
+				Steps: []kargoapi.StepPluginStep{{Kind: "fake-step"}},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-plugin"},
+			Spec: kargoapi.StepPluginSpec{
+				Steps: []kargoapi.StepPluginStep{{Kind: "other-step"}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		spec       kargoapi.StepPluginSpec
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name: "invalid",
+			spec: kargoapi.StepPluginSpec{
+				Steps: []kargoapi.StepPluginStep{
+					{Kind: "fake-step"},
+					{Kind: "fake-step"}, // Duplicate!
+					{Kind: "builtin-step"},
+					{Kind: "other-step"},
+					{
+						Kind:         "another-step",
+						ConfigSchema: &apiextensionsv1.JSON{Raw: []byte(`{"type":42}`)},
+					},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 4)
+				require.Equal(t, field.ErrorTypeDuplicate, errs[0].Type)
+				require.Equal(t, "spec.steps[1].kind", errs[0].Field)
+				require.Equal(t, field.ErrorTypeForbidden, errs[1].Type)
+				require.Equal(t, "spec.steps[2].kind", errs[1].Field)
+				require.Equal(t, field.ErrorTypeInvalid, errs[2].Type)
+				require.Equal(t, "spec.steps[3].kind", errs[2].Field)
+				require.Contains(t, errs[2].Detail, `"other-plugin"`)
+				require.Equal(t, field.ErrorTypeInvalid, errs[3].Type)
+				require.Equal(t, "spec.steps[4].configSchema", errs[3].Field)
+			},
+		},
+		{
+			name: "valid",
+			spec: kargoapi.StepPluginSpec{
+				Steps: []kargoapi.StepPluginStep{
+					{Kind: "fake-step"},
+					{
+						Kind: "another-step",
+						ConfigSchema: &apiextensionsv1.JSON{
+							Raw: []byte(`{"type":"object","properties":{"path":{"type":"string"}}}`),
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				w.validateSpec(field.NewPath("spec"), "fake-plugin", testCase.spec, others),
+			)
+		})
+	}
+}
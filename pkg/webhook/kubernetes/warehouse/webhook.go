@@ -75,7 +75,7 @@ func (w *webhook) Default(ctx context.Context, obj runtime.Object) error {
 			return err
 		}
 		// The registration's value is a factory function
-		subscriber, err := subReg.Value(ctx, nil)
+		subscriber, err := subReg.Value(ctx, nil, nil)
 		if err != nil {
 			return fmt.Errorf("error instantiating subscriber: %w", err)
 		}
@@ -201,7 +201,7 @@ func (w *webhook) validateSub(
 		)}
 	}
 	// The registration's value is a factory function
-	subscriber, err := subReg.Value(ctx, nil)
+	subscriber, err := subReg.Value(ctx, nil, nil)
 	if err != nil {
 		return field.ErrorList{field.Invalid(
 			f,
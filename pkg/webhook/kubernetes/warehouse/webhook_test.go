@@ -11,6 +11,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
@@ -30,7 +31,7 @@ func init() {
 			// Match all subscriptions for testing purposes
 			return true, nil
 		},
-		Value: func(context.Context, credentials.Database) (subscription.Subscriber, error) {
+		Value: func(context.Context, client.Client, credentials.Database) (subscription.Subscriber, error) {
 			const testDiscoveryLimit = 42
 			return &subscription.MockSubscriber{
 				ApplySubscriptionDefaultsFn: func(_ context.Context, sub *kargoapi.RepoSubscription) error {
@@ -111,6 +111,28 @@ type ArgoCDKustomizeImageUpdate struct {
 	Tag string `json:"tag,omitempty"`
 }
 
+type ContainerRunConfig struct {
+	// The arguments to the command. If not specified, the image's default arguments are used.
+	Args []string `json:"args,omitempty"`
+	// The command to execute. If not specified, the image's entrypoint is used.
+	Command []string `json:"command,omitempty"`
+	// Environment variables to set in the container.
+	Env []EnvVar `json:"env,omitempty"`
+	// The container image to run.
+	Image string `json:"image"`
+	// The path, relative to the working directory of the Promotion, to use as the working
+	// directory of the container. If not specified, the working directory of the Promotion is
+	// used.
+	Path string `json:"path,omitempty"`
+}
+
+type EnvVar struct {
+	// The name of the environment variable.
+	Name string `json:"name"`
+	// The value of the environment variable.
+	Value string `json:"value"`
+}
+
 type CopyConfig struct {
 	// Ignore is a (multiline) string of glob patterns to ignore when copying files. It accepts
 	// the same syntax as .gitignore files.
@@ -121,6 +143,29 @@ type CopyConfig struct {
 	OutPath string `json:"outPath"`
 }
 
+type CueExportConfig struct {
+	// An expression selecting the value to export. If not specified, the entire package is
+	// exported.
+	Expression string `json:"expression,omitempty"`
+	// Path to the file to write the exported output to. If the path has a .json extension, the
+	// output is written as JSON. Otherwise, it is written as YAML.
+	OutPath string `json:"outPath"`
+	// The name of the package to export. Only required when the directory contains more than
+	// one package.
+	Package string `json:"package,omitempty"`
+	// Path to the directory containing the CUE package to export.
+	Path string `json:"path"`
+	// Values to inject into fields marked with @tag() attributes.
+	Tags []CueTag `json:"tags,omitempty"`
+}
+
+type CueTag struct {
+	// The name of the tag.
+	Name string `json:"name"`
+	// The value to inject into fields marked with the tag.
+	Value string `json:"value"`
+}
+
 type DeleteConfig struct {
 	// Path is the path to the file or directory to delete.
 	Path string `json:"path"`
@@ -128,6 +173,66 @@ type DeleteConfig struct {
 	Strict bool `json:"strict,omitempty"`
 }
 
+type DotenvUpdateConfig struct {
+	// The path to a .env file.
+	Path string `json:"path"`
+	// A list of updates to apply to the .env file.
+	Updates []DotenvUpdate `json:"updates"`
+}
+
+type DotenvUpdate struct {
+	// The name of the variable whose value needs to be updated. If the variable does not exist,
+	// it is added to the end of the file.
+	Key string `json:"key"`
+	// The new value for the specified key. Must be a string, number, or boolean.
+	Value interface{} `json:"value"`
+}
+
+type FluxUpdateConfig struct {
+	// Resources describes Flux resources to update and reconcile.
+	Resources []FluxResourceUpdate `json:"resources"`
+}
+
+type FluxResourceUpdate struct {
+	// The API version of the Flux resource. If not specified, the latest stable API version of
+	// the kind is used.
+	APIVersion string `json:"apiVersion,omitempty"`
+	// The version of the chart to pin a HelmRelease to. Only applicable to HelmReleases that
+	// reference a chart by name.
+	ChartVersion string `json:"chartVersion,omitempty"`
+	// The revision the Flux resource is expected to reconcile for it to be considered healthy.
+	// Flux revisions have the format '<ref>@<algorithm>:<checksum>'. A commit ID, digest, or
+	// chart version matching the corresponding part of the revision is sufficient. If not
+	// specified, it is derived from the commit, digest, or chart version being pinned, if any.
+	DesiredRevision string `json:"desiredRevision,omitempty"`
+	// The kind of the Flux resource.
+	Kind FluxResourceKind `json:"kind"`
+	// The name of the Flux resource.
+	Name string `json:"name"`
+	// The namespace of the Flux resource. Defaults to 'flux-system'.
+	Namespace string `json:"namespace,omitempty"`
+	// The reference to pin a GitRepository or OCIRepository to. It replaces the source's
+	// existing reference.
+	Ref *FluxSourceRef `json:"ref,omitempty"`
+}
+
+// The reference to pin a GitRepository or OCIRepository to. It replaces the source's
+// existing reference.
+type FluxSourceRef struct {
+	// The Git branch to pin a GitRepository to.
+	Branch string `json:"branch,omitempty"`
+	// The Git commit to pin a GitRepository to.
+	Commit string `json:"commit,omitempty"`
+	// The digest to pin an OCIRepository to.
+	Digest string `json:"digest,omitempty"`
+	// The Git reference name (e.g. 'refs/heads/main') to pin a GitRepository to.
+	Name string `json:"name,omitempty"`
+	// The semantic version range to pin a GitRepository or OCIRepository to.
+	Semver string `json:"semver,omitempty"`
+	// The tag to pin a GitRepository or OCIRepository to.
+	Tag string `json:"tag,omitempty"`
+}
+
 type GitClearConfig struct {
 	// Path to a working directory of a local repository from which to remove all files,
 	// excluding the .git/ directory.
@@ -295,6 +400,22 @@ type GitWaitForPRConfig struct {
 	RepoURL string `json:"repoURL"`
 }
 
+type HCLAttributeUpdateConfig struct {
+	// The path to an HCL file.
+	Path string `json:"path"`
+	// A list of updates to apply to the HCL file.
+	Updates []HCLAttributeUpdate `json:"updates"`
+}
+
+type HCLAttributeUpdate struct {
+	// The key whose value needs to be updated. For nested values, use a dot notation path in
+	// which blocks are identified by their type followed by their labels. If the key does not
+	// exist, it is added as an attribute to the body that contains it.
+	Key string `json:"key"`
+	// The new value for the specified key. Must be a string, number, or boolean.
+	Value interface{} `json:"value"`
+}
+
 type HelmTemplateConfig struct {
 	// APIVersions allows a manual set of supported API Versions to be passed when rendering the
 	// manifests.
@@ -449,6 +570,24 @@ type HTTPDownloadConfigQueryParam struct {
 	Value string `json:"value"`
 }
 
+type ImageCopyConfig struct {
+	// Digest of the image to copy. Mutually exclusive with 'tag'.
+	Digest string `json:"digest,omitempty"`
+	// Whether to skip TLS verification when interacting with either repository. Defaults to
+	// false.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+	// RepoURL is the URL of the image repository to copy the image from. This is typically the
+	// repository URL of an image subscription.
+	RepoURL string `json:"repoURL"`
+	// Tag of the image to copy. Mutually exclusive with 'digest'.
+	Tag string `json:"tag,omitempty"`
+	// TargetRepoURL is the URL of the image repository to copy the image to.
+	TargetRepoURL string `json:"targetRepoURL"`
+	// TargetTag is the tag to apply to the image in the target repository. If not specified,
+	// the image is copied to the target repository by digest only.
+	TargetTag string `json:"targetTag,omitempty"`
+}
+
 type JSONParseConfig struct {
 	// An array of outputs to extract from the JSON file.
 	Outputs []JSONParse `json:"outputs"`
@@ -477,6 +616,81 @@ type JSONUpdate struct {
 	Value interface{} `json:"value"`
 }
 
+type JsonnetRenderConfig struct {
+	// External variables, accessible via std.extVar().
+	ExtVars []JsonnetVar `json:"extVars,omitempty"`
+	// Library paths in which to search for imported files. When a file is found in more than
+	// one library path, the last one wins.
+	JPaths []string `json:"jPaths,omitempty"`
+	// Path to the file to write the rendered output to. If the path has a .json extension, the
+	// output is written as JSON. Otherwise, it is written as YAML.
+	OutPath string `json:"outPath"`
+	// Path to the Jsonnet file to render.
+	Path string `json:"path"`
+	// Top-level arguments, passed to the function the Jsonnet file evaluates to.
+	TLAs []JsonnetVar `json:"tlas,omitempty"`
+}
+
+type JsonnetVar struct {
+	// Whether the value is Jsonnet code to be evaluated rather than a string.
+	Code bool `json:"code,omitempty"`
+	// The name of the variable.
+	Name string `json:"name"`
+	// The value of the variable.
+	Value string `json:"value"`
+}
+
+type KubernetesApplyConfig struct {
+	// The name of the field manager to apply the manifests as. Defaults to 'kargo'.
+	FieldManager string `json:"fieldManager,omitempty"`
+	// Whether to take ownership of fields managed by other field managers when they conflict
+	// with the applied manifests. Defaults to false.
+	Force bool `json:"force,omitempty"`
+	// A reference to a Secret in the Project namespace that holds a kubeconfig for the target
+	// cluster.
+	KubeconfigSecret KubeconfigSecretRef `json:"kubeconfigSecret"`
+	// The manifests to apply, as one or more YAML or JSON documents. Mutually exclusive with
+	// 'path'.
+	Manifests string `json:"manifests,omitempty"`
+	// The namespace to apply namespaced resources to when their manifests do not specify one.
+	// If not specified, the namespace of the kubeconfig's current context is used, falling back
+	// to 'default'.
+	Namespace string `json:"namespace,omitempty"`
+	// Path to a file or directory containing the manifests to apply. If a directory is
+	// specified, all YAML and JSON files beneath it are applied. This path is relative to the
+	// temporary workspace that Kargo provisions for use by the promotion process. Mutually
+	// exclusive with 'manifests'.
+	Path string `json:"path,omitempty"`
+	// Prune enables the deletion of resources that were previously applied by this step but
+	// are no longer among the applied manifests. The specified labels are added to every
+	// applied resource. After the manifests are applied, any resource of the same kind, in the
+	// same namespace, that carries all of these labels but is not among the applied manifests
+	// is deleted.
+	Prune *KubernetesPrune `json:"prune,omitempty"`
+	// Whether to wait for all applied resources to become ready before the step is considered
+	// complete. Defaults to false.
+	Wait bool `json:"wait,omitempty"`
+}
+
+// A reference to a Secret in the Project namespace that holds a kubeconfig for the target
+// cluster.
+type KubeconfigSecretRef struct {
+	// The key of the Secret's data that holds the kubeconfig. Defaults to 'kubeconfig'.
+	Key string `json:"key,omitempty"`
+	// The name of the Secret.
+	Name string `json:"name"`
+}
+
+// Prune enables the deletion of resources that were previously applied by this step but
+// are no longer among the applied manifests. The specified labels are added to every
+// applied resource. After the manifests are applied, any resource of the same kind, in the
+// same namespace, that carries all of these labels but is not among the applied manifests
+// is deleted.
+type KubernetesPrune struct {
+	// The labels identifying the resources managed by this step.
+	Labels map[string]string `json:"labels"`
+}
+
 type KustomizeBuildConfig struct {
 	// OutPath is the file path to write the built manifests to.
 	OutPath string `json:"outPath"`
@@ -548,6 +762,27 @@ type OCIDownloadConfig struct {
 	OutPath string `json:"outPath"`
 }
 
+type OCIPushConfig struct {
+	// Annotations to add to the artifact's manifest. These take precedence over the
+	// annotations Kargo adds by default.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// ConfigMediaType is the media type of the artifact's config, which is commonly used to
+	// identify the type of the artifact. Defaults to 'application/vnd.kargo.config.v1+json'.
+	ConfigMediaType string `json:"configMediaType,omitempty"`
+	// ImageRef is the reference, in the format 'registry/repository:tag', to push the
+	// artifact to.
+	ImageRef string `json:"imageRef"`
+	// Whether to skip TLS verification when pushing the artifact. Defaults to false.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+	// MediaType of the artifact's layer. If not specified,
+	// 'application/vnd.oci.image.layer.v1.tar+gzip' is used for a directory and
+	// 'application/octet-stream' for a file.
+	MediaType string `json:"mediaType,omitempty"`
+	// Path to the file or directory to package. A directory is packaged as a single gzipped
+	// tarball layer. A file is packaged as a single layer containing the file as-is.
+	Path string `json:"path"`
+}
+
 type SetMetadataConfig struct {
 	// List of metadata updates to apply to various resources
 	Updates []Update `json:"updates"`
@@ -562,6 +797,22 @@ type Update struct {
 	Values map[string]interface{} `json:"values"`
 }
 
+type TOMLUpdateConfig struct {
+	// The path to a TOML file.
+	Path string `json:"path"`
+	// A list of updates to apply to the TOML file.
+	Updates []TOMLUpdate `json:"updates"`
+}
+
+type TOMLUpdate struct {
+	// The key whose value needs to be updated. For nested values, use a TOML dot notation path.
+	// If the key does not exist, it is added to the table that contains it, which must be the
+	// root table or a table with its own header.
+	Key string `json:"key"`
+	// The new value for the specified key. Must be a string, number, or boolean.
+	Value interface{} `json:"value"`
+}
+
 type UntarConfig struct {
 	// Ignore is a (multiline) string of glob patterns to ignore when extracting files. It
 	// accepts the same syntax as .gitignore files.
@@ -624,6 +875,16 @@ const (
 	NotIn        Operator = "NotIn"
 )
 
+// The kind of the Flux resource.
+type FluxResourceKind string
+
+const (
+	GitRepository FluxResourceKind = "GitRepository"
+	HelmRelease   FluxResourceKind = "HelmRelease"
+	Kustomization FluxResourceKind = "Kustomization"
+	OCIRepository FluxResourceKind = "OCIRepository"
+)
+
 // The name of the Git provider to use. Currently 'azure', 'bitbucket', 'gitea', 'github',
 // and 'gitlab' are supported. Kargo will try to infer the provider if it is not explicitly
 // specified.